@@ -61,3 +61,56 @@ func ResolveClientWithOverride(email string, override string) (string, error) {
 
 	return client, nil
 }
+
+type serviceAccountOnlyKey struct{}
+
+// WithServiceAccountOnly marks ctx so API clients must authenticate with a
+// stored service account key instead of falling back to OAuth tokens.
+func WithServiceAccountOnly(ctx context.Context, only bool) context.Context {
+	if !only {
+		return ctx
+	}
+
+	return context.WithValue(ctx, serviceAccountOnlyKey{}, true)
+}
+
+func ServiceAccountOnly(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	only, _ := ctx.Value(serviceAccountOnlyKey{}).(bool)
+
+	return only
+}
+
+type impersonationKey struct{}
+
+type impersonation struct {
+	subject    string
+	keyAccount string
+}
+
+// WithImpersonation makes API clients for subject mint delegated tokens with
+// the service account key stored for keyAccount (empty: the only stored key).
+func WithImpersonation(ctx context.Context, subject string, keyAccount string) context.Context {
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, impersonationKey{}, impersonation{subject: subject, keyAccount: strings.TrimSpace(keyAccount)})
+}
+
+func Impersonation(ctx context.Context) (string, string, bool) {
+	if ctx == nil {
+		return "", "", false
+	}
+
+	v, ok := ctx.Value(impersonationKey{}).(impersonation)
+	if !ok {
+		return "", "", false
+	}
+
+	return v.subject, v.keyAccount, true
+}
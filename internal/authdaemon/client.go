@@ -0,0 +1,126 @@
+package authdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const clientTimeout = 10 * time.Second
+
+// SocketPath returns the daemon socket from GOG_AUTH_SOCKET, or "" when unset.
+func SocketPath() string {
+	return strings.TrimSpace(os.Getenv(SocketEnv))
+}
+
+// FetchToken asks the daemon listening on socket for an access token.
+func FetchToken(ctx context.Context, socket string, r Request) (Token, error) {
+	client := &http.Client{
+		Timeout: clientTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+
+	query := url.Values{"account": {r.Email}}
+	if r.Client != "" {
+		query.Set("client", r.Client)
+	}
+
+	for _, scope := range r.Scopes {
+		query.Add("scope", scope)
+	}
+
+	// The host is ignored; requests go to the socket.
+	endpoint := "http://gog-auth-daemon/v1/token?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Token{}, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Token{}, fmt.Errorf("auth daemon: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return Token{}, fmt.Errorf("auth daemon: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var payload struct {
+			Error string `json:"error"`
+		}
+
+		_ = json.Unmarshal(body, &payload)
+
+		if resp.StatusCode == http.StatusNotFound {
+			return Token{}, fmt.Errorf("%w: %s", ErrUnknownAccount, describe(r.Client, r.Email))
+		}
+
+		return Token{}, fmt.Errorf("auth daemon: HTTP %d: %s", resp.StatusCode, payload.Error)
+	}
+
+	var tok Token
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return Token{}, fmt.Errorf("decode auth daemon token: %w", err)
+	}
+
+	return tok, nil
+}
+
+type tokenSource struct {
+	ctx      context.Context //nolint:containedctx // oauth2.TokenSource has no context parameter
+	socket   string
+	request  Request
+	fallback func() (oauth2.TokenSource, error)
+
+	mu       sync.Mutex
+	fellBack oauth2.TokenSource
+}
+
+// NewTokenSource serves tokens for r from the daemon at socket. When the
+// daemon is unreachable or does not hold r's client and account, it switches
+// to fallback for good.
+func NewTokenSource(ctx context.Context, socket string, r Request, fallback func() (oauth2.TokenSource, error)) oauth2.TokenSource {
+	return &tokenSource{ctx: ctx, socket: socket, request: r, fallback: fallback}
+}
+
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fellBack == nil {
+		tok, err := FetchToken(s.ctx, s.socket, s.request)
+		if err == nil {
+			return &oauth2.Token{AccessToken: tok.AccessToken, TokenType: tok.TokenType, Expiry: tok.Expiry}, nil
+		}
+
+		slog.Debug("auth daemon unavailable; using stored refresh token", "email", s.request.Email, "client", s.request.Client, "err", err)
+
+		ts, fbErr := s.fallback()
+		if fbErr != nil {
+			return nil, fbErr
+		}
+
+		s.fellBack = ts
+	}
+
+	return s.fellBack.Token()
+}
@@ -0,0 +1,390 @@
+// Package authdaemon keeps OAuth access tokens fresh in a long-running process
+// and serves them to gog commands over a local unix socket.
+package authdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+// SocketEnv points gog commands at a running `gog auth daemon`.
+const SocketEnv = "GOG_AUTH_SOCKET"
+
+const (
+	// minValidity is the shortest remaining lifetime a served token may have.
+	minValidity = time.Minute
+	minRetry    = 30 * time.Second
+	maxRetry    = 5 * time.Minute
+)
+
+var (
+	ErrUnknownAccount = errors.New("account not served by auth daemon")
+	errAlreadyRunning = errors.New("auth daemon already listening")
+)
+
+// Token is an access token handed out by the daemon.
+type Token struct {
+	Account     string    `json:"account"`
+	Client      string    `json:"client"`
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// Account is an OAuth client and email the daemon holds a refresh token for.
+type Account struct {
+	Client string
+	Email  string
+}
+
+// Request names the token a command needs. Scopes are what the command would
+// request when refreshing the stored token itself; empty means everything the
+// refresh token was granted.
+type Request struct {
+	Client string
+	Email  string
+	Scopes []string
+}
+
+// AccountStatus is the daemon's view of one account.
+type AccountStatus struct {
+	Account     string    `json:"account"`
+	Client      string    `json:"client"`
+	Expiry      time.Time `json:"expiry,omitzero"`
+	RefreshedAt time.Time `json:"refreshed_at,omitzero"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+// Minter exchanges the stored refresh token of acc for a new access token
+// limited to scopes (all granted scopes when empty).
+type Minter func(ctx context.Context, acc Account, scopes []string) (accessToken string, expiry time.Time, err error)
+
+type account struct {
+	mu          sync.Mutex
+	id          Account
+	tokens      map[string]Token // by scopeKey
+	refreshedAt time.Time
+	lastErr     string
+}
+
+type Server struct {
+	mint          Minter
+	refreshBefore time.Duration
+	accounts      map[string]*account // by accountKey
+	now           func() time.Time
+}
+
+// NewServer serves accounts, minting a replacement refreshBefore ahead of
+// each token's expiry.
+func NewServer(accounts []Account, mint Minter, refreshBefore time.Duration) *Server {
+	s := &Server{
+		mint:          mint,
+		refreshBefore: refreshBefore,
+		accounts:      make(map[string]*account, len(accounts)),
+		now:           time.Now,
+	}
+
+	for _, acc := range accounts {
+		key := accountKey(acc.Client, acc.Email)
+		if normalizeEmail(acc.Email) == "" {
+			continue
+		}
+
+		s.accounts[key] = &account{
+			id:     Account{Client: strings.TrimSpace(acc.Client), Email: strings.TrimSpace(acc.Email)},
+			tokens: map[string]Token{},
+		}
+	}
+
+	return s
+}
+
+// Token returns a cached token for req, minting one when the cached token is
+// missing or about to expire. Tokens are cached per client, account and
+// scope set, so a command never gets more (or less) than it asked for.
+func (s *Server) Token(ctx context.Context, req Request) (Token, error) {
+	acc, ok := s.lookup(req.Client, req.Email)
+	if !ok {
+		return Token{}, fmt.Errorf("%w: %s", ErrUnknownAccount, describe(req.Client, req.Email))
+	}
+
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	scopes := normalizeScopes(req.Scopes)
+	if tok := acc.tokens[scopeKey(scopes)]; tok.AccessToken != "" && tok.Expiry.After(s.now().Add(minValidity)) {
+		return tok, nil
+	}
+
+	return s.refreshLocked(ctx, acc, scopes)
+}
+
+// lookup finds the account for client and email. Without a client, the
+// email must be unambiguous.
+func (s *Server) lookup(client string, email string) (*account, bool) {
+	if strings.TrimSpace(client) != "" {
+		acc, ok := s.accounts[accountKey(client, email)]
+
+		return acc, ok
+	}
+
+	var found *account
+
+	for _, acc := range s.accounts {
+		if normalizeEmail(acc.id.Email) != normalizeEmail(email) {
+			continue
+		}
+
+		if found != nil {
+			return nil, false
+		}
+
+		found = acc
+	}
+
+	return found, found != nil
+}
+
+func (s *Server) refreshLocked(ctx context.Context, acc *account, scopes []string) (Token, error) {
+	accessToken, expiry, err := s.mint(ctx, acc.id, scopes)
+	if err != nil {
+		acc.lastErr = err.Error()
+
+		return Token{}, err
+	}
+
+	tok := Token{Account: acc.id.Email, Client: acc.id.Client, AccessToken: accessToken, TokenType: "Bearer", Expiry: expiry}
+	acc.tokens[scopeKey(scopes)] = tok
+	acc.refreshedAt = s.now()
+	acc.lastErr = ""
+
+	return tok, nil
+}
+
+// Status reports every account, sorted by email. Expiry is that of the
+// full-scope token the refresh loop keeps warm.
+func (s *Server) Status() []AccountStatus {
+	out := make([]AccountStatus, 0, len(s.accounts))
+
+	for _, acc := range s.accounts {
+		acc.mu.Lock()
+		out = append(out, AccountStatus{
+			Account:     acc.id.Email,
+			Client:      acc.id.Client,
+			Expiry:      acc.tokens[""].Expiry,
+			RefreshedAt: acc.refreshedAt,
+			LastError:   acc.lastErr,
+		})
+		acc.mu.Unlock()
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Account != out[j].Account {
+			return out[i].Account < out[j].Account
+		}
+
+		return out[i].Client < out[j].Client
+	})
+
+	return out
+}
+
+// Run refreshes the full-scope token of every account in the background
+// until ctx is done. Scoped tokens are minted on demand. Failures are retried
+// with backoff and reported through Status.
+func (s *Server) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, acc := range s.accounts {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			s.keepFresh(ctx, acc)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (s *Server) keepFresh(ctx context.Context, acc *account) {
+	backoff := minRetry
+
+	for {
+		acc.mu.Lock()
+		tok, err := s.refreshLocked(ctx, acc, nil)
+		acc.mu.Unlock()
+
+		wait := backoff
+		if err != nil {
+			backoff = min(backoff*2, maxRetry)
+		} else {
+			backoff = minRetry
+			wait = max(tok.Expiry.Sub(s.now())-s.refreshBefore, minRetry)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Handler serves GET /v1/token?account=<email>&client=<name>&scope=<scope>...
+// and GET /v1/status.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/token", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		req := Request{
+			Client: strings.TrimSpace(q.Get("client")),
+			Email:  strings.TrimSpace(q.Get("account")),
+			Scopes: q["scope"],
+		}
+
+		if req.Email == "" && len(s.accounts) == 1 {
+			for _, acc := range s.accounts {
+				req.Client, req.Email = acc.id.Client, acc.id.Email
+			}
+		}
+
+		if req.Email == "" {
+			writeError(w, http.StatusBadRequest, "missing account parameter")
+			return
+		}
+
+		tok, err := s.Token(r.Context(), req)
+		if errors.Is(err, ErrUnknownAccount) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		if err != nil {
+			writeError(w, http.StatusBadGateway, err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusOK, tok)
+	})
+
+	mux.HandleFunc("GET /v1/status", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"accounts": s.Status()})
+	})
+
+	return mux
+}
+
+// Serve answers requests on ln until ctx is done.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	srv := &http.Server{Handler: s.Handler(), ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// DefaultSocketPath is <config dir>/auth.sock.
+func DefaultSocketPath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "auth.sock"), nil
+}
+
+// Listen opens the unix socket at path, readable by the current user only.
+// A stale socket left by a crashed daemon is replaced; a live one is an error.
+func Listen(path string) (net.Listener, error) {
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("%w on %s", errAlreadyRunning, path)
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("remove stale socket: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("ensure socket dir: %w", err)
+	}
+
+	ln, err := config.ListenPrivateSocket(path)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s: %w", path, err)
+	}
+
+	return ln, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func accountKey(client string, email string) string {
+	return strings.TrimSpace(client) + "\n" + normalizeEmail(email)
+}
+
+func describe(client string, email string) string {
+	if strings.TrimSpace(client) == "" {
+		return email
+	}
+
+	return email + " (client " + client + ")"
+}
+
+// normalizeScopes sorts and dedupes scopes so equal sets share a cache entry.
+func normalizeScopes(scopes []string) []string {
+	out := make([]string, 0, len(scopes))
+
+	for _, sc := range scopes {
+		if sc = strings.TrimSpace(sc); sc != "" {
+			out = append(out, sc)
+		}
+	}
+
+	sort.Strings(out)
+
+	return slices.Compact(out)
+}
+
+func scopeKey(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
@@ -0,0 +1,266 @@
+package authdaemon
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var errBoom = errors.New("boom")
+
+func socketPath(t *testing.T) string {
+	t.Helper()
+
+	// Unix socket paths are limited to ~100 bytes; t.TempDir can be longer.
+	dir, err := os.MkdirTemp("", "gogd")
+	if err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	return filepath.Join(dir, "auth.sock")
+}
+
+func startServer(t *testing.T, srv *Server) string {
+	t.Helper()
+
+	path := socketPath(t)
+
+	ln, err := Listen(path)
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = srv.Serve(ctx, ln)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return path
+}
+
+func TestServerCachesAndServesTokens(t *testing.T) {
+	var mints atomic.Int32
+
+	srv := NewServer([]Account{{Client: "default", Email: "User@Example.com"}}, func(_ context.Context, acc Account, _ []string) (string, time.Time, error) {
+		mints.Add(1)
+
+		return "at-" + acc.Email, time.Now().Add(time.Hour), nil
+	}, 5*time.Minute)
+
+	path := startServer(t, srv)
+
+	for range 3 {
+		tok, err := FetchToken(context.Background(), path, Request{Client: "default", Email: "user@example.com"})
+		if err != nil {
+			t.Fatalf("FetchToken: %v", err)
+		}
+
+		if tok.AccessToken != "at-User@Example.com" || tok.TokenType != "Bearer" || tok.Account != "User@Example.com" || tok.Client != "default" {
+			t.Fatalf("unexpected token: %#v", tok)
+		}
+	}
+
+	if got := mints.Load(); got != 1 {
+		t.Fatalf("expected one mint, got %d", got)
+	}
+
+	if _, err := FetchToken(context.Background(), path, Request{Client: "default", Email: "other@example.com"}); !errors.Is(err, ErrUnknownAccount) {
+		t.Fatalf("expected ErrUnknownAccount, got %v", err)
+	}
+}
+
+func TestServerKeysCacheOnClientAndScopes(t *testing.T) {
+	var mints atomic.Int32
+
+	srv := NewServer([]Account{{Client: "default", Email: "a@b.com"}, {Client: "work", Email: "a@b.com"}}, func(_ context.Context, acc Account, scopes []string) (string, time.Time, error) {
+		mints.Add(1)
+
+		return acc.Client + ":" + strings.Join(scopes, ","), time.Now().Add(time.Hour), nil
+	}, time.Minute)
+
+	path := startServer(t, srv)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		req  Request
+		want string
+	}{
+		{Request{Client: "default", Email: "a@b.com", Scopes: []string{"s2", "s1"}}, "default:s1,s2"},
+		{Request{Client: "default", Email: "a@b.com", Scopes: []string{"s1", "s2", "s1"}}, "default:s1,s2"},
+		{Request{Client: "default", Email: "a@b.com", Scopes: []string{"s1"}}, "default:s1"},
+		{Request{Client: "work", Email: "a@b.com", Scopes: []string{"s1"}}, "work:s1"},
+		{Request{Client: "default", Email: "a@b.com"}, "default:"},
+	} {
+		tok, err := FetchToken(ctx, path, tc.req)
+		if err != nil || tok.AccessToken != tc.want {
+			t.Fatalf("%+v: got %#v %v, want %q", tc.req, tok, err, tc.want)
+		}
+	}
+
+	if got := mints.Load(); got != 4 {
+		t.Fatalf("expected one mint per client and scope set, got %d", got)
+	}
+
+	if _, err := FetchToken(ctx, path, Request{Client: "other", Email: "a@b.com"}); !errors.Is(err, ErrUnknownAccount) {
+		t.Fatalf("expected ErrUnknownAccount for an unserved client, got %v", err)
+	}
+
+	// Without a client the email is ambiguous here.
+	if _, err := FetchToken(ctx, path, Request{Email: "a@b.com"}); !errors.Is(err, ErrUnknownAccount) {
+		t.Fatalf("expected ErrUnknownAccount for an ambiguous account, got %v", err)
+	}
+}
+
+func TestServerRemintsExpiringToken(t *testing.T) {
+	var mints atomic.Int32
+
+	srv := NewServer([]Account{{Email: "a@b.com"}}, func(context.Context, Account, []string) (string, time.Time, error) {
+		n := mints.Add(1)
+		if n == 2 {
+			return "", time.Time{}, errBoom
+		}
+
+		// Shorter than minValidity, so every request re-mints.
+		return "at", time.Now().Add(30 * time.Second), nil
+	}, time.Minute)
+
+	if _, err := srv.Token(context.Background(), Request{Email: "a@b.com"}); err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+
+	if _, err := srv.Token(context.Background(), Request{Email: "a@b.com"}); !errors.Is(err, errBoom) {
+		t.Fatalf("expected mint error, got %v", err)
+	}
+
+	if st := srv.Status(); len(st) != 1 || st[0].LastError != "boom" {
+		t.Fatalf("unexpected status: %#v", st)
+	}
+
+	if _, err := srv.Token(context.Background(), Request{Email: "a@b.com"}); err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+
+	if st := srv.Status(); st[0].LastError != "" || st[0].RefreshedAt.IsZero() {
+		t.Fatalf("status not reset after success: %#v", st)
+	}
+}
+
+func TestListenRejectsLiveDaemonAndReplacesStaleSocket(t *testing.T) {
+	path := socketPath(t)
+
+	ln, err := Listen(path)
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+
+	if info, statErr := os.Stat(path); statErr != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("unexpected socket mode: %v %v", info, statErr)
+	}
+
+	if _, err := Listen(path); !errors.Is(err, errAlreadyRunning) {
+		t.Fatalf("expected errAlreadyRunning, got %v", err)
+	}
+
+	_ = ln.Close()
+
+	// Leave a stale file behind, as a crashed daemon would.
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatalf("write stale: %v", err)
+	}
+
+	ln, err = Listen(path)
+	if err != nil {
+		t.Fatalf("Listen over stale socket: %v", err)
+	}
+
+	_ = ln.Close()
+}
+
+func TestTokenSourceFallsBack(t *testing.T) {
+	srv := NewServer([]Account{{Client: "default", Email: "served@example.com"}}, func(context.Context, Account, []string) (string, time.Time, error) {
+		return "daemon", time.Now().Add(time.Hour), nil
+	}, time.Minute)
+
+	path := startServer(t, srv)
+
+	fallbacks := 0
+	fallback := func() (oauth2.TokenSource, error) {
+		fallbacks++
+
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "stored"}), nil
+	}
+
+	tok, err := NewTokenSource(context.Background(), path, Request{Client: "default", Email: "served@example.com"}, fallback).Token()
+	if err != nil || tok.AccessToken != "daemon" {
+		t.Fatalf("expected daemon token, got %#v %v", tok, err)
+	}
+
+	ts := NewTokenSource(context.Background(), path, Request{Client: "default", Email: "other@example.com"}, fallback)
+	for range 2 {
+		tok, err = ts.Token()
+		if err != nil || tok.AccessToken != "stored" {
+			t.Fatalf("expected fallback token, got %#v %v", tok, err)
+		}
+	}
+
+	tok, err = NewTokenSource(context.Background(), filepath.Join(filepath.Dir(path), "missing.sock"), Request{Client: "default", Email: "served@example.com"}, fallback).Token()
+	if err != nil || tok.AccessToken != "stored" {
+		t.Fatalf("expected fallback when daemon is down, got %#v %v", tok, err)
+	}
+
+	if fallbacks != 2 {
+		t.Fatalf("expected fallback built once per source, got %d", fallbacks)
+	}
+}
+
+func TestRunRefreshesAheadOfExpiry(t *testing.T) {
+	var mints atomic.Int32
+
+	srv := NewServer([]Account{{Email: "a@b.com"}}, func(context.Context, Account, []string) (string, time.Time, error) {
+		mints.Add(1)
+
+		return "at", time.Now().Add(time.Hour), nil
+	}, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		srv.Run(ctx)
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for mints.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	<-done
+
+	if mints.Load() != 1 {
+		t.Fatalf("expected the initial refresh, got %d", mints.Load())
+	}
+
+	if tok, err := srv.Token(context.Background(), Request{Email: "a@b.com"}); err != nil || tok.AccessToken != "at" || mints.Load() != 1 {
+		t.Fatalf("expected cached token from the refresh loop: %#v %v (mints=%d)", tok, err, mints.Load())
+	}
+}
@@ -19,31 +19,27 @@ func requireAccount(flags *RootFlags) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if v := strings.TrimSpace(flags.Account); v != "" {
-		if resolved, ok, err := resolveAccountAlias(v); err != nil {
-			return "", err
-		} else if ok {
-			return resolved, nil
-		}
-		if shouldAutoSelectAccount(v) {
-			v = ""
-		}
-		if v != "" {
-			return v, nil
-		}
+	if flags != nil && strings.TrimSpace(flags.Impersonate) != "" {
+		return normalizeEmail(flags.Impersonate), nil
 	}
-	if v := strings.TrimSpace(os.Getenv("GOG_ACCOUNT")); v != "" {
-		if resolved, ok, err := resolveAccountAlias(v); err != nil {
+	if v, err := explicitAccount(flags); err != nil || v != "" {
+		return v, err
+	}
+
+	// Unattended service-account runs usually have no OAuth tokens; pick the
+	// only subject with a stored key.
+	if flags.AsServiceAccount {
+		emails, err := config.ListServiceAccountEmails()
+		if err != nil {
 			return "", err
-		} else if ok {
-			return resolved, nil
 		}
-		if shouldAutoSelectAccount(v) {
-			v = ""
+		if len(emails) == 1 {
+			return emails[0], nil
 		}
-		if v != "" {
-			return v, nil
+		if len(emails) == 0 {
+			return "", usage("no service account configured (run `gog auth service-account add --key <key.json> --subject <email>`)")
 		}
+		return "", usage("multiple service accounts configured; pass --account (or set GOG_ACCOUNT)")
 	}
 
 	if store, err := openSecretsStoreForAccount(); err == nil {
@@ -79,6 +75,49 @@ func requireAccount(flags *RootFlags) (string, error) {
 	return "", usage("missing --account (or set GOG_ACCOUNT, set default via `gog auth manage`, or store exactly one token)")
 }
 
+// explicitAccount returns the account named by --account, GOG_ACCOUNT, the
+// profile or .gogcli.toml (aliases resolved), or "" when it should be
+// auto-selected.
+func explicitAccount(flags *RootFlags) (string, error) {
+	values := []string{os.Getenv("GOG_ACCOUNT")}
+	if flags != nil {
+		values = append([]string{flags.Account}, values...)
+	}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if resolved, ok, err := resolveAccountAlias(v); err != nil {
+			return "", err
+		} else if ok {
+			return resolved, nil
+		}
+		if shouldAutoSelectAccount(v) {
+			continue
+		}
+		return v, nil
+	}
+	// A profile or a .gogcli.toml above the working directory pins the account.
+	profile := ""
+	if flags != nil {
+		profile = flags.Profile
+	}
+	if p, err := loadDefaults(profile); err != nil {
+		return "", err
+	} else if p.Account != "" {
+		if resolved, aliased, err := resolveAccountAlias(p.Account); err != nil {
+			return "", err
+		} else if aliased {
+			return resolved, nil
+		}
+		if !shouldAutoSelectAccount(p.Account) {
+			return p.Account, nil
+		}
+	}
+	return "", nil
+}
+
 func resolveAccountAlias(value string) (string, bool, error) {
 	value = strings.TrimSpace(value)
 	if value == "" || strings.Contains(value, "@") || shouldAutoSelectAccount(value) {
@@ -0,0 +1,428 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	admin "google.golang.org/api/admin/directory/v1"
+	gapi "google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/errfmt"
+	"github.com/steipete/gogcli/internal/googleapi"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var (
+	newAdminDirectoryService      = googleapi.NewAdminDirectory
+	newAdminDirectoryWriteService = googleapi.NewAdminDirectoryWriter
+)
+
+type AdminCmd struct {
+	Users AdminUsersCmd `cmd:"" name:"users" help:"Workspace users (Directory API)"`
+}
+
+type AdminUsersCmd struct {
+	List    AdminUsersListCmd    `cmd:"" name:"list" aliases:"ls" help:"List users"`
+	Get     AdminUsersGetCmd     `cmd:"" name:"get" help:"Get a user"`
+	Suspend AdminUsersSuspendCmd `cmd:"" name:"suspend" help:"Suspend users"`
+	Restore AdminUsersRestoreCmd `cmd:"" name:"restore" aliases:"unsuspend" help:"Restore suspended users"`
+}
+
+const defaultAdminUserFields = "email,name,suspended,admin,lastlogin"
+
+// Directory API maximum for users.list.
+const maxAdminUsersPageSize = 500
+
+// adminUserField is one selectable --fields column; mask is the Directory API
+// field used for partial responses.
+type adminUserField struct {
+	name   string
+	mask   string
+	header string
+	key    string
+	value  func(*admin.User) string
+}
+
+var adminUserFieldList = []adminUserField{
+	{"email", "primaryEmail", "EMAIL", "email", func(u *admin.User) string { return u.PrimaryEmail }},
+	{"name", "name", "NAME", "name", adminUserName},
+	{"given", "name", "GIVEN", "givenName", func(u *admin.User) string {
+		if u.Name == nil {
+			return ""
+		}
+		return u.Name.GivenName
+	}},
+	{"family", "name", "FAMILY", "familyName", func(u *admin.User) string {
+		if u.Name == nil {
+			return ""
+		}
+		return u.Name.FamilyName
+	}},
+	{"suspended", "suspended", "SUSPENDED", "suspended", func(u *admin.User) string { return strconv.FormatBool(u.Suspended) }},
+	{"admin", "isAdmin", "ADMIN", "admin", func(u *admin.User) string { return strconv.FormatBool(u.IsAdmin) }},
+	{"orgunit", "orgUnitPath", "ORG_UNIT", "orgUnitPath", func(u *admin.User) string { return u.OrgUnitPath }},
+	{"lastlogin", "lastLoginTime", "LAST_LOGIN", "lastLoginTime", adminUserLastLogin},
+	{"created", "creationTime", "CREATED", "creationTime", func(u *admin.User) string { return u.CreationTime }},
+	{"2sv", "isEnrolledIn2Sv", "2SV", "enrolledIn2Sv", func(u *admin.User) string { return strconv.FormatBool(u.IsEnrolledIn2Sv) }},
+	{"aliases", "aliases", "ALIASES", "aliases", func(u *admin.User) string { return strings.Join(u.Aliases, ";") }},
+	{"archived", "archived", "ARCHIVED", "archived", func(u *admin.User) string { return strconv.FormatBool(u.Archived) }},
+	{"id", "id", "ID", "id", func(u *admin.User) string { return u.Id }},
+}
+
+var adminUserFieldAliases = map[string]string{
+	"primaryemail":  "email",
+	"fullname":      "name",
+	"givenname":     "given",
+	"first":         "given",
+	"familyname":    "family",
+	"last":          "family",
+	"isadmin":       "admin",
+	"org":           "orgunit",
+	"orgunitpath":   "orgunit",
+	"lastlogintime": "lastlogin",
+	"creationtime":  "created",
+	"alias":         "aliases",
+}
+
+func parseAdminUserFields(value string) ([]adminUserField, error) {
+	if strings.TrimSpace(value) == "" {
+		value = defaultAdminUserFields
+	}
+	var out []adminUserField
+	seen := map[string]bool{}
+	for _, name := range splitCSV(strings.ToLower(value)) {
+		if alias, ok := adminUserFieldAliases[name]; ok {
+			name = alias
+		}
+		found := false
+		for _, f := range adminUserFieldList {
+			if f.name == name {
+				found = true
+				if !seen[f.name] {
+					seen[f.name] = true
+					out = append(out, f)
+				}
+				break
+			}
+		}
+		if !found {
+			return nil, usagef("unknown field %q (valid: email, name, given, family, suspended, admin, orgunit, lastlogin, created, 2sv, aliases, archived, id)", name)
+		}
+	}
+	return out, nil
+}
+
+// adminUsersFieldsMask builds the partial-response selector for users.list.
+func adminUsersFieldsMask(fields []adminUserField) gapi.Field {
+	masks := []string{"primaryEmail"}
+	for _, f := range fields {
+		if !slices.Contains(masks, f.mask) {
+			masks = append(masks, f.mask)
+		}
+	}
+	return gapi.Field("nextPageToken,users(" + strings.Join(masks, ",") + ")")
+}
+
+type AdminUsersListCmd struct {
+	Domain  string `name:"domain" help:"Only users in this domain (default: all domains of your organization)"`
+	Query   string `name:"query" help:"Directory search query (e.g. 'isSuspended=true', 'orgUnitPath=/Sales', 'email:alice*')"`
+	OrderBy string `name:"order-by" help:"email|givenName|familyName"`
+	Max     int64  `name:"max" aliases:"limit" help:"Max results per page (max 500)" default:"100"`
+	Page    string `name:"page" help:"Page token"`
+	All     bool   `name:"all" help:"Fetch all pages"`
+	Fields  string `name:"fields" help:"Columns: email, name, given, family, suspended, admin, orgunit, lastlogin, created, 2sv, aliases, archived, id" default:"email,name,suspended,admin,lastlogin"`
+	CSV     bool   `name:"csv" help:"Write CSV instead of a table"`
+}
+
+func (c *AdminUsersListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	fields, err := parseAdminUserFields(c.Fields)
+	if err != nil {
+		return err
+	}
+	if c.Max <= 0 {
+		return usage("--max must be positive")
+	}
+
+	svc, err := newAdminDirectoryService(ctx, account)
+	if err != nil {
+		return wrapAdminError(err)
+	}
+
+	var users []*admin.User
+	pageToken := c.Page
+	for {
+		call := svc.Users.List().
+			MaxResults(min(c.Max, maxAdminUsersPageSize)).
+			PageToken(pageToken).
+			Fields(adminUsersFieldsMask(fields)).
+			Context(ctx)
+		if domain := strings.TrimSpace(c.Domain); domain != "" {
+			call = call.Domain(domain)
+		} else {
+			call = call.Customer("my_customer")
+		}
+		if q := strings.TrimSpace(c.Query); q != "" {
+			call = call.Query(q)
+		}
+		if orderBy := strings.TrimSpace(c.OrderBy); orderBy != "" {
+			call = call.OrderBy(orderBy)
+		}
+		resp, listErr := call.Do()
+		if listErr != nil {
+			return wrapAdminError(listErr)
+		}
+		users = append(users, resp.Users...)
+		pageToken = resp.NextPageToken
+		if !c.All || pageToken == "" {
+			break
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		items := make([]map[string]string, 0, len(users))
+		for _, user := range users {
+			item := map[string]string{}
+			for _, f := range fields {
+				if v := f.value(user); v != "" {
+					item[f.key] = v
+				}
+			}
+			items = append(items, item)
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"users":         items,
+			"nextPageToken": pageToken,
+		})
+	}
+
+	if c.CSV {
+		return writeAdminUsersCSV(os.Stdout, users, fields)
+	}
+
+	if len(users) == 0 {
+		u.Err().Println("No users")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	headers := make([]string, 0, len(fields))
+	for _, f := range fields {
+		headers = append(headers, f.header)
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, user := range users {
+		values := make([]string, 0, len(fields))
+		for _, f := range fields {
+			values = append(values, sanitizeTab(f.value(user)))
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	flush()
+	printNextPageHint(u, pageToken)
+	return nil
+}
+
+func writeAdminUsersCSV(w io.Writer, users []*admin.User, fields []adminUserField) error {
+	cw := csv.NewWriter(w)
+	headers := make([]string, 0, len(fields))
+	for _, f := range fields {
+		headers = append(headers, f.header)
+	}
+	_ = cw.Write(headers)
+	for _, user := range users {
+		row := make([]string, 0, len(fields))
+		for _, f := range fields {
+			row = append(row, f.value(user))
+		}
+		_ = cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type AdminUsersGetCmd struct {
+	User   string `arg:"" name:"user" help:"User email, alias or ID"`
+	Domain string `name:"domain" help:"Domain appended to a bare username"`
+}
+
+func (c *AdminUsersGetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	key, err := adminUserKey(c.User, c.Domain)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newAdminDirectoryService(ctx, account)
+	if err != nil {
+		return wrapAdminError(err)
+	}
+	user, err := svc.Users.Get(key).Context(ctx).Do()
+	if err != nil {
+		return wrapAdminError(err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"user": user})
+	}
+	for _, f := range adminUserFieldList {
+		if v := f.value(user); v != "" {
+			u.Out().Printf("%s\t%s", f.key, sanitizeTab(v))
+		}
+	}
+	if user.SuspensionReason != "" {
+		u.Out().Printf("suspensionReason\t%s", user.SuspensionReason)
+	}
+	return nil
+}
+
+type AdminUsersSuspendCmd struct {
+	Users  []string `arg:"" name:"user" help:"User emails or IDs"`
+	Domain string   `name:"domain" help:"Domain appended to bare usernames"`
+}
+
+func (c *AdminUsersSuspendCmd) Run(ctx context.Context, flags *RootFlags) error {
+	keys, err := adminUserKeys(c.Users, c.Domain)
+	if err != nil {
+		return err
+	}
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("suspend %d user(s): %s", len(keys), strings.Join(keys, ", "))); confirmErr != nil {
+		return confirmErr
+	}
+	return setAdminUsersSuspended(ctx, flags, keys, true)
+}
+
+type AdminUsersRestoreCmd struct {
+	Users  []string `arg:"" name:"user" help:"User emails or IDs"`
+	Domain string   `name:"domain" help:"Domain appended to bare usernames"`
+}
+
+func (c *AdminUsersRestoreCmd) Run(ctx context.Context, flags *RootFlags) error {
+	keys, err := adminUserKeys(c.Users, c.Domain)
+	if err != nil {
+		return err
+	}
+	return setAdminUsersSuspended(ctx, flags, keys, false)
+}
+
+func setAdminUsersSuspended(ctx context.Context, flags *RootFlags, keys []string, suspended bool) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	svc, err := newAdminDirectoryWriteService(ctx, account)
+	if err != nil {
+		return wrapAdminError(err)
+	}
+
+	type result struct {
+		Email     string `json:"email"`
+		Suspended bool   `json:"suspended"`
+	}
+	results := make([]result, 0, len(keys))
+	for _, key := range keys {
+		patch := &admin.User{Suspended: suspended}
+		patch.ForceSendFields = []string{"Suspended"}
+		updated, updateErr := svc.Users.Update(key, patch).Context(ctx).Do()
+		if updateErr != nil {
+			return fmt.Errorf("%s: %w", key, wrapAdminError(updateErr))
+		}
+		email := updated.PrimaryEmail
+		if email == "" {
+			email = key
+		}
+		results = append(results, result{Email: email, Suspended: updated.Suspended})
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"users": results})
+	}
+	for _, r := range results {
+		u.Out().Printf("%s\tsuspended=%t", r.Email, r.Suspended)
+	}
+	return nil
+}
+
+// adminUserKey returns the Directory API userKey, appending --domain to bare
+// usernames. Numeric IDs are passed through.
+func adminUserKey(user, domain string) (string, error) {
+	user = strings.TrimSpace(user)
+	if user == "" {
+		return "", usage("user required")
+	}
+	domain = strings.TrimPrefix(strings.TrimSpace(domain), "@")
+	if domain == "" || strings.Contains(user, "@") {
+		return user, nil
+	}
+	if _, err := strconv.ParseUint(user, 10, 64); err == nil {
+		return user, nil
+	}
+	return user + "@" + domain, nil
+}
+
+func adminUserKeys(users []string, domain string) ([]string, error) {
+	users = parseCommaArgs(users)
+	if len(users) == 0 {
+		return nil, usage("user required")
+	}
+	keys := make([]string, 0, len(users))
+	for _, user := range users {
+		key, err := adminUserKey(user, domain)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func adminUserName(u *admin.User) string {
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.FullName != "" {
+		return u.Name.FullName
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+// adminUserLastLogin hides the epoch timestamp the API returns for users who
+// never signed in.
+func adminUserLastLogin(u *admin.User) string {
+	if strings.HasPrefix(u.LastLoginTime, "1970-01-01") {
+		return ""
+	}
+	return u.LastLoginTime
+}
+
+// wrapAdminError provides helpful error messages for common Admin SDK issues.
+func wrapAdminError(err error) error {
+	errStr := err.Error()
+	if strings.Contains(errStr, "accessNotConfigured") ||
+		strings.Contains(errStr, "Admin SDK API has not been used") {
+		return errfmt.NewUserFacingError("Admin SDK API is not enabled; enable it at: https://console.developers.google.com/apis/api/admin.googleapis.com/overview", err)
+	}
+	if strings.Contains(errStr, "insufficientPermissions") ||
+		strings.Contains(errStr, "insufficient authentication scopes") {
+		return errfmt.NewUserFacingError("Insufficient permissions for the Admin SDK; re-authenticate with the admin.directory.user scope: gog auth add <account> --services admin", err)
+	}
+	if strings.Contains(errStr, "Not Authorized to access this resource/api") {
+		return errfmt.NewUserFacingError("This account is not a Workspace admin (or lacks the user management privilege)", err)
+	}
+	return err
+}
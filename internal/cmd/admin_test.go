@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/option"
+)
+
+func newTestAdminDirectoryService(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	svc, err := admin.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	origRead, origWrite := newAdminDirectoryService, newAdminDirectoryWriteService
+	t.Cleanup(func() {
+		newAdminDirectoryService = origRead
+		newAdminDirectoryWriteService = origWrite
+	})
+	build := func(context.Context, string) (*admin.Service, error) { return svc, nil }
+	newAdminDirectoryService = build
+	newAdminDirectoryWriteService = build
+}
+
+func TestAdminUsersList_AllPagesCSV(t *testing.T) {
+	var queries []string
+	newTestAdminDirectoryService(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/users") {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		queries = append(queries, q.Encode())
+		w.Header().Set("Content-Type", "application/json")
+		if q.Get("pageToken") == "" {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"users": []any{
+					map[string]any{"primaryEmail": "alice@x.com", "name": map[string]any{"fullName": "Alice A"}, "suspended": false, "lastLoginTime": "1970-01-01T00:00:00.000Z"},
+				},
+				"nextPageToken": "p2",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"users": []any{
+				map[string]any{"primaryEmail": "bob@x.com", "name": map[string]any{"givenName": "Bob", "familyName": "B"}, "suspended": true, "lastLoginTime": "2026-01-02T03:04:05.000Z"},
+			},
+		})
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@x.com", "admin", "users", "list", "--domain", "x.com", "--all", "--csv", "--fields", "email,name,suspended,lastlogin"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	want := "EMAIL,NAME,SUSPENDED,LAST_LOGIN\nalice@x.com,Alice A,false,\nbob@x.com,Bob B,true,2026-01-02T03:04:05.000Z\n"
+	if out != want {
+		t.Fatalf("unexpected CSV:\n%s", out)
+	}
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %v", queries)
+	}
+	if !strings.Contains(queries[0], "domain=x.com") || strings.Contains(queries[0], "customer=") {
+		t.Fatalf("expected domain filter: %s", queries[0])
+	}
+	if !strings.Contains(queries[0], "fields=nextPageToken%2Cusers%28primaryEmail%2Cname%2Csuspended%2ClastLoginTime%29") {
+		t.Fatalf("expected partial response fields: %s", queries[0])
+	}
+}
+
+func TestAdminUsersGet_DomainAppended(t *testing.T) {
+	newTestAdminDirectoryService(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/users/alice@x.com") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"primaryEmail": "alice@x.com", "id": "123", "isAdmin": true})
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@x.com", "admin", "users", "get", "alice", "--domain", "x.com"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !strings.Contains(out, "email\talice@x.com") || !strings.Contains(out, "admin\ttrue") || !strings.Contains(out, "id\t123") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestAdminUsersSuspendRestore(t *testing.T) {
+	var bodies []map[string]any
+	newTestAdminDirectoryService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || !strings.Contains(r.URL.Path, "/users/") {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		email := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"primaryEmail": email, "suspended": body["suspended"]})
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--force", "--account", "a@x.com", "admin", "users", "suspend", "alice@x.com,bob@x.com"}); err != nil {
+			t.Fatalf("suspend: %v", err)
+		}
+		if err := Execute([]string{"--json", "--account", "a@x.com", "admin", "users", "restore", "carol", "--domain", "x.com"}); err != nil {
+			t.Fatalf("restore: %v", err)
+		}
+	})
+	if len(bodies) != 3 || bodies[0]["suspended"] != true || bodies[2]["suspended"] != false {
+		t.Fatalf("unexpected bodies: %v", bodies)
+	}
+	if !strings.Contains(out, `"email": "carol@x.com"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestAdminUsersSuspend_RequiresForce(t *testing.T) {
+	err := Execute([]string{"--no-input", "--account", "a@x.com", "admin", "users", "suspend", "alice@x.com"})
+	if err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("expected --force error, got %v", err)
+	}
+}
+
+func TestParseAdminUserFields(t *testing.T) {
+	fields, err := parseAdminUserFields("Email, orgUnitPath,email")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(fields) != 2 || fields[0].name != "email" || fields[1].name != "orgunit" {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+	if _, err := parseAdminUserFields("email,shoe-size"); err == nil {
+		t.Fatalf("expected unknown field error")
+	}
+}
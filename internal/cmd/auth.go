@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/steipete/gogcli/internal/authclient"
 	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/errfmt"
+	"github.com/steipete/gogcli/internal/googleapi"
 	"github.com/steipete/gogcli/internal/googleauth"
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/secrets"
@@ -24,6 +27,9 @@ var (
 	authorizeGoogle      = googleauth.Authorize
 	startManageServer    = googleauth.StartManageServer
 	checkRefreshToken    = googleauth.CheckRefreshToken
+	refreshAccessToken   = googleauth.RefreshAccessToken
+	mintAccessToken      = googleauth.MintAccessToken
+	serviceAccountToken  = googleapi.ServiceAccountAccessToken
 	ensureKeychainAccess = secrets.EnsureKeychainAccess
 	fetchAuthorizedEmail = googleauth.EmailForRefreshToken
 )
@@ -33,10 +39,13 @@ func ensureKeychainAccessIfNeeded() error {
 	if err != nil {
 		return fmt.Errorf("resolve keyring backend: %w", err)
 	}
-	if backendInfo.Value == strFile {
+	switch backendInfo.Value {
+	case "auto", "keychain":
+		return ensureKeychainAccess()
+	default:
+		// file and external secret managers never touch the OS keychain.
 		return nil
 	}
-	return ensureKeychainAccess()
 }
 
 func normalizeEmail(value string) string {
@@ -56,9 +65,16 @@ type AuthCmd struct {
 	List        AuthListCmd           `cmd:"" name:"list" help:"List stored accounts"`
 	Aliases     AuthAliasCmd          `cmd:"" name:"alias" help:"Manage account aliases"`
 	Status      AuthStatusCmd         `cmd:"" name:"status" help:"Show auth configuration and keyring backend"`
+	Doctor      AuthDoctorCmd         `cmd:"" name:"doctor" help:"Diagnose keyring backend, stored accounts, scope coverage and common misconfigurations"`
+	Daemon      AuthDaemonCmd         `cmd:"" name:"daemon" help:"Keep access tokens fresh and serve them over a unix socket (GOG_AUTH_SOCKET)"`
 	Keyring     AuthKeyringCmd        `cmd:"" name:"keyring" help:"Configure keyring backend"`
+	Unlock      AuthUnlockCmd         `cmd:"" name:"unlock" help:"Cache the file keyring passphrase in a background agent for --ttl"`
+	Lock        AuthLockCmd           `cmd:"" name:"lock" help:"Make the keyring agent forget the file keyring passphrase"`
 	Remove      AuthRemoveCmd         `cmd:"" name:"remove" help:"Remove a stored refresh token"`
 	Tokens      AuthTokensCmd         `cmd:"" name:"tokens" help:"Manage stored refresh tokens"`
+	Scopes      AuthScopesCmd         `cmd:"" name:"scopes" help:"Grant additional scopes to a stored account"`
+	Profile     AuthProfileCmd        `cmd:"" name:"profile" help:"Manage named profiles (account + defaults)"`
+	AccessToken AuthAccessTokenCmd    `cmd:"" name:"print-access-token" help:"Print a short-lived access token for --account (for curl and other tools)"`
 	Manage      AuthManageCmd         `cmd:"" name:"manage" help:"Open accounts manager in browser" aliases:"login"`
 	ServiceAcct AuthServiceAccountCmd `cmd:"" name:"service-account" help:"Configure service account (Workspace only; domain-wide delegation)"`
 	Keep        AuthKeepCmd           `cmd:"" name:"keep" help:"Configure service account for Google Keep (Workspace only)"`
@@ -212,10 +228,12 @@ func (c *AuthCredentialsListCmd) Run(ctx context.Context) error {
 }
 
 type AuthTokensCmd struct {
-	List   AuthTokensListCmd   `cmd:"" name:"list" help:"List stored tokens (by key only)"`
-	Delete AuthTokensDeleteCmd `cmd:"" name:"delete" help:"Delete a stored refresh token"`
-	Export AuthTokensExportCmd `cmd:"" name:"export" help:"Export a refresh token to a file (contains secrets)"`
-	Import AuthTokensImportCmd `cmd:"" name:"import" help:"Import a refresh token file into keyring (contains secrets)"`
+	List    AuthTokensListCmd    `cmd:"" name:"list" help:"List stored tokens with scopes, creation time and last known expiry"`
+	Show    AuthTokensShowCmd    `cmd:"" name:"show" help:"Show a stored token's scopes, creation time and expiry (never the secret)"`
+	Refresh AuthTokensRefreshCmd `cmd:"" name:"refresh" help:"Force a refresh-token exchange and record the granted scopes and expiry"`
+	Delete  AuthTokensDeleteCmd  `cmd:"" name:"delete" help:"Delete a stored refresh token"`
+	Export  AuthTokensExportCmd  `cmd:"" name:"export" help:"Export a refresh token to a file (contains secrets)"`
+	Import  AuthTokensImportCmd  `cmd:"" name:"import" help:"Import a refresh token file into keyring (contains secrets)"`
 }
 
 type AuthTokensListCmd struct{}
@@ -230,31 +248,192 @@ func (c *AuthTokensListCmd) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	filtered := make([]string, 0, len(tokens))
+	infos := make([]tokenInfo, 0, len(tokens))
 	for _, tok := range tokens {
 		if strings.TrimSpace(tok.Email) == "" {
 			continue
 		}
-		filtered = append(filtered, secrets.TokenKey(tok.Client, tok.Email))
+		infos = append(infos, newTokenInfo(tok))
 	}
-	sort.Strings(filtered)
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Key < infos[j].Key })
 
-	if len(filtered) == 0 {
-		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"keys": []string{}})
-		}
+	keys := make([]string, 0, len(infos))
+	for _, info := range infos {
+		keys = append(keys, info.Key)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"keys": keys, "tokens": infos})
+	}
+	if len(infos) == 0 {
 		u.Err().Println("No tokens stored")
 		return nil
 	}
+
+	w, done := tableWriter(ctx)
+	defer done()
+	_, _ = fmt.Fprintln(w, "KEY	SERVICES	SCOPES	CREATED	EXPIRY")
+	for _, info := range infos {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", info.Key, strings.Join(info.Services, ","), len(info.Scopes), info.CreatedAt, info.Expiry)
+	}
+	return nil
+}
+
+// tokenInfo is the non-secret view of a stored token.
+type tokenInfo struct {
+	Key         string   `json:"key"`
+	Email       string   `json:"email"`
+	Client      string   `json:"client"`
+	Services    []string `json:"services"`
+	Scopes      []string `json:"scopes"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	RefreshedAt string   `json:"refreshed_at,omitempty"`
+	Expiry      string   `json:"expiry,omitempty"`
+}
+
+func newTokenInfo(tok secrets.Token) tokenInfo {
+	client := tok.Client
+	if client == "" {
+		client = config.DefaultClientName
+	}
+	services := tok.Services
+	if services == nil {
+		services = []string{}
+	}
+	scopes := tok.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return tokenInfo{
+		Key:         secrets.TokenKey(tok.Client, tok.Email),
+		Email:       tok.Email,
+		Client:      client,
+		Services:    services,
+		Scopes:      scopes,
+		CreatedAt:   formatTokenTime(tok.CreatedAt),
+		RefreshedAt: formatTokenTime(tok.RefreshedAt),
+		Expiry:      formatTokenTime(tok.Expiry),
+	}
+}
+
+func formatTokenTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func printTokenInfo(ctx context.Context, info tokenInfo) {
+	u := ui.FromContext(ctx)
+	u.Out().Printf("email\t%s", info.Email)
+	u.Out().Printf("client\t%s", info.Client)
+	u.Out().Printf("services\t%s", strings.Join(info.Services, ","))
+	u.Out().Printf("created_at\t%s", info.CreatedAt)
+	if info.RefreshedAt != "" {
+		u.Out().Printf("refreshed_at\t%s", info.RefreshedAt)
+	}
+	if info.Expiry != "" {
+		u.Out().Printf("expiry\t%s", info.Expiry)
+	}
+	for _, scope := range info.Scopes {
+		u.Out().Printf("scope\t%s", scope)
+	}
+}
+
+type AuthTokensShowCmd struct {
+	Email string `arg:"" name:"email" help:"Email"`
+}
+
+func (c *AuthTokensShowCmd) Run(ctx context.Context) error {
+	email := strings.TrimSpace(c.Email)
+	if email == "" {
+		return usage("empty email")
+	}
+
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	client, err := resolveClientForEmailWithContext(ctx, email, "")
+	if err != nil {
+		return err
+	}
+	tok, err := store.GetToken(client, email)
+	if err != nil {
+		return err
+	}
+
+	info := newTokenInfo(tok)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"keys": filtered})
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"token": info})
+	}
+	printTokenInfo(ctx, info)
+	return nil
+}
+
+type AuthTokensRefreshCmd struct {
+	Email   string        `arg:"" name:"email" help:"Email"`
+	Timeout time.Duration `name:"timeout" help:"Refresh timeout" default:"15s"`
+}
+
+func (c *AuthTokensRefreshCmd) Run(ctx context.Context) error {
+	email := strings.TrimSpace(c.Email)
+	if email == "" {
+		return usage("empty email")
+	}
+
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	client, err := resolveClientForEmailWithContext(ctx, email, "")
+	if err != nil {
+		return err
+	}
+	tok, err := store.GetToken(client, email)
+	if err != nil {
+		return err
+	}
+
+	res, err := refreshAccessToken(ctx, client, tok.RefreshToken, tok.Scopes, c.Timeout)
+	if errors.Is(err, googleauth.ErrTokenRevoked) {
+		return revokedTokenError(tok.Email, tok.Services, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	tok.RefreshedAt = time.Now().UTC()
+	tok.Expiry = res.Expiry.UTC()
+	if len(res.Scopes) > 0 {
+		tok.Scopes = res.Scopes
+	}
+	if res.RefreshToken != "" {
+		tok.RefreshToken = res.RefreshToken
+	}
+	if err := store.SetToken(client, tok.Email, tok); err != nil {
+		return fmt.Errorf("store token: %w", err)
 	}
-	for _, k := range filtered {
-		u.Out().Println(k)
+
+	info := newTokenInfo(tok)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"refreshed": true, "token": info})
 	}
+	ui.FromContext(ctx).Out().Printf("refreshed\ttrue")
+	printTokenInfo(ctx, info)
 	return nil
 }
 
+// revokedTokenError explains a rejected refresh token with the command that
+// re-authorizes the same services.
+func revokedTokenError(email string, services []string, err error) error {
+	hint := fmt.Sprintf("gog auth add %s --force-consent", email)
+	if len(services) > 0 {
+		hint = fmt.Sprintf("gog auth add %s --services %s --force-consent", email, strings.Join(services, ","))
+	}
+	return errfmt.NewUserFacingError(fmt.Sprintf("Refresh token for %s was revoked or has expired. Re-authorize with: %s", email, hint), err)
+}
+
 type AuthTokensDeleteCmd struct {
 	Email string `arg:"" name:"email" help:"Email"`
 }
@@ -481,6 +660,9 @@ func (c *AuthTokensImportCmd) Run(ctx context.Context) error {
 type AuthAddCmd struct {
 	Email        string `arg:"" name:"email" help:"Email"`
 	Manual       bool   `name:"manual" help:"Browserless auth flow (paste redirect URL)"`
+	Device       bool   `name:"device" help:"Device code flow for headless machines (enter a code on another device)"`
+	Remote       bool   `name:"remote" help:"Remote/SSH flow: print the auth URL and the SSH port-forward for the loopback callback instead of opening a browser"`
+	Port         int    `name:"port" help:"Loopback callback port (default: ephemeral; 8085 with --remote)"`
 	ForceConsent bool   `name:"force-consent" help:"Force consent screen to obtain a refresh token"`
 	ServicesCSV  string `name:"services" help:"Services to authorize: user|all or comma-separated ${auth_services} (Keep uses service account: gog auth service-account set)" default:"user"`
 	Readonly     bool   `name:"readonly" help:"Use read-only scopes where available (still includes OIDC identity scopes)"`
@@ -507,6 +689,15 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 	if c.Readonly && c.DriveScope == strFile {
 		return usage("cannot combine --readonly with --drive-scope=file (file is write-capable)")
 	}
+	if c.Manual && c.Device {
+		return usage("use either --manual or --device")
+	}
+	if c.Remote && (c.Manual || c.Device) {
+		return usage("--remote cannot be combined with --manual or --device")
+	}
+	if c.Port < 0 || c.Port > 65535 {
+		return usagef("invalid --port %d", c.Port)
+	}
 	scopes, err := googleauth.ScopesForManageWithOptions(services, googleauth.ScopeOptions{
 		Readonly:   c.Readonly,
 		DriveScope: googleauth.DriveScopeMode(c.DriveScope),
@@ -514,6 +705,11 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.Device {
+		if err := googleauth.ValidateDeviceScopes(scopes); err != nil {
+			return usage(err.Error())
+		}
+	}
 
 	// Pre-flight: ensure keychain is accessible before starting OAuth
 	if keychainErr := ensureKeychainAccessIfNeeded(); keychainErr != nil {
@@ -524,8 +720,11 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 		Services:     services,
 		Scopes:       scopes,
 		Manual:       c.Manual,
+		Device:       c.Device,
 		ForceConsent: c.ForceConsent,
 		Client:       client,
+		Remote:       c.Remote,
+		ListenPort:   c.Port,
 	})
 	if err != nil {
 		return err
@@ -535,7 +734,8 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("fetch authorized email: %w", err)
 	}
-	if normalizeEmail(authorizedEmail) != normalizeEmail(c.Email) {
+	// `auth login --device|--remote` accepts whichever account completes the flow.
+	if c.Email != "" && normalizeEmail(authorizedEmail) != normalizeEmail(c.Email) {
 		return fmt.Errorf("authorized as %s, expected %s", authorizedEmail, c.Email)
 	}
 
@@ -605,6 +805,30 @@ func (c *AuthStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
+	project, projectFound, projectErr := loadProject()
+	projectStatus := map[string]any{
+		"path":    project.Path,
+		"exists":  projectFound,
+		"ignored": project.Ignored,
+	}
+	if projectErr != nil {
+		projectStatus["error"] = projectErr.Error()
+	}
+	profileArg := ""
+	if flags != nil {
+		profileArg = flags.Profile
+	}
+	profile, profileExplicit, err := activeProfileName(profileArg)
+	if err != nil {
+		return err
+	}
+	profileSource := ""
+	if profile != "" {
+		profileSource = "current"
+		if profileExplicit {
+			profileSource = "explicit"
+		}
+	}
 
 	account := ""
 	authPreferred := ""
@@ -651,6 +875,11 @@ func (c *AuthStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 				"backend": backendInfo.Value,
 				"source":  backendInfo.Source,
 			},
+			"project": projectStatus,
+			"profile": map[string]any{
+				"name":   profile,
+				"source": profileSource,
+			},
 			"account": map[string]any{
 				"email":                      account,
 				"client":                     client,
@@ -666,6 +895,19 @@ func (c *AuthStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u.Out().Printf("config_exists\t%t", configExists)
 	u.Out().Printf("keyring_backend\t%s", backendInfo.Value)
 	u.Out().Printf("keyring_backend_source\t%s", backendInfo.Source)
+	if projectFound {
+		u.Out().Printf("project_config\t%s", project.Path)
+	}
+	if len(project.Ignored) > 0 {
+		u.Out().Printf("project_config_ignored_keys\t%s", strings.Join(project.Ignored, ","))
+	}
+	if projectErr != nil {
+		u.Out().Printf("project_config_error\t%v", projectErr)
+	}
+	if profile != "" {
+		u.Out().Printf("profile\t%s", profile)
+		u.Out().Printf("profile_source\t%s", profileSource)
+	}
 	if account != "" {
 		u.Out().Printf("account\t%s", account)
 		u.Out().Printf("client\t%s", client)
@@ -966,9 +1208,27 @@ type AuthManageCmd struct {
 	ForceConsent bool          `name:"force-consent" help:"Force consent screen when adding accounts"`
 	ServicesCSV  string        `name:"services" help:"Services to authorize: user|all or comma-separated ${auth_services} (Keep uses service account: gog auth service-account set)" default:"user"`
 	Timeout      time.Duration `name:"timeout" help:"Server timeout duration" default:"10m"`
+	Device       bool          `name:"device" help:"Skip the browser manager and add an account via the device code flow (headless machines)"`
+	Remote       bool          `name:"remote" help:"Skip the browser manager and add an account from a remote/SSH session (prints the auth URL and port-forward)"`
+	Port         int           `name:"port" help:"With --remote: loopback callback port" default:"8085"`
+	Readonly     bool          `name:"readonly" help:"With --device/--remote: use read-only scopes where available"`
+	DriveScope   string        `name:"drive-scope" help:"With --device/--remote: Drive scope mode: full|readonly|file (the device flow only allows file)" enum:"full,readonly,file" default:"full"`
 }
 
 func (c *AuthManageCmd) Run(ctx context.Context) error {
+	if c.Device || c.Remote {
+		add := &AuthAddCmd{
+			Device:       c.Device,
+			Remote:       c.Remote,
+			Port:         c.Port,
+			ForceConsent: c.ForceConsent,
+			ServicesCSV:  c.ServicesCSV,
+			Readonly:     c.Readonly,
+			DriveScope:   c.DriveScope,
+		}
+		return add.Run(ctx)
+	}
+
 	services, err := parseAuthServices(c.ServicesCSV)
 	if err != nil {
 		return err
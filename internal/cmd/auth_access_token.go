@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/steipete/gogcli/internal/authclient"
+	"github.com/steipete/gogcli/internal/googleapi"
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/outfmt"
+)
+
+const googleScopePrefix = "https://www.googleapis.com/auth/"
+
+// AuthAccessTokenCmd prints a short-lived access token (gcloud-style) so
+// curl and other tools can reuse the stored credentials.
+type AuthAccessTokenCmd struct {
+	Scopes  string        `name:"scopes" help:"Comma-separated scopes to narrow the token to (full URLs or short names like gmail.readonly); default: all granted scopes. Required for service accounts"`
+	Timeout time.Duration `name:"timeout" help:"Token exchange timeout" default:"15s"`
+}
+
+func (c *AuthAccessTokenCmd) Run(ctx context.Context, flags *RootFlags) error {
+	email, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	scopes := parseScopeList(c.Scopes)
+
+	if _, ok, pathErr := googleapi.StoredServiceAccountKeyPath(ctx, email); pathErr != nil {
+		return pathErr
+	} else if ok {
+		if len(scopes) == 0 {
+			return usage("service accounts need --scopes (the scopes granted to the domain-wide delegation)")
+		}
+		tok, _, tokErr := serviceAccountToken(ctx, email, scopes)
+		if tokErr != nil {
+			return tokErr
+		}
+		return writeAccessToken(ctx, email, tok.AccessToken, tok.Expiry, scopes)
+	} else if authclient.ServiceAccountOnly(ctx) {
+		return fmt.Errorf("no service account key stored for %s (run: gog auth service-account add --key <key.json> --subject %s)", email, email)
+	}
+
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	client, err := resolveClientForEmailWithContext(ctx, email, "")
+	if err != nil {
+		return err
+	}
+	tok, err := store.GetToken(client, email)
+	if err != nil {
+		return fmt.Errorf("no stored token for %s (run: gog auth add %s): %w", email, email, err)
+	}
+	if len(tok.Scopes) > 0 {
+		if missing := googleauth.MissingScopes(tok.Scopes, scopes); len(missing) > 0 {
+			return usagef("scopes not granted to %s: %s (run: gog --account %s auth scopes add <service>)", email, strings.Join(missing, " "), email)
+		}
+	}
+
+	res, err := mintAccessToken(ctx, client, tok.RefreshToken, scopes, c.Timeout)
+	if errors.Is(err, googleauth.ErrTokenRevoked) {
+		return revokedTokenError(tok.Email, tok.Services, err)
+	}
+	if err != nil {
+		return err
+	}
+	if len(scopes) == 0 {
+		scopes = res.Scopes
+	}
+	return writeAccessToken(ctx, email, res.AccessToken, res.Expiry, scopes)
+}
+
+func writeAccessToken(ctx context.Context, email string, accessToken string, expiry time.Time, scopes []string) error {
+	if outfmt.IsJSON(ctx) {
+		if scopes == nil {
+			scopes = []string{}
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expiry":       formatTokenTime(expiry),
+			"account":      email,
+			"scopes":       scopes,
+		})
+	}
+	// Bare token on stdout so $(gog auth print-access-token) works.
+	_, err := fmt.Fprintln(os.Stdout, accessToken)
+	return err
+}
+
+// parseScopeList splits a comma/space separated scope list, expanding short
+// names (gmail.readonly) to full Google scope URLs.
+func parseScopeList(raw string) []string {
+	var out []string
+	for _, part := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == ' ' }) {
+		switch {
+		case strings.Contains(part, "://"), part == "openid", part == "email", part == "profile":
+		default:
+			part = googleScopePrefix + part
+		}
+		out = append(out, part)
+	}
+	return out
+}
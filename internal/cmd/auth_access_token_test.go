@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+func TestAuthPrintAccessToken_StoredToken(t *testing.T) {
+	setupProfileHome(t)
+	origOpen := openSecretsStore
+	origMint := mintAccessToken
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		mintAccessToken = origMint
+	})
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	_ = store.SetToken(config.DefaultClientName, "user@example.com", secrets.Token{
+		RefreshToken: "rt",
+		Scopes:       []string{"openid", "https://www.googleapis.com/auth/gmail.modify", "https://www.googleapis.com/auth/gmail.readonly"},
+	})
+
+	var gotScopes []string
+	mintAccessToken = func(_ context.Context, client string, refreshToken string, scopes []string, _ time.Duration) (googleauth.RefreshResult, error) {
+		if client != config.DefaultClientName || refreshToken != "rt" {
+			t.Fatalf("unexpected client/refresh token: %q %q", client, refreshToken)
+		}
+		gotScopes = scopes
+		return googleauth.RefreshResult{AccessToken: "ya29.token", Expiry: time.Now().Add(time.Hour), Scopes: []string{"openid"}}, nil
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "user@example.com", "auth", "print-access-token"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if out != "ya29.token\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if gotScopes != nil {
+		t.Fatalf("expected all granted scopes, got %v", gotScopes)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "user@example.com", "auth", "print-access-token", "--scopes", "gmail.readonly"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !slices.Equal(gotScopes, []string{"https://www.googleapis.com/auth/gmail.readonly"}) {
+		t.Fatalf("unexpected narrowed scopes: %v", gotScopes)
+	}
+	var parsed struct {
+		AccessToken string   `json:"access_token"`
+		Account     string   `json:"account"`
+		Expiry      string   `json:"expiry"`
+		Scopes      []string `json:"scopes"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.AccessToken != "ya29.token" || parsed.Account != "user@example.com" || parsed.Expiry == "" || !slices.Equal(parsed.Scopes, gotScopes) {
+		t.Fatalf("unexpected json: %#v", parsed)
+	}
+}
+
+func TestAuthPrintAccessToken_UngrantedScope(t *testing.T) {
+	setupProfileHome(t)
+	origOpen := openSecretsStore
+	origMint := mintAccessToken
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		mintAccessToken = origMint
+	})
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	_ = store.SetToken(config.DefaultClientName, "user@example.com", secrets.Token{
+		RefreshToken: "rt",
+		Scopes:       []string{"https://www.googleapis.com/auth/gmail.readonly"},
+	})
+	mintAccessToken = func(context.Context, string, string, []string, time.Duration) (googleauth.RefreshResult, error) {
+		t.Fatal("mint should not run for ungranted scopes")
+		return googleauth.RefreshResult{}, nil
+	}
+
+	errOut := captureStderr(t, func() {
+		err := Execute([]string{"--account", "user@example.com", "auth", "print-access-token", "--scopes", "drive"})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+	if !strings.Contains(errOut, "auth/drive") || !strings.Contains(errOut, "auth scopes add") {
+		t.Fatalf("unexpected stderr: %q", errOut)
+	}
+}
+
+func TestAuthPrintAccessToken_ServiceAccount(t *testing.T) {
+	setupProfileHome(t)
+	origSA := serviceAccountToken
+	t.Cleanup(func() { serviceAccountToken = origSA })
+
+	saPath, err := config.ServiceAccountPath("admin@example.com")
+	if err != nil {
+		t.Fatalf("ServiceAccountPath: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(saPath), 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(saPath, []byte(`{"type":"service_account"}`), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+
+	serviceAccountToken = func(_ context.Context, email string, scopes []string) (*oauth2.Token, bool, error) {
+		if email != "admin@example.com" || !slices.Equal(scopes, []string{"https://www.googleapis.com/auth/admin.directory.user.readonly"}) {
+			t.Fatalf("unexpected request: %q %v", email, scopes)
+		}
+		return &oauth2.Token{AccessToken: "sa-token"}, true, nil
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--account", "admin@example.com", "auth", "print-access-token"}); err == nil {
+			t.Fatal("expected --scopes to be required for service accounts")
+		}
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "admin@example.com", "auth", "print-access-token", "--scopes", "admin.directory.user.readonly"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if out != "sa-token\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestParseScopeList(t *testing.T) {
+	got := parseScopeList("gmail.readonly, openid https://www.googleapis.com/auth/drive")
+	want := []string{"https://www.googleapis.com/auth/gmail.readonly", "openid", "https://www.googleapis.com/auth/drive"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("parseScopeList = %v", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -481,3 +482,122 @@ func containsStringInSlice(items []string, want string) bool {
 	}
 	return false
 }
+
+func TestAuthLoginDevice_StoresAuthorizedAccount(t *testing.T) {
+	origAuth := authorizeGoogle
+	origOpen := openSecretsStore
+	origKeychain := ensureKeychainAccess
+	origFetch := fetchAuthorizedEmail
+	t.Cleanup(func() {
+		authorizeGoogle = origAuth
+		openSecretsStore = origOpen
+		ensureKeychainAccess = origKeychain
+		fetchAuthorizedEmail = origFetch
+	})
+
+	ensureKeychainAccess = func() error { return nil }
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	var gotOpts googleauth.AuthorizeOptions
+	authorizeGoogle = func(ctx context.Context, opts googleauth.AuthorizeOptions) (string, error) {
+		gotOpts = opts
+		return "rt", nil
+	}
+	fetchAuthorizedEmail = func(context.Context, string, string, []string, time.Duration) (string, error) {
+		return "headless@example.com", nil
+	}
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "login", "--device", "--services", "drive", "--drive-scope", "file"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	if !gotOpts.Device || gotOpts.Manual {
+		t.Fatalf("expected device flow, got %+v", gotOpts)
+	}
+	if !slices.Contains(gotOpts.Scopes, "https://www.googleapis.com/auth/drive.file") || slices.Contains(gotOpts.Scopes, "https://www.googleapis.com/auth/drive") {
+		t.Fatalf("expected drive.file scope, got %v", gotOpts.Scopes)
+	}
+	tok, err := store.GetToken(config.DefaultClientName, "headless@example.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+	if tok.RefreshToken != "rt" || len(tok.Services) != 1 || tok.Services[0] != "drive" {
+		t.Fatalf("unexpected token: %#v", tok)
+	}
+
+	_ = captureStderr(t, func() {
+		err = Execute([]string{"auth", "add", "a@b.com", "--device", "--manual"})
+	})
+	if err == nil || !strings.Contains(err.Error(), "either --manual or --device") {
+		t.Fatalf("expected usage error, got: %v", err)
+	}
+
+	// Default services and the full Drive scope are not allowed in the device
+	// flow; fail before a code is requested.
+	gotOpts = googleauth.AuthorizeOptions{}
+	_ = captureStderr(t, func() {
+		err = Execute([]string{"auth", "login", "--device"})
+	})
+	if err == nil || ExitCode(err) != 2 || !strings.Contains(err.Error(), "not allowed in the device flow") || !strings.Contains(err.Error(), "gmail") {
+		t.Fatalf("expected device scope usage error, got: %v", err)
+	}
+	if gotOpts.Device {
+		t.Fatal("device flow started with disallowed scopes")
+	}
+}
+
+func TestAuthLoginRemote(t *testing.T) {
+	origAuth := authorizeGoogle
+	origOpen := openSecretsStore
+	origKeychain := ensureKeychainAccess
+	origFetch := fetchAuthorizedEmail
+	t.Cleanup(func() {
+		authorizeGoogle = origAuth
+		openSecretsStore = origOpen
+		ensureKeychainAccess = origKeychain
+		fetchAuthorizedEmail = origFetch
+	})
+
+	ensureKeychainAccess = func() error { return nil }
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	var gotOpts googleauth.AuthorizeOptions
+	authorizeGoogle = func(ctx context.Context, opts googleauth.AuthorizeOptions) (string, error) {
+		gotOpts = opts
+		return "rt", nil
+	}
+	fetchAuthorizedEmail = func(context.Context, string, string, []string, time.Duration) (string, error) {
+		return "remote@example.com", nil
+	}
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "login", "--remote", "--port", "9123", "--services", "gmail"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	if !gotOpts.Remote || gotOpts.ListenPort != 9123 || gotOpts.Device || gotOpts.Manual {
+		t.Fatalf("expected remote flow on port 9123, got %+v", gotOpts)
+	}
+	if _, err := store.GetToken(config.DefaultClientName, "remote@example.com"); err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+
+	var err error
+	_ = captureStderr(t, func() {
+		err = Execute([]string{"auth", "add", "a@b.com", "--remote", "--device"})
+	})
+	if err == nil || !strings.Contains(err.Error(), "--remote cannot be combined") {
+		t.Fatalf("expected usage error, got: %v", err)
+	}
+}
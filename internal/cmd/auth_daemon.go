@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/steipete/gogcli/internal/authdaemon"
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/secrets"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// serveAuthDaemon runs the refresh loop and the socket server until ctx ends.
+var serveAuthDaemon = func(ctx context.Context, srv *authdaemon.Server, ln net.Listener) error {
+	go srv.Run(ctx)
+	return srv.Serve(ctx, ln)
+}
+
+type AuthDaemonCmd struct {
+	Accounts      []string      `name:"accounts" sep:"," help:"Accounts to keep fresh (comma-separated; default: every stored OAuth account)"`
+	Socket        string        `name:"socket" help:"Unix socket path (default: <config dir>/auth.sock)"`
+	RefreshBefore time.Duration `name:"refresh-before" help:"Refresh this long before an access token expires" default:"5m"`
+	Timeout       time.Duration `name:"timeout" help:"Per-refresh timeout" default:"15s"`
+}
+
+// daemonAccount is an account the daemon serves; the refresh token is read
+// once at startup so the keyring is never consulted again.
+type daemonAccount struct {
+	email        string
+	client       string
+	refreshToken string
+}
+
+func (c *AuthDaemonCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+
+	socket := strings.TrimSpace(c.Socket)
+	if socket == "" {
+		path, err := authdaemon.DefaultSocketPath()
+		if err != nil {
+			return err
+		}
+		socket = path
+	}
+	socket, err := config.ExpandPath(socket)
+	if err != nil {
+		return err
+	}
+
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	accounts, err := loadDaemonAccounts(ctx, store, c.Accounts)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return usage("no stored OAuth accounts to serve (run: gog auth add <email>)")
+	}
+
+	var mu sync.Mutex
+	byAccount := make(map[authdaemon.Account]*daemonAccount, len(accounts))
+	served := make([]authdaemon.Account, 0, len(accounts))
+	labels := make([]string, 0, len(accounts))
+	for i := range accounts {
+		id := authdaemon.Account{Client: accounts[i].client, Email: accounts[i].email}
+		byAccount[id] = &accounts[i]
+		served = append(served, id)
+		labels = append(labels, daemonAccountLabel(id))
+	}
+	mint := func(ctx context.Context, id authdaemon.Account, scopes []string) (string, time.Time, error) {
+		mu.Lock()
+		acc := byAccount[id]
+		refreshToken := acc.refreshToken
+		mu.Unlock()
+
+		res, err := refreshAccessToken(ctx, id.Client, refreshToken, scopes, c.Timeout)
+		if err != nil {
+			if errors.Is(err, googleauth.ErrTokenRevoked) {
+				u.Err().Printf("auth daemon: %s: refresh token revoked or expired (run: gog auth add %s --force-consent)", daemonAccountLabel(id), id.Email)
+			}
+			return "", time.Time{}, err
+		}
+		if res.RefreshToken != "" {
+			mu.Lock()
+			acc.refreshToken = res.RefreshToken
+			mu.Unlock()
+		}
+		return res.AccessToken, res.Expiry, nil
+	}
+
+	ln, err := authdaemon.Listen(socket)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(socket) }()
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{"socket": socket, "accounts": labels}); err != nil {
+			_ = ln.Close()
+			return err
+		}
+	} else {
+		// Like ssh-agent: paste this into the shells that should use the daemon.
+		_, _ = fmt.Fprintf(os.Stdout, "export %s=%s\n", authdaemon.SocketEnv, socket)
+		u.Err().Printf("auth daemon: serving %s (Ctrl-C to stop)", strings.Join(labels, ", "))
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return serveAuthDaemon(ctx, authdaemon.NewServer(served, mint, c.RefreshBefore), ln)
+}
+
+// daemonAccountLabel names an account in output; the client is only spelled
+// out when it is not the default one.
+func daemonAccountLabel(id authdaemon.Account) string {
+	if id.Client == "" || id.Client == config.DefaultClientName {
+		return id.Email
+	}
+	return id.Email + " (" + id.Client + ")"
+}
+
+// loadDaemonAccounts reads the refresh tokens for emails (every stored OAuth
+// token, for each client, when empty).
+func loadDaemonAccounts(ctx context.Context, store secrets.Store, emails []string) ([]daemonAccount, error) {
+	var out []daemonAccount
+	if len(emails) == 0 {
+		tokens, err := store.ListTokens()
+		if err != nil {
+			return nil, err
+		}
+		for _, tok := range tokens {
+			if normalizeEmail(tok.Email) == "" || tok.RefreshToken == "" {
+				continue
+			}
+			client := tok.Client
+			if client == "" {
+				client = config.DefaultClientName
+			}
+			out = append(out, daemonAccount{email: tok.Email, client: client, refreshToken: tok.RefreshToken})
+		}
+		return out, nil
+	}
+	for _, raw := range emails {
+		email := strings.TrimSpace(raw)
+		if email == "" {
+			continue
+		}
+		if resolved, ok, err := resolveAccountAlias(email); err != nil {
+			return nil, err
+		} else if ok {
+			email = resolved
+		}
+		client, err := resolveClientForEmailWithContext(ctx, email, "")
+		if err != nil {
+			return nil, err
+		}
+		tok, err := store.GetToken(client, email)
+		if err != nil {
+			return nil, fmt.Errorf("no stored token for %s (run: gog auth add %s): %w", email, email, err)
+		}
+		out = append(out, daemonAccount{email: tok.Email, client: client, refreshToken: tok.RefreshToken})
+	}
+	return out, nil
+}
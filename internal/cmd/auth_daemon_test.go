@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/gogcli/internal/authdaemon"
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+func TestAuthDaemon_ServesStoredAccounts(t *testing.T) {
+	setupProfileHome(t)
+	origOpen := openSecretsStore
+	origRefresh := refreshAccessToken
+	origServe := serveAuthDaemon
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		refreshAccessToken = origRefresh
+		serveAuthDaemon = origServe
+	})
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	_ = store.SetToken(config.DefaultClientName, "a@example.com", secrets.Token{RefreshToken: "rt-a"})
+	_ = store.SetToken(config.DefaultClientName, "b@example.com", secrets.Token{RefreshToken: "rt-b"})
+
+	refreshAccessToken = func(_ context.Context, client string, refreshToken string, _ []string, _ time.Duration) (googleauth.RefreshResult, error) {
+		return googleauth.RefreshResult{AccessToken: "at-" + refreshToken, Expiry: time.Now().Add(time.Hour)}, nil
+	}
+
+	dir, err := os.MkdirTemp("", "gogd")
+	if err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "auth.sock")
+
+	var served []authdaemon.AccountStatus
+	var tokA authdaemon.Token
+	serveAuthDaemon = func(ctx context.Context, srv *authdaemon.Server, ln net.Listener) error {
+		defer ln.Close()
+		if ln.Addr().String() != socket {
+			t.Errorf("listening on %q, want %q", ln.Addr().String(), socket)
+		}
+		tokA, err = srv.Token(ctx, authdaemon.Request{Client: config.DefaultClientName, Email: "A@example.com"})
+		served = srv.Status()
+		return err
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"auth", "daemon", "--socket", socket}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	if strings.TrimSpace(out) != "export GOG_AUTH_SOCKET="+socket {
+		t.Fatalf("unexpected stdout: %q", out)
+	}
+	if tokA.AccessToken != "at-rt-a" {
+		t.Fatalf("unexpected token: %#v", tokA)
+	}
+	if len(served) != 2 || served[0].Account != "a@example.com" || served[1].Account != "b@example.com" {
+		t.Fatalf("unexpected accounts: %#v", served)
+	}
+	if _, statErr := os.Stat(socket); !os.IsNotExist(statErr) {
+		t.Fatalf("socket not removed on exit: %v", statErr)
+	}
+}
+
+func TestAuthDaemon_UnknownAccount(t *testing.T) {
+	setupProfileHome(t)
+	origOpen := openSecretsStore
+	t.Cleanup(func() { openSecretsStore = origOpen })
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"auth", "daemon", "--accounts", "missing@example.com", "--socket", filepath.Join(t.TempDir(), "s")}); err == nil {
+			t.Fatal("expected error for account without a stored token")
+		}
+		if err := Execute([]string{"auth", "daemon", "--socket", filepath.Join(t.TempDir(), "s")}); err == nil {
+			t.Fatal("expected error without stored accounts")
+		}
+	})
+}
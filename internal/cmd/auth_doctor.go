@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/secrets"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const (
+	doctorOK   = "ok"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+)
+
+var (
+	doctorStdinIsTTY    = func() bool { return term.IsTerminal(int(os.Stdin.Fd())) }
+	doctorAgentUnlocked = secrets.AgentUnlocked
+)
+
+type AuthDoctorCmd struct{}
+
+type doctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Hint   string `json:"hint,omitempty"`
+}
+
+type doctorAccount struct {
+	Email    string            `json:"email"`
+	Client   string            `json:"client,omitempty"`
+	Type     string            `json:"type"`
+	Services []string          `json:"services"`
+	Coverage map[string]string `json:"coverage,omitempty"`
+	Missing  []string          `json:"missing_services,omitempty"`
+}
+
+func (c *AuthDoctorCmd) Run(ctx context.Context, flags *RootFlags) error {
+	var checks []doctorCheck
+	add := func(name, status, detail, hint string) {
+		checks = append(checks, doctorCheck{Name: name, Status: status, Detail: detail, Hint: hint})
+	}
+
+	if _, err := config.ReadConfig(); err != nil {
+		add("config", doctorFail, err.Error(), "fix or remove the config file (gog config path)")
+	} else {
+		path, _ := config.ConfigPath()
+		add("config", doctorOK, path, "")
+	}
+
+	info, err := secrets.ResolveKeyringBackendInfo()
+	if err != nil {
+		return err
+	}
+	effective := secrets.EffectiveKeyringBackend(info)
+	backendDetail := fmt.Sprintf("%s (source: %s)", info.Value, info.Source)
+	if effective != info.Value {
+		backendDetail += fmt.Sprintf("; using %s (no D-Bus session)", effective)
+	}
+	add("keyring_backend", doctorOK, backendDetail, "")
+	checks = append(checks, keyringEnvChecks(effective, doctorStdinIsTTY())...)
+
+	var accounts []doctorAccount
+	store, storeErr := openSecretsStore()
+	if storeErr == nil {
+		var keys []string
+		if keys, storeErr = store.Keys(); storeErr == nil {
+			add("keyring_unlock", doctorOK, fmt.Sprintf("opened; %d keys", len(keys)), "")
+		}
+	}
+	if storeErr != nil {
+		add("keyring_unlock", doctorFail, storeErr.Error(), "see gog auth keyring --help for backend setup")
+	} else {
+		accounts, checks = doctorAccounts(store, checks)
+	}
+
+	if v := strings.TrimSpace(os.Getenv("GOG_ACCOUNT")); v != "" && storeErr == nil {
+		email, accErr := explicitAccount(flags)
+		switch {
+		case accErr != nil:
+			add("default_account", doctorFail, accErr.Error(), "")
+		case email != "" && !hasDoctorAccount(accounts, email):
+			add("default_account", doctorWarn, fmt.Sprintf("GOG_ACCOUNT=%s has no stored token or service account", v), "gog auth add "+email)
+		default:
+			add("default_account", doctorOK, "GOG_ACCOUNT="+v, "")
+		}
+	}
+
+	failed := 0
+	for _, ch := range checks {
+		if ch.Status == doctorFail {
+			failed++
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if accounts == nil {
+			accounts = []doctorAccount{}
+		}
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{
+			"ok": failed == 0,
+			"keyring": map[string]any{
+				"backend":   info.Value,
+				"source":    info.Source,
+				"effective": effective,
+			},
+			"checks":   checks,
+			"accounts": accounts,
+		}); err != nil {
+			return err
+		}
+	} else {
+		printDoctorReport(ctx, checks, accounts)
+	}
+
+	if failed > 0 {
+		return &ExitError{Code: 1, Err: fmt.Errorf("auth doctor: %d check(s) failed", failed)}
+	}
+	return nil
+}
+
+// keyringEnvChecks flags environment setups that make the effective backend
+// fail at runtime, most commonly a file keyring without a password in CI.
+func keyringEnvChecks(backend string, isTTY bool) []doctorCheck {
+	env := func(name string) string { return strings.TrimSpace(os.Getenv(name)) }
+	ci := env("CI") != ""
+	var out []doctorCheck
+	add := func(status, detail, hint string) {
+		out = append(out, doctorCheck{Name: "keyring_env", Status: status, Detail: detail, Hint: hint})
+	}
+
+	switch backend {
+	case "file":
+		expires, unlocked := doctorAgentUnlocked(context.Background())
+		switch {
+		case env("GOG_KEYRING_PASSWORD") != "":
+			add(doctorOK, "GOG_KEYRING_PASSWORD is set", "")
+		case unlocked:
+			add(doctorOK, "unlocked by gog auth unlock until "+expires.Local().Format(time.RFC3339), "")
+		case !isTTY:
+			add(doctorFail, "file keyring needs a password but GOG_KEYRING_PASSWORD is unset and there is no TTY to prompt", "export GOG_KEYRING_PASSWORD=<password> (e.g. from your CI secret store)")
+		default:
+			add(doctorOK, "file keyring will prompt for its password", "")
+		}
+	case "age", "gpg":
+		if env("GOG_KEYRING_RECIPIENTS") == "" {
+			add(doctorWarn, "GOG_KEYRING_RECIPIENTS is unset; storing tokens will fail", "export GOG_KEYRING_RECIPIENTS=<recipients>")
+		}
+		if backend == "age" && env("GOG_AGE_IDENTITY") == "" {
+			add(doctorWarn, "GOG_AGE_IDENTITY is unset; reading tokens will fail", "export GOG_AGE_IDENTITY=<identity file>")
+		}
+	case "vault":
+		if env("VAULT_ADDR") == "" {
+			add(doctorFail, "VAULT_ADDR is unset", "export VAULT_ADDR=https://vault.example.com and VAULT_TOKEN")
+		}
+	case "auto", "keychain":
+		if ci && !isTTY {
+			add(doctorWarn, "CI environment without a TTY; OS keychains are usually unavailable or prompt", "use GOG_KEYRING_BACKEND=file with GOG_KEYRING_PASSWORD")
+		}
+	}
+	if len(out) == 0 {
+		add(doctorOK, "no problems detected", "")
+	}
+	return out
+}
+
+func doctorAccounts(store secrets.Store, checks []doctorCheck) ([]doctorAccount, []doctorCheck) {
+	tokens, err := store.ListTokens()
+	if err != nil {
+		return nil, append(checks, doctorCheck{Name: "accounts", Status: doctorFail, Detail: err.Error()})
+	}
+	saEmails, err := config.ListServiceAccountEmails()
+	if err != nil {
+		return nil, append(checks, doctorCheck{Name: "accounts", Status: doctorFail, Detail: err.Error()})
+	}
+
+	var accounts []doctorAccount
+	clients := map[string]bool{}
+	var gaps []string
+	for _, tok := range tokens {
+		if strings.TrimSpace(tok.Email) == "" {
+			continue
+		}
+		client := tok.Client
+		if client == "" {
+			client = config.DefaultClientName
+		}
+		clients[client] = true
+		acc := doctorAccount{Email: tok.Email, Client: client, Type: authTypeOAuth, Services: tok.Services, Coverage: map[string]string{}}
+		if acc.Services == nil {
+			acc.Services = []string{}
+		}
+		if len(tok.Scopes) > 0 {
+			for _, svc := range googleauth.UserServices() {
+				level, covErr := googleauth.ScopeCoverage(svc, tok.Scopes)
+				if covErr != nil {
+					continue
+				}
+				acc.Coverage[string(svc)] = level
+			}
+			for _, name := range tok.Services {
+				if acc.Coverage[name] == googleauth.CoverageMissing {
+					acc.Missing = append(acc.Missing, name)
+				}
+			}
+		}
+		if len(acc.Missing) > 0 {
+			gaps = append(gaps, fmt.Sprintf("%s lacks scopes for %s", acc.Email, strings.Join(acc.Missing, ",")))
+		}
+		accounts = append(accounts, acc)
+	}
+	for _, email := range saEmails {
+		accounts = append(accounts, doctorAccount{Email: email, Type: authTypeServiceAccount, Services: []string{}})
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Email < accounts[j].Email })
+
+	if len(accounts) == 0 {
+		checks = append(checks, doctorCheck{Name: "accounts", Status: doctorWarn, Detail: "no stored tokens or service accounts", Hint: "gog auth add <email>"})
+	} else {
+		checks = append(checks, doctorCheck{Name: "accounts", Status: doctorOK, Detail: fmt.Sprintf("%d oauth, %d service account", len(accounts)-len(saEmails), len(saEmails))})
+	}
+
+	names := make([]string, 0, len(clients))
+	for name := range clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path, err := config.ClientCredentialsPathFor(name)
+		if err == nil {
+			if st, statErr := os.Stat(path); statErr == nil && !st.IsDir() {
+				checks = append(checks, doctorCheck{Name: "client_credentials", Status: doctorOK, Detail: name + ": " + path})
+				continue
+			} else if statErr != nil && !errors.Is(statErr, os.ErrNotExist) {
+				err = statErr
+			}
+		}
+		detail := name + ": credentials file missing"
+		if err != nil {
+			detail = name + ": " + err.Error()
+		}
+		checks = append(checks, doctorCheck{Name: "client_credentials", Status: doctorFail, Detail: detail, Hint: fmt.Sprintf("gog --client %s auth credentials <credentials.json>", name)})
+	}
+
+	if len(gaps) > 0 {
+		checks = append(checks, doctorCheck{Name: "scope_coverage", Status: doctorWarn, Detail: strings.Join(gaps, "; "), Hint: "gog --account <email> auth scopes add <service>"})
+	} else if len(tokens) > 0 {
+		checks = append(checks, doctorCheck{Name: "scope_coverage", Status: doctorOK, Detail: "stored services are covered by granted scopes"})
+	}
+	return accounts, checks
+}
+
+func hasDoctorAccount(accounts []doctorAccount, email string) bool {
+	for _, a := range accounts {
+		if normalizeEmail(a.Email) == normalizeEmail(email) {
+			return true
+		}
+	}
+	return false
+}
+
+func printDoctorReport(ctx context.Context, checks []doctorCheck, accounts []doctorAccount) {
+	w, flush := tableWriter(ctx)
+	_, _ = fmt.Fprintln(w, "CHECK\tSTATUS\tDETAIL")
+	for _, ch := range checks {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", ch.Name, ch.Status, ch.Detail)
+	}
+	flush()
+
+	if len(accounts) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout)
+		w, flush = tableWriter(ctx)
+		_, _ = fmt.Fprintln(w, "ACCOUNT\tCLIENT\tTYPE\tSERVICES\tMISSING")
+		for _, a := range accounts {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", a.Email, a.Client, a.Type, strings.Join(a.Services, ","), strings.Join(a.Missing, ","))
+		}
+		flush()
+	}
+
+	u := ui.FromContext(ctx)
+	for _, ch := range checks {
+		if ch.Status != doctorOK && ch.Hint != "" {
+			u.Err().Printf("%s: %s", ch.Name, ch.Hint)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+type doctorReport struct {
+	OK       bool            `json:"ok"`
+	Checks   []doctorCheck   `json:"checks"`
+	Accounts []doctorAccount `json:"accounts"`
+}
+
+func (r doctorReport) check(name string) doctorCheck {
+	for _, ch := range r.Checks {
+		if ch.Name == name {
+			return ch
+		}
+	}
+	return doctorCheck{}
+}
+
+func setupDoctor(t *testing.T, tok secrets.Token, tty bool) {
+	t.Helper()
+	setupProfileHome(t)
+	t.Setenv("CI", "")
+	t.Setenv("GOG_KEYRING_BACKEND", "file")
+
+	origOpen := openSecretsStore
+	origTTY := doctorStdinIsTTY
+	origAgent := doctorAgentUnlocked
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		doctorStdinIsTTY = origTTY
+		doctorAgentUnlocked = origAgent
+	})
+	doctorStdinIsTTY = func() bool { return tty }
+	doctorAgentUnlocked = func(context.Context) (time.Time, bool) { return time.Time{}, false }
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	if tok.Email != "" {
+		_ = store.SetToken(config.DefaultClientName, tok.Email, tok)
+	}
+
+	path, err := config.ClientCredentialsPathFor(config.DefaultClientName)
+	if err != nil {
+		t.Fatalf("ClientCredentialsPathFor: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(`{"installed":{"client_id":"id","client_secret":"secret"}}`), 0o600); err != nil {
+		t.Fatalf("write credentials: %v", err)
+	}
+}
+
+func runDoctor(t *testing.T) (doctorReport, error) {
+	t.Helper()
+	var runErr error
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			runErr = Execute([]string{"--json", "auth", "doctor"})
+		})
+	})
+	var report doctorReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	return report, runErr
+}
+
+func TestAuthDoctor_Healthy(t *testing.T) {
+	scopes, err := googleauth.ScopesForManageWithOptions([]googleauth.Service{googleauth.ServiceGmail}, googleauth.ScopeOptions{})
+	if err != nil {
+		t.Fatalf("scopes: %v", err)
+	}
+	setupDoctor(t, secrets.Token{Email: "user@example.com", RefreshToken: "rt", Services: []string{"gmail"}, Scopes: scopes}, true)
+	t.Setenv("GOG_KEYRING_PASSWORD", "pw")
+
+	report, err := runDoctor(t)
+	if err != nil {
+		t.Fatalf("doctor: %v", err)
+	}
+	if !report.OK || report.check("keyring_unlock").Status != doctorOK || report.check("client_credentials").Status != doctorOK {
+		t.Fatalf("unexpected report: %#v", report)
+	}
+	if len(report.Accounts) != 1 || report.Accounts[0].Coverage["gmail"] != googleauth.CoverageFull || report.Accounts[0].Coverage["calendar"] != googleauth.CoverageMissing {
+		t.Fatalf("unexpected accounts: %#v", report.Accounts)
+	}
+}
+
+func TestAuthDoctor_FileBackendWithoutPasswordInCI(t *testing.T) {
+	setupDoctor(t, secrets.Token{}, false)
+	t.Setenv("GOG_KEYRING_PASSWORD", "")
+	t.Setenv("CI", "true")
+
+	report, err := runDoctor(t)
+	if err == nil || ExitCode(err) != 1 {
+		t.Fatalf("expected exit 1, got %v", err)
+	}
+	if report.OK {
+		t.Fatalf("expected ok=false: %#v", report)
+	}
+	if ch := report.check("keyring_env"); ch.Status != doctorFail || ch.Hint == "" {
+		t.Fatalf("unexpected keyring_env check: %#v", ch)
+	}
+	if ch := report.check("accounts"); ch.Status != doctorWarn {
+		t.Fatalf("expected no-accounts warning: %#v", ch)
+	}
+}
+
+func TestAuthDoctor_ReportsMissingServiceScopes(t *testing.T) {
+	scopes, err := googleauth.ScopesForManageWithOptions([]googleauth.Service{googleauth.ServiceGmail}, googleauth.ScopeOptions{})
+	if err != nil {
+		t.Fatalf("scopes: %v", err)
+	}
+	setupDoctor(t, secrets.Token{Email: "user@example.com", RefreshToken: "rt", Services: []string{"calendar", "gmail"}, Scopes: scopes}, true)
+	t.Setenv("GOG_KEYRING_PASSWORD", "pw")
+
+	report, err := runDoctor(t)
+	if err != nil {
+		t.Fatalf("doctor: %v", err)
+	}
+	if ch := report.check("scope_coverage"); ch.Status != doctorWarn {
+		t.Fatalf("unexpected scope_coverage check: %#v", ch)
+	}
+	if !slices.Equal(report.Accounts[0].Missing, []string{"calendar"}) {
+		t.Fatalf("unexpected missing services: %#v", report.Accounts[0])
+	}
+}
@@ -14,7 +14,7 @@ import (
 )
 
 type AuthKeyringCmd struct {
-	Backend  string `arg:"" optional:"" name:"backend" help:"Keyring backend: auto|keychain|file"`
+	Backend  string `arg:"" optional:"" name:"backend" help:"Keyring backend: auto|keychain|file|age|gpg|pass|1password|vault"`
 	Backend2 string `arg:"" optional:"" name:"backend2" help:"(compat) Use: gog auth keyring set <backend>"`
 }
 
@@ -54,7 +54,7 @@ func (c *AuthKeyringCmd) Run(ctx context.Context) error {
 		u.Out().Printf("path\t%s", path)
 		u.Out().Printf("keyring_backend\t%s", info.Value)
 		u.Out().Printf("source\t%s", info.Source)
-		u.Err().Println("Hint: gog auth keyring <auto|keychain|file>")
+		u.Err().Println("Hint: gog auth keyring <auto|keychain|file|age|gpg|pass|1password|vault>")
 		return nil
 	}
 
@@ -67,12 +67,17 @@ func (c *AuthKeyringCmd) Run(ctx context.Context) error {
 	}
 
 	allowed := map[string]struct{}{
-		"auto":     {},
-		"keychain": {},
-		strFile:    {},
+		"auto":      {},
+		"keychain":  {},
+		strFile:     {},
+		"age":       {},
+		"gpg":       {},
+		"pass":      {},
+		"1password": {},
+		"vault":     {},
 	}
 	if _, ok := allowed[backend]; !ok {
-		return usagef("invalid backend: %q (expected auto, keychain, or file)", c.Backend)
+		return usagef("invalid backend: %q (expected auto, keychain, file, age, gpg, pass, 1password, or vault)", c.Backend)
 	}
 
 	cfg, err := config.ReadConfig()
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/secrets"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var (
+	readKeyringPassphrase   = promptKeyringPassphrase
+	verifyKeyringPassphrase = secrets.VerifyFilePassphrase
+	openFileKeyring         = secrets.OpenFileKeyring
+	startKeyringAgent       = spawnKeyringAgent
+	serveKeyringAgent       = func(ctx context.Context, agent *secrets.Agent, ln net.Listener) error {
+		return agent.Serve(ctx, ln)
+	}
+)
+
+var errAgentStart = errors.New("keyring agent did not start")
+
+type AuthUnlockCmd struct {
+	TTL        time.Duration `name:"ttl" help:"Lock the keyring again after this long" default:"15m"`
+	Foreground bool          `name:"foreground" help:"Run the agent in this process instead of in the background"`
+}
+
+func (c *AuthUnlockCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+
+	info, err := secrets.ResolveKeyringBackendInfo()
+	if err != nil {
+		return fmt.Errorf("resolve keyring backend: %w", err)
+	}
+	if backend := secrets.EffectiveKeyringBackend(info); backend != strFile {
+		return usagef("auth unlock only applies to the file keyring backend (current: %s)", backend)
+	}
+	if c.TTL <= 0 {
+		return usage("--ttl must be positive")
+	}
+
+	passphrase, err := readKeyringPassphrase()
+	if err != nil {
+		return err
+	}
+	if err := verifyKeyringPassphrase(passphrase); err != nil {
+		return err
+	}
+
+	socket, err := secrets.AgentSocketPath()
+	if err != nil {
+		return err
+	}
+
+	// Unlocking again replaces the running agent, which restarts the TTL.
+	// LockAgent returns once the old agent has let go of the socket.
+	if err := secrets.LockAgent(ctx); err != nil && !errors.Is(err, secrets.ErrAgentNotRunning) {
+		return err
+	}
+
+	if !c.Foreground {
+		if err := startKeyringAgent(ctx, c.TTL, passphrase); err != nil {
+			return err
+		}
+		expires, _ := secrets.AgentUnlocked(ctx)
+		return printUnlocked(ctx, u, socket, expires)
+	}
+
+	ring, err := openFileKeyring(passphrase)
+	if err != nil {
+		return err
+	}
+
+	ln, err := secrets.ListenAgent(socket)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(socket) }()
+
+	agent := secrets.NewAgent(ring, c.TTL)
+	if err := printUnlocked(ctx, u, socket, agent.ExpiresAt()); err != nil {
+		_ = ln.Close()
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return serveKeyringAgent(ctx, agent, ln)
+}
+
+func printUnlocked(ctx context.Context, u *ui.UI, socket string, expires time.Time) error {
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"unlocked": true, "socket": socket, "expires_at": expires})
+	}
+	if u == nil {
+		return nil
+	}
+	u.Out().Printf("unlocked\ttrue")
+	u.Out().Printf("expires\t%s", expires.Local().Format(time.RFC3339))
+	u.Err().Println("File keyring unlocked; run `gog auth lock` to lock it sooner.")
+	return nil
+}
+
+type AuthLockCmd struct{}
+
+func (c *AuthLockCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+
+	wasUnlocked := true
+	if err := secrets.LockAgent(ctx); err != nil {
+		if !errors.Is(err, secrets.ErrAgentNotRunning) {
+			return err
+		}
+		wasUnlocked = false
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"locked": true, "was_unlocked": wasUnlocked})
+	}
+	if u == nil {
+		return nil
+	}
+	u.Out().Printf("locked\ttrue")
+	u.Out().Printf("was_unlocked\t%t", wasUnlocked)
+	return nil
+}
+
+// promptKeyringPassphrase asks on the terminal, or reads one line from stdin
+// so the passphrase can be piped in from a password manager.
+func promptKeyringPassphrase() (string, error) {
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		_, _ = fmt.Fprint(os.Stderr, "Enter passphrase to unlock the keyring: ")
+		b, err := term.ReadPassword(int(os.Stdin.Fd()))
+		_, _ = fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("read passphrase: %w", err)
+		}
+		return string(b), nil
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("read passphrase from stdin: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// spawnKeyringAgent starts `gog auth unlock --foreground` detached from this
+// terminal, hands it the passphrase over stdin and waits until it answers.
+func spawnKeyringAgent(ctx context.Context, ttl time.Duration, passphrase string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locate gog binary: %w", err)
+	}
+
+	cmd := exec.Command(exe, "auth", "unlock", "--foreground", "--ttl", ttl.String()) //nolint:gosec // re-executes this binary
+	cmd.SysProcAttr = detachedProcAttr()
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start keyring agent: %w", err)
+	}
+	_, _ = fmt.Fprintln(stdin, passphrase)
+	_ = stdin.Close()
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	deadline := time.After(10 * time.Second)
+	for {
+		if _, ok := secrets.AgentUnlocked(ctx); ok {
+			return nil
+		}
+		select {
+		case err := <-exited:
+			return fmt.Errorf("%w: %v", errAgentStart, err)
+		case <-deadline:
+			_ = cmd.Process.Kill()
+			return fmt.Errorf("%w within 10s", errAgentStart)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+func setupKeyringAgent(t *testing.T) string {
+	t.Helper()
+	setupProfileHome(t)
+	t.Setenv("GOG_KEYRING_BACKEND", "file")
+	t.Setenv("GOG_KEYRING_PASSWORD", "")
+
+	// Unix socket paths are limited to ~100 bytes; t.TempDir can be longer.
+	dir, err := os.MkdirTemp("", "gogk")
+	if err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "agent.sock")
+	t.Setenv("GOG_KEYRING_AGENT_SOCK", socket)
+
+	origRead := readKeyringPassphrase
+	origVerify := verifyKeyringPassphrase
+	origOpen := openFileKeyring
+	origStart := startKeyringAgent
+	origServe := serveKeyringAgent
+	t.Cleanup(func() {
+		readKeyringPassphrase = origRead
+		verifyKeyringPassphrase = origVerify
+		openFileKeyring = origOpen
+		startKeyringAgent = origStart
+		serveKeyringAgent = origServe
+	})
+	readKeyringPassphrase = func() (string, error) { return "pw", nil }
+	verifyKeyringPassphrase = func(passphrase string) error {
+		if passphrase != "pw" {
+			return errors.New("wrong passphrase")
+		}
+		return nil
+	}
+	openFileKeyring = func(string) (keyring.Keyring, error) {
+		return keyring.NewArrayKeyring([]keyring.Item{{Key: "k", Data: []byte("v")}}), nil
+	}
+	startKeyringAgent = func(context.Context, time.Duration, string) error {
+		t.Fatal("unexpected background agent")
+		return nil
+	}
+	return socket
+}
+
+func TestAuthUnlock_ForegroundServesUntilLocked(t *testing.T) {
+	socket := setupKeyringAgent(t)
+
+	var expires time.Time
+	serveKeyringAgent = func(ctx context.Context, agent *secrets.Agent, ln net.Listener) error {
+		done := make(chan error, 1)
+		go func() { done <- agent.Serve(ctx, ln) }()
+
+		var ok bool
+		deadline := time.Now().Add(5 * time.Second)
+		for !ok && time.Now().Before(deadline) {
+			expires, ok = secrets.AgentUnlocked(ctx)
+			time.Sleep(10 * time.Millisecond)
+		}
+		if !ok {
+			t.Error("agent not reachable")
+		}
+		// Other commands read the keyring through the agent, without a passphrase.
+		if v, err := secrets.GetSecret("k"); err != nil || string(v) != "v" {
+			t.Errorf("GetSecret via agent: %q %v", v, err)
+		}
+		if err := secrets.LockAgent(ctx); err != nil {
+			t.Errorf("LockAgent: %v", err)
+		}
+		return <-done
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "unlock", "--foreground", "--ttl", "30m"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var payload struct {
+		Unlocked  bool      `json:"unlocked"`
+		Socket    string    `json:"socket"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.Unmarshal([]byte(out), &payload); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if !payload.Unlocked || payload.Socket != socket || !payload.ExpiresAt.Equal(expires) {
+		t.Fatalf("unexpected payload: %#v (agent expires %v)", payload, expires)
+	}
+	if d := time.Until(payload.ExpiresAt); d < 29*time.Minute || d > 30*time.Minute {
+		t.Fatalf("unexpected ttl: %v", d)
+	}
+	if _, err := os.Stat(socket); !os.IsNotExist(err) {
+		t.Fatalf("socket not removed on exit: %v", err)
+	}
+}
+
+func TestAuthUnlock_StartsBackgroundAgent(t *testing.T) {
+	setupKeyringAgent(t)
+
+	var gotTTL time.Duration
+	var gotPass string
+	startKeyringAgent = func(_ context.Context, ttl time.Duration, passphrase string) error {
+		gotTTL, gotPass = ttl, passphrase
+		return nil
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"auth", "unlock", "--ttl", "1h"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if gotTTL != time.Hour || gotPass != "pw" {
+		t.Fatalf("unexpected agent start: ttl=%v pass=%q", gotTTL, gotPass)
+	}
+	if !strings.Contains(out, "unlocked\ttrue") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestAuthUnlock_Rejects(t *testing.T) {
+	setupKeyringAgent(t)
+
+	_ = captureStderr(t, func() {
+		readKeyringPassphrase = func() (string, error) { return "nope", nil }
+		if err := Execute([]string{"auth", "unlock"}); err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+			t.Fatalf("expected wrong passphrase error, got %v", err)
+		}
+
+		t.Setenv("GOG_KEYRING_BACKEND", "keychain")
+		err := Execute([]string{"auth", "unlock"})
+		if err == nil || ExitCode(err) != 2 || !strings.Contains(err.Error(), "file keyring backend") {
+			t.Fatalf("expected usage error for keychain backend, got %v", err)
+		}
+	})
+}
+
+func TestAuthLock_NotRunning(t *testing.T) {
+	setupKeyringAgent(t)
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "auth", "lock"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	var payload map[string]bool
+	if err := json.Unmarshal([]byte(out), &payload); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if !payload["locked"] || payload["was_unlocked"] {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build windows
+
+package cmd
+
+import "syscall"
+
+// detachedProcAttr is a no-op on Windows; the agent outlives its parent anyway.
+func detachedProcAttr() *syscall.SysProcAttr {
+	return nil
+}
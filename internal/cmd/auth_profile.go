@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type AuthProfileCmd struct {
+	List   AuthProfileListCmd   `cmd:"" name:"list" default:"withargs" help:"List profiles"`
+	Create AuthProfileCreateCmd `cmd:"" name:"create" help:"Create or replace a profile (account + defaults)"`
+	Use    AuthProfileUseCmd    `cmd:"" name:"use" help:"Make a profile current (applies when --profile/GOG_PROFILE are unset)"`
+	Delete AuthProfileDeleteCmd `cmd:"" name:"delete" help:"Delete a profile"`
+}
+
+type AuthProfileListCmd struct{}
+
+func (c *AuthProfileListCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+	profiles, current, err := config.ListProfiles()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"profiles": profiles, "current": current})
+	}
+	if len(profiles) == 0 {
+		u.Err().Println("No profiles (create one: gog auth profile create <name> <account>)")
+		return nil
+	}
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "CURRENT\tPROFILE\tACCOUNT\tCLIENT\tOUTPUT\tCALENDAR\tDRIVE_FOLDER")
+	for _, name := range names {
+		p := profiles[name]
+		marker := ""
+		if name == current {
+			marker = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", marker, name, p.Account, p.Client, p.Output, p.Calendar, p.DriveFolder)
+	}
+	return nil
+}
+
+type AuthProfileCreateCmd struct {
+	Name        string `arg:"" name:"name" help:"Profile name (e.g. work, personal)"`
+	Account     string `arg:"" name:"account" help:"Account email or alias"`
+	OAuthClient string `name:"oauth-client" help:"OAuth client name to use with this profile"`
+	Output      string `name:"output" help:"Default output: json|plain|text"`
+	Calendar    string `name:"calendar" help:"Default calendar ID for calendar commands"`
+	DriveFolder string `name:"drive-folder" help:"Default Drive folder ID for drive ls/upload/mkdir"`
+	Use         bool   `name:"use" help:"Also make it the current profile"`
+}
+
+func (c *AuthProfileCreateCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+	name := config.NormalizeProfileName(c.Name)
+	if name == "" {
+		return usage("empty profile name")
+	}
+	if strings.ContainsAny(name, " \t@") {
+		return usage("profile name must not contain spaces or '@'")
+	}
+	account := strings.TrimSpace(c.Account)
+	if account == "" {
+		return usage("empty account")
+	}
+	output := strings.ToLower(strings.TrimSpace(c.Output))
+	switch output {
+	case "", "json", "plain", "text":
+	default:
+		return usagef("invalid --output %q (expected json|plain|text)", c.Output)
+	}
+	client := strings.TrimSpace(c.OAuthClient)
+	if client != "" {
+		normalized, err := config.NormalizeClientName(client)
+		if err != nil {
+			return usage(err.Error())
+		}
+		client = normalized
+	}
+
+	p := config.Profile{
+		Account:     account,
+		Client:      client,
+		Output:      output,
+		Calendar:    strings.TrimSpace(c.Calendar),
+		DriveFolder: strings.TrimSpace(c.DriveFolder),
+	}
+	if err := config.SetProfile(name, p); err != nil {
+		return err
+	}
+	if c.Use {
+		if err := config.UseProfile(name); err != nil {
+			return err
+		}
+	}
+
+	saved, err := config.GetProfile(name)
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"profile": name,
+			"current": c.Use,
+			"config":  saved,
+		})
+	}
+	u.Out().Printf("profile\t%s", name)
+	u.Out().Printf("account\t%s", saved.Account)
+	if saved.Client != "" {
+		u.Out().Printf("client\t%s", saved.Client)
+	}
+	u.Out().Printf("current\t%t", c.Use)
+	return nil
+}
+
+type AuthProfileUseCmd struct {
+	Name string `arg:"" name:"name" help:"Profile name ('none' clears the current profile)"`
+}
+
+func (c *AuthProfileUseCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+	name := config.NormalizeProfileName(c.Name)
+	if name == "" {
+		return usage("empty profile name")
+	}
+	if name == "none" {
+		name = ""
+	}
+	if err := config.UseProfile(name); err != nil {
+		return usage(err.Error())
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"current": name})
+	}
+	u.Out().Printf("current\t%s", name)
+	if v := strings.TrimSpace(os.Getenv("GOG_PROFILE")); v != "" && !outfmt.IsPlain(ctx) {
+		u.Err().Printf("NOTE: GOG_PROFILE=%s overrides the current profile", v)
+	}
+	return nil
+}
+
+type AuthProfileDeleteCmd struct {
+	Name string `arg:"" name:"name" help:"Profile name"`
+}
+
+func (c *AuthProfileDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	name := config.NormalizeProfileName(c.Name)
+	if name == "" {
+		return usage("empty profile name")
+	}
+	if err := confirmDestructive(ctx, flags, fmt.Sprintf("delete profile %s", name)); err != nil {
+		return err
+	}
+	deleted, err := config.DeleteProfile(name)
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"deleted": deleted, "profile": name})
+	}
+	u.Out().Printf("deleted\t%t", deleted)
+	u.Out().Printf("profile\t%s", name)
+	return nil
+}
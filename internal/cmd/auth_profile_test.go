@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+func setupProfileHome(t *testing.T) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv("GOG_ACCOUNT", "")
+	t.Setenv("GOG_PROFILE", "")
+	t.Setenv("GOG_CLIENT", "")
+	t.Setenv("GOG_JSON", "")
+	t.Setenv("GOG_PLAIN", "")
+	t.Chdir(home)
+}
+
+type profileStatus struct {
+	Profile struct {
+		Name   string `json:"name"`
+		Source string `json:"source"`
+	} `json:"profile"`
+	Account struct {
+		Email  string `json:"email"`
+		Client string `json:"client"`
+	} `json:"account"`
+}
+
+func runProfileStatus(t *testing.T, args ...string) profileStatus {
+	t.Helper()
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute(append(args, "--json", "auth", "status")); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	var parsed profileStatus
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	return parsed
+}
+
+func TestAuthProfile_CreateUseList(t *testing.T) {
+	setupProfileHome(t)
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"auth", "profile", "create", "work", "Work@Example.com", "--oauth-client", "corp", "--calendar", "team"}); err != nil {
+			t.Fatalf("create work: %v", err)
+		}
+		if err := Execute([]string{"auth", "profile", "create", "personal", "me@example.com", "--use"}); err != nil {
+			t.Fatalf("create personal: %v", err)
+		}
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "auth", "profile", "list"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	var listed struct {
+		Profiles map[string]config.Profile `json:"profiles"`
+		Current  string                    `json:"current"`
+	}
+	if err := json.Unmarshal([]byte(out), &listed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if listed.Current != "personal" || len(listed.Profiles) != 2 {
+		t.Fatalf("unexpected list: %#v", listed)
+	}
+	if w := listed.Profiles["work"]; w.Account != "work@example.com" || w.Client != "corp" || w.Calendar != "team" {
+		t.Fatalf("unexpected work profile: %#v", w)
+	}
+
+	if got := runProfileStatus(t); got.Account.Email != "me@example.com" || got.Profile.Name != "personal" || got.Profile.Source != "current" {
+		t.Fatalf("current profile not applied: %#v", got)
+	}
+
+	got := runProfileStatus(t, "--profile", "work")
+	if got.Account.Email != "work@example.com" || got.Account.Client != "corp" || got.Profile.Source != "explicit" {
+		t.Fatalf("--profile not applied: %#v", got)
+	}
+
+	t.Setenv("GOG_PROFILE", "work")
+	if got := runProfileStatus(t); got.Account.Email != "work@example.com" {
+		t.Fatalf("GOG_PROFILE not applied: %#v", got)
+	}
+	t.Setenv("GOG_PROFILE", "")
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"auth", "profile", "use", "none"}); err != nil {
+			t.Fatalf("use none: %v", err)
+		}
+	})
+	if got := runProfileStatus(t); got.Profile.Name != "" || got.Account.Email != "" {
+		t.Fatalf("current profile not cleared: %#v", got)
+	}
+}
+
+func TestAuthProfile_UnknownProfile(t *testing.T) {
+	setupProfileHome(t)
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"auth", "profile", "use", "missing"}); err == nil {
+			t.Fatal("expected error for unknown profile")
+		}
+		if err := Execute([]string{"--profile", "missing", "auth", "status"}); err == nil {
+			t.Fatal("expected error for unknown --profile")
+		}
+	})
+}
+
+func TestAuthProfile_PrecedenceWithProject(t *testing.T) {
+	setupProfileHome(t)
+	if err := config.SetProfile("work", config.Profile{Account: "work@example.com"}); err != nil {
+		t.Fatalf("SetProfile: %v", err)
+	}
+	if err := config.UseProfile("work"); err != nil {
+		t.Fatalf("UseProfile: %v", err)
+	}
+	chdirProject(t, "account = \"proj@example.com\"\n")
+
+	// The project file beats the current profile...
+	if got, err := explicitAccount(&RootFlags{}); err != nil || got != "proj@example.com" {
+		t.Fatalf("explicitAccount = %q, %v", got, err)
+	}
+	// ...an explicitly selected profile beats the project file...
+	if got, err := explicitAccount(&RootFlags{Profile: "work"}); err != nil || got != "work@example.com" {
+		t.Fatalf("explicitAccount(--profile) = %q, %v", got, err)
+	}
+	// ...and --account beats both.
+	if got, err := explicitAccount(&RootFlags{Profile: "work", Account: "flag@example.com"}); err != nil || got != "flag@example.com" {
+		t.Fatalf("explicitAccount(--account) = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/secrets"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type AuthScopesCmd struct {
+	Add AuthScopesAddCmd `cmd:"" name:"add" help:"Grant scopes for more services to a stored account without a full re-login"`
+}
+
+type AuthScopesAddCmd struct {
+	Services     []string `arg:"" name:"service" help:"Services to add (comma-separated or repeated): ${auth_services}"`
+	Manual       bool     `name:"manual" help:"Browserless auth flow (paste redirect URL)"`
+	Device       bool     `name:"device" help:"Device code flow (re-requests all scopes; device flow has no incremental consent)"`
+	ForceConsent bool     `name:"force-consent" help:"Force consent screen to obtain a refresh token"`
+	Readonly     bool     `name:"readonly" help:"Use read-only scopes where available"`
+	DriveScope   string   `name:"drive-scope" help:"Drive scope mode: full|readonly|file" enum:"full,readonly,file" default:"full"`
+}
+
+func (c *AuthScopesAddCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+
+	email, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if c.Manual && c.Device {
+		return usage("use either --manual or --device")
+	}
+	if c.Readonly && c.DriveScope == strFile {
+		return usage("cannot combine --readonly with --drive-scope=file (file is write-capable)")
+	}
+
+	services, err := parseAuthServices(strings.Join(c.Services, ","))
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		return usage("no services selected")
+	}
+
+	wanted, err := googleauth.ScopesForManageWithOptions(services, googleauth.ScopeOptions{
+		Readonly:   c.Readonly,
+		DriveScope: googleauth.DriveScopeMode(c.DriveScope),
+	})
+	if err != nil {
+		return err
+	}
+
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	client, err := resolveClientForEmailWithContext(ctx, email, "")
+	if err != nil {
+		return err
+	}
+	tok, err := store.GetToken(client, email)
+	if err != nil {
+		return fmt.Errorf("no stored token for %s (run: gog auth add %s): %w", email, email, err)
+	}
+
+	serviceNames := mergeServiceNames(tok.Services, services)
+	missing := googleauth.MissingScopes(tok.Scopes, wanted)
+
+	if len(missing) == 0 {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, map[string]any{
+				"updated":  false,
+				"email":    tok.Email,
+				"client":   client,
+				"services": tok.Services,
+				"added":    []string{},
+			})
+		}
+		u.Err().Printf("All scopes for %s are already granted", strings.Join(c.Services, ","))
+		return nil
+	}
+
+	merged := googleauth.MergeScopes(tok.Scopes, wanted)
+
+	// Incremental consent: ask only for the new scopes (plus identity) and let
+	// include_granted_scopes fold the existing grant into the new token. The
+	// device flow does not support that, so it re-requests everything.
+	request := googleauth.MergeScopes(missing, googleauth.IdentityScopes())
+	if c.Device {
+		request = merged
+		if err := googleauth.ValidateDeviceScopes(request); err != nil {
+			return usage(err.Error())
+		}
+	}
+
+	if keychainErr := ensureKeychainAccessIfNeeded(); keychainErr != nil {
+		return fmt.Errorf("keychain access: %w", keychainErr)
+	}
+
+	refreshToken, err := authorizeGoogle(ctx, googleauth.AuthorizeOptions{
+		Services:     services,
+		Scopes:       request,
+		Manual:       c.Manual,
+		Device:       c.Device,
+		ForceConsent: c.ForceConsent,
+		Client:       client,
+	})
+	if err != nil {
+		return err
+	}
+
+	authorizedEmail, err := fetchAuthorizedEmail(ctx, client, refreshToken, merged, 15*time.Second)
+	if err != nil {
+		return fmt.Errorf("fetch authorized email: %w", err)
+	}
+	if normalizeEmail(authorizedEmail) != normalizeEmail(tok.Email) {
+		return fmt.Errorf("authorized as %s, expected %s", authorizedEmail, tok.Email)
+	}
+
+	if err := store.SetToken(client, tok.Email, secrets.Token{
+		Client:       client,
+		Email:        tok.Email,
+		Services:     serviceNames,
+		Scopes:       merged,
+		RefreshToken: refreshToken,
+	}); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"updated":  true,
+			"email":    tok.Email,
+			"client":   client,
+			"services": serviceNames,
+			"added":    missing,
+		})
+	}
+	u.Out().Printf("email\t%s", tok.Email)
+	u.Out().Printf("services\t%s", strings.Join(serviceNames, ","))
+	u.Out().Printf("client\t%s", client)
+	for _, scope := range missing {
+		u.Out().Printf("added\t%s", scope)
+	}
+	return nil
+}
+
+func mergeServiceNames(existing []string, services []googleauth.Service) []string {
+	seen := make(map[string]struct{}, len(existing)+len(services))
+	out := make([]string, 0, len(existing)+len(services))
+	for _, name := range existing {
+		if _, ok := seen[name]; ok || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		out = append(out, name)
+	}
+	for _, svc := range services {
+		if _, ok := seen[string(svc)]; ok {
+			continue
+		}
+		seen[string(svc)] = struct{}{}
+		out = append(out, string(svc))
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+func TestAuthScopesAdd_RequestsOnlyNewScopes(t *testing.T) {
+	origAuth := authorizeGoogle
+	origOpen := openSecretsStore
+	origKeychain := ensureKeychainAccess
+	origFetch := fetchAuthorizedEmail
+	t.Cleanup(func() {
+		authorizeGoogle = origAuth
+		openSecretsStore = origOpen
+		ensureKeychainAccess = origKeychain
+		fetchAuthorizedEmail = origFetch
+	})
+
+	ensureKeychainAccess = func() error { return nil }
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	gmailScopes, err := googleauth.ScopesForManageWithOptions([]googleauth.Service{googleauth.ServiceGmail}, googleauth.ScopeOptions{})
+	if err != nil {
+		t.Fatalf("scopes: %v", err)
+	}
+	_ = store.SetToken(config.DefaultClientName, "user@example.com", secrets.Token{
+		RefreshToken: "old",
+		Services:     []string{"gmail"},
+		Scopes:       gmailScopes,
+	})
+
+	var gotOpts googleauth.AuthorizeOptions
+	authorizeGoogle = func(_ context.Context, opts googleauth.AuthorizeOptions) (string, error) {
+		gotOpts = opts
+		return "new", nil
+	}
+	fetchAuthorizedEmail = func(context.Context, string, string, []string, time.Duration) (string, error) {
+		return "user@example.com", nil
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "user@example.com", "auth", "scopes", "add", "tasks"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	tasksScopes, err := googleauth.Scopes(googleauth.ServiceTasks)
+	if err != nil {
+		t.Fatalf("tasks scopes: %v", err)
+	}
+	for _, s := range gmailScopes {
+		if slices.Contains(googleauth.IdentityScopes(), s) {
+			continue
+		}
+		if slices.Contains(gotOpts.Scopes, s) {
+			t.Fatalf("re-requested granted scope %q: %v", s, gotOpts.Scopes)
+		}
+	}
+	for _, s := range tasksScopes {
+		if !slices.Contains(gotOpts.Scopes, s) {
+			t.Fatalf("missing requested scope %q: %v", s, gotOpts.Scopes)
+		}
+	}
+
+	var parsed struct {
+		Updated  bool     `json:"updated"`
+		Services []string `json:"services"`
+		Added    []string `json:"added"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if !parsed.Updated || !slices.Equal(parsed.Services, []string{"gmail", "tasks"}) || len(parsed.Added) != len(tasksScopes) {
+		t.Fatalf("unexpected response: %#v", parsed)
+	}
+
+	tok, err := store.GetToken(config.DefaultClientName, "user@example.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+	if tok.RefreshToken != "new" || !slices.Contains(tok.Scopes, gmailScopes[0]) || !slices.Contains(tok.Scopes, tasksScopes[0]) {
+		t.Fatalf("unexpected merged token: %#v", tok)
+	}
+}
+
+func TestAuthScopesAdd_AlreadyGranted(t *testing.T) {
+	origAuth := authorizeGoogle
+	origOpen := openSecretsStore
+	t.Cleanup(func() {
+		authorizeGoogle = origAuth
+		openSecretsStore = origOpen
+	})
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	scopes, err := googleauth.ScopesForManageWithOptions([]googleauth.Service{googleauth.ServiceGmail}, googleauth.ScopeOptions{})
+	if err != nil {
+		t.Fatalf("scopes: %v", err)
+	}
+	_ = store.SetToken(config.DefaultClientName, "user@example.com", secrets.Token{RefreshToken: "old", Services: []string{"gmail"}, Scopes: scopes})
+
+	authorizeGoogle = func(context.Context, googleauth.AuthorizeOptions) (string, error) {
+		t.Fatal("authorize should not run when nothing is missing")
+		return "", nil
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "user@example.com", "auth", "scopes", "add", "gmail"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var parsed struct {
+		Updated bool `json:"updated"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil || parsed.Updated {
+		t.Fatalf("unexpected output: %q (%v)", out, err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 )
 
 type AuthServiceAccountCmd struct {
+	Add    AuthServiceAccountAddCmd    `cmd:"" name:"add" help:"Store a service account key for a delegated subject (use with --as-service-account)"`
 	Set    AuthServiceAccountSetCmd    `cmd:"" name:"set" help:"Store a service account key for impersonation"`
 	Unset  AuthServiceAccountUnsetCmd  `cmd:"" name:"unset" help:"Remove stored service account key"`
 	Status AuthServiceAccountStatusCmd `cmd:"" name:"status" help:"Show stored service account key status"`
@@ -84,8 +85,6 @@ type AuthServiceAccountSetCmd struct {
 }
 
 func (c *AuthServiceAccountSetCmd) Run(ctx context.Context) error {
-	u := ui.FromContext(ctx)
-
 	email := strings.TrimSpace(c.Email)
 	if email == "" {
 		return usage("empty email")
@@ -95,7 +94,29 @@ func (c *AuthServiceAccountSetCmd) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	return writeServiceAccountStored(ctx, email, destPath, info, "gog <cmd> --account "+email)
+}
+
+type AuthServiceAccountAddCmd struct {
+	Key     string `name:"key" required:"" help:"Path to service account JSON key file"`
+	Subject string `name:"subject" required:"" help:"Workspace user to impersonate via domain-wide delegation"`
+}
+
+func (c *AuthServiceAccountAddCmd) Run(ctx context.Context) error {
+	subject := normalizeEmail(c.Subject)
+	if subject == "" {
+		return usage("empty --subject")
+	}
 
+	destPath, info, err := storeServiceAccountKey(subject, c.Key)
+	if err != nil {
+		return err
+	}
+	return writeServiceAccountStored(ctx, subject, destPath, info, "gog <cmd> --as-service-account --account "+subject)
+}
+
+func writeServiceAccountStored(ctx context.Context, email string, destPath string, info serviceAccountJSONInfo, usageHint string) error {
+	u := ui.FromContext(ctx)
 	if outfmt.IsJSON(ctx) {
 		return outfmt.WriteJSON(os.Stdout, map[string]any{
 			"stored":       true,
@@ -113,7 +134,7 @@ func (c *AuthServiceAccountSetCmd) Run(ctx context.Context) error {
 	if info.ClientID != "" {
 		u.Out().Printf("client_id\t%s", info.ClientID)
 	}
-	u.Out().Println("Service account configured. Use: gog <cmd> --account " + email)
+	u.Out().Println("Service account configured. Use: " + usageHint)
 	return nil
 }
 
@@ -92,3 +92,54 @@ func TestAuthStatus_ShowsServiceAccountPreferred(t *testing.T) {
 		t.Fatalf("unexpected status output: %q", out)
 	}
 }
+
+func TestAuthServiceAccountAdd_SelectsSubjectWithAsServiceAccount(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv("GOG_ACCOUNT", "")
+
+	flags := &RootFlags{AsServiceAccount: true}
+	if _, err := requireAccount(flags); err == nil || !strings.Contains(err.Error(), "no service account configured") {
+		t.Fatalf("expected missing service account error, got: %v", err)
+	}
+
+	keyPath := filepath.Join(t.TempDir(), "sa.json")
+	if err := os.WriteFile(keyPath, []byte(`{"type":"service_account","client_email":"svc@example.com"}`), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "auth", "service-account", "add", "--key", keyPath, "--subject", "User@Example.com"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"email": "user@example.com"`) || !strings.Contains(out, `"client_email": "svc@example.com"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+
+	account, err := requireAccount(flags)
+	if err != nil || account != "user@example.com" {
+		t.Fatalf("expected subject selected, got %q (%v)", account, err)
+	}
+
+	if _, _, err := storeServiceAccountKey("other@example.com", keyPath); err != nil {
+		t.Fatalf("store: %v", err)
+	}
+	if _, err := requireAccount(flags); err == nil || !strings.Contains(err.Error(), "multiple service accounts") {
+		t.Fatalf("expected ambiguity error, got: %v", err)
+	}
+	flags.Account = "other@example.com"
+	if account, err := requireAccount(flags); err != nil || account != "other@example.com" {
+		t.Fatalf("expected explicit account, got %q (%v)", account, err)
+	}
+}
+
+func TestImpersonate_RequiresEmail(t *testing.T) {
+	_ = captureStderr(t, func() {
+		err := Execute([]string{"--impersonate", "bob", "auth", "service-account", "status", "bob@example.com"})
+		if ExitCode(err) != 2 {
+			t.Fatalf("expected usage error, got: %v", err)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/errfmt"
+	"github.com/steipete/gogcli/internal/googleauth"
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/secrets"
 	"github.com/steipete/gogcli/internal/ui"
@@ -207,3 +210,114 @@ func (m *memStore) SetDefaultAccount(client string, email string) error {
 	m.defaultEmail = email
 	return nil
 }
+
+func TestAuthTokensShowAndRefresh_JSON(t *testing.T) {
+	origOpen := openSecretsStore
+	origRefresh := refreshAccessToken
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		refreshAccessToken = origRefresh
+	})
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	_ = store.SetToken(config.DefaultClientName, "a@b.com", secrets.Token{
+		RefreshToken: "rt",
+		Services:     []string{"gmail"},
+		Scopes:       []string{"s1"},
+		CreatedAt:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	showOut := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "tokens", "show", "a@b.com"}); err != nil {
+				t.Fatalf("show: %v", err)
+			}
+		})
+	})
+	if strings.Contains(showOut, `"rt"`) {
+		t.Fatalf("show leaked refresh token: %q", showOut)
+	}
+	var showResp struct {
+		Token tokenInfo `json:"token"`
+	}
+	if err := json.Unmarshal([]byte(showOut), &showResp); err != nil {
+		t.Fatalf("show json: %v\nout=%q", err, showOut)
+	}
+	if showResp.Token.CreatedAt != "2025-01-01T00:00:00Z" || len(showResp.Token.Scopes) != 1 || showResp.Token.Expiry != "" {
+		t.Fatalf("unexpected show resp: %#v", showResp.Token)
+	}
+
+	expiry := time.Date(2030, 1, 1, 1, 0, 0, 0, time.UTC)
+	refreshAccessToken = func(_ context.Context, client string, refreshToken string, _ []string, _ time.Duration) (googleauth.RefreshResult, error) {
+		if client != config.DefaultClientName || refreshToken != "rt" {
+			t.Fatalf("unexpected refresh args: %q %q", client, refreshToken)
+		}
+		return googleauth.RefreshResult{Expiry: expiry, Scopes: []string{"s1", "s2"}}, nil
+	}
+
+	refreshOut := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "tokens", "refresh", "a@b.com"}); err != nil {
+				t.Fatalf("refresh: %v", err)
+			}
+		})
+	})
+	var refreshResp struct {
+		Refreshed bool      `json:"refreshed"`
+		Token     tokenInfo `json:"token"`
+	}
+	if err := json.Unmarshal([]byte(refreshOut), &refreshResp); err != nil {
+		t.Fatalf("refresh json: %v\nout=%q", err, refreshOut)
+	}
+	if !refreshResp.Refreshed || refreshResp.Token.Expiry != "2030-01-01T01:00:00Z" || len(refreshResp.Token.Scopes) != 2 {
+		t.Fatalf("unexpected refresh resp: %#v", refreshResp)
+	}
+
+	stored, err := store.GetToken(config.DefaultClientName, "a@b.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+	if !stored.Expiry.Equal(expiry) || stored.RefreshedAt.IsZero() || stored.RefreshToken != "rt" {
+		t.Fatalf("refresh not recorded: %#v", stored)
+	}
+
+	listOut := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"auth", "tokens", "list"}); err != nil {
+				t.Fatalf("list: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(listOut, "token:default:a@b.com") || !strings.Contains(listOut, "2030-01-01T01:00:00Z") {
+		t.Fatalf("unexpected list output: %q", listOut)
+	}
+}
+
+func TestAuthTokensRefresh_Revoked(t *testing.T) {
+	origOpen := openSecretsStore
+	origRefresh := refreshAccessToken
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		refreshAccessToken = origRefresh
+	})
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	_ = store.SetToken(config.DefaultClientName, "a@b.com", secrets.Token{RefreshToken: "rt", Services: []string{"gmail", "drive"}})
+
+	refreshAccessToken = func(context.Context, string, string, []string, time.Duration) (googleauth.RefreshResult, error) {
+		return googleauth.RefreshResult{}, fmt.Errorf("refresh access token: %w", googleauth.ErrTokenRevoked)
+	}
+
+	var err error
+	_ = captureStderr(t, func() {
+		err = Execute([]string{"auth", "tokens", "refresh", "a@b.com"})
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if msg := errfmt.Format(err); !strings.Contains(msg, "revoked") || !strings.Contains(msg, "gog auth add a@b.com --services gmail,drive --force-consent") {
+		t.Fatalf("unexpected error: %q", msg)
+	}
+}
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"strings"
 
@@ -11,18 +10,28 @@ import (
 )
 
 type CalendarCmd struct {
-	Calendars       CalendarCalendarsCmd       `cmd:"" name:"calendars" help:"List calendars"`
-	ACL             CalendarAclCmd             `cmd:"" name:"acl" help:"List calendar ACL"`
+	Calendars       CalendarCalendarsCmd       `cmd:"" name:"calendars" help:"List and manage calendars"`
+	ACL             CalendarAclCmd             `cmd:"" name:"acl" help:"List and manage calendar sharing (ACL)"`
 	Events          CalendarEventsCmd          `cmd:"" name:"events" aliases:"list" help:"List events from a calendar or all calendars"`
 	Event           CalendarEventCmd           `cmd:"" name:"event" aliases:"get" help:"Get event"`
+	Instances       CalendarInstancesCmd       `cmd:"" name:"instances" help:"List occurrences of a recurring event"`
 	Create          CalendarCreateCmd          `cmd:"" name:"create" help:"Create an event"`
 	Update          CalendarUpdateCmd          `cmd:"" name:"update" help:"Update an event"`
 	Delete          CalendarDeleteCmd          `cmd:"" name:"delete" help:"Delete an event"`
+	Move            CalendarMoveCmd            `cmd:"" name:"move" help:"Move an event to another calendar"`
+	Copy            CalendarCopyCmd            `cmd:"" name:"copy" help:"Copy an event to another calendar"`
+	Sync            CalendarSyncCmd            `cmd:"" name:"sync" help:"Mirror events one-way from one calendar into another"`
+	ImportCSV       CalendarImportCSVCmd       `cmd:"" name:"import-csv" help:"Create events from a CSV file"`
+	Attachments     CalendarAttachmentsCmd     `cmd:"" name:"attachments" help:"List or download an event's attachments"`
+	Reminders       CalendarRemindersCmd       `cmd:"" name:"reminders" help:"Show or set event reminders and calendar defaults"`
+	Meet            CalendarMeetCmd            `cmd:"" name:"meet" help:"Print (or add) an event's Google Meet link"`
 	FreeBusy        CalendarFreeBusyCmd        `cmd:"" name:"freebusy" help:"Get free/busy"`
+	Watch           CalendarWatchCmd           `cmd:"" name:"watch" help:"Watch a calendar for changes (push channel or polling)"`
 	Respond         CalendarRespondCmd         `cmd:"" name:"respond" help:"Respond to an event invitation"`
 	ProposeTime     CalendarProposeTimeCmd     `cmd:"" name:"propose-time" help:"Generate URL to propose a new meeting time (browser-only feature)"`
 	Colors          CalendarColorsCmd          `cmd:"" name:"colors" help:"Show calendar colors"`
 	Conflicts       CalendarConflictsCmd       `cmd:"" name:"conflicts" help:"Find conflicts"`
+	FindSlot        CalendarFindSlotCmd        `cmd:"" name:"find-slot" help:"Propose free meeting slots across attendees"`
 	Search          CalendarSearchCmd          `cmd:"" name:"search" help:"Search events"`
 	Time            CalendarTimeCmd            `cmd:"" name:"time" help:"Show server time"`
 	Users           CalendarUsersCmd           `cmd:"" name:"users" help:"List workspace users (use their email as calendar ID)"`
@@ -32,101 +41,6 @@ type CalendarCmd struct {
 	WorkingLocation CalendarWorkingLocationCmd `cmd:"" name:"working-location" aliases:"wl" help:"Set working location (home/office/custom)"`
 }
 
-type CalendarCalendarsCmd struct {
-	Max  int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
-	Page string `name:"page" help:"Page token"`
-}
-
-func (c *CalendarCalendarsCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
-	account, err := requireAccount(flags)
-	if err != nil {
-		return err
-	}
-
-	svc, err := newCalendarService(ctx, account)
-	if err != nil {
-		return err
-	}
-
-	resp, err := svc.CalendarList.List().MaxResults(c.Max).PageToken(c.Page).Do()
-	if err != nil {
-		return err
-	}
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"calendars":     resp.Items,
-			"nextPageToken": resp.NextPageToken,
-		})
-	}
-	if len(resp.Items) == 0 {
-		u.Err().Println("No calendars")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "ID\tNAME\tROLE")
-	for _, cal := range resp.Items {
-		fmt.Fprintf(w, "%s\t%s\t%s\n", cal.Id, cal.Summary, cal.AccessRole)
-	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
-}
-
-type CalendarAclCmd struct {
-	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
-	Max        int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
-	Page       string `name:"page" help:"Page token"`
-}
-
-func (c *CalendarAclCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
-	account, err := requireAccount(flags)
-	if err != nil {
-		return err
-	}
-	calendarID := strings.TrimSpace(c.CalendarID)
-	if calendarID == "" {
-		return usage("calendarId required")
-	}
-
-	svc, err := newCalendarService(ctx, account)
-	if err != nil {
-		return err
-	}
-
-	resp, err := svc.Acl.List(calendarID).MaxResults(c.Max).PageToken(c.Page).Do()
-	if err != nil {
-		return err
-	}
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"rules":         resp.Items,
-			"nextPageToken": resp.NextPageToken,
-		})
-	}
-	if len(resp.Items) == 0 {
-		u.Err().Println("No ACL rules")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "SCOPE_TYPE\tSCOPE_VALUE\tROLE")
-	for _, rule := range resp.Items {
-		scopeType := ""
-		scopeValue := ""
-		if rule.Scope != nil {
-			scopeType = rule.Scope.Type
-			scopeValue = rule.Scope.Value
-		}
-		fmt.Fprintf(w, "%s\t%s\t%s\n", scopeType, scopeValue, rule.Role)
-	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
-}
-
 type CalendarEventsCmd struct {
 	CalendarID        string `arg:"" name:"calendarId" optional:"" help:"Calendar ID (default: primary)"`
 	From              string `name:"from" help:"Start time (RFC3339, date, or relative: today, tomorrow, monday)"`
@@ -157,7 +71,7 @@ func (c *CalendarEventsCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("calendarId not allowed with --all flag")
 	}
 	if !c.All && calendarID == "" {
-		calendarID = "primary"
+		calendarID = defaultCalendarID(flags)
 	}
 
 	svc, err := newCalendarService(ctx, account)
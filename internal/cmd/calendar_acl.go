@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarAclCmd struct {
+	List   CalendarAclListCmd   `cmd:"" default:"withargs" help:"List calendar ACL rules"`
+	Add    CalendarAclAddCmd    `cmd:"" help:"Share a calendar (add or update ACL rules)"`
+	Remove CalendarAclRemoveCmd `cmd:"" help:"Stop sharing a calendar (remove ACL rules)" aliases:"rm,delete"`
+}
+
+type CalendarAclListCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+	Max        int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
+	Page       string `name:"page" help:"Page token"`
+}
+
+func (c *CalendarAclListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("calendarId required")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	resp, err := svc.Acl.List(calendarID).MaxResults(c.Max).PageToken(c.Page).Do()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"rules":         resp.Items,
+			"nextPageToken": resp.NextPageToken,
+		})
+	}
+	if len(resp.Items) == 0 {
+		u.Err().Println("No ACL rules")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "RULE_ID\tSCOPE_TYPE\tSCOPE_VALUE\tROLE")
+	for _, rule := range resp.Items {
+		scopeType := ""
+		scopeValue := ""
+		if rule.Scope != nil {
+			scopeType = rule.Scope.Type
+			scopeValue = rule.Scope.Value
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rule.Id, scopeType, scopeValue, rule.Role)
+	}
+	printNextPageHint(u, resp.NextPageToken)
+	return nil
+}
+
+// CalendarAclTargets selects who an ACL rule applies to.
+type CalendarAclTargets struct {
+	Email  string `name:"email" help:"Comma-separated user emails"`
+	Group  string `name:"group" help:"Comma-separated group emails"`
+	Domain string `name:"domain" help:"Domain (everyone in it)"`
+	Public bool   `name:"public" help:"Everyone (public calendar)"`
+}
+
+func (t CalendarAclTargets) scopes() []*calendar.AclRuleScope {
+	var out []*calendar.AclRuleScope
+	for _, e := range splitCSV(t.Email) {
+		out = append(out, &calendar.AclRuleScope{Type: "user", Value: e})
+	}
+	for _, g := range splitCSV(t.Group) {
+		out = append(out, &calendar.AclRuleScope{Type: "group", Value: g})
+	}
+	if d := strings.TrimSpace(t.Domain); d != "" {
+		out = append(out, &calendar.AclRuleScope{Type: "domain", Value: d})
+	}
+	if t.Public {
+		out = append(out, &calendar.AclRuleScope{Type: "default"})
+	}
+	return out
+}
+
+// aclRuleID builds the rule ID Calendar assigns to a scope ("user:a@b.com", "default").
+func aclRuleID(scope *calendar.AclRuleScope) string {
+	if scope.Type == "default" {
+		return "default"
+	}
+	return scope.Type + ":" + scope.Value
+}
+
+func normalizeAclRole(role string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(role)) {
+	case "", "reader", "read":
+		return "reader", nil
+	case "writer", "write", "editor":
+		return "writer", nil
+	case "owner":
+		return "owner", nil
+	case "freebusy", "freebusyreader", "free-busy":
+		return "freeBusyReader", nil
+	default:
+		return "", usagef("invalid --role %q (expected reader|writer|owner|freebusy)", role)
+	}
+}
+
+type CalendarAclAddCmd struct {
+	CalendarID string             `arg:"" name:"calendarId" help:"Calendar ID"`
+	Targets    CalendarAclTargets `embed:""`
+	Role       string             `name:"role" help:"Access: reader, writer, owner, freebusy" default:"reader"`
+	NoNotify   bool               `name:"no-notify" help:"Do not email the new readers/writers"`
+}
+
+func (c *CalendarAclAddCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("calendarId required")
+	}
+	scopes := c.Targets.scopes()
+	if len(scopes) == 0 {
+		return usage("must specify --email, --group, --domain or --public")
+	}
+	role, err := normalizeAclRole(c.Role)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	rules := make([]*calendar.AclRule, 0, len(scopes))
+	for _, scope := range scopes {
+		created, err := svc.Acl.Insert(calendarID, &calendar.AclRule{Role: role, Scope: scope}).
+			SendNotifications(!c.NoNotify).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return fmt.Errorf("share with %s: %w", aclRuleID(scope), err)
+		}
+		rules = append(rules, created)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"calendarId": calendarID, "rules": rules})
+	}
+	for _, rule := range rules {
+		u.Out().Printf("added\t%s\t%s", rule.Id, rule.Role)
+	}
+	return nil
+}
+
+type CalendarAclRemoveCmd struct {
+	CalendarID string             `arg:"" name:"calendarId" help:"Calendar ID"`
+	RuleIDs    []string           `arg:"" name:"ruleId" optional:"" help:"ACL rule IDs (from 'acl list', e.g. user:a@b.com)"`
+	Targets    CalendarAclTargets `embed:""`
+}
+
+func (c *CalendarAclRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("calendarId required")
+	}
+	var ruleIDs []string
+	for _, id := range c.RuleIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			ruleIDs = append(ruleIDs, id)
+		}
+	}
+	for _, scope := range c.Targets.scopes() {
+		ruleIDs = append(ruleIDs, aclRuleID(scope))
+	}
+	if len(ruleIDs) == 0 {
+		return usage("must specify a ruleId, --email, --group, --domain or --public")
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("remove %s from calendar %s", strings.Join(ruleIDs, ", "), calendarID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ruleIDs {
+		if err := svc.Acl.Delete(calendarID, id).Context(ctx).Do(); err != nil {
+			return fmt.Errorf("remove %s: %w", id, err)
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"calendarId": calendarID, "removed": ruleIDs})
+	}
+	for _, id := range ruleIDs {
+		u.Out().Printf("removed\t%s", id)
+	}
+	return nil
+}
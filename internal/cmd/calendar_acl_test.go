@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestNormalizeAclRole(t *testing.T) {
+	for in, want := range map[string]string{"": "reader", "Writer": "writer", "owner": "owner", "freebusy": "freeBusyReader"} {
+		got, err := normalizeAclRole(in)
+		if err != nil || got != want {
+			t.Fatalf("normalizeAclRole(%q) = %q, %v", in, got, err)
+		}
+	}
+	if _, err := normalizeAclRole("admin"); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestCalendarAclAddRemove(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var inserted []map[string]any
+	var deleted []string
+	var notify []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/calendars/team@example.com/acl") && r.Method == http.MethodPost:
+			var rule map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&rule)
+			inserted = append(inserted, rule)
+			notify = append(notify, r.URL.Query().Get("sendNotifications"))
+			scope, _ := rule["scope"].(map[string]any)
+			rule["id"] = scope["type"].(string) + ":" + scope["value"].(string)
+			_ = json.NewEncoder(w).Encode(rule)
+		case strings.Contains(r.URL.Path, "/calendars/team@example.com/acl/") && r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "acl", "add", "team@example.com", "--email", "x@example.com,y@example.com", "--role", "writer", "--no-notify"}); err != nil {
+			t.Fatalf("Execute add: %v", err)
+		}
+	})
+	if len(inserted) != 2 || inserted[0]["role"] != "writer" || notify[0] != "false" {
+		t.Fatalf("unexpected inserts: %v (notify %v)", inserted, notify)
+	}
+	if !strings.Contains(out, "user:y@example.com") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+
+	if err := Execute([]string{"--no-input", "--account", "a@b.com", "calendar", "acl", "remove", "team@example.com", "--email", "x@example.com"}); err == nil {
+		t.Fatalf("expected confirmation refusal")
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("deleted without confirmation: %v", deleted)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--force", "--account", "a@b.com", "calendar", "acl", "rm", "team@example.com", "group:eng@example.com", "--email", "x@example.com"}); err != nil {
+			t.Fatalf("Execute remove: %v", err)
+		}
+	})
+	if strings.Join(deleted, ",") != "group:eng@example.com,user:x@example.com" {
+		t.Fatalf("unexpected deletes: %v", deleted)
+	}
+}
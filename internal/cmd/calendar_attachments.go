@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/drive/v3"
+	gapi "google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// Calendar rejects events with more than 25 attachments.
+const maxEventAttachments = 25
+
+const driveAttachmentFields = "id, name, mimeType, webViewLink, iconLink"
+
+// resolveDriveAttachments turns --attach values into event attachments. Local
+// files are uploaded to Drive first; anything else is treated as a Drive file ID.
+func resolveDriveAttachments(ctx context.Context, account string, refs []string) ([]*calendar.EventAttachment, error) {
+	var cleaned []string
+	for _, ref := range refs {
+		if ref = strings.TrimSpace(ref); ref != "" {
+			cleaned = append(cleaned, ref)
+		}
+	}
+	if len(cleaned) == 0 {
+		return nil, nil
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*calendar.EventAttachment, 0, len(cleaned))
+	for _, ref := range cleaned {
+		file, err := resolveDriveAttachment(ctx, svc, ref)
+		if err != nil {
+			return nil, fmt.Errorf("attach %s: %w", ref, err)
+		}
+		out = append(out, &calendar.EventAttachment{
+			FileId:   file.Id,
+			FileUrl:  file.WebViewLink,
+			Title:    file.Name,
+			MimeType: file.MimeType,
+			IconLink: file.IconLink,
+		})
+	}
+	return out, nil
+}
+
+func resolveDriveAttachment(ctx context.Context, svc *drive.Service, ref string) (*drive.File, error) {
+	localPath, err := config.ExpandPath(ref)
+	if err != nil {
+		return nil, err
+	}
+	st, statErr := os.Stat(localPath)
+	if statErr != nil {
+		// Drive IDs never contain a path separator; anything that does was meant as a file.
+		if strings.ContainsRune(ref, '/') || strings.ContainsRune(ref, filepath.Separator) {
+			return nil, statErr
+		}
+		return svc.Files.Get(ref).SupportsAllDrives(true).Fields(driveAttachmentFields).Context(ctx).Do()
+	}
+	if st.IsDir() {
+		return nil, usage("is a directory")
+	}
+
+	f, err := os.Open(localPath) //nolint:gosec // user-provided path
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return svc.Files.Create(&drive.File{Name: filepath.Base(localPath)}).
+		SupportsAllDrives(true).
+		Media(f, gapi.ContentType(guessMimeType(localPath))).
+		Fields(driveAttachmentFields).
+		Context(ctx).
+		Do()
+}
+
+type CalendarAttachmentsCmd struct {
+	EventID    string `arg:"" name:"eventId" help:"Event ID"`
+	CalendarID string `name:"calendar" help:"Calendar ID" default:"primary"`
+	Download   bool   `name:"download" help:"Download Drive attachments"`
+	OutDir     string `name:"out" aliases:"output" help:"Directory for downloads (default: gogcli config dir)"`
+	Format     string `name:"format" help:"Export format for Google Docs attachments (e.g. pdf, docx)"`
+}
+
+func (c *CalendarAttachmentsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	eventID := strings.TrimSpace(c.EventID)
+	if calendarID == "" {
+		return usage("empty --calendar")
+	}
+	if eventID == "" {
+		return usage("empty eventId")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	event, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if !c.Download {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, map[string]any{"eventId": event.Id, "attachments": event.Attachments})
+		}
+		if len(event.Attachments) == 0 {
+			u.Err().Println("No attachments")
+			return nil
+		}
+		w, flush := tableWriter(ctx)
+		defer flush()
+		fmt.Fprintln(w, "TITLE\tFILE_ID\tMIME_TYPE\tURL")
+		for _, a := range event.Attachments {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", sanitizeTab(a.Title), a.FileId, a.MimeType, a.FileUrl)
+		}
+		return nil
+	}
+
+	type downloaded struct {
+		FileID string `json:"fileId"`
+		Title  string `json:"title"`
+		Path   string `json:"path"`
+		Size   int64  `json:"size"`
+	}
+	var results []downloaded
+
+	outDir := strings.TrimSpace(c.OutDir)
+	if outDir != "" {
+		if outDir, err = config.ExpandPath(outDir); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(outDir, 0o700); err != nil {
+			return err
+		}
+	}
+
+	var driveSvc *drive.Service
+	for _, a := range event.Attachments {
+		if a == nil {
+			continue
+		}
+		if a.FileId == "" {
+			u.Err().Printf("Skipping %s: not a Drive file", a.FileUrl)
+			continue
+		}
+		if driveSvc == nil {
+			if driveSvc, err = newDriveService(ctx, account); err != nil {
+				return err
+			}
+		}
+		meta, err := driveSvc.Files.Get(a.FileId).SupportsAllDrives(true).Fields("id, name, mimeType").Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("download %s: %w", a.FileId, err)
+		}
+		destPath, err := resolveDriveDownloadDestPath(meta, outDir)
+		if err != nil {
+			return err
+		}
+		path, size, err := downloadDriveFile(ctx, driveSvc, meta, destPath, c.Format)
+		if err != nil {
+			return fmt.Errorf("download %s: %w", a.FileId, err)
+		}
+		results = append(results, downloaded{FileID: meta.Id, Title: meta.Name, Path: path, Size: size})
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"eventId": event.Id, "downloaded": results})
+	}
+	if len(results) == 0 {
+		u.Err().Println("No Drive attachments to download")
+		return nil
+	}
+	for _, r := range results {
+		u.Out().Printf("path\t%s", r.Path)
+		u.Out().Printf("size\t%s", formatDriveSize(r.Size))
+	}
+	return nil
+}
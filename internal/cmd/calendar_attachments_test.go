@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarAttachments(t *testing.T) {
+	origCal := newCalendarService
+	origDrive := newDriveService
+	origDownload := driveDownload
+	t.Cleanup(func() {
+		newCalendarService = origCal
+		newDriveService = origDrive
+		driveDownload = origDownload
+	})
+
+	var inserted map[string]any
+	var supportsAttachments string
+	attachments := []map[string]any{
+		{"fileId": "drv1", "fileUrl": "https://drive.google.com/file/d/drv1/view", "title": "agenda.pdf", "mimeType": "application/pdf"},
+		{"fileUrl": "https://example.com/notes"},
+	}
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/upload/") && r.Method == http.MethodPost:
+			_, _ = io.Copy(io.Discard, r.Body)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "up1", "name": "slides.txt", "mimeType": "text/plain", "webViewLink": "https://drive.google.com/file/d/up1/view"})
+		case strings.HasSuffix(r.URL.Path, "/files/drv1") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "drv1", "name": "agenda.pdf", "mimeType": "application/pdf", "webViewLink": "https://drive.google.com/file/d/drv1/view"})
+		case strings.HasSuffix(r.URL.Path, "/calendars/primary/events") && r.Method == http.MethodPost:
+			supportsAttachments = r.URL.Query().Get("supportsAttachments")
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			inserted["id"] = "ev1"
+			_ = json.NewEncoder(w).Encode(inserted)
+		case strings.HasSuffix(r.URL.Path, "/events/ev1") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "ev1", "attachments": attachments})
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+	defer srv.Close()
+
+	calSvc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	driveSvc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return calSvc, nil }
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return driveSvc, nil }
+	driveDownload = func(context.Context, *drive.Service, string) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("pdfdata"))}, nil
+	}
+
+	dir := t.TempDir()
+	local := filepath.Join(dir, "slides.txt")
+	if err := os.WriteFile(local, []byte("hello"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "create", "primary",
+			"--summary", "Review", "--from", "2026-01-05T10:00:00Z", "--to", "2026-01-05T11:00:00Z",
+			"--attach", local, "--attach", "drv1"}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	})
+	if supportsAttachments != "true" {
+		t.Fatalf("expected supportsAttachments=true, got %q", supportsAttachments)
+	}
+	got, _ := inserted["attachments"].([]any)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 attachments, got %v", inserted["attachments"])
+	}
+	first, _ := got[0].(map[string]any)
+	second, _ := got[1].(map[string]any)
+	if first["fileId"] != "up1" || second["fileId"] != "drv1" || second["title"] != "agenda.pdf" {
+		t.Fatalf("unexpected attachments: %v", got)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "attachments", "ev1"}); err != nil {
+			t.Fatalf("attachments: %v", err)
+		}
+	})
+	if !strings.Contains(out, "agenda.pdf") || !strings.Contains(out, "https://example.com/notes") {
+		t.Fatalf("unexpected list out=%q", out)
+	}
+
+	outDir := filepath.Join(dir, "downloads")
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "attachments", "ev1", "--download", "--out", outDir}); err != nil {
+				t.Fatalf("download: %v", err)
+			}
+		})
+	})
+	var parsed struct {
+		Downloaded []struct {
+			Path string `json:"path"`
+			Size int64  `json:"size"`
+		} `json:"downloaded"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Downloaded) != 1 || parsed.Downloaded[0].Size != 7 {
+		t.Fatalf("unexpected downloads: %+v", parsed.Downloaded)
+	}
+	if data, err := os.ReadFile(parsed.Downloaded[0].Path); err != nil || string(data) != "pdfdata" {
+		t.Fatalf("downloaded file: %q, %v", data, err)
+	}
+}
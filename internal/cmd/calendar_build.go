@@ -25,6 +25,34 @@ func buildEventDateTime(value string, allDay bool) *calendar.EventDateTime {
 	return edt
 }
 
+// buildEventDateTimeIn is buildEventDateTime with an explicit IANA timezone.
+// Wall-clock values without an offset ("2025-01-10T09:00") are interpreted in
+// that zone.
+func buildEventDateTimeIn(value string, allDay bool, tz string) (*calendar.EventDateTime, error) {
+	tz = strings.TrimSpace(tz)
+	if tz == "" {
+		return buildEventDateTime(value, allDay), nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, usagef("invalid --timezone %q (want an IANA name like Europe/Berlin)", tz)
+	}
+
+	value = strings.TrimSpace(value)
+	if allDay {
+		return &calendar.EventDateTime{Date: value, TimeZone: tz}, nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return &calendar.EventDateTime{DateTime: value, TimeZone: tz}, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return &calendar.EventDateTime{DateTime: t.Format(time.RFC3339), TimeZone: tz}, nil
+		}
+	}
+	return nil, usagef("invalid time %q (want RFC3339 or YYYY-MM-DDTHH:MM with --timezone)", value)
+}
+
 // extractTimezone attempts to determine a timezone from an RFC3339 datetime string.
 // Returns an IANA timezone name if determinable, empty string otherwise.
 func extractTimezone(value string) string {
@@ -91,6 +119,93 @@ func buildRecurrence(rules []string) []string {
 	return out
 }
 
+// everyRules maps --every shorthands to RRULE parts.
+var everyRules = map[string]string{
+	"daily":    "FREQ=DAILY",
+	"weekday":  "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+	"weekdays": "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+	"weekly":   "FREQ=WEEKLY",
+	"biweekly": "FREQ=WEEKLY;INTERVAL=2",
+	"monthly":  "FREQ=MONTHLY",
+	"yearly":   "FREQ=YEARLY",
+}
+
+// buildEveryRule turns --every/--until/--count into an RRULE. UNTIL is a date
+// for all-day events; for timed events it is the end of that day in loc (the
+// event's time zone) written in UTC, matching the DTSTART value type Google
+// Calendar expects.
+func buildEveryRule(every, until string, count int, allDay bool, loc *time.Location) (string, error) {
+	every = strings.ToLower(strings.TrimSpace(every))
+	until = strings.TrimSpace(until)
+	if every == "" {
+		if until != "" || count != 0 {
+			return "", usage("--until/--count require --every")
+		}
+		return "", nil
+	}
+	rule, ok := everyRules[every]
+	if !ok {
+		return "", usagef("invalid --every %q (want daily, weekday, weekly, biweekly, monthly, yearly)", every)
+	}
+	if until != "" && count != 0 {
+		return "", usage("use either --until or --count, not both")
+	}
+	if count < 0 {
+		return "", usage("--count must be positive")
+	}
+	if until != "" {
+		t, err := time.Parse("2006-01-02", until)
+		if err != nil {
+			return "", usagef("invalid --until %q (want YYYY-MM-DD)", until)
+		}
+		if allDay {
+			rule += ";UNTIL=" + t.Format("20060102")
+		} else {
+			if loc == nil {
+				loc = time.Local
+			}
+			end := time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, loc)
+			rule += ";UNTIL=" + end.UTC().Format("20060102T150405Z")
+		}
+	}
+	if count > 0 {
+		rule += ";COUNT=" + strconv.Itoa(count)
+	}
+	return "RRULE:" + rule, nil
+}
+
+// eventLocation is the zone an event time is expressed in: its IANA time
+// zone, else the offset of its date-time, else the local zone.
+func eventLocation(dt *calendar.EventDateTime) *time.Location {
+	if dt == nil {
+		return time.Local
+	}
+	if tz := strings.TrimSpace(dt.TimeZone); tz != "" {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			return loc
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, dt.DateTime); err == nil {
+		return t.Location()
+	}
+	return time.Local
+}
+
+// resolveRecurrence combines --rrule and --every; they are mutually exclusive.
+func resolveRecurrence(rules []string, every, until string, count int, allDay bool, loc *time.Location) ([]string, error) {
+	rule, err := buildEveryRule(every, until, count, allDay, loc)
+	if err != nil {
+		return nil, err
+	}
+	if rule == "" {
+		return buildRecurrence(rules), nil
+	}
+	if len(buildRecurrence(rules)) > 0 {
+		return nil, usage("use either --rrule or --every, not both")
+	}
+	return []string{rule}, nil
+}
+
 var durationRegex = regexp.MustCompile(`^(\d+)(w|d|h|m)?$`)
 
 func parseDuration(s string) (int64, error) {
@@ -1,6 +1,17 @@
 package cmd
 
-import "testing"
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
 
 func TestExtractTimezone(t *testing.T) {
 	tests := []struct {
@@ -64,3 +75,127 @@ func TestBuildExtendedProperties(t *testing.T) {
 		t.Fatalf("unexpected shared props: %#v", props.Shared)
 	}
 }
+
+func TestBuildEventDateTimeIn(t *testing.T) {
+	edt, err := buildEventDateTimeIn("2025-01-10T09:00", false, "Europe/Berlin")
+	if err != nil || edt.DateTime != "2025-01-10T09:00:00+01:00" || edt.TimeZone != "Europe/Berlin" {
+		t.Fatalf("unexpected local time: %#v err=%v", edt, err)
+	}
+
+	edt, err = buildEventDateTimeIn("2025-07-10T09:00:00Z", false, "Europe/Berlin")
+	if err != nil || edt.DateTime != "2025-07-10T09:00:00Z" || edt.TimeZone != "Europe/Berlin" {
+		t.Fatalf("unexpected RFC3339 time: %#v err=%v", edt, err)
+	}
+
+	edt, err = buildEventDateTimeIn("2025-01-10", true, "Europe/Berlin")
+	if err != nil || edt.Date != "2025-01-10" || edt.DateTime != "" {
+		t.Fatalf("unexpected all-day: %#v err=%v", edt, err)
+	}
+
+	edt, err = buildEventDateTimeIn("2025-01-10T09:00:00Z", false, "")
+	if err != nil || edt.TimeZone != tzUTC {
+		t.Fatalf("expected fallback to buildEventDateTime: %#v err=%v", edt, err)
+	}
+
+	if _, err := buildEventDateTimeIn("2025-01-10T09:00", false, "Mars/Olympus"); err == nil {
+		t.Fatalf("expected invalid timezone error")
+	}
+	if _, err := buildEventDateTimeIn("tomorrow 9am", false, "Europe/Berlin"); err == nil {
+		t.Fatalf("expected invalid time error")
+	}
+}
+
+func TestBuildEveryRule(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skipf("tzdata: %v", err)
+	}
+	cases := []struct {
+		every, until string
+		count        int
+		allDay       bool
+		loc          *time.Location
+		want         string
+	}{
+		{"weekday", "2025-01-01", 0, false, time.UTC, "RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;UNTIL=20250101T235959Z"},
+		{"daily", "2025-01-01", 0, false, la, "RRULE:FREQ=DAILY;UNTIL=20250102T075959Z"},
+		{"daily", "2025-07-01", 0, false, la, "RRULE:FREQ=DAILY;UNTIL=20250702T065959Z"},
+		{"weekly", "2025-01-01", 0, true, la, "RRULE:FREQ=WEEKLY;UNTIL=20250101"},
+		{"biweekly", "", 6, false, time.UTC, "RRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=6"},
+		{"Monthly", "", 0, false, time.UTC, "RRULE:FREQ=MONTHLY"},
+		{"", "", 0, false, time.UTC, ""},
+	}
+	for _, tc := range cases {
+		got, err := buildEveryRule(tc.every, tc.until, tc.count, tc.allDay, tc.loc)
+		if err != nil {
+			t.Fatalf("buildEveryRule(%q): %v", tc.every, err)
+		}
+		if got != tc.want {
+			t.Fatalf("buildEveryRule(%q) = %q, want %q", tc.every, got, tc.want)
+		}
+	}
+
+	for _, bad := range []struct {
+		every, until string
+		count        int
+	}{
+		{"hourly", "", 0},
+		{"daily", "01/01/2025", 0},
+		{"daily", "2025-01-01", 3},
+		{"", "2025-01-01", 0},
+	} {
+		if _, err := buildEveryRule(bad.every, bad.until, bad.count, false, time.UTC); err == nil {
+			t.Fatalf("expected error for %+v", bad)
+		}
+	}
+
+	if _, err := resolveRecurrence([]string{"RRULE:FREQ=DAILY"}, "weekly", "", 0, false, time.UTC); err == nil {
+		t.Fatalf("expected error for --rrule with --every")
+	}
+}
+
+func TestCalendarUpdate_UntilUsesEventTimezone(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var patched []string
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/events/ev1") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":    "ev1",
+				"start": map[string]any{"dateTime": "2025-06-02T09:00:00-07:00", "timeZone": "America/Los_Angeles"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/events/ev1") && r.Method == http.MethodPatch:
+			var body struct {
+				Recurrence []string `json:"recurrence"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			patched = body.Recurrence
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "ev1"})
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "update", "primary", "ev1", "--every", "daily", "--until", "2025-07-01"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if len(patched) != 1 || patched[0] != "RRULE:FREQ=DAILY;UNTIL=20250702T065959Z" {
+		t.Fatalf("unexpected recurrence: %v", patched)
+	}
+}
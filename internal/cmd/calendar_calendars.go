@@ -0,0 +1,424 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarCalendarsCmd struct {
+	List        CalendarCalendarsListCmd        `cmd:"" default:"withargs" help:"List calendars"`
+	Create      CalendarCalendarsCreateCmd      `cmd:"" help:"Create a secondary calendar"`
+	Update      CalendarCalendarsUpdateCmd      `cmd:"" help:"Rename a calendar or change its color/default reminders"`
+	Delete      CalendarCalendarsDeleteCmd      `cmd:"" help:"Delete a secondary calendar you own" aliases:"rm"`
+	Subscribe   CalendarCalendarsSubscribeCmd   `cmd:"" help:"Add an existing calendar to your list"`
+	Unsubscribe CalendarCalendarsUnsubscribeCmd `cmd:"" help:"Remove a calendar from your list (does not delete it)"`
+	Hide        CalendarCalendarsHideCmd        `cmd:"" help:"Hide a calendar from your list"`
+	Unhide      CalendarCalendarsUnhideCmd      `cmd:"" help:"Show a hidden calendar in your list again"`
+}
+
+type CalendarCalendarsListCmd struct {
+	Max        int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
+	Page       string `name:"page" help:"Page token"`
+	ShowHidden bool   `name:"show-hidden" help:"Include hidden calendars"`
+}
+
+func (c *CalendarCalendarsListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.CalendarList.List().MaxResults(c.Max).PageToken(c.Page)
+	if c.ShowHidden {
+		call = call.ShowHidden(true)
+	}
+	resp, err := call.Do()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"calendars":     resp.Items,
+			"nextPageToken": resp.NextPageToken,
+		})
+	}
+	if len(resp.Items) == 0 {
+		u.Err().Println("No calendars")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	if c.ShowHidden {
+		fmt.Fprintln(w, "ID\tNAME\tROLE\tHIDDEN")
+		for _, cal := range resp.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", cal.Id, cal.Summary, cal.AccessRole, cal.Hidden)
+		}
+	} else {
+		fmt.Fprintln(w, "ID\tNAME\tROLE")
+		for _, cal := range resp.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", cal.Id, cal.Summary, cal.AccessRole)
+		}
+	}
+	printNextPageHint(u, resp.NextPageToken)
+	return nil
+}
+
+// CalendarListSettings are the per-user display settings of a calendar list entry.
+type CalendarListSettings struct {
+	Color     string   `name:"calendar-color" help:"Calendar color ID (1-24, see 'gog calendar colors') or hex #rrggbb"`
+	Reminders []string `name:"reminder" help:"Default reminders as method:duration (e.g., popup:10m). Can be repeated (max 5). Set empty to clear."`
+}
+
+// apply copies the provided settings onto entry; it reports whether anything
+// was set and whether the call needs colorRgbFormat.
+func (s CalendarListSettings) apply(kctx *kong.Context, entry *calendar.CalendarListEntry) (bool, bool, error) {
+	changed, rgb := false, false
+	if flagProvided(kctx, "calendar-color") {
+		id, hex, err := validateCalendarColor(s.Color)
+		if err != nil {
+			return false, false, usage(err.Error())
+		}
+		switch {
+		case hex != "":
+			entry.BackgroundColor = hex
+			entry.ForegroundColor = contrastColor(hex)
+			rgb = true
+		case id != "":
+			entry.ColorId = id
+		}
+		changed = true
+	}
+	if flagProvided(kctx, "reminder") {
+		reminders, err := buildReminders(s.Reminders)
+		if err != nil {
+			return false, false, usage(err.Error())
+		}
+		entry.DefaultReminders = []*calendar.EventReminder{}
+		if reminders != nil {
+			entry.DefaultReminders = reminders.Overrides
+		}
+		entry.ForceSendFields = append(entry.ForceSendFields, "DefaultReminders")
+		changed = true
+	}
+	return changed, rgb, nil
+}
+
+// contrastColor picks black or white text for a #rrggbb background.
+func contrastColor(hex string) string {
+	v, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil {
+		return "#000000"
+	}
+	r, g, b := (v>>16)&0xff, (v>>8)&0xff, v&0xff
+	if 299*r+587*g+114*b > 128000 {
+		return "#000000"
+	}
+	return "#ffffff"
+}
+
+func patchCalendarListEntry(ctx context.Context, svc *calendar.Service, calendarID string, entry *calendar.CalendarListEntry, rgb bool) (*calendar.CalendarListEntry, error) {
+	call := svc.CalendarList.Patch(calendarID, entry)
+	if rgb {
+		call = call.ColorRgbFormat(true)
+	}
+	return call.Context(ctx).Do()
+}
+
+func writeCalendarListEntry(ctx context.Context, entry *calendar.CalendarListEntry) error {
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"calendar": entry})
+	}
+	u := ui.FromContext(ctx)
+	u.Out().Printf("id\t%s", entry.Id)
+	u.Out().Printf("name\t%s", entry.Summary)
+	if entry.AccessRole != "" {
+		u.Out().Printf("role\t%s", entry.AccessRole)
+	}
+	if entry.TimeZone != "" {
+		u.Out().Printf("timezone\t%s", entry.TimeZone)
+	}
+	if entry.BackgroundColor != "" {
+		u.Out().Printf("color\t%s", entry.BackgroundColor)
+	} else if entry.ColorId != "" {
+		u.Out().Printf("color\t%s", entry.ColorId)
+	}
+	if entry.Hidden {
+		u.Out().Printf("hidden\ttrue")
+	}
+	for _, r := range entry.DefaultReminders {
+		u.Out().Printf("reminder\t%s:%dm", r.Method, r.Minutes)
+	}
+	return nil
+}
+
+type CalendarCalendarsCreateCmd struct {
+	Name        string               `arg:"" name:"name" help:"Calendar name"`
+	Description string               `name:"description" help:"Description"`
+	Timezone    string               `name:"timezone" aliases:"tz" help:"IANA timezone (default: your account's)"`
+	Settings    CalendarListSettings `embed:""`
+}
+
+func (c *CalendarCalendarsCreateCmd) Run(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		return usage("empty name")
+	}
+	entry := &calendar.CalendarListEntry{}
+	changed, rgb, err := c.Settings.apply(kctx, entry)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	created, err := svc.Calendars.Insert(&calendar.Calendar{
+		Summary:     name,
+		Description: strings.TrimSpace(c.Description),
+		TimeZone:    strings.TrimSpace(c.Timezone),
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var listed *calendar.CalendarListEntry
+	if changed {
+		listed, err = patchCalendarListEntry(ctx, svc, created.Id, entry, rgb)
+	} else {
+		listed, err = svc.CalendarList.Get(created.Id).Context(ctx).Do()
+	}
+	if err != nil {
+		return fmt.Errorf("calendar %s created, but updating its list entry failed: %w", created.Id, err)
+	}
+	return writeCalendarListEntry(ctx, listed)
+}
+
+type CalendarCalendarsUpdateCmd struct {
+	CalendarID  string               `arg:"" name:"calendarId" help:"Calendar ID"`
+	Name        string               `name:"name" help:"New calendar name (owners only)"`
+	Description string               `name:"description" help:"New description (owners only; set empty to clear)"`
+	Timezone    string               `name:"timezone" aliases:"tz" help:"New IANA timezone (owners only)"`
+	Settings    CalendarListSettings `embed:""`
+}
+
+func (c *CalendarCalendarsUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+
+	meta := &calendar.Calendar{}
+	metaChanged := false
+	if flagProvided(kctx, "name") {
+		if strings.TrimSpace(c.Name) == "" {
+			return usage("empty --name")
+		}
+		meta.Summary = strings.TrimSpace(c.Name)
+		metaChanged = true
+	}
+	if flagProvided(kctx, "description") {
+		meta.Description = strings.TrimSpace(c.Description)
+		meta.ForceSendFields = append(meta.ForceSendFields, "Description")
+		metaChanged = true
+	}
+	if flagProvided(kctx, "timezone") {
+		meta.TimeZone = strings.TrimSpace(c.Timezone)
+		metaChanged = true
+	}
+	entry := &calendar.CalendarListEntry{}
+	entryChanged, rgb, err := c.Settings.apply(kctx, entry)
+	if err != nil {
+		return err
+	}
+	if !metaChanged && !entryChanged {
+		return usage("no updates provided")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	if metaChanged {
+		if _, err := svc.Calendars.Patch(calendarID, meta).Context(ctx).Do(); err != nil {
+			return err
+		}
+	}
+	var listed *calendar.CalendarListEntry
+	if entryChanged {
+		listed, err = patchCalendarListEntry(ctx, svc, calendarID, entry, rgb)
+	} else {
+		listed, err = svc.CalendarList.Get(calendarID).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+	return writeCalendarListEntry(ctx, listed)
+}
+
+type CalendarCalendarsDeleteCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+}
+
+func (c *CalendarCalendarsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+	if calendarID == "primary" {
+		return usage("the primary calendar cannot be deleted")
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete calendar %s and all its events", calendarID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if err := svc.Calendars.Delete(calendarID).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"deleted": true, "calendarId": calendarID})
+	}
+	u.Out().Printf("deleted\t%s", calendarID)
+	return nil
+}
+
+type CalendarCalendarsSubscribeCmd struct {
+	CalendarID string               `arg:"" name:"calendarId" help:"Calendar ID (e.g. a colleague's email or en.usa#holiday@group.v.calendar.google.com)"`
+	Hidden     bool                 `name:"hidden" help:"Subscribe but keep it hidden"`
+	Settings   CalendarListSettings `embed:""`
+}
+
+func (c *CalendarCalendarsSubscribeCmd) Run(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+	entry := &calendar.CalendarListEntry{Id: calendarID, Hidden: c.Hidden}
+	_, rgb, err := c.Settings.apply(kctx, entry)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	call := svc.CalendarList.Insert(entry)
+	if rgb {
+		call = call.ColorRgbFormat(true)
+	}
+	listed, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return writeCalendarListEntry(ctx, listed)
+}
+
+type CalendarCalendarsUnsubscribeCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+}
+
+func (c *CalendarCalendarsUnsubscribeCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if err := svc.CalendarList.Delete(calendarID).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"unsubscribed": true, "calendarId": calendarID})
+	}
+	u.Out().Printf("unsubscribed\t%s", calendarID)
+	return nil
+}
+
+type CalendarCalendarsHideCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+}
+
+func (c *CalendarCalendarsHideCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return setCalendarHidden(ctx, flags, c.CalendarID, true)
+}
+
+type CalendarCalendarsUnhideCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+}
+
+func (c *CalendarCalendarsUnhideCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return setCalendarHidden(ctx, flags, c.CalendarID, false)
+}
+
+func setCalendarHidden(ctx context.Context, flags *RootFlags, calendarID string, hidden bool) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID = strings.TrimSpace(calendarID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	entry := &calendar.CalendarListEntry{Hidden: hidden, ForceSendFields: []string{"Hidden"}}
+	listed, err := patchCalendarListEntry(ctx, svc, calendarID, entry, false)
+	if err != nil {
+		return err
+	}
+	return writeCalendarListEntry(ctx, listed)
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestValidateCalendarColor(t *testing.T) {
+	if id, hex, err := validateCalendarColor("17"); err != nil || id != "17" || hex != "" {
+		t.Fatalf("17: %q %q %v", id, hex, err)
+	}
+	if id, hex, err := validateCalendarColor("#0088AA"); err != nil || id != "" || hex != "#0088aa" {
+		t.Fatalf("hex: %q %q %v", id, hex, err)
+	}
+	for _, bad := range []string{"0", "25", "#fff", "#gggggg", "blue"} {
+		if _, _, err := validateCalendarColor(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+	if contrastColor("#ffff00") != "#000000" || contrastColor("#202040") != "#ffffff" {
+		t.Fatalf("unexpected contrast colors")
+	}
+}
+
+func TestCalendarCalendarsManage(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	type call struct {
+		method, path, query string
+		body                map[string]any
+	}
+	var calls []call
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		calls = append(calls, call{r.Method, path, r.URL.RawQuery, body})
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && path == "/calendars":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "new@group.calendar.google.com", "summary": body["summary"]})
+		case r.Method == http.MethodPatch && strings.HasPrefix(path, "/users/me/calendarList/"):
+			body["id"] = strings.TrimPrefix(path, "/users/me/calendarList/")
+			_ = json.NewEncoder(w).Encode(body)
+		case r.Method == http.MethodPost && path == "/users/me/calendarList":
+			_ = json.NewEncoder(w).Encode(body)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "calendars", "create", "Team", "--calendar-color", "#0088aa", "--reminder", "popup:10m"}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	})
+	if len(calls) != 2 || calls[1].method != http.MethodPatch || !strings.Contains(calls[1].query, "colorRgbFormat=true") {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	if calls[1].body["backgroundColor"] != "#0088aa" {
+		t.Fatalf("unexpected patch body: %v", calls[1].body)
+	}
+	if !strings.Contains(out, "id\tnew@group.calendar.google.com") || !strings.Contains(out, "reminder\tpopup:10m") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+
+	calls = nil
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "calendars", "hide", "team@example.com"}); err != nil {
+			t.Fatalf("hide: %v", err)
+		}
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "calendars", "subscribe", "en.usa#holiday@group.v.calendar.google.com", "--calendar-color", "5"}); err != nil {
+			t.Fatalf("subscribe: %v", err)
+		}
+	})
+	if len(calls) != 2 || calls[0].body["hidden"] != true || calls[1].body["colorId"] != "5" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+
+	calls = nil
+	if err := Execute([]string{"--no-input", "--account", "a@b.com", "calendar", "calendars", "delete", "new@group.calendar.google.com"}); err == nil {
+		t.Fatalf("expected confirmation refusal")
+	}
+	if err := Execute([]string{"--force", "--account", "a@b.com", "calendar", "calendars", "delete", "primary"}); err == nil {
+		t.Fatalf("expected primary refusal")
+	}
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--force", "--account", "a@b.com", "calendar", "calendars", "rm", "new@group.calendar.google.com"}); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+	})
+	if len(calls) != 1 || calls[0].path != "/calendars/new@group.calendar.google.com" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+}
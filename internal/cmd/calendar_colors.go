@@ -46,7 +46,7 @@ func (c *CalendarColorsCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if len(colors.Event) > 0 {
 		fmt.Println("EVENT COLORS:")
 		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-		fmt.Fprintln(tw, "ID\tBACKGROUND\tFOREGROUND")
+		fmt.Fprintln(tw, "ID\tNAME\tBACKGROUND\tFOREGROUND")
 
 		ids := make([]int, 0, len(colors.Event))
 		for id := range colors.Event {
@@ -59,7 +59,11 @@ func (c *CalendarColorsCmd) Run(ctx context.Context, flags *RootFlags) error {
 		for _, num := range ids {
 			id := strconv.Itoa(num)
 			c := colors.Event[id]
-			fmt.Fprintf(tw, "%s\t%s\t%s\n", id, c.Background, c.Foreground)
+			name := ""
+			if num > 0 && num < len(eventColorNames) {
+				name = eventColorNames[num]
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", id, name, c.Background, c.Foreground)
 		}
 		_ = tw.Flush()
 		fmt.Println()
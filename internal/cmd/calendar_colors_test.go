@@ -168,6 +168,10 @@ func TestCalendarColorsCmd_TableOutput(t *testing.T) {
 		t.Errorf("output missing FOREGROUND column header: %q", out)
 	}
 
+	if !strings.Contains(out, "sage") {
+		t.Errorf("output missing event color name: %q", out)
+	}
+
 	// Verify color values appear in output
 	if !strings.Contains(out, "#a4bdfc") {
 		t.Errorf("output missing event color background: %q", out)
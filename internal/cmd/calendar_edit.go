@@ -22,9 +22,13 @@ type CalendarCreateCmd struct {
 	Location              string   `name:"location" help:"Location"`
 	Attendees             string   `name:"attendees" help:"Comma-separated attendee emails"`
 	AllDay                bool     `name:"all-day" help:"All-day event (use date-only in --from/--to)"`
-	Recurrence            []string `name:"rrule" help:"Recurrence rules (e.g., 'RRULE:FREQ=MONTHLY;BYMONTHDAY=11'). Can be repeated."`
+	Timezone              string   `name:"timezone" aliases:"tz" help:"IANA timezone for the event (e.g. Europe/Berlin); --from/--to may then omit the offset"`
+	Recurrence            []string `name:"rrule" aliases:"recurrence" help:"Recurrence rules (e.g., 'RRULE:FREQ=MONTHLY;BYMONTHDAY=11'). Can be repeated."`
+	Every                 string   `name:"every" help:"Recurrence shorthand: daily, weekday, weekly, biweekly, monthly, yearly"`
+	Until                 string   `name:"until" help:"Last date for --every (YYYY-MM-DD)"`
+	Count                 int      `name:"count" help:"Number of occurrences for --every"`
 	Reminders             []string `name:"reminder" help:"Custom reminders as method:duration (e.g., popup:30m, email:1d). Can be repeated (max 5)."`
-	ColorId               string   `name:"event-color" help:"Event color ID (1-11). Use 'gog calendar colors' to see available colors."`
+	ColorId               string   `name:"event-color" help:"Event color ID (1-11) or name (e.g. tomato). Use 'gog calendar colors' to see available colors."`
 	Visibility            string   `name:"visibility" help:"Event visibility: default, public, private, confidential"`
 	Transparency          string   `name:"transparency" help:"Show as busy (opaque) or free (transparent). Aliases: busy, free"`
 	SendUpdates           string   `name:"send-updates" help:"Notification mode: all, externalOnly, none (default: all)"`
@@ -35,6 +39,7 @@ type CalendarCreateCmd struct {
 	SourceUrl             string   `name:"source-url" help:"URL where event was created/imported from"`
 	SourceTitle           string   `name:"source-title" help:"Title of the source"`
 	Attachments           []string `name:"attachment" help:"File attachment URL (can be repeated)"`
+	Attach                []string `name:"attach" help:"Attach a Drive file ID or local path (uploaded to Drive first); can be repeated"`
 	PrivateProps          []string `name:"private-prop" help:"Private extended property (key=value, can be repeated)"`
 	SharedProps           []string `name:"shared-prop" help:"Shared extended property (key=value, can be repeated)"`
 	EventType             string   `name:"event-type" help:"Event type: default, focus-time, out-of-office, working-location"`
@@ -102,6 +107,19 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 	transparency = applyEventTypeTransparencyDefault(transparency, eventType)
 
+	start, err := buildEventDateTimeIn(c.From, allDay, c.Timezone)
+	if err != nil {
+		return err
+	}
+	end, err := buildEventDateTimeIn(c.To, allDay, c.Timezone)
+	if err != nil {
+		return err
+	}
+	recurrence, err := resolveRecurrence(c.Recurrence, c.Every, c.Until, c.Count, allDay, eventLocation(start))
+	if err != nil {
+		return err
+	}
+
 	svc, err := newCalendarService(ctx, account)
 	if err != nil {
 		return err
@@ -111,10 +129,10 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		Summary:            summary,
 		Description:        strings.TrimSpace(c.Description),
 		Location:           strings.TrimSpace(c.Location),
-		Start:              buildEventDateTime(c.From, allDay),
-		End:                buildEventDateTime(c.To, allDay),
+		Start:              start,
+		End:                end,
 		Attendees:          buildAttendees(c.Attendees),
-		Recurrence:         buildRecurrence(c.Recurrence),
+		Recurrence:         recurrence,
 		Reminders:          reminders,
 		ColorId:            colorId,
 		Visibility:         visibility,
@@ -142,6 +160,17 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err = c.applyCreateEventType(event, eventType); err != nil {
 		return err
 	}
+	// Check before uploading anything.
+	if n := len(event.Attachments) + len(c.Attach); n > maxEventAttachments {
+		return usagef("too many attachments (%d, max %d)", n, maxEventAttachments)
+	}
+	if len(c.Attach) > 0 {
+		driveAttachments, attachErr := resolveDriveAttachments(ctx, account, c.Attach)
+		if attachErr != nil {
+			return attachErr
+		}
+		event.Attachments = append(event.Attachments, driveAttachments...)
+	}
 
 	call := svc.Events.Insert(calendarID, event)
 	if sendUpdates != "" {
@@ -305,14 +334,19 @@ type CalendarUpdateCmd struct {
 	Attendees             string   `name:"attendees" help:"Comma-separated attendee emails (replaces all; set empty to clear)"`
 	AddAttendee           string   `name:"add-attendee" help:"Comma-separated attendee emails to add (preserves existing attendees)"`
 	AllDay                bool     `name:"all-day" help:"All-day event (use date-only in --from/--to)"`
-	Recurrence            []string `name:"rrule" help:"Recurrence rules (e.g., 'RRULE:FREQ=MONTHLY;BYMONTHDAY=11'). Can be repeated. Set empty to clear."`
+	Timezone              string   `name:"timezone" aliases:"tz" help:"IANA timezone for --from/--to (e.g. Europe/Berlin); they may then omit the offset"`
+	Recurrence            []string `name:"rrule" aliases:"recurrence" help:"Recurrence rules (e.g., 'RRULE:FREQ=MONTHLY;BYMONTHDAY=11'). Can be repeated. Set empty to clear."`
+	Every                 string   `name:"every" help:"Recurrence shorthand: daily, weekday, weekly, biweekly, monthly, yearly (replaces existing rules)"`
+	Until                 string   `name:"until" help:"Last date for --every (YYYY-MM-DD)"`
+	Count                 int      `name:"count" help:"Number of occurrences for --every"`
 	Reminders             []string `name:"reminder" help:"Custom reminders as method:duration (e.g., popup:30m, email:1d). Can be repeated (max 5). Set empty to clear."`
-	ColorId               string   `name:"event-color" help:"Event color ID (1-11, or empty to clear)"`
+	ColorId               string   `name:"event-color" help:"Event color ID (1-11) or name (e.g. tomato), or empty to clear"`
 	Visibility            string   `name:"visibility" help:"Event visibility: default, public, private, confidential"`
 	Transparency          string   `name:"transparency" help:"Show as busy (opaque) or free (transparent). Aliases: busy, free"`
 	GuestsCanInviteOthers *bool    `name:"guests-can-invite" help:"Allow guests to invite others"`
 	GuestsCanModify       *bool    `name:"guests-can-modify" help:"Allow guests to modify event"`
 	GuestsCanSeeOthers    *bool    `name:"guests-can-see-others" help:"Allow guests to see other guests"`
+	WithMeet              bool     `name:"with-meet" help:"Add a Google Meet video conference to the event"`
 	Scope                 string   `name:"scope" help:"For recurring events: single, future, all" default:"all"`
 	OriginalStartTime     string   `name:"original-start" help:"Original start time of instance (required for scope=single,future)"`
 	PrivateProps          []string `name:"private-prop" help:"Private extended property (key=value, can be repeated)"`
@@ -395,6 +429,20 @@ func (c *CalendarUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *
 		return err
 	}
 
+	// Without --from or --timezone, UNTIL follows the event's own zone (and
+	// whether it is all-day).
+	if flagProvided(kctx, "until") && patch.Start == nil && strings.TrimSpace(c.Timezone) == "" {
+		existing, getErr := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+		if getErr != nil {
+			return fmt.Errorf("failed to fetch current event: %w", getErr)
+		}
+		allDay := existing.Start != nil && existing.Start.Date != ""
+		patch.Recurrence, err = resolveRecurrence(c.Recurrence, c.Every, c.Until, c.Count, allDay, eventLocation(existing.Start))
+		if err != nil {
+			return err
+		}
+	}
+
 	// For --add-attendee, fetch current event to preserve existing attendees with metadata.
 	if wantsAddAttendee {
 		existing, getErr := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
@@ -414,7 +462,11 @@ func (c *CalendarUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *
 		return err
 	}
 
-	updated, err := svc.Events.Patch(calendarID, targetEventID, patch).Do()
+	patchCall := svc.Events.Patch(calendarID, targetEventID, patch)
+	if c.WithMeet {
+		patchCall = patchCall.ConferenceDataVersion(1)
+	}
+	updated, err := patchCall.Do()
 	if err != nil {
 		return err
 	}
@@ -456,7 +508,16 @@ func (c *CalendarUpdateCmd) buildUpdatePatch(kctx *kong.Context) (*calendar.Even
 		changed = true
 	}
 
-	if c.applyRecurrence(kctx, patch) {
+	if c.WithMeet {
+		patch.ConferenceData = buildConferenceData(true)
+		changed = true
+	}
+
+	recurrenceChanged, err := c.applyRecurrence(kctx, patch)
+	if err != nil {
+		return nil, false, err
+	}
+	if recurrenceChanged {
 		changed = true
 	}
 
@@ -535,12 +596,18 @@ func resolveUpdateAllDay(value string, allDay bool, eventType string) (bool, err
 
 func (c *CalendarUpdateCmd) applyTimeFields(kctx *kong.Context, patch *calendar.Event, eventType string) (bool, error) {
 	changed := false
+	if flagProvided(kctx, "timezone") && !flagProvided(kctx, "from") && !flagProvided(kctx, "to") {
+		return false, usage("--timezone requires --from and/or --to")
+	}
 	if flagProvided(kctx, "from") {
 		allDay, err := resolveUpdateAllDay(c.From, c.AllDay, eventType)
 		if err != nil {
 			return false, err
 		}
-		patch.Start = buildEventDateTime(c.From, allDay)
+		patch.Start, err = buildEventDateTimeIn(c.From, allDay, c.Timezone)
+		if err != nil {
+			return false, err
+		}
 		changed = true
 	}
 	if flagProvided(kctx, "to") {
@@ -548,7 +615,10 @@ func (c *CalendarUpdateCmd) applyTimeFields(kctx *kong.Context, patch *calendar.
 		if err != nil {
 			return false, err
 		}
-		patch.End = buildEventDateTime(c.To, allDay)
+		patch.End, err = buildEventDateTimeIn(c.To, allDay, c.Timezone)
+		if err != nil {
+			return false, err
+		}
 		changed = true
 	}
 	return changed, nil
@@ -562,18 +632,29 @@ func (c *CalendarUpdateCmd) applyAttendees(kctx *kong.Context, patch *calendar.E
 	return true
 }
 
-func (c *CalendarUpdateCmd) applyRecurrence(kctx *kong.Context, patch *calendar.Event) bool {
-	if !flagProvided(kctx, "rrule") {
-		return false
+func (c *CalendarUpdateCmd) applyRecurrence(kctx *kong.Context, patch *calendar.Event) (bool, error) {
+	if !flagProvided(kctx, "rrule") && !flagProvided(kctx, "every") {
+		if flagProvided(kctx, "until") || flagProvided(kctx, "count") {
+			return false, usage("--until/--count require --every")
+		}
+		return false, nil
+	}
+	start := patch.Start
+	if start == nil {
+		start = &calendar.EventDateTime{TimeZone: c.Timezone}
+	}
+	loc := eventLocation(start)
+	recurrence, err := resolveRecurrence(c.Recurrence, c.Every, c.Until, c.Count, c.AllDay, loc)
+	if err != nil {
+		return false, err
 	}
-	recurrence := buildRecurrence(c.Recurrence)
 	if recurrence == nil {
 		patch.Recurrence = []string{}
 		patch.ForceSendFields = append(patch.ForceSendFields, "Recurrence")
 	} else {
 		patch.Recurrence = recurrence
 	}
-	return true
+	return true, nil
 }
 
 func (c *CalendarUpdateCmd) applyReminders(kctx *kong.Context, patch *calendar.Event) (bool, error) {
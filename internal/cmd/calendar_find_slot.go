@@ -0,0 +1,354 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// slotBufferCap caps how much free time around a slot counts toward its rank.
+const slotBufferCap = time.Hour
+
+type CalendarFindSlotCmd struct {
+	Attendees       string `name:"attendees" help:"Comma-separated attendee emails (your primary calendar is always included)"`
+	Duration        string `name:"duration" help:"Meeting length (e.g. 30m, 45m, 1h30m)" default:"30m"`
+	Window          string `name:"window" help:"Search window: today, tomorrow, this week, next week, or Nd (next N days)" default:"7d"`
+	From            string `name:"from" help:"Window start (RFC3339, date, or relative); overrides --window"`
+	To              string `name:"to" help:"Window end (RFC3339, date, or relative); overrides --window"`
+	WorkingHours    string `name:"working-hours" help:"Working hours in your calendar timezone (e.g. 9-17, 08:30-18:00)" default:"9-17"`
+	IncludeWeekends bool   `name:"include-weekends" help:"Also propose Saturday and Sunday slots"`
+	Step            string `name:"step" help:"Granularity of candidate start times" default:"30m"`
+	WeekStart       string `name:"week-start" help:"Week start day for this/next week (sun, mon, ...)" default:""`
+	Max             int    `name:"max" aliases:"limit" help:"Max candidates to print" default:"5"`
+	Book            bool   `name:"book" help:"Create the event in the best (or --pick) slot"`
+	Pick            int    `name:"pick" help:"Candidate number to book (1 = best)" default:"1"`
+	Summary         string `name:"summary" help:"Event summary/title (required with --book)"`
+	Description     string `name:"description" help:"Event description (with --book)"`
+	WithMeet        bool   `name:"with-meet" help:"Add a Google Meet link (with --book)"`
+	SendUpdates     string `name:"send-updates" help:"Notification mode for --book: all, externalOnly, none (default: all)"`
+}
+
+type slotCandidate struct {
+	Start         time.Time `json:"start"`
+	End           time.Time `json:"end"`
+	BufferMinutes int       `json:"bufferMinutes"`
+}
+
+type busyInterval struct {
+	start, end time.Time
+}
+
+func (c *CalendarFindSlotCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	attendees := buildAttendees(c.Attendees)
+	if len(attendees) == 0 {
+		return usage("required: --attendees")
+	}
+	duration, err := parseSlotDuration(c.Duration, "--duration")
+	if err != nil {
+		return err
+	}
+	step, err := parseSlotDuration(c.Step, "--step")
+	if err != nil {
+		return err
+	}
+	workStart, workEnd, err := parseWorkingHours(c.WorkingHours)
+	if err != nil {
+		return err
+	}
+	if duration > workEnd-workStart {
+		return usagef("--duration %s does not fit into working hours %s", c.Duration, c.WorkingHours)
+	}
+	if c.Book && strings.TrimSpace(c.Summary) == "" {
+		return usage("required: --summary with --book")
+	}
+	sendUpdates, err := validateSendUpdates(c.SendUpdates)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	loc, err := getUserTimezone(ctx, svc)
+	if err != nil {
+		return err
+	}
+	now := time.Now().In(loc)
+	from, to, err := c.resolveWindow(now, loc)
+	if err != nil {
+		return err
+	}
+
+	items := []*calendar.FreeBusyRequestItem{{Id: "primary"}}
+	for _, a := range attendees {
+		items = append(items, &calendar.FreeBusyRequestItem{Id: a.Email})
+	}
+	resp, err := svc.Freebusy.Query(&calendar.FreeBusyRequest{
+		TimeMin:  from.Format(time.RFC3339),
+		TimeMax:  to.Format(time.RFC3339),
+		TimeZone: loc.String(),
+		Items:    items,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var busy []busyInterval
+	for id, cal := range resp.Calendars {
+		for _, e := range cal.Errors {
+			u.Err().Printf("warning: no free/busy data for %s (%s); treating as free", id, e.Reason)
+		}
+		for _, b := range cal.Busy {
+			start, errStart := time.Parse(time.RFC3339, b.Start)
+			end, errEnd := time.Parse(time.RFC3339, b.End)
+			if errStart != nil || errEnd != nil {
+				continue
+			}
+			busy = append(busy, busyInterval{start: start, end: end})
+		}
+	}
+
+	if from.Before(now) {
+		from = now
+	}
+	slots := findFreeSlots(busy, from, to, loc, workStart, workEnd, duration, step, c.IncludeWeekends)
+	rankSlots(slots)
+
+	if c.Book {
+		if c.Pick < 1 || c.Pick > len(slots) {
+			return fmt.Errorf("no candidate #%d (found %d)", c.Pick, len(slots))
+		}
+		return c.book(ctx, svc, slots[c.Pick-1], attendees, loc, sendUpdates)
+	}
+
+	if c.Max > 0 && len(slots) > c.Max {
+		slots = slots[:c.Max]
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"slots":    slots,
+			"timezone": loc.String(),
+			"duration": duration.String(),
+		})
+	}
+	if len(slots) == 0 {
+		u.Err().Println("No free slots")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "#\tSTART\tEND\tBUFFER")
+	for i, s := range slots {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%dm\n", i+1, s.Start.In(loc).Format("Mon 2006-01-02 15:04"), s.End.In(loc).Format("15:04"), s.BufferMinutes)
+	}
+	return nil
+}
+
+func (c *CalendarFindSlotCmd) resolveWindow(now time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	if strings.TrimSpace(c.From) != "" || strings.TrimSpace(c.To) != "" {
+		if strings.TrimSpace(c.From) == "" || strings.TrimSpace(c.To) == "" {
+			return time.Time{}, time.Time{}, usage("--from and --to must be used together")
+		}
+		from, err := parseTimeExpr(c.From, now, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from: %w", err)
+		}
+		to, err := parseTimeExpr(c.To, now, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to: %w", err)
+		}
+		if !to.After(from) {
+			return time.Time{}, time.Time{}, usage("--to must be after --from")
+		}
+		return from, to, nil
+	}
+	weekStart, err := resolveWeekStart(c.WeekStart)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return parseSlotWindow(c.Window, now, weekStart)
+}
+
+// parseSlotWindow resolves --window relative to now.
+func parseSlotWindow(window string, now time.Time, weekStart time.Weekday) (time.Time, time.Time, error) {
+	w := strings.ToLower(strings.Join(strings.Fields(window), " "))
+	switch w {
+	case "today":
+		return now, endOfDay(now), nil
+	case "tomorrow":
+		t := now.AddDate(0, 0, 1)
+		return startOfDay(t), endOfDay(t), nil
+	case "this week", "week":
+		return now, endOfWeek(now, weekStart), nil
+	case "next week":
+		t := startOfWeek(now, weekStart).AddDate(0, 0, 7)
+		return t, endOfWeek(t, weekStart), nil
+	}
+	w = strings.TrimPrefix(w, "next ")
+	w = strings.TrimSuffix(strings.TrimSuffix(w, " days"), "d")
+	if n, err := strconv.Atoi(strings.TrimSpace(w)); err == nil && n > 0 {
+		return now, endOfDay(now.AddDate(0, 0, n-1)), nil
+	}
+	return time.Time{}, time.Time{}, usagef("invalid --window %q (want today, tomorrow, this week, next week, or Nd)", window)
+}
+
+// parseWorkingHours parses "9-17" or "08:30-18:00" into offsets from midnight.
+func parseWorkingHours(value string) (time.Duration, time.Duration, error) {
+	startStr, endStr, ok := strings.Cut(strings.TrimSpace(value), "-")
+	if ok {
+		start, errStart := parseClock(startStr)
+		end, errEnd := parseClock(endStr)
+		if errStart == nil && errEnd == nil && end > start {
+			return start, end, nil
+		}
+	}
+	return 0, 0, usagef("invalid --working-hours %q (want e.g. 9-17 or 08:30-18:00)", value)
+}
+
+func parseClock(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	hourStr, minStr, hasMin := strings.Cut(value, ":")
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil || hour < 0 || hour > 24 {
+		return 0, fmt.Errorf("invalid hour %q", value)
+	}
+	minute := 0
+	if hasMin {
+		minute, err = strconv.Atoi(minStr)
+		if err != nil || minute < 0 || minute > 59 || (hour == 24 && minute > 0) {
+			return 0, fmt.Errorf("invalid minute %q", value)
+		}
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
+
+func parseSlotDuration(value, flag string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if n, err := strconv.Atoi(value); err == nil {
+		value = strconv.Itoa(n) + "m"
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < time.Minute {
+		return 0, usagef("invalid %s %q (want e.g. 30m or 1h)", flag, value)
+	}
+	return d, nil
+}
+
+// findFreeSlots lists every step-aligned slot of the given duration inside the
+// working hours of each day in [from, to) that overlaps none of busy.
+func findFreeSlots(busy []busyInterval, from, to time.Time, loc *time.Location, workStart, workEnd, duration, step time.Duration, weekends bool) []slotCandidate {
+	sort.Slice(busy, func(i, j int) bool { return busy[i].start.Before(busy[j].start) })
+
+	var out []slotCandidate
+	for day := startOfDay(from.In(loc)); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !weekends && (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) {
+			continue
+		}
+		dayStart := clockTimeOn(day, workStart, loc)
+		dayEnd := clockTimeOn(day, workEnd, loc)
+		for start := dayStart; !start.Add(duration).After(dayEnd); start = start.Add(step) {
+			end := start.Add(duration)
+			if start.Before(from) || end.After(to) {
+				continue
+			}
+			before, after, free := slotBuffers(busy, start, end, dayStart, dayEnd)
+			if !free {
+				continue
+			}
+			buffer := before
+			if after < buffer {
+				buffer = after
+			}
+			out = append(out, slotCandidate{Start: start, End: end, BufferMinutes: int(buffer / time.Minute)})
+		}
+	}
+	return out
+}
+
+// clockTimeOn is the wall-clock time offset (e.g. 9h30m) on day's date in loc.
+// Adding it to midnight would be an hour off on DST transition days.
+func clockTimeOn(day time.Time, offset time.Duration, loc *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, loc)
+}
+
+// slotBuffers reports the free time before and after [start, end), capped at
+// slotBufferCap and bounded by the working day, or free=false on overlap.
+func slotBuffers(busy []busyInterval, start, end, dayStart, dayEnd time.Time) (time.Duration, time.Duration, bool) {
+	prevEnd := dayStart
+	nextStart := dayEnd
+	for _, b := range busy {
+		if b.start.Before(end) && b.end.After(start) {
+			return 0, 0, false
+		}
+		if !b.end.After(start) && b.end.After(prevEnd) {
+			prevEnd = b.end
+		}
+		if !b.start.Before(end) && b.start.Before(nextStart) {
+			nextStart = b.start
+		}
+	}
+	before := start.Sub(prevEnd)
+	after := nextStart.Sub(end)
+	if before > slotBufferCap || prevEnd.Equal(dayStart) {
+		before = slotBufferCap
+	}
+	if after > slotBufferCap || nextStart.Equal(dayEnd) {
+		after = slotBufferCap
+	}
+	return before, after, true
+}
+
+// rankSlots orders candidates by breathing room around them, then by time.
+func rankSlots(slots []slotCandidate) {
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].BufferMinutes != slots[j].BufferMinutes {
+			return slots[i].BufferMinutes > slots[j].BufferMinutes
+		}
+		return slots[i].Start.Before(slots[j].Start)
+	})
+}
+
+func (c *CalendarFindSlotCmd) book(ctx context.Context, svc *calendar.Service, slot slotCandidate, attendees []*calendar.EventAttendee, loc *time.Location, sendUpdates string) error {
+	u := ui.FromContext(ctx)
+	event := &calendar.Event{
+		Summary:        strings.TrimSpace(c.Summary),
+		Description:    strings.TrimSpace(c.Description),
+		Start:          &calendar.EventDateTime{DateTime: slot.Start.Format(time.RFC3339), TimeZone: loc.String()},
+		End:            &calendar.EventDateTime{DateTime: slot.End.Format(time.RFC3339), TimeZone: loc.String()},
+		Attendees:      attendees,
+		ConferenceData: buildConferenceData(c.WithMeet),
+	}
+	call := svc.Events.Insert("primary", event)
+	if sendUpdates != "" {
+		call = call.SendUpdates(sendUpdates)
+	}
+	if c.WithMeet {
+		call = call.ConferenceDataVersion(1)
+	}
+	created, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, loc.String(), loc)})
+	}
+	printCalendarEventWithTimezone(u, created, loc.String(), loc)
+	return nil
+}
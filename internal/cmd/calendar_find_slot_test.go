@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestParseWorkingHours(t *testing.T) {
+	start, end, err := parseWorkingHours("9-17")
+	if err != nil || start != 9*time.Hour || end != 17*time.Hour {
+		t.Fatalf("9-17: %v %v %v", start, end, err)
+	}
+	start, end, err = parseWorkingHours("08:30-18:00")
+	if err != nil || start != 8*time.Hour+30*time.Minute || end != 18*time.Hour {
+		t.Fatalf("08:30-18:00: %v %v %v", start, end, err)
+	}
+	for _, bad := range []string{"", "17-9", "9", "9-25", "9:75-17"} {
+		if _, _, err := parseWorkingHours(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseSlotWindow(t *testing.T) {
+	now := time.Date(2025, 1, 8, 10, 0, 0, 0, time.UTC) // Wednesday
+	from, to, err := parseSlotWindow("next week", now, time.Monday)
+	if err != nil {
+		t.Fatalf("next week: %v", err)
+	}
+	if !from.Equal(time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)) || to.Day() != 19 {
+		t.Fatalf("next week: %v - %v", from, to)
+	}
+	from, to, err = parseSlotWindow("3d", now, time.Monday)
+	if err != nil || !from.Equal(now) || to.Day() != 10 {
+		t.Fatalf("3d: %v - %v (%v)", from, to, err)
+	}
+	if _, _, err := parseSlotWindow("someday", now, time.Monday); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestFindFreeSlots(t *testing.T) {
+	day := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC) // Wednesday
+	busy := []busyInterval{
+		{start: day.Add(9 * time.Hour), end: day.Add(10 * time.Hour)},
+		{start: day.Add(11 * time.Hour), end: day.Add(16 * time.Hour)},
+	}
+	slots := findFreeSlots(busy, day, day.AddDate(0, 0, 1), time.UTC, 9*time.Hour, 17*time.Hour, 45*time.Minute, 15*time.Minute, false)
+	var starts []string
+	for _, s := range slots {
+		starts = append(starts, s.Start.Format("15:04"))
+	}
+	if got := strings.Join(starts, ","); got != "10:00,10:15,16:00,16:15" {
+		t.Fatalf("slots = %s", got)
+	}
+
+	rankSlots(slots)
+	if slots[0].Start.Format("15:04") != "16:15" || slots[0].BufferMinutes != 15 {
+		t.Fatalf("best slot = %+v", slots[0])
+	}
+
+	// Weekends are skipped unless requested.
+	sat := time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)
+	if got := findFreeSlots(nil, sat, sat.AddDate(0, 0, 2), time.UTC, 9*time.Hour, 17*time.Hour, time.Hour, time.Hour, false); len(got) != 0 {
+		t.Fatalf("expected no weekend slots, got %d", len(got))
+	}
+	if got := findFreeSlots(nil, sat, sat.AddDate(0, 0, 2), time.UTC, 9*time.Hour, 17*time.Hour, time.Hour, time.Hour, true); len(got) != 16 {
+		t.Fatalf("expected 16 weekend slots, got %d", len(got))
+	}
+}
+
+func TestFindFreeSlots_DSTTransition(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata: %v", err)
+	}
+	// Clocks go forward on 2025-03-09; working hours stay 9:00-17:00 local.
+	day := time.Date(2025, 3, 9, 0, 0, 0, 0, loc)
+	slots := findFreeSlots(nil, day, day.AddDate(0, 0, 1), loc, 9*time.Hour, 17*time.Hour, time.Hour, time.Hour, true)
+	if len(slots) != 8 || slots[0].Start.Format("15:04") != "09:00" || slots[7].End.Format("15:04") != "17:00" {
+		t.Fatalf("unexpected DST day slots: %+v", slots)
+	}
+}
+
+func TestCalendarFindSlotCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var inserted map[string]any
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/freeBusy") && r.Method == http.MethodPost:
+			var req map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if items, _ := req["items"].([]any); len(items) != 2 {
+				t.Errorf("items = %v", req["items"])
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"calendars": map[string]any{
+					"primary": map[string]any{"busy": []map[string]any{
+						{"start": "2030-01-09T09:00:00Z", "end": "2030-01-09T12:00:00Z"},
+					}},
+					"bob@example.com": map[string]any{"busy": []map[string]any{
+						{"start": "2030-01-09T12:00:00Z", "end": "2030-01-09T16:00:00Z"},
+					}},
+				},
+			})
+		case strings.HasSuffix(r.URL.Path, "/calendars/primary/events") && r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":      "ev1",
+				"summary": inserted["summary"],
+				"start":   inserted["start"],
+				"end":     inserted["end"],
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	args := []string{"--account", "a@b.com", "calendar", "find-slot", "--attendees", "bob@example.com", "--duration", "30m", "--from", "2030-01-09T00:00:00Z", "--to", "2030-01-10T00:00:00Z"}
+	out := captureStdout(t, func() {
+		if err := Execute(append([]string{"--json"}, args...)); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	var parsed struct {
+		Slots []slotCandidate `json:"slots"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Slots) != 2 || parsed.Slots[0].Start.Format("15:04") != "16:30" {
+		t.Fatalf("unexpected slots: %+v", parsed.Slots)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute(append(args, "--book", "--summary", "Sync")); err != nil {
+			t.Fatalf("Execute book: %v", err)
+		}
+	})
+	start, _ := inserted["start"].(map[string]any)
+	if inserted["summary"] != "Sync" || start["dateTime"] != "2030-01-09T16:30:00Z" {
+		t.Fatalf("unexpected insert: %v", inserted)
+	}
+}
@@ -13,14 +13,15 @@ import (
 )
 
 type CalendarFocusTimeCmd struct {
-	CalendarID     string   `arg:"" name:"calendarId" help:"Calendar ID (default: primary)" default:"primary"`
-	Summary        string   `name:"summary" help:"Focus time title" default:"Focus Time"`
-	From           string   `name:"from" required:"" help:"Start time (RFC3339)"`
-	To             string   `name:"to" required:"" help:"End time (RFC3339)"`
-	AutoDecline    string   `name:"auto-decline" help:"Auto-decline mode: none, all, new" default:"all"`
-	DeclineMessage string   `name:"decline-message" help:"Message for declined invitations"`
-	ChatStatus     string   `name:"chat-status" help:"Chat status: available, doNotDisturb" default:"doNotDisturb"`
-	Recurrence     []string `name:"rrule" help:"Recurrence rules. Can be repeated."`
+	CalendarID      string   `arg:"" name:"calendarId" help:"Calendar ID (default: primary)" default:"primary"`
+	Summary         string   `name:"summary" help:"Focus time title" default:"Focus Time"`
+	From            string   `name:"from" required:"" help:"Start time (RFC3339)"`
+	To              string   `name:"to" required:"" help:"End time (RFC3339)"`
+	AutoDecline     string   `name:"auto-decline" help:"Auto-decline mode: none, all, new" default:"all"`
+	DeclineMeetings bool     `name:"decline-meetings" help:"Decline all conflicting meetings (same as --auto-decline all)"`
+	DeclineMessage  string   `name:"decline-message" help:"Message for declined invitations"`
+	ChatStatus      string   `name:"chat-status" help:"Chat status: available, doNotDisturb" default:"doNotDisturb"`
+	Recurrence      []string `name:"rrule" help:"Recurrence rules. Can be repeated."`
 }
 
 func (c *CalendarFocusTimeCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -30,7 +31,7 @@ func (c *CalendarFocusTimeCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	autoDeclineMode, err := validateAutoDeclineMode(c.AutoDecline)
+	autoDeclineMode, err := resolveAutoDeclineMode(c.AutoDecline, c.DeclineMeetings)
 	if err != nil {
 		return err
 	}
@@ -86,6 +87,18 @@ func validateAutoDeclineMode(s string) (string, error) {
 	}
 }
 
+// resolveAutoDeclineMode applies --decline-meetings, which means "all" and
+// conflicts with any other --auto-decline mode.
+func resolveAutoDeclineMode(mode string, declineMeetings bool) (string, error) {
+	if declineMeetings {
+		if m := strings.TrimSpace(strings.ToLower(mode)); m != "" && m != "all" {
+			return "", usagef("--decline-meetings conflicts with --auto-decline %s", m)
+		}
+		mode = "all"
+	}
+	return validateAutoDeclineMode(mode)
+}
+
 func validateChatStatus(s string) (string, error) {
 	s = strings.TrimSpace(strings.ToLower(s))
 	switch s {
@@ -0,0 +1,368 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarImportCSVCmd struct {
+	File        string `arg:"" name:"file" help:"CSV file with a header row (- for stdin)"`
+	CalendarID  string `name:"calendar" help:"Calendar ID" default:"primary"`
+	Mapping     string `name:"mapping" help:"Column mapping as field=Column,... (fields: summary|title, start, start-time, end, end-time, all-day, description, location, attendees, timezone); unmapped fields match headers by name"`
+	Timezone    string `name:"timezone" aliases:"tz" help:"IANA timezone for times without an offset (default: calendar timezone)"`
+	DryRun      bool   `name:"dry-run" help:"Parse and preview the events; do not create them"`
+	SendUpdates string `name:"send-updates" help:"Notification mode: all, externalOnly, none (default: none)"`
+}
+
+// csvEventFields maps normalized header/field names to import fields.
+var csvEventFields = map[string]string{
+	"summary":            "summary",
+	"title":              "summary",
+	"subject":            "summary",
+	"name":               "summary",
+	"start":              "start",
+	"start-date":         "start",
+	"start-datetime":     "start",
+	"begin":              "start",
+	"start-time":         "start-time",
+	"end":                "end",
+	"end-date":           "end",
+	"end-datetime":       "end",
+	"finish":             "end",
+	"end-time":           "end-time",
+	"all-day":            "all-day",
+	"allday":             "all-day",
+	"all-day-event":      "all-day",
+	"description":        "description",
+	"notes":              "description",
+	"details":            "description",
+	"location":           "location",
+	"where":              "location",
+	"place":              "location",
+	"attendees":          "attendees",
+	"guests":             "attendees",
+	"invitees":           "attendees",
+	"required-attendees": "attendees",
+	"timezone":           "timezone",
+	"time-zone":          "timezone",
+	"tz":                 "timezone",
+}
+
+func normalizeCSVField(name string) string {
+	name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+	return strings.NewReplacer("_", "-", " ", "-").Replace(name)
+}
+
+// resolveCSVColumns returns field -> column index, applying --mapping over
+// header-name matches.
+func resolveCSVColumns(header []string, mapping string) (map[string]int, error) {
+	columns := map[string]int{}
+	byHeader := map[string]int{}
+	for i, h := range header {
+		byHeader[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+		if field, ok := csvEventFields[normalizeCSVField(h)]; ok {
+			if _, seen := columns[field]; !seen {
+				columns[field] = i
+			}
+		}
+	}
+	for _, pair := range splitCSV(mapping) {
+		key, column, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, usagef("invalid --mapping entry %q (want field=Column)", pair)
+		}
+		field, known := csvEventFields[normalizeCSVField(key)]
+		if !known {
+			return nil, usagef("unknown --mapping field %q", key)
+		}
+		idx, found := byHeader[strings.ToLower(strings.TrimSpace(column))]
+		if !found {
+			return nil, usagef("--mapping %s: no column %q in header", key, column)
+		}
+		columns[field] = idx
+	}
+	if _, ok := columns["summary"]; !ok {
+		return nil, usage("no summary/title column (use --mapping summary=Column)")
+	}
+	if _, ok := columns["start"]; !ok {
+		return nil, usage("no start column (use --mapping start=Column)")
+	}
+	return columns, nil
+}
+
+var (
+	csvDateLayouts = []string{"2006-01-02", "2006/01/02", "01/02/2006", "1/2/2006", "02.01.2006", "2.1.2006"}
+	csvTimeLayouts = []string{"15:04", "15:04:05", "3:04 PM", "3:04PM", "3:04:05 PM", "3 PM", "3PM"}
+)
+
+// parseCSVEventTime parses spreadsheet-style dates and times in loc.
+// dateOnly reports that no time of day was given.
+func parseCSVEventTime(value string, loc *time.Location) (t time.Time, dateOnly bool, err error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	for _, d := range csvDateLayouts {
+		if t, err := time.ParseInLocation(d, value, loc); err == nil {
+			return t, true, nil
+		}
+		for _, tl := range csvTimeLayouts {
+			for _, sep := range []string{" ", "T"} {
+				if t, err := time.ParseInLocation(d+sep+tl, strings.ToUpper(value), loc); err == nil {
+					return t, false, nil
+				}
+			}
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("unrecognized date/time %q", value)
+}
+
+func parseCSVBool(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "y", "1", "x":
+		return true
+	default:
+		return false
+	}
+}
+
+// csvRowEvent builds an event from one CSV record. All-day is taken from the
+// all-day column, or detected when start (and end, if given) have no time.
+// All-day end dates are inclusive, as spreadsheets usually write them.
+func csvRowEvent(record []string, columns map[string]int, tz string, loc *time.Location) (*calendar.Event, error) {
+	get := func(field string) string {
+		idx, ok := columns[field]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	summary := get("summary")
+	if summary == "" {
+		return nil, errors.New("empty summary")
+	}
+	if rowTZ := get("timezone"); rowTZ != "" {
+		rowLoc, err := time.LoadLocation(rowTZ)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q", rowTZ)
+		}
+		tz, loc = rowTZ, rowLoc
+	}
+
+	startValue := strings.TrimSpace(get("start") + " " + get("start-time"))
+	if startValue == "" {
+		return nil, errors.New("empty start")
+	}
+	start, startDateOnly, err := parseCSVEventTime(startValue, loc)
+	if err != nil {
+		return nil, fmt.Errorf("start: %w", err)
+	}
+	endValue := strings.TrimSpace(get("end") + " " + get("end-time"))
+	var (
+		end         time.Time
+		endDateOnly = true
+	)
+	if endValue != "" {
+		if end, endDateOnly, err = parseCSVEventTime(endValue, loc); err != nil {
+			return nil, fmt.Errorf("end: %w", err)
+		}
+	}
+
+	event := &calendar.Event{
+		Summary:     summary,
+		Description: get("description"),
+		Location:    get("location"),
+		Attendees:   buildAttendees(strings.ReplaceAll(get("attendees"), ";", ",")),
+	}
+
+	allDay := parseCSVBool(get("all-day")) || (startDateOnly && endDateOnly)
+	if allDay {
+		last := start
+		if endValue != "" {
+			last = end
+		}
+		if last.Before(start) {
+			return nil, errors.New("end is before start")
+		}
+		event.Start = &calendar.EventDateTime{Date: start.Format("2006-01-02")}
+		event.End = &calendar.EventDateTime{Date: last.AddDate(0, 0, 1).Format("2006-01-02")}
+		return event, nil
+	}
+
+	if startDateOnly {
+		return nil, errors.New("start has no time (mark the row all-day or add a time)")
+	}
+	if endValue == "" {
+		end = start.Add(time.Hour)
+	} else if endDateOnly {
+		return nil, errors.New("end has no time")
+	}
+	if !end.After(start) {
+		return nil, errors.New("end must be after start")
+	}
+	event.Start = &calendar.EventDateTime{DateTime: start.Format(time.RFC3339), TimeZone: tz}
+	event.End = &calendar.EventDateTime{DateTime: end.Format(time.RFC3339), TimeZone: tz}
+	return event, nil
+}
+
+type csvImportResult struct {
+	Row     int    `json:"row"`
+	EventID string `json:"eventId,omitempty"`
+	Summary string `json:"summary"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	AllDay  bool   `json:"allDay"`
+}
+
+type csvImportError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+func (c *CalendarImportCSVCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty --calendar")
+	}
+	sendUpdates, err := validateSendUpdates(c.SendUpdates)
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	if path := strings.TrimSpace(c.File); path != "-" {
+		path, err = config.ExpandPath(path)
+		if err != nil {
+			return err
+		}
+		f, openErr := os.Open(path) //nolint:gosec // user-provided path
+		if openErr != nil {
+			return openErr
+		}
+		defer f.Close()
+		in = f
+	}
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("read CSV header: %w", err)
+	}
+	columns, err := resolveCSVColumns(header, c.Mapping)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	tz := strings.TrimSpace(c.Timezone)
+	var loc *time.Location
+	if tz != "" {
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return usagef("invalid --timezone %q (want an IANA name like Europe/Berlin)", tz)
+		}
+	} else if tz, loc, err = getCalendarLocation(ctx, svc, calendarID); err != nil {
+		return err
+	}
+
+	var (
+		results []csvImportResult
+		failed  []csvImportError
+		rows    int
+	)
+	for {
+		record, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			var parseErr *csv.ParseError
+			if errors.As(readErr, &parseErr) {
+				return fmt.Errorf("read CSV line %d: %w", parseErr.Line, parseErr.Err)
+			}
+			return fmt.Errorf("read CSV: %w", readErr)
+		}
+		line, _ := reader.FieldPos(0)
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		rows++
+
+		event, rowErr := csvRowEvent(record, columns, tz, loc)
+		if rowErr == nil && !c.DryRun {
+			call := svc.Events.Insert(calendarID, event)
+			if sendUpdates != "" {
+				call = call.SendUpdates(sendUpdates)
+			}
+			event, rowErr = call.Context(ctx).Do()
+		}
+		if rowErr != nil {
+			failed = append(failed, csvImportError{Row: line, Error: rowErr.Error()})
+			u.Err().Printf("row %d: %v", line, rowErr)
+			continue
+		}
+		results = append(results, csvImportResult{
+			Row:     line,
+			EventID: event.Id,
+			Summary: event.Summary,
+			Start:   eventStart(event),
+			End:     eventEnd(event),
+			AllDay:  event.Start.Date != "",
+		})
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{
+			"calendarId": calendarID,
+			"dryRun":     c.DryRun,
+			"events":     results,
+			"errors":     failed,
+		}); err != nil {
+			return err
+		}
+	} else if len(results) > 0 {
+		w, flush := tableWriter(ctx)
+		if c.DryRun {
+			fmt.Fprintln(w, "ROW\tSTART\tEND\tALL_DAY\tSUMMARY")
+			for _, r := range results {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%t\t%s\n", r.Row, r.Start, r.End, r.AllDay, sanitizeTab(r.Summary))
+			}
+		} else {
+			fmt.Fprintln(w, "ROW\tID\tSTART\tSUMMARY")
+			for _, r := range results {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", r.Row, r.EventID, r.Start, sanitizeTab(r.Summary))
+			}
+		}
+		flush()
+	}
+
+	verb := "Imported"
+	if c.DryRun {
+		verb = "Would import"
+	}
+	u.Err().Printf("%s %d of %d row(s)", verb, len(results), rows)
+	if len(failed) > 0 {
+		return fmt.Errorf("%d row(s) failed", len(failed))
+	}
+	return nil
+}
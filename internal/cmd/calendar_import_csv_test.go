@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCSVRowEvent(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("tzdata: %v", err)
+	}
+	columns, err := resolveCSVColumns(
+		[]string{"Subject", "Start Date", "Start Time", "End Date", "End Time", "All day event", "Where"},
+		"",
+	)
+	if err != nil {
+		t.Fatalf("resolveCSVColumns: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		record    []string
+		wantStart string
+		wantEnd   string
+		wantErr   string
+	}{
+		{"timed", []string{"Standup", "2026-01-05", "9:30 am", "2026-01-05", "10:00", "", "Room 1"}, "2026-01-05T09:30:00+01:00", "2026-01-05T10:00:00+01:00", ""},
+		{"default hour", []string{"Call", "01/05/2026", "14:00", "", "", "", ""}, "2026-01-05T14:00:00+01:00", "2026-01-05T15:00:00+01:00", ""},
+		{"all-day detected", []string{"Trip", "2026-01-05", "", "2026-01-07", "", "", ""}, "2026-01-05", "2026-01-08", ""},
+		{"all-day column", []string{"Holiday", "2026-01-05", "09:00", "", "", "TRUE", ""}, "2026-01-05", "2026-01-06", ""},
+		{"end before start", []string{"Bad", "2026-01-05", "10:00", "2026-01-05", "09:00", "", ""}, "", "", "end must be after start"},
+		{"date only with timed end", []string{"Bad", "2026-01-05", "", "2026-01-05", "09:00", "", ""}, "", "", "start has no time"},
+		{"garbage", []string{"Bad", "tomorrow-ish", "", "", "", "", ""}, "", "", "unrecognized"},
+		{"no summary", []string{"", "2026-01-05", "", "", "", "", ""}, "", "", "empty summary"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev, err := csvRowEvent(tt.record, columns, "Europe/Berlin", loc)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("csvRowEvent: %v", err)
+			}
+			if got := eventStart(ev); got != tt.wantStart {
+				t.Fatalf("start=%q want %q", got, tt.wantStart)
+			}
+			if got := eventEnd(ev); got != tt.wantEnd {
+				t.Fatalf("end=%q want %q", got, tt.wantEnd)
+			}
+		})
+	}
+
+	if _, err := resolveCSVColumns([]string{"What", "When"}, "title=What,start=Nope"); err == nil || !strings.Contains(err.Error(), "Nope") {
+		t.Fatalf("expected missing column error, got %v", err)
+	}
+}
+
+func TestCalendarImportCSVCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var inserted []string
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/calendars/primary/events") && r.Method == http.MethodPost {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			summary, _ := body["summary"].(string)
+			inserted = append(inserted, summary)
+			body["id"] = "ev" + summary
+			_ = json.NewEncoder(w).Encode(body)
+			return
+		}
+		http.NotFound(w, r)
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	path := filepath.Join(t.TempDir(), "events.csv")
+	data := "Name,When,Until,Notes\n" +
+		"A,2026-01-05 10:00,2026-01-05 11:00,first\n" +
+		"B,not a date,,\n" +
+		"C,2026-01-06,,all day\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	var out string
+	_ = captureStderr(t, func() {
+		out = captureStdout(t, func() {
+			err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "import-csv", path,
+				"--mapping", "title=Name,start=When,end=Until", "--dry-run"})
+			if err == nil || !strings.Contains(err.Error(), "1 row(s) failed") {
+				t.Fatalf("expected row failure, got %v", err)
+			}
+		})
+	})
+	if len(inserted) != 0 {
+		t.Fatalf("dry run inserted events: %v", inserted)
+	}
+	var parsed struct {
+		DryRun bool              `json:"dryRun"`
+		Events []csvImportResult `json:"events"`
+		Errors []csvImportError  `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if !parsed.DryRun || len(parsed.Events) != 2 || len(parsed.Errors) != 1 || parsed.Errors[0].Row != 3 {
+		t.Fatalf("unexpected dry run result: %+v", parsed)
+	}
+	if !parsed.Events[1].AllDay || parsed.Events[0].Start != "2026-01-05T10:00:00Z" {
+		t.Fatalf("unexpected events: %+v", parsed.Events)
+	}
+
+	_ = captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			_ = Execute([]string{"--account", "a@b.com", "calendar", "import-csv", path, "--mapping", "title=Name,start=When,end=Until"})
+		})
+	})
+	if strings.Join(inserted, ",") != "A,C" {
+		t.Fatalf("unexpected inserts: %v", inserted)
+	}
+
+	// A malformed row is an error, not a panic.
+	if err := os.WriteFile(path, []byte("Name,When\nA,2026-01-05\nB \"x,2026-01-06\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_ = captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			err = Execute([]string{"--account", "a@b.com", "calendar", "import-csv", path, "--mapping", "title=Name,start=When", "--dry-run"})
+		})
+	})
+	if err == nil || !strings.Contains(err.Error(), "read CSV line 3") {
+		t.Fatalf("expected CSV parse error, got %v", err)
+	}
+}
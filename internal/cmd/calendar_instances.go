@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarInstancesCmd struct {
+	CalendarID  string `arg:"" name:"calendarId" help:"Calendar ID"`
+	EventID     string `arg:"" name:"eventId" help:"Recurring event ID"`
+	From        string `name:"from" help:"Only instances ending after this time (RFC3339)"`
+	To          string `name:"to" help:"Only instances starting before this time (RFC3339)"`
+	Max         int64  `name:"max" aliases:"limit" help:"Max results" default:"25"`
+	Page        string `name:"page" help:"Page token"`
+	ShowDeleted bool   `name:"show-deleted" help:"Include cancelled instances"`
+}
+
+func (c *CalendarInstancesCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	eventID := strings.TrimSpace(c.EventID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+	if eventID == "" {
+		return usage("empty eventId")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.Events.Instances(calendarID, eventID).
+		MaxResults(c.Max).
+		PageToken(c.Page).
+		ShowDeleted(c.ShowDeleted)
+	if from := strings.TrimSpace(c.From); from != "" {
+		call = call.TimeMin(from)
+	}
+	if to := strings.TrimSpace(c.To); to != "" {
+		call = call.TimeMax(to)
+	}
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"instances":     wrapEventsWithDays(resp.Items),
+			"nextPageToken": resp.NextPageToken,
+		})
+	}
+
+	if len(resp.Items) == 0 {
+		u.Err().Println("No instances")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+
+	fmt.Fprintln(w, "ID\tSTART\tEND\tSTATUS\tSUMMARY")
+	for _, e := range resp.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Id, eventStart(e), eventEnd(e), e.Status, e.Summary)
+	}
+	printNextPageHint(u, resp.NextPageToken)
+	return nil
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarInstancesCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/calendars/primary/events/rec1/instances") || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.URL.Query().Get("timeMin"); got != "2025-01-01T00:00:00Z" {
+			t.Errorf("timeMin = %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]any{
+				{
+					"id":      "rec1_20250106T100000Z",
+					"summary": "Standup",
+					"status":  "confirmed",
+					"start":   map[string]any{"dateTime": "2025-01-06T10:00:00Z"},
+					"end":     map[string]any{"dateTime": "2025-01-06T10:15:00Z"},
+				},
+				{
+					"id":      "rec1_20250108T100000Z",
+					"summary": "Standup",
+					"status":  "cancelled",
+					"start":   map[string]any{"dateTime": "2025-01-08T10:00:00Z"},
+					"end":     map[string]any{"dateTime": "2025-01-08T10:15:00Z"},
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	args := []string{"--account", "a@b.com", "calendar", "instances", "primary", "rec1", "--from", "2025-01-01T00:00:00Z"}
+	out := captureStdout(t, func() {
+		if err := Execute(args); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !strings.Contains(out, "STATUS") || !strings.Contains(out, "rec1_20250108T100000Z") || !strings.Contains(out, "cancelled") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute(append([]string{"--json"}, args...)); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	var parsed struct {
+		Instances []struct {
+			ID string `json:"id"`
+		} `json:"instances"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Instances) != 2 || parsed.Instances[0].ID != "rec1_20250106T100000Z" {
+		t.Fatalf("unexpected instances: %+v", parsed.Instances)
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -82,6 +83,34 @@ type eventWithCalendar struct {
 	EndLocal       string `json:"endLocal,omitempty"`
 }
 
+// MarshalJSON merges the extra fields into the event object; without it the
+// embedded Event's MarshalJSON would be promoted and drop them.
+func (e *eventWithCalendar) MarshalJSON() ([]byte, error) {
+	fields := map[string]any{}
+	if e.Event != nil {
+		base, err := e.Event.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(base, &fields); err != nil {
+			return nil, err
+		}
+	}
+	fields["calendarId"] = e.CalendarID
+	for k, v := range map[string]string{
+		"startDayOfWeek": e.StartDayOfWeek,
+		"endDayOfWeek":   e.EndDayOfWeek,
+		"timezone":       e.Timezone,
+		"startLocal":     e.StartLocal,
+		"endLocal":       e.EndLocal,
+	} {
+		if v != "" {
+			fields[k] = v
+		}
+	}
+	return json.Marshal(fields)
+}
+
 func listAllCalendarsEvents(ctx context.Context, svc *calendar.Service, from, to string, maxResults int64, page, query, privatePropFilter, sharedPropFilter, fields string, showWeekday bool) error {
 	u := ui.FromContext(ctx)
 
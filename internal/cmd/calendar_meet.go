@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarMeetCmd struct {
+	EventID    string `arg:"" name:"eventId" help:"Event ID"`
+	CalendarID string `name:"calendar" help:"Calendar ID" default:"primary"`
+	Create     bool   `name:"create" help:"Add a Meet conference if the event has none"`
+}
+
+func (c *CalendarMeetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	eventID := strings.TrimSpace(c.EventID)
+	if calendarID == "" {
+		return usage("empty --calendar")
+	}
+	if eventID == "" {
+		return usage("empty eventId")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	event, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	created := false
+	if eventMeetLink(event) == "" && c.Create {
+		event, err = svc.Events.Patch(calendarID, eventID, &calendar.Event{ConferenceData: buildConferenceData(true)}).
+			ConferenceDataVersion(1).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return err
+		}
+		created = true
+	}
+
+	link := eventMeetLink(event)
+	if link == "" {
+		if created {
+			return errors.New("meet conference requested but not ready yet; retry in a few seconds")
+		}
+		return errors.New("event has no Meet link (use --create to add one)")
+	}
+
+	if outfmt.IsJSON(ctx) {
+		out := map[string]any{
+			"eventId": event.Id,
+			"meet":    link,
+			"created": created,
+		}
+		if event.ConferenceData != nil {
+			out["conferenceId"] = event.ConferenceData.ConferenceId
+			out["entryPoints"] = event.ConferenceData.EntryPoints
+		}
+		return outfmt.WriteJSON(os.Stdout, out)
+	}
+	u.Out().Println(link)
+	return nil
+}
+
+// eventMeetLink returns the event's Meet URL, or "" when it has none.
+func eventMeetLink(event *calendar.Event) string {
+	if event == nil {
+		return ""
+	}
+	if event.HangoutLink != "" {
+		return event.HangoutLink
+	}
+	if event.ConferenceData != nil {
+		for _, ep := range event.ConferenceData.EntryPoints {
+			if ep != nil && ep.EntryPointType == "video" && ep.Uri != "" {
+				return ep.Uri
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarMeetCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var patches []string
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/events/withmeet") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "withmeet", "hangoutLink": "https://meet.google.com/abc-defg-hij"})
+		case strings.HasSuffix(r.URL.Path, "/events/nomeet") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "nomeet"})
+		case strings.HasSuffix(r.URL.Path, "/events/nomeet") && r.Method == http.MethodPatch:
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if _, ok := body["conferenceData"]; !ok {
+				t.Errorf("patch without conferenceData: %v", body)
+			}
+			patches = append(patches, r.URL.Query().Get("conferenceDataVersion"))
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id": "nomeet",
+				"conferenceData": map[string]any{
+					"conferenceId": "xyz-abcd-efg",
+					"entryPoints":  []map[string]any{{"entryPointType": "video", "uri": "https://meet.google.com/xyz-abcd-efg"}},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "meet", "withmeet"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if strings.TrimSpace(out) != "https://meet.google.com/abc-defg-hij" {
+		t.Fatalf("unexpected out=%q", out)
+	}
+
+	if err := Execute([]string{"--account", "a@b.com", "calendar", "meet", "nomeet"}); err == nil || !strings.Contains(err.Error(), "--create") {
+		t.Fatalf("expected missing-link error, got %v", err)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "meet", "nomeet", "--create"}); err != nil {
+			t.Fatalf("Execute create: %v", err)
+		}
+	})
+	var parsed struct {
+		Meet    string `json:"meet"`
+		Created bool   `json:"created"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Meet != "https://meet.google.com/xyz-abcd-efg" || !parsed.Created {
+		t.Fatalf("unexpected result: %+v", parsed)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "update", "primary", "nomeet", "--with-meet"}); err != nil {
+			t.Fatalf("Execute update: %v", err)
+		}
+	})
+	if strings.Join(patches, ",") != "1,1" {
+		t.Fatalf("conferenceDataVersion = %v", patches)
+	}
+}
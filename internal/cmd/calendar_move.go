@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarMoveCmd struct {
+	EventID     string `arg:"" name:"eventId" help:"Event ID"`
+	CalendarID  string `name:"calendar" help:"Source calendar ID" default:"primary"`
+	ToCalendar  string `name:"to-calendar" required:"" help:"Destination calendar ID"`
+	SendUpdates string `name:"send-updates" help:"Notification mode: all, externalOnly, none (default: none)"`
+}
+
+func (c *CalendarMoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID, eventID, dest, err := moveCopyArgs(c.CalendarID, c.EventID, c.ToCalendar)
+	if err != nil {
+		return err
+	}
+	sendUpdates, err := validateSendUpdates(c.SendUpdates)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.Events.Move(calendarID, eventID, dest)
+	if sendUpdates != "" {
+		call = call.SendUpdates(sendUpdates)
+	}
+	moved, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	tz, loc, _ := getCalendarLocation(ctx, svc, dest)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(moved, tz, loc), "calendarId": dest})
+	}
+	printCalendarEventWithTimezone(u, moved, tz, loc)
+	return nil
+}
+
+type CalendarCopyCmd struct {
+	EventID     string `arg:"" name:"eventId" help:"Event ID"`
+	CalendarID  string `name:"calendar" help:"Source calendar ID" default:"primary"`
+	ToCalendar  string `name:"to-calendar" required:"" help:"Destination calendar ID"`
+	NoAttendees bool   `name:"no-attendees" help:"Do not copy attendees (no invitations are sent)"`
+	SendUpdates string `name:"send-updates" help:"Notification mode: all, externalOnly, none (default: none)"`
+}
+
+func (c *CalendarCopyCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID, eventID, dest, err := moveCopyArgs(c.CalendarID, c.EventID, c.ToCalendar)
+	if err != nil {
+		return err
+	}
+	sendUpdates, err := validateSendUpdates(c.SendUpdates)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	src, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	event := copyEventDetails(src, !c.NoAttendees)
+
+	call := svc.Events.Insert(dest, event)
+	if sendUpdates != "" {
+		call = call.SendUpdates(sendUpdates)
+	}
+	if event.ConferenceData != nil {
+		call = call.ConferenceDataVersion(1)
+	}
+	if len(event.Attachments) > 0 {
+		call = call.SupportsAttachments(true)
+	}
+	created, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	tz, loc, _ := getCalendarLocation(ctx, svc, dest)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc), "calendarId": dest, "sourceEventId": src.Id})
+	}
+	printCalendarEventWithTimezone(u, created, tz, loc)
+	return nil
+}
+
+func moveCopyArgs(calendarID, eventID, dest string) (string, string, string, error) {
+	calendarID = strings.TrimSpace(calendarID)
+	eventID = strings.TrimSpace(eventID)
+	dest = strings.TrimSpace(dest)
+	if calendarID == "" {
+		return "", "", "", usage("empty --calendar")
+	}
+	if eventID == "" {
+		return "", "", "", usage("empty eventId")
+	}
+	if dest == "" {
+		return "", "", "", usage("empty --to-calendar")
+	}
+	if dest == calendarID {
+		return "", "", "", usage("--to-calendar must differ from --calendar")
+	}
+	return calendarID, eventID, dest, nil
+}
+
+// copyEventDetails builds a new event with src's user-visible details. Server
+// fields (id, iCalUID, organizer, sequence) are left for the destination to
+// assign; an existing Meet conference is reused rather than recreated.
+func copyEventDetails(src *calendar.Event, withAttendees bool) *calendar.Event {
+	event := &calendar.Event{
+		Summary:                 src.Summary,
+		Description:             src.Description,
+		Location:                src.Location,
+		Start:                   src.Start,
+		End:                     src.End,
+		Recurrence:              src.Recurrence,
+		Reminders:               src.Reminders,
+		ColorId:                 src.ColorId,
+		Visibility:              src.Visibility,
+		Transparency:            src.Transparency,
+		Attachments:             src.Attachments,
+		ExtendedProperties:      src.ExtendedProperties,
+		GuestsCanInviteOthers:   src.GuestsCanInviteOthers,
+		GuestsCanModify:         src.GuestsCanModify,
+		GuestsCanSeeOtherGuests: src.GuestsCanSeeOtherGuests,
+		Source:                  src.Source,
+	}
+	if src.EventType != "" && src.EventType != eventTypeDefault && src.EventType != "fromGmail" {
+		event.EventType = src.EventType
+		event.FocusTimeProperties = src.FocusTimeProperties
+		event.OutOfOfficeProperties = src.OutOfOfficeProperties
+		event.WorkingLocationProperties = src.WorkingLocationProperties
+	}
+	if withAttendees {
+		for _, a := range src.Attendees {
+			if a == nil || a.Email == "" || a.Self || a.Resource {
+				continue
+			}
+			event.Attendees = append(event.Attendees, &calendar.EventAttendee{
+				Email:       a.Email,
+				DisplayName: a.DisplayName,
+				Optional:    a.Optional,
+			})
+		}
+	}
+	if cd := src.ConferenceData; cd != nil && cd.ConferenceId != "" {
+		event.ConferenceData = &calendar.ConferenceData{
+			ConferenceId:       cd.ConferenceId,
+			ConferenceSolution: cd.ConferenceSolution,
+			EntryPoints:        cd.EntryPoints,
+			Notes:              cd.Notes,
+			Signature:          cd.Signature,
+		}
+	}
+	return event
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarMoveAndCopy(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var moveDest string
+	var inserted map[string]any
+	var insertQuery string
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/calendars/primary/events/ev1/move") && r.Method == http.MethodPost:
+			moveDest = r.URL.Query().Get("destination")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "ev1", "summary": "Planning"})
+		case strings.HasSuffix(r.URL.Path, "/calendars/primary/events/ev1") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":        "ev1",
+				"iCalUID":   "ev1@google.com",
+				"summary":   "Planning",
+				"eventType": "default",
+				"start":     map[string]any{"dateTime": "2025-01-15T10:00:00Z"},
+				"end":       map[string]any{"dateTime": "2025-01-15T11:00:00Z"},
+				"attendees": []map[string]any{
+					{"email": "me@example.com", "self": true, "responseStatus": "accepted"},
+					{"email": "bob@example.com", "responseStatus": "declined", "optional": true},
+					{"email": "room@resource.calendar.google.com", "resource": true},
+				},
+				"conferenceData": map[string]any{
+					"conferenceId":       "abc-defg-hij",
+					"conferenceSolution": map[string]any{"key": map[string]any{"type": "hangoutsMeet"}},
+					"entryPoints":        []map[string]any{{"entryPointType": "video", "uri": "https://meet.google.com/abc-defg-hij"}},
+				},
+			})
+		case strings.HasSuffix(r.URL.Path, "/calendars/team/events") && r.Method == http.MethodPost:
+			insertQuery = r.URL.RawQuery
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "copy1", "summary": inserted["summary"]})
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	if err := Execute([]string{"--account", "a@b.com", "calendar", "move", "ev1", "--to-calendar", "primary"}); err == nil {
+		t.Fatalf("expected error for same calendar")
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "move", "ev1", "--to-calendar", "team"}); err != nil {
+			t.Fatalf("move: %v", err)
+		}
+	})
+	if moveDest != "team" {
+		t.Fatalf("destination = %q", moveDest)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "copy", "ev1", "--to-calendar", "team"}); err != nil {
+			t.Fatalf("copy: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"sourceEventId": "ev1"`) {
+		t.Fatalf("unexpected out=%q", out)
+	}
+	if _, ok := inserted["iCalUID"]; ok {
+		t.Fatalf("copy must not reuse iCalUID: %v", inserted)
+	}
+	attendees, _ := inserted["attendees"].([]any)
+	if len(attendees) != 1 {
+		t.Fatalf("expected only bob to be copied, got %v", attendees)
+	}
+	if a, _ := attendees[0].(map[string]any); a["email"] != "bob@example.com" || a["responseStatus"] != nil || a["optional"] != true {
+		t.Fatalf("unexpected attendee: %v", a)
+	}
+	if cd, _ := inserted["conferenceData"].(map[string]any); cd["conferenceId"] != "abc-defg-hij" {
+		t.Fatalf("unexpected conferenceData: %v", inserted["conferenceData"])
+	}
+	if !strings.Contains(insertQuery, "conferenceDataVersion=1") {
+		t.Fatalf("missing conferenceDataVersion: %q", insertQuery)
+	}
+}
@@ -12,13 +12,14 @@ import (
 )
 
 type CalendarOOOCmd struct {
-	CalendarID     string `arg:"" name:"calendarId" help:"Calendar ID (default: primary)" default:"primary"`
-	Summary        string `name:"summary" help:"Out of office title" default:"Out of office"`
-	From           string `name:"from" required:"" help:"Start date or datetime (RFC3339 or YYYY-MM-DD)"`
-	To             string `name:"to" required:"" help:"End date or datetime (RFC3339 or YYYY-MM-DD)"`
-	AutoDecline    string `name:"auto-decline" help:"Auto-decline mode: none, all, new" default:"all"`
-	DeclineMessage string `name:"decline-message" help:"Message for declined invitations" default:"I am out of office and will respond when I return."`
-	AllDay         bool   `name:"all-day" help:"Create as all-day event"`
+	CalendarID      string `arg:"" name:"calendarId" help:"Calendar ID (default: primary)" default:"primary"`
+	Summary         string `name:"summary" help:"Out of office title" default:"Out of office"`
+	From            string `name:"from" required:"" help:"Start date or datetime (RFC3339 or YYYY-MM-DD)"`
+	To              string `name:"to" required:"" help:"End date or datetime (RFC3339 or YYYY-MM-DD)"`
+	AutoDecline     string `name:"auto-decline" help:"Auto-decline mode: none, all, new" default:"all"`
+	DeclineMeetings bool   `name:"decline-meetings" help:"Decline all conflicting meetings (same as --auto-decline all)"`
+	DeclineMessage  string `name:"decline-message" help:"Message for declined invitations" default:"I am out of office and will respond when I return."`
+	AllDay          bool   `name:"all-day" help:"Create as all-day event"`
 }
 
 func (c *CalendarOOOCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -28,7 +29,7 @@ func (c *CalendarOOOCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	autoDeclineMode, err := validateAutoDeclineMode(c.AutoDecline)
+	autoDeclineMode, err := resolveAutoDeclineMode(c.AutoDecline, c.DeclineMeetings)
 	if err != nil {
 		return err
 	}
@@ -57,6 +57,62 @@ func TestCalendarOOOCmd_JSON(t *testing.T) {
 	}
 }
 
+func TestCalendarOOOCmd_DeclineMeetings(t *testing.T) {
+	origCal := newCalendarService
+	t.Cleanup(func() { newCalendarService = origCal })
+
+	var props map[string]any
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.Contains(r.URL.Path, "/events") {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			props, _ = body["outOfOfficeProperties"].(map[string]any)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "evt1", "summary": "Out of office"})
+			return
+		}
+		http.NotFound(w, r)
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	base := []string{"--account", "a@b.com", "calendar", "ooo", "--from", "2025-01-01T09:00:00Z", "--to", "2025-01-01T17:00:00Z"}
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--auto-decline", "new"}, "declineOnlyNewConflictingInvitations"},
+		{[]string{"--decline-meetings"}, "declineAllConflictingInvitations"},
+		{[]string{"--decline-meetings", "--auto-decline", "all"}, "declineAllConflictingInvitations"},
+	} {
+		props = nil
+		_ = captureStdout(t, func() {
+			if err := Execute(append(append([]string{}, base...), tc.args...)); err != nil {
+				t.Fatalf("Execute %v: %v", tc.args, err)
+			}
+		})
+		if props["autoDeclineMode"] != tc.want {
+			t.Fatalf("%v: unexpected outOfOfficeProperties: %v", tc.args, props)
+		}
+	}
+
+	_ = captureStderr(t, func() {
+		err := Execute(append(append([]string{}, base...), "--decline-meetings", "--auto-decline", "new"))
+		if err == nil || ExitCode(err) != 2 || !strings.Contains(err.Error(), "conflicts with --auto-decline new") {
+			t.Fatalf("expected conflict usage error, got %v", err)
+		}
+	})
+}
+
 func TestCalendarUsersCmd_TextAndJSON(t *testing.T) {
 	origPeople := newPeopleDirectoryService
 	t.Cleanup(func() { newPeopleDirectoryService = origPeople })
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarRemindersCmd struct {
+	Show        CalendarRemindersShowCmd        `cmd:"" default:"withargs" help:"Show an event's reminders"`
+	Set         CalendarRemindersSetCmd         `cmd:"" help:"Set reminders on one or more events"`
+	Defaults    CalendarRemindersDefaultsCmd    `cmd:"" help:"Show a calendar's default reminders"`
+	SetDefaults CalendarRemindersSetDefaultsCmd `cmd:"" name:"set-defaults" help:"Set a calendar's default reminders"`
+}
+
+// CalendarReminderFlags collects reminders by method.
+type CalendarReminderFlags struct {
+	Popup []string `name:"popup" help:"Popup reminder before the event (e.g. 10m, 1h, 1d). Can be repeated."`
+	Email []string `name:"email" help:"Email reminder before the event (e.g. 1d). Can be repeated."`
+	None  bool     `name:"none" help:"No reminders"`
+}
+
+// build returns the reminder overrides; empty (non-nil) with --none.
+func (f CalendarReminderFlags) build() ([]*calendar.EventReminder, error) {
+	specs := make([]string, 0, len(f.Popup)+len(f.Email))
+	for _, d := range f.Popup {
+		specs = append(specs, "popup:"+d)
+	}
+	for _, d := range f.Email {
+		specs = append(specs, "email:"+d)
+	}
+	if f.None {
+		if len(specs) > 0 {
+			return nil, usage("--none cannot be combined with --popup/--email")
+		}
+		return []*calendar.EventReminder{}, nil
+	}
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	reminders, err := buildReminders(specs)
+	if err != nil {
+		return nil, usage(err.Error())
+	}
+	return reminders.Overrides, nil
+}
+
+func formatReminder(r *calendar.EventReminder) string {
+	return fmt.Sprintf("%s:%dm", r.Method, r.Minutes)
+}
+
+type CalendarRemindersShowCmd struct {
+	EventID    string `arg:"" name:"eventId" help:"Event ID"`
+	CalendarID string `name:"calendar" help:"Calendar ID" default:"primary"`
+}
+
+func (c *CalendarRemindersShowCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	eventID := strings.TrimSpace(c.EventID)
+	if calendarID == "" || eventID == "" {
+		return usage("calendar and eventId required")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	event, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	useDefault := event.Reminders == nil || event.Reminders.UseDefault
+	var reminders []*calendar.EventReminder
+	if useDefault {
+		entry, getErr := svc.CalendarList.Get(calendarID).Context(ctx).Do()
+		if getErr != nil {
+			return getErr
+		}
+		reminders = entry.DefaultReminders
+	} else {
+		reminders = event.Reminders.Overrides
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"eventId":    event.Id,
+			"useDefault": useDefault,
+			"reminders":  reminders,
+		})
+	}
+	source := "event"
+	if useDefault {
+		source = "calendar default"
+	}
+	u.Out().Printf("source\t%s", source)
+	for _, r := range reminders {
+		u.Out().Printf("reminder\t%s", formatReminder(r))
+	}
+	if len(reminders) == 0 {
+		u.Err().Println("No reminders")
+	}
+	return nil
+}
+
+type CalendarRemindersSetCmd struct {
+	EventIDs   []string              `arg:"" name:"eventId" help:"Event IDs"`
+	CalendarID string                `name:"calendar" help:"Calendar ID" default:"primary"`
+	Reminders  CalendarReminderFlags `embed:""`
+	UseDefault bool                  `name:"use-default" help:"Revert to the calendar's default reminders"`
+}
+
+func (c *CalendarRemindersSetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty --calendar")
+	}
+	overrides, err := c.Reminders.build()
+	if err != nil {
+		return err
+	}
+	switch {
+	case c.UseDefault && overrides != nil:
+		return usage("--use-default cannot be combined with --popup/--email/--none")
+	case !c.UseDefault && overrides == nil:
+		return usage("specify --popup/--email, --none, or --use-default")
+	}
+
+	reminders := &calendar.EventReminders{UseDefault: true}
+	if !c.UseDefault {
+		reminders = &calendar.EventReminders{
+			UseDefault:      false,
+			Overrides:       overrides,
+			ForceSendFields: []string{"UseDefault", "Overrides"},
+		}
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	updated := make([]*calendar.Event, 0, len(c.EventIDs))
+	for _, id := range c.EventIDs {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		event, patchErr := svc.Events.Patch(calendarID, id, &calendar.Event{Reminders: reminders}).Context(ctx).Do()
+		if patchErr != nil {
+			return fmt.Errorf("set reminders on %s: %w", id, patchErr)
+		}
+		updated = append(updated, event)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		out := make([]map[string]any, 0, len(updated))
+		for _, e := range updated {
+			out = append(out, map[string]any{"eventId": e.Id, "reminders": e.Reminders})
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"events": out})
+	}
+	for _, e := range updated {
+		switch {
+		case e.Reminders == nil || e.Reminders.UseDefault:
+			u.Out().Printf("%s\tdefault", e.Id)
+		case len(e.Reminders.Overrides) == 0:
+			u.Out().Printf("%s\tnone", e.Id)
+		default:
+			parts := make([]string, 0, len(e.Reminders.Overrides))
+			for _, r := range e.Reminders.Overrides {
+				parts = append(parts, formatReminder(r))
+			}
+			u.Out().Printf("%s\t%s", e.Id, strings.Join(parts, ","))
+		}
+	}
+	return nil
+}
+
+type CalendarRemindersDefaultsCmd struct {
+	CalendarID string `arg:"" name:"calendarId" optional:"" help:"Calendar ID (default: primary)"`
+}
+
+func (c *CalendarRemindersDefaultsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		calendarID = defaultCalendarID(flags)
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	entry, err := svc.CalendarList.Get(calendarID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"calendarId": entry.Id, "defaultReminders": entry.DefaultReminders})
+	}
+	if len(entry.DefaultReminders) == 0 {
+		u.Err().Println("No default reminders")
+		return nil
+	}
+	for _, r := range entry.DefaultReminders {
+		u.Out().Printf("reminder\t%s", formatReminder(r))
+	}
+	return nil
+}
+
+type CalendarRemindersSetDefaultsCmd struct {
+	CalendarID string                `arg:"" name:"calendarId" optional:"" help:"Calendar ID (default: primary)"`
+	Reminders  CalendarReminderFlags `embed:""`
+}
+
+func (c *CalendarRemindersSetDefaultsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		calendarID = defaultCalendarID(flags)
+	}
+	overrides, err := c.Reminders.build()
+	if err != nil {
+		return err
+	}
+	if overrides == nil {
+		return usage("specify --popup/--email or --none")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	entry, err := patchCalendarListEntry(ctx, svc, calendarID, &calendar.CalendarListEntry{
+		DefaultReminders: overrides,
+		ForceSendFields:  []string{"DefaultReminders"},
+	}, false)
+	if err != nil {
+		return err
+	}
+	return writeCalendarListEntry(ctx, entry)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarRemindersCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var eventPatches []map[string]any
+	var listPatch map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/calendarList/primary") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":               "primary",
+				"timeZone":         "UTC",
+				"defaultReminders": []map[string]any{{"method": "popup", "minutes": 30}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/calendarList/primary") && r.Method == http.MethodPatch:
+			_ = json.NewDecoder(r.Body).Decode(&listPatch)
+			listPatch["id"] = "primary"
+			_ = json.NewEncoder(w).Encode(listPatch)
+		case strings.HasSuffix(r.URL.Path, "/events/ev1") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "ev1", "reminders": map[string]any{"useDefault": true}})
+		case strings.Contains(r.URL.Path, "/events/") && r.Method == http.MethodPatch:
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			eventPatches = append(eventPatches, body)
+			body["id"] = r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			_ = json.NewEncoder(w).Encode(body)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "reminders", "ev1"}); err != nil {
+			t.Fatalf("show: %v", err)
+		}
+	})
+	if !strings.Contains(out, "calendar default") || !strings.Contains(out, "popup:30m") {
+		t.Fatalf("unexpected show out=%q", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "reminders", "set", "ev1", "ev2", "--popup", "10m", "--email", "1d"}); err != nil {
+			t.Fatalf("set: %v", err)
+		}
+	})
+	if len(eventPatches) != 2 {
+		t.Fatalf("expected 2 patches, got %d", len(eventPatches))
+	}
+	if !strings.Contains(out, "ev2\tpopup:10m,email:1440m") {
+		t.Fatalf("unexpected set out=%q", out)
+	}
+
+	eventPatches = nil
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "reminders", "set", "ev1", "--none"}); err != nil {
+			t.Fatalf("set --none: %v", err)
+		}
+	})
+	rem, _ := eventPatches[0]["reminders"].(map[string]any)
+	if rem["useDefault"] != false {
+		t.Fatalf("expected useDefault=false, got %v", rem)
+	}
+	if overrides, ok := rem["overrides"].([]any); !ok || len(overrides) != 0 {
+		t.Fatalf("expected empty overrides, got %v", rem["overrides"])
+	}
+
+	if err := Execute([]string{"--account", "a@b.com", "calendar", "reminders", "set", "ev1", "--use-default", "--popup", "5m"}); err == nil {
+		t.Fatalf("expected conflicting flags error")
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "reminders", "set-defaults", "--popup", "15m"}); err != nil {
+			t.Fatalf("set-defaults: %v", err)
+		}
+	})
+	defaults, _ := listPatch["defaultReminders"].([]any)
+	if len(defaults) != 1 {
+		t.Fatalf("unexpected defaults patch: %v", listPatch)
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
+	"sync"
 	"text/tabwriter"
 	"time"
 
+	"google.golang.org/api/calendar/v3"
+
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/ui"
 )
@@ -16,6 +20,7 @@ type CalendarSearchCmd struct {
 	Query string `arg:"" name:"query" help:"Search query"`
 	TimeRangeFlags
 	CalendarID string `name:"calendar" help:"Calendar ID" default:"primary"`
+	Calendars  string `name:"calendars" help:"Comma-separated calendar IDs, or 'all' for every visible calendar (searched concurrently)"`
 	Max        int64  `name:"max" aliases:"limit" help:"Max results" default:"25"`
 }
 
@@ -44,6 +49,10 @@ func (c *CalendarSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 	from, to := timeRange.FormatRFC3339()
 
+	if strings.TrimSpace(c.Calendars) != "" {
+		return c.searchCalendars(ctx, svc, query, from, to, timeRange.Location)
+	}
+
 	call := svc.Events.List(c.CalendarID).
 		Q(query).
 		TimeMin(from).
@@ -77,3 +86,136 @@ func (c *CalendarSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	_ = tw.Flush()
 	return nil
 }
+
+func (c *CalendarSearchCmd) searchCalendars(ctx context.Context, svc *calendar.Service, query, from, to string, loc *time.Location) error {
+	u := ui.FromContext(ctx)
+
+	calendarIDs := splitCSV(c.Calendars)
+	if len(calendarIDs) == 1 && strings.EqualFold(calendarIDs[0], "all") {
+		ids, err := listAllCalendarIDs(ctx, svc)
+		if err != nil {
+			return err
+		}
+		calendarIDs = ids
+	}
+	if len(calendarIDs) == 0 {
+		return usage("no calendar IDs provided")
+	}
+
+	type found struct {
+		event *eventWithCalendar
+		start time.Time
+	}
+	var (
+		mu       sync.Mutex
+		results  []found
+		failures []string
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, 10) // max 10 concurrent requests
+	)
+	for _, calendarID := range calendarIDs {
+		wg.Add(1)
+		go func(calendarID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			resp, err := svc.Events.List(calendarID).
+				Q(query).
+				TimeMin(from).
+				TimeMax(to).
+				MaxResults(c.Max).
+				SingleEvents(true).
+				OrderBy("startTime").
+				Context(ctx).
+				Do()
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", calendarID, err))
+				return
+			}
+			for _, e := range resp.Items {
+				if e == nil {
+					continue
+				}
+				startDay, endDay := eventDaysOfWeek(e)
+				results = append(results, found{
+					event: &eventWithCalendar{
+						Event:          e,
+						CalendarID:     calendarID,
+						StartDayOfWeek: startDay,
+						EndDayOfWeek:   endDay,
+						Timezone:       eventTimezone(e),
+						StartLocal:     formatEventLocal(e.Start, nil),
+						EndLocal:       formatEventLocal(e.End, nil),
+					},
+					start: parseEventStart(e, loc),
+				})
+			}
+		}(calendarID)
+	}
+	wg.Wait()
+
+	sort.Slice(failures, func(i, j int) bool { return failures[i] < failures[j] })
+	for _, f := range failures {
+		u.Err().Printf("Warning: %s", f)
+	}
+	if len(failures) == len(calendarIDs) {
+		return fmt.Errorf("search failed on all %d calendar(s)", len(calendarIDs))
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if !results[i].start.Equal(results[j].start) {
+			return results[i].start.Before(results[j].start)
+		}
+		return results[i].event.CalendarID < results[j].event.CalendarID
+	})
+	if c.Max > 0 && int64(len(results)) > c.Max {
+		results = results[:c.Max]
+	}
+	events := make([]*eventWithCalendar, 0, len(results))
+	for _, r := range results {
+		events = append(events, r.event)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"events":    events,
+			"query":     query,
+			"calendars": calendarIDs,
+		})
+	}
+	if len(events) == 0 {
+		u.Err().Println("No events found")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "CALENDAR\tID\tSTART\tEND\tSUMMARY")
+	for _, e := range events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.CalendarID, e.Id, eventStart(e.Event), eventEnd(e.Event), sanitizeTab(e.Summary))
+	}
+	return nil
+}
+
+// listAllCalendarIDs returns the IDs of every calendar on the user's
+// calendar list, following NextPageToken.
+func listAllCalendarIDs(ctx context.Context, svc *calendar.Service) ([]string, error) {
+	var ids []string
+	pageToken := ""
+	for {
+		resp, err := svc.CalendarList.List().PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, cal := range resp.Items {
+			ids = append(ids, cal.Id)
+		}
+		if resp.NextPageToken == "" {
+			return ids, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
@@ -394,3 +394,80 @@ func TestCalendarSearchCmd_MaxResults(t *testing.T) {
 		t.Fatalf("expected 2 events, got %d", len(parsed.Events))
 	}
 }
+
+func TestCalendarSearchCmd_AllCalendars(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/calendarList") && r.Method == http.MethodGet:
+			if r.URL.Query().Get("pageToken") == "p2" {
+				_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{"id": "broken"}}})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items":         []map[string]any{{"id": "me@example.com"}, {"id": "team"}},
+				"nextPageToken": "p2",
+			})
+		case strings.HasSuffix(r.URL.Path, "/calendars/me@example.com/events"):
+			if r.URL.Query().Get("q") != "standup" {
+				t.Errorf("unexpected q: %q", r.URL.Query().Get("q"))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "m2", "summary": "Standup", "start": map[string]any{"dateTime": "2024-01-16T09:00:00Z"}},
+			}})
+		case strings.HasSuffix(r.URL.Path, "/calendars/team/events"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "t1", "summary": "Team standup", "start": map[string]any{"dateTime": "2024-01-15T10:00:00+01:00"}},
+				{"id": "t3", "summary": "Standup retro", "start": map[string]any{"date": "2024-01-17"}},
+			}})
+		case strings.HasSuffix(r.URL.Path, "/calendars/broken/events"):
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": 403, "message": "forbidden"}})
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	var stderr string
+	out := captureStdout(t, func() {
+		stderr = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "search", "standup", "--calendars", "all", "--from", "2024-01-01", "--to", "2024-02-01"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(stderr, "broken") {
+		t.Fatalf("expected warning for failing calendar, got %q", stderr)
+	}
+
+	var parsed struct {
+		Events []struct {
+			ID         string `json:"id"`
+			CalendarID string `json:"calendarId"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	var got []string
+	for _, e := range parsed.Events {
+		got = append(got, e.CalendarID+"/"+e.ID)
+	}
+	if strings.Join(got, ",") != "team/t1,me@example.com/m2,team/t3" {
+		t.Fatalf("unexpected order: %v", got)
+	}
+}
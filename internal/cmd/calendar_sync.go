@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// Private extended properties that tie a mirrored event to its source.
+const (
+	syncPropSource  = "gogSyncSource"
+	syncPropEventID = "gogSyncEventId"
+	syncPropHash    = "gogSyncHash"
+)
+
+type CalendarSyncCmd struct {
+	SourceID      string `arg:"" name:"srcCalendarId" help:"Calendar to mirror from"`
+	DestID        string `arg:"" name:"destCalendarId" help:"Calendar to mirror into"`
+	Window        string `name:"window" help:"How far ahead to mirror (e.g. 60d, next week)" default:"60d"`
+	Prefix        string `name:"prefix" help:"Prefix for mirrored summaries (e.g. [Mirror])"`
+	RedactDetails bool   `name:"redact-details" help:"Only mirror the time: summary becomes the prefix (or Busy), no description or location"`
+	DryRun        bool   `name:"dry-run" help:"Only show what would change"`
+}
+
+type calendarSyncChange struct {
+	Action   string `json:"action"`
+	SourceID string `json:"sourceEventId"`
+	MirrorID string `json:"mirrorEventId,omitempty"`
+	Summary  string `json:"summary"`
+	Start    string `json:"start"`
+}
+
+func (c *CalendarSyncCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	srcID := strings.TrimSpace(c.SourceID)
+	destID := strings.TrimSpace(c.DestID)
+	if srcID == "" || destID == "" {
+		return usage("source and destination calendar IDs required")
+	}
+	if srcID == destID {
+		return usage("source and destination must differ")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	_, loc, err := getCalendarLocation(ctx, svc, srcID)
+	if err != nil {
+		return err
+	}
+	from, to, err := parseSlotWindow(c.Window, time.Now().In(loc), time.Monday)
+	if err != nil {
+		return err
+	}
+	timeMin, timeMax := from.Format(time.RFC3339), to.Format(time.RFC3339)
+
+	var sources []*calendar.Event
+	err = svc.Events.List(srcID).TimeMin(timeMin).TimeMax(timeMax).SingleEvents(true).MaxResults(250).
+		Pages(ctx, func(resp *calendar.Events) error {
+			for _, e := range resp.Items {
+				if shouldMirrorEvent(e) {
+					sources = append(sources, e)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return fmt.Errorf("list %s: %w", srcID, err)
+	}
+
+	mirrors := map[string]*calendar.Event{}
+	var stale []*calendar.Event
+	err = svc.Events.List(destID).TimeMin(timeMin).TimeMax(timeMax).SingleEvents(true).MaxResults(250).
+		PrivateExtendedProperty(syncPropSource+"="+srcID).
+		Pages(ctx, func(resp *calendar.Events) error {
+			for _, e := range resp.Items {
+				sourceEventID := syncPrivateProp(e, syncPropEventID)
+				if _, dup := mirrors[sourceEventID]; dup || sourceEventID == "" {
+					stale = append(stale, e)
+					continue
+				}
+				mirrors[sourceEventID] = e
+			}
+			return nil
+		})
+	if err != nil {
+		return fmt.Errorf("list %s: %w", destID, err)
+	}
+
+	var changes []calendarSyncChange
+	seen := map[string]bool{}
+	for _, src := range sources {
+		seen[src.Id] = true
+		want := c.mirrorEvent(srcID, src)
+		existing := mirrors[src.Id]
+		change := calendarSyncChange{SourceID: src.Id, Summary: want.Summary, Start: eventStart(want)}
+
+		switch {
+		case existing == nil:
+			change.Action = "create"
+			if !c.DryRun {
+				created, insertErr := svc.Events.Insert(destID, want).Context(ctx).Do()
+				if insertErr != nil {
+					return fmt.Errorf("mirror %s: %w", src.Id, insertErr)
+				}
+				change.MirrorID = created.Id
+			}
+		case syncPrivateProp(existing, syncPropHash) != syncPrivateProp(want, syncPropHash):
+			change.Action = "update"
+			change.MirrorID = existing.Id
+			if !c.DryRun {
+				if _, updateErr := svc.Events.Update(destID, existing.Id, want).Context(ctx).Do(); updateErr != nil {
+					return fmt.Errorf("update mirror of %s: %w", src.Id, updateErr)
+				}
+			}
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	// Mirrors whose source is gone (deleted, declined, or moved out of the window).
+	for sourceEventID, mirror := range mirrors {
+		if !seen[sourceEventID] {
+			stale = append(stale, mirror)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].Id < stale[j].Id })
+	for _, mirror := range stale {
+		if !c.DryRun {
+			if deleteErr := svc.Events.Delete(destID, mirror.Id).Context(ctx).Do(); deleteErr != nil {
+				return fmt.Errorf("delete mirror %s: %w", mirror.Id, deleteErr)
+			}
+		}
+		changes = append(changes, calendarSyncChange{
+			Action:   "delete",
+			SourceID: syncPrivateProp(mirror, syncPropEventID),
+			MirrorID: mirror.Id,
+			Summary:  mirror.Summary,
+			Start:    eventStart(mirror),
+		})
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"source":      srcID,
+			"destination": destID,
+			"dryRun":      c.DryRun,
+			"changes":     changes,
+			"unchanged":   len(sources) + len(stale) - len(changes),
+		})
+	}
+	if len(changes) > 0 {
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "ACTION\tSOURCE_ID\tMIRROR_ID\tSTART\tSUMMARY")
+		for _, ch := range changes {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", ch.Action, ch.SourceID, ch.MirrorID, ch.Start, sanitizeTab(ch.Summary))
+		}
+		flush()
+	}
+	prefix := ""
+	if c.DryRun {
+		prefix = "Dry run: "
+	}
+	u.Err().Printf("%s%d change(s), %d unchanged", prefix, len(changes), len(sources)+len(stale)-len(changes))
+	return nil
+}
+
+// shouldMirrorEvent skips events that do not block time: cancelled, free,
+// declined, working-location entries, and mirrors created by another sync.
+func shouldMirrorEvent(e *calendar.Event) bool {
+	if e == nil || e.Status == "cancelled" || e.Transparency == "transparent" || e.EventType == "workingLocation" {
+		return false
+	}
+	if syncPrivateProp(e, syncPropSource) != "" {
+		return false
+	}
+	for _, a := range e.Attendees {
+		if a != nil && a.Self && a.ResponseStatus == "declined" {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *CalendarSyncCmd) mirrorEvent(srcCalendarID string, src *calendar.Event) *calendar.Event {
+	prefix := strings.TrimSpace(c.Prefix)
+	event := &calendar.Event{
+		Start:        src.Start,
+		End:          src.End,
+		Transparency: "opaque",
+		// Mirrors never notify; the source calendar already does.
+		Reminders: &calendar.EventReminders{UseDefault: false, ForceSendFields: []string{"UseDefault"}},
+	}
+	if c.RedactDetails {
+		event.Summary = prefix
+		if event.Summary == "" {
+			event.Summary = "Busy"
+		}
+		event.Visibility = "private"
+	} else {
+		event.Summary = strings.TrimSpace(prefix + " " + src.Summary)
+		event.Description = src.Description
+		event.Location = src.Location
+	}
+
+	h := sha256.New()
+	for _, part := range []string{event.Summary, event.Description, event.Location, eventStart(event), eventEnd(event), event.Visibility} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	event.ExtendedProperties = &calendar.EventExtendedProperties{Private: map[string]string{
+		syncPropSource:  srcCalendarID,
+		syncPropEventID: src.Id,
+		syncPropHash:    hex.EncodeToString(h.Sum(nil))[:16],
+	}}
+	return event
+}
+
+func syncPrivateProp(e *calendar.Event, key string) string {
+	if e == nil || e.ExtendedProperties == nil {
+		return ""
+	}
+	return e.ExtendedProperties.Private[key]
+}
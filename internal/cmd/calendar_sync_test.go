@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarSyncCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	start := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	timed := func(id, summary string) *calendar.Event {
+		return &calendar.Event{
+			Id:      id,
+			Summary: summary,
+			Start:   &calendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+			End:     &calendar.EventDateTime{DateTime: start.Add(time.Hour).Format(time.RFC3339)},
+		}
+	}
+	sources := []*calendar.Event{
+		timed("s1", "Dentist"),
+		timed("s2", "Gym"),
+		timed("s3", "Lunch"),
+		{Id: "s4", Summary: "Free", Transparency: "transparent", Start: timed("", "").Start, End: timed("", "").End},
+	}
+
+	cmd := &CalendarSyncCmd{Prefix: "[Mirror]", RedactDetails: true}
+	upToDate := cmd.mirrorEvent("primary", sources[1])
+	upToDate.Id = "m2"
+	outdated := cmd.mirrorEvent("primary", sources[2])
+	outdated.Id = "m3"
+	outdated.ExtendedProperties.Private[syncPropHash] = "old"
+	orphan := cmd.mirrorEvent("primary", timed("gone", "Gone"))
+	orphan.Id = "m9"
+
+	var (
+		mu      sync.Mutex
+		actions []string
+		propQ   string
+	)
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/calendars/primary/events") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": sources})
+		case strings.HasSuffix(r.URL.Path, "/calendars/work/events") && r.Method == http.MethodGet:
+			propQ = r.URL.Query().Get("privateExtendedProperty")
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []*calendar.Event{upToDate, outdated, orphan}})
+		case strings.HasSuffix(r.URL.Path, "/calendars/work/events") && r.Method == http.MethodPost:
+			var body calendar.Event
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.Summary != "[Mirror]" || body.Description != "" || syncPrivateProp(&body, syncPropEventID) != "s1" {
+				t.Errorf("unexpected insert: %+v", body)
+			}
+			actions = append(actions, "insert:"+syncPrivateProp(&body, syncPropEventID))
+			body.Id = "m1"
+			_ = json.NewEncoder(w).Encode(body)
+		case strings.Contains(r.URL.Path, "/calendars/work/events/") && r.Method == http.MethodPut:
+			actions = append(actions, "update:"+r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "m3"})
+		case strings.Contains(r.URL.Path, "/calendars/work/events/") && r.Method == http.MethodDelete:
+			actions = append(actions, "delete:"+r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	args := []string{"--json", "--account", "a@b.com", "calendar", "sync", "primary", "work", "--prefix", "[Mirror]", "--redact-details"}
+	out := captureStdout(t, func() {
+		if err := Execute(append(args, "--dry-run")); err != nil {
+			t.Fatalf("Execute dry-run: %v", err)
+		}
+	})
+	if len(actions) != 0 {
+		t.Fatalf("dry run wrote: %v", actions)
+	}
+	if propQ != syncPropSource+"=primary" {
+		t.Fatalf("unexpected privateExtendedProperty %q", propQ)
+	}
+	var parsed struct {
+		Changes   []calendarSyncChange `json:"changes"`
+		Unchanged int                  `json:"unchanged"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Changes) != 3 || parsed.Unchanged != 1 {
+		t.Fatalf("unexpected plan: %+v", parsed)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute(args); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	sort.Strings(actions)
+	if strings.Join(actions, ",") != "delete:m9,insert:s1,update:m3" {
+		t.Fatalf("unexpected actions: %v", actions)
+	}
+}
@@ -48,6 +48,8 @@ func TestBuildColorId(t *testing.T) {
 		{"12", "", true},
 		{"", "", false},
 		{"abc", "", true},
+		{"tomato", "11", false},
+		{"Lavender", "1", false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
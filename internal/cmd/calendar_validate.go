@@ -12,6 +12,9 @@ const (
 	sendUpdatesNone         = "none"
 )
 
+// eventColorNames are the names Google Calendar shows for event color IDs 1-11.
+var eventColorNames = []string{"", "lavender", "sage", "grape", "flamingo", "banana", "tangerine", "peacock", "graphite", "blueberry", "basil", "tomato"}
+
 func validateColorId(s string) (string, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
@@ -19,7 +22,12 @@ func validateColorId(s string) (string, error) {
 	}
 	id, err := strconv.Atoi(s)
 	if err != nil {
-		return "", fmt.Errorf("invalid color ID: %q (must be 1-11)", s)
+		for i, name := range eventColorNames {
+			if i > 0 && strings.EqualFold(s, name) {
+				return strconv.Itoa(i), nil
+			}
+		}
+		return "", fmt.Errorf("invalid color: %q (must be 1-11 or a name like tomato)", s)
 	}
 	if id < 1 || id > 11 {
 		return "", fmt.Errorf("color ID must be 1-11 (got %d)", id)
@@ -77,3 +85,26 @@ func validateSendUpdates(s string) (string, error) {
 		return "", fmt.Errorf("invalid send-updates value: %q (must be all, externalOnly, or none)", s)
 	}
 }
+
+// validateCalendarColor accepts a calendar color ID (1-24) or a hex color
+// ("#0088aa"). It returns the ID or the normalized hex, exactly one non-empty.
+func validateCalendarColor(s string) (string, string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", "", nil
+	}
+	if strings.HasPrefix(s, "#") {
+		if len(s) != 7 {
+			return "", "", fmt.Errorf("invalid hex color: %q (want #rrggbb)", s)
+		}
+		if _, err := strconv.ParseUint(s[1:], 16, 32); err != nil {
+			return "", "", fmt.Errorf("invalid hex color: %q (want #rrggbb)", s)
+		}
+		return "", strings.ToLower(s), nil
+	}
+	id, err := strconv.Atoi(s)
+	if err != nil || id < 1 || id > 24 {
+		return "", "", fmt.Errorf("invalid calendar color: %q (must be 1-24 or #rrggbb)", s)
+	}
+	return s, "", nil
+}
@@ -0,0 +1,303 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarWatchCmd struct {
+	CalendarID string `name:"calendar" help:"Calendar ID" default:"primary"`
+	Webhook    string `name:"webhook" help:"HTTPS URL that receives push notifications (channel-based)"`
+	Token      string `name:"token" help:"Channel token echoed in X-Goog-Channel-Token (with --webhook)"`
+	TTL        string `name:"ttl" help:"Requested channel lifetime (seconds or Go duration; with --webhook)"`
+	Poll       bool   `name:"poll" help:"Poll for changes with sync tokens and print JSONL events"`
+	Interval   string `name:"interval" help:"Poll interval (seconds or Go duration)" default:"30s"`
+	State      string `name:"state" help:"File to persist the sync token in, so --poll resumes where it stopped"`
+	Once       bool   `name:"once" help:"With --poll: check once and exit"`
+	StopID     string `name:"stop" help:"Stop the push channel with this ID (needs --resource-id)"`
+	ResourceID string `name:"resource-id" help:"Resource ID of the channel to stop"`
+}
+
+// calendarWatchEvent is one JSONL line emitted by `calendar watch --poll`.
+type calendarWatchEvent struct {
+	Type       string          `json:"type"`
+	CalendarID string          `json:"calendarId"`
+	EventID    string          `json:"eventId"`
+	Summary    string          `json:"summary,omitempty"`
+	Start      string          `json:"start,omitempty"`
+	End        string          `json:"end,omitempty"`
+	Updated    string          `json:"updated,omitempty"`
+	Event      *calendar.Event `json:"event"`
+}
+
+func (c *CalendarWatchCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty --calendar")
+	}
+	modes := 0
+	for _, set := range []bool{strings.TrimSpace(c.Webhook) != "", c.Poll, strings.TrimSpace(c.StopID) != ""} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return usage("specify exactly one of --webhook, --poll or --stop")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case c.Poll:
+		interval, err := parseDurationSeconds(c.Interval)
+		if err != nil || interval <= 0 {
+			return usagef("invalid --interval %q", c.Interval)
+		}
+		return c.poll(ctx, svc, calendarID, interval, os.Stdout)
+	case strings.TrimSpace(c.StopID) != "":
+		return c.stop(ctx, svc)
+	default:
+		return c.startChannel(ctx, svc, calendarID)
+	}
+}
+
+func (c *CalendarWatchCmd) startChannel(ctx context.Context, svc *calendar.Service, calendarID string) error {
+	u := ui.FromContext(ctx)
+	webhook := strings.TrimSpace(c.Webhook)
+	if !strings.HasPrefix(webhook, "https://") {
+		return usage("--webhook must be an https:// URL")
+	}
+	ttl, err := parseDurationSeconds(c.TTL)
+	if err != nil {
+		return usagef("invalid --ttl %q", c.TTL)
+	}
+	id, err := newCalendarChannelID()
+	if err != nil {
+		return err
+	}
+
+	channel := &calendar.Channel{
+		Id:      id,
+		Type:    "web_hook",
+		Address: webhook,
+		Token:   strings.TrimSpace(c.Token),
+	}
+	if ttl > 0 {
+		channel.Params = map[string]string{"ttl": strconv.FormatInt(int64(ttl/time.Second), 10)}
+	}
+	resp, err := svc.Events.Watch(calendarID, channel).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"channel": resp})
+	}
+	u.Out().Printf("channel_id\t%s", resp.Id)
+	u.Out().Printf("resource_id\t%s", resp.ResourceId)
+	if resp.Expiration > 0 {
+		u.Out().Printf("expiration\t%s", formatUnixMillis(resp.Expiration))
+	}
+	u.Err().Printf("Stop with: gog calendar watch --stop %s --resource-id %s", resp.Id, resp.ResourceId)
+	return nil
+}
+
+func (c *CalendarWatchCmd) stop(ctx context.Context, svc *calendar.Service) error {
+	u := ui.FromContext(ctx)
+	id := strings.TrimSpace(c.StopID)
+	resourceID := strings.TrimSpace(c.ResourceID)
+	if resourceID == "" {
+		return usage("--resource-id required with --stop")
+	}
+	if err := svc.Channels.Stop(&calendar.Channel{Id: id, ResourceId: resourceID}).Context(ctx).Do(); err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"stopped": true, "channelId": id, "resourceId": resourceID})
+	}
+	u.Out().Printf("stopped\t%s", id)
+	return nil
+}
+
+func (c *CalendarWatchCmd) poll(ctx context.Context, svc *calendar.Service, calendarID string, interval time.Duration, w io.Writer) error {
+	u := ui.FromContext(ctx)
+	enc := json.NewEncoder(w)
+
+	token, err := c.loadSyncToken()
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		// Initial full sync only establishes the baseline; existing events are not emitted.
+		if token, err = syncCalendarEvents(ctx, svc, calendarID, "", nil); err != nil {
+			return err
+		}
+		if err := c.saveSyncToken(token); err != nil {
+			return err
+		}
+		u.Err().Println("Watching for changes (Ctrl-C to stop)")
+	}
+
+	for {
+		if !c.Once {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(interval):
+			}
+		}
+
+		next, err := syncCalendarEvents(ctx, svc, calendarID, token, func(e *calendar.Event) error {
+			return enc.Encode(newCalendarWatchEvent(calendarID, e))
+		})
+		if isSyncTokenExpired(err) {
+			u.Err().Println("Sync token expired; resyncing (changes since the last check may be missed)")
+			next, err = syncCalendarEvents(ctx, svc, calendarID, "", nil)
+		}
+		if err != nil {
+			return err
+		}
+		token = next
+		if err := c.saveSyncToken(token); err != nil {
+			return err
+		}
+		if c.Once {
+			return nil
+		}
+	}
+}
+
+// syncCalendarEvents pages through events.list (incremental when syncToken is
+// set) and returns the next sync token.
+func syncCalendarEvents(ctx context.Context, svc *calendar.Service, calendarID, syncToken string, emit func(*calendar.Event) error) (string, error) {
+	page := ""
+	for {
+		call := svc.Events.List(calendarID).ShowDeleted(true).MaxResults(250).PageToken(page)
+		if syncToken != "" {
+			call = call.SyncToken(syncToken)
+		}
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return "", err
+		}
+		if emit != nil {
+			for _, e := range resp.Items {
+				if err := emit(e); err != nil {
+					return "", err
+				}
+			}
+		}
+		if resp.NextPageToken == "" {
+			if resp.NextSyncToken == "" {
+				return "", errors.New("calendar API returned no sync token")
+			}
+			return resp.NextSyncToken, nil
+		}
+		page = resp.NextPageToken
+	}
+}
+
+func newCalendarWatchEvent(calendarID string, e *calendar.Event) calendarWatchEvent {
+	kind := "updated"
+	switch {
+	case e.Status == "cancelled":
+		kind = "cancelled"
+	case e.Created != "" && sameSecond(e.Created, e.Updated):
+		kind = "created"
+	}
+	return calendarWatchEvent{
+		Type:       kind,
+		CalendarID: calendarID,
+		EventID:    e.Id,
+		Summary:    e.Summary,
+		Start:      eventStart(e),
+		End:        eventEnd(e),
+		Updated:    e.Updated,
+		Event:      e,
+	}
+}
+
+// sameSecond reports whether two RFC3339 timestamps fall in the same second;
+// Calendar sets created and updated a few milliseconds apart on insert.
+func sameSecond(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	d := tb.Sub(ta)
+	return d > -time.Second && d < time.Second
+}
+
+func isSyncTokenExpired(err error) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == http.StatusGone
+}
+
+func (c *CalendarWatchCmd) loadSyncToken() (string, error) {
+	path := strings.TrimSpace(c.State)
+	if path == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var state struct {
+		CalendarID string `json:"calendarId"`
+		SyncToken  string `json:"syncToken"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return "", fmt.Errorf("read %s: %w", path, err)
+	}
+	if state.CalendarID != strings.TrimSpace(c.CalendarID) {
+		return "", nil
+	}
+	return state.SyncToken, nil
+}
+
+func (c *CalendarWatchCmd) saveSyncToken(token string) error {
+	path := strings.TrimSpace(c.State)
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(map[string]string{"calendarId": strings.TrimSpace(c.CalendarID), "syncToken": token})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func newCalendarChannelID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate channel id: %w", err)
+	}
+	return "gog-" + hex.EncodeToString(b), nil
+}
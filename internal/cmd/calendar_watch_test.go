@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarWatchPoll(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var tokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/calendars/primary/events") || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		tok := r.URL.Query().Get("syncToken")
+		tokens = append(tokens, tok)
+		switch tok {
+		case "":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items":         []map[string]any{{"id": "old", "status": "confirmed"}},
+				"nextSyncToken": "t1",
+			})
+		case "t1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]any{
+					{"id": "new", "status": "confirmed", "summary": "Kickoff", "created": "2025-01-01T10:00:00.000Z", "updated": "2025-01-01T10:00:00.120Z", "start": map[string]any{"dateTime": "2025-01-02T09:00:00Z"}},
+					{"id": "moved", "status": "confirmed", "created": "2024-12-01T10:00:00.000Z", "updated": "2025-01-01T11:00:00.000Z"},
+					{"id": "gone", "status": "cancelled"},
+				},
+				"nextSyncToken": "t2",
+			})
+		case "stale":
+			w.WriteHeader(http.StatusGone)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": 410, "message": "Sync token is no longer valid"}})
+		default:
+			t.Errorf("unexpected sync token %q", tok)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	state := filepath.Join(t.TempDir(), "watch.json")
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "calendar", "watch", "--poll", "--once", "--state", state}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var types []string
+	sc := bufio.NewScanner(strings.NewReader(out))
+	for sc.Scan() {
+		var ev calendarWatchEvent
+		if err := json.Unmarshal(sc.Bytes(), &ev); err != nil {
+			t.Fatalf("jsonl parse: %v (%q)", err, sc.Text())
+		}
+		types = append(types, ev.EventID+"="+ev.Type)
+	}
+	if got := strings.Join(types, ","); got != "new=created,moved=updated,gone=cancelled" {
+		t.Fatalf("events = %s", got)
+	}
+	data, err := os.ReadFile(state)
+	if err != nil || !strings.Contains(string(data), `"syncToken":"t2"`) {
+		t.Fatalf("state = %s (%v)", data, err)
+	}
+
+	// An expired token triggers a full resync instead of failing.
+	if err := os.WriteFile(state, []byte(`{"calendarId":"primary","syncToken":"stale"}`), 0o600); err != nil {
+		t.Fatalf("write state: %v", err)
+	}
+	tokens = nil
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "calendar", "watch", "--poll", "--once", "--state", state}); err != nil {
+				t.Fatalf("Execute resync: %v", err)
+			}
+		})
+	})
+	if strings.Join(tokens, ",") != "stale," {
+		t.Fatalf("tokens = %q", tokens)
+	}
+}
+
+func TestCalendarWatchWebhook(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var channel map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/calendars/team/events/watch") && r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&channel)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": channel["id"], "resourceId": "res-1", "expiration": "1767225600000"})
+		case strings.HasSuffix(r.URL.Path, "/channels/stop") && r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	if err := Execute([]string{"--account", "a@b.com", "calendar", "watch", "--calendar", "team", "--webhook", "http://insecure.example.com"}); err == nil {
+		t.Fatalf("expected https error")
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "calendar", "watch", "--calendar", "team", "--webhook", "https://hooks.example.com/cal", "--ttl", "1h", "--token", "secret"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if channel["type"] != "web_hook" || channel["address"] != "https://hooks.example.com/cal" || channel["token"] != "secret" {
+		t.Fatalf("unexpected channel: %v", channel)
+	}
+	if params, _ := channel["params"].(map[string]any); params["ttl"] != "3600" {
+		t.Fatalf("unexpected params: %v", channel["params"])
+	}
+	if !strings.Contains(out, "resource_id\tres-1") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "calendar", "watch", "--stop", "gog-1", "--resource-id", "res-1"}); err != nil {
+			t.Fatalf("Execute stop: %v", err)
+		}
+	})
+	if !strings.Contains(out, "stopped\tgog-1") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+}
@@ -1,8 +1,12 @@
 package cmd
 
 type ChatCmd struct {
+	Send     ChatSendCmd     `cmd:"" name:"send" help:"Send a message to a space or incoming webhook"`
+	Reply    ChatReplyCmd    `cmd:"" name:"reply" help:"Reply in an existing thread"`
 	Spaces   ChatSpacesCmd   `cmd:"" name:"spaces" help:"Chat spaces"`
 	Messages ChatMessagesCmd `cmd:"" name:"messages" help:"Chat messages"`
 	Threads  ChatThreadsCmd  `cmd:"" name:"threads" help:"Chat threads"`
 	DM       ChatDMCmd       `cmd:"" name:"dm" help:"Direct messages"`
+	Members  ChatMembersCmd  `cmd:"" name:"members" help:"List members of a space"`
+	FindDM   ChatFindDMCmd   `cmd:"" name:"find-dm" help:"Find an existing DM space with a user"`
 }
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/chat/v1"
+)
+
+// chatCardFlags build CardsV2 payloads, either from a JSON file or from a few
+// friendly flags for the common "title, image, link buttons" notification.
+type chatCardFlags struct {
+	CardFile     string   `name:"card-file" help:"CardsV2 JSON (a card, {cardId, card}, a list of those, or {\"cardsV2\": [...]}; - for stdin)"`
+	CardTitle    string   `name:"card-title" help:"Card header title"`
+	CardSubtitle string   `name:"card-subtitle" help:"Card header subtitle"`
+	Image        string   `name:"image" help:"Image URL shown in the card"`
+	Buttons      []string `name:"button" sep:"none" help:"Link button as LABEL|URL (repeatable)"`
+}
+
+func (f chatCardFlags) friendly() bool {
+	return strings.TrimSpace(f.CardTitle) != "" || strings.TrimSpace(f.CardSubtitle) != "" ||
+		strings.TrimSpace(f.Image) != "" || len(f.Buttons) > 0
+}
+
+func (f chatCardFlags) cards() ([]*chat.CardWithId, error) {
+	path := strings.TrimSpace(f.CardFile)
+	if path != "" {
+		if f.friendly() {
+			return nil, usage("--card-file can't be combined with --card-title/--card-subtitle/--image/--button")
+		}
+		data, err := readInputFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return parseChatCards(data)
+	}
+	if !f.friendly() {
+		return nil, nil
+	}
+
+	card := &chat.GoogleAppsCardV1Card{}
+	if title, subtitle := strings.TrimSpace(f.CardTitle), strings.TrimSpace(f.CardSubtitle); title != "" || subtitle != "" {
+		card.Header = &chat.GoogleAppsCardV1CardHeader{Title: title, Subtitle: subtitle}
+	}
+	var widgets []*chat.GoogleAppsCardV1Widget
+	if image := strings.TrimSpace(f.Image); image != "" {
+		if !isChatWebhookURL(image) {
+			return nil, usagef("--image must be an http(s) URL, got %q", image)
+		}
+		widgets = append(widgets, &chat.GoogleAppsCardV1Widget{Image: &chat.GoogleAppsCardV1Image{ImageUrl: image}})
+	}
+	if len(f.Buttons) > 0 {
+		list := &chat.GoogleAppsCardV1ButtonList{}
+		for _, raw := range f.Buttons {
+			label, link, ok := strings.Cut(raw, "|")
+			label, link = strings.TrimSpace(label), strings.TrimSpace(link)
+			if !ok || label == "" || !isChatWebhookURL(link) {
+				return nil, usagef("invalid --button %q (expected LABEL|https://...)", raw)
+			}
+			list.Buttons = append(list.Buttons, &chat.GoogleAppsCardV1Button{
+				Text:    label,
+				OnClick: &chat.GoogleAppsCardV1OnClick{OpenLink: &chat.GoogleAppsCardV1OpenLink{Url: link}},
+			})
+		}
+		widgets = append(widgets, &chat.GoogleAppsCardV1Widget{ButtonList: list})
+	}
+	if len(widgets) > 0 {
+		card.Sections = []*chat.GoogleAppsCardV1Section{{Widgets: widgets}}
+	}
+	return []*chat.CardWithId{{CardId: "gog", Card: card}}, nil
+}
+
+// parseChatCards accepts the shapes people usually copy from the Card Builder
+// or API docs. Unknown fields are rejected so typos don't silently vanish.
+func parseChatCards(data []byte) ([]*chat.CardWithId, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, usage("empty --card-file")
+	}
+	decode := func(v any) error {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(v); err != nil {
+			return fmt.Errorf("parse card file: %w", err)
+		}
+		return nil
+	}
+
+	var cards []*chat.CardWithId
+	if data[0] == '[' {
+		if err := decode(&cards); err != nil {
+			return nil, err
+		}
+	} else {
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(data, &keys); err != nil {
+			return nil, fmt.Errorf("parse card file: %w", err)
+		}
+		switch {
+		case keys["cardsV2"] != nil:
+			var body struct {
+				CardsV2 []*chat.CardWithId `json:"cardsV2"`
+			}
+			if err := decode(&body); err != nil {
+				return nil, err
+			}
+			cards = body.CardsV2
+		case keys["card"] != nil:
+			var one chat.CardWithId
+			if err := decode(&one); err != nil {
+				return nil, err
+			}
+			cards = []*chat.CardWithId{&one}
+		default:
+			var card chat.GoogleAppsCardV1Card
+			if err := decode(&card); err != nil {
+				return nil, err
+			}
+			cards = []*chat.CardWithId{{Card: &card}}
+		}
+	}
+	if len(cards) == 0 {
+		return nil, usage("no cards in --card-file")
+	}
+	for i, card := range cards {
+		if card == nil || card.Card == nil {
+			return nil, usagef("card %d is empty", i+1)
+		}
+		if card.CardId == "" {
+			card.CardId = fmt.Sprintf("gog-%d", i+1)
+		}
+	}
+	return cards, nil
+}
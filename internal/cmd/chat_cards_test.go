@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/chat/v1"
+)
+
+func TestParseChatCards(t *testing.T) {
+	for name, data := range map[string]string{
+		"card":    `{"header":{"title":"Build"},"sections":[{"widgets":[{"textParagraph":{"text":"ok"}}]}]}`,
+		"withId":  `{"cardId":"c1","card":{"header":{"title":"Build"}}}`,
+		"list":    `[{"cardId":"c1","card":{"header":{"title":"Build"}}}]`,
+		"message": `{"cardsV2":[{"cardId":"c1","card":{"header":{"title":"Build"}}}]}`,
+	} {
+		cards, err := parseChatCards([]byte(data))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(cards) != 1 || cards[0].CardId == "" || cards[0].Card.Header.Title != "Build" {
+			t.Fatalf("%s: unexpected cards %#v", name, cards[0])
+		}
+	}
+	if _, err := parseChatCards([]byte(`{"header":{"titel":"Build"}}`)); err == nil {
+		t.Fatalf("expected unknown field error")
+	}
+}
+
+func TestExecute_ChatSend_CardFlags(t *testing.T) {
+	var got chat.Message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": "spaces/aaa/messages/m1"})
+	}))
+	defer srv.Close()
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"chat", "send", srv.URL + "/v1/spaces/aaa/messages?key=k",
+			"--text", "Deploy finished",
+			"--card-title", "Deploy", "--image", "https://example.com/graph.png",
+			"--button", "Open|https://ci.example.com/42", "--button", "Logs|https://ci.example.com/42/logs",
+		}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if got.Text != "Deploy finished" || len(got.CardsV2) != 1 {
+		t.Fatalf("unexpected message: %#v", got)
+	}
+	card := got.CardsV2[0].Card
+	if card.Header == nil || card.Header.Title != "Deploy" || len(card.Sections) != 1 || len(card.Sections[0].Widgets) != 2 {
+		t.Fatalf("unexpected card: %#v", card)
+	}
+	buttons := card.Sections[0].Widgets[1].ButtonList.Buttons
+	if card.Sections[0].Widgets[0].Image.ImageUrl != "https://example.com/graph.png" || len(buttons) != 2 || buttons[1].OnClick.OpenLink.Url != "https://ci.example.com/42/logs" {
+		t.Fatalf("unexpected widgets: %#v", card.Sections[0].Widgets)
+	}
+
+	cardFile := filepath.Join(t.TempDir(), "card.json")
+	if err := os.WriteFile(cardFile, []byte(`{"header":{"title":"From file"}}`), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got = chat.Message{}
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"chat", "send", srv.URL + "/v1/spaces/aaa/messages?key=k", "--card-file", cardFile}); err != nil {
+			t.Fatalf("Execute card-file: %v", err)
+		}
+	})
+	if len(got.CardsV2) != 1 || got.CardsV2[0].Card.Header.Title != "From file" {
+		t.Fatalf("unexpected card-file message: %#v", got)
+	}
+
+	t.Setenv("GOG_CHAT_APP_KEY", "")
+	_ = captureStderr(t, func() {
+		err := Execute([]string{"--account", "a@company.com", "chat", "send", "aaa", "--button", "Open|https://x.example.com"})
+		if err == nil || !strings.Contains(err.Error(), "Chat app") {
+			t.Fatalf("expected card auth error, got %v", err)
+		}
+		if err := Execute([]string{"chat", "send", srv.URL, "--button", "Open"}); err == nil {
+			t.Fatalf("expected invalid button error")
+		}
+	})
+}
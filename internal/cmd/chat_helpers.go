@@ -76,6 +76,14 @@ func parseCommaArgs(values []string) []string {
 	return out
 }
 
+var chatSpaceTypes = map[string]string{
+	"space":          "SPACE",
+	"group":          "GROUP_CHAT",
+	"group_chat":     "GROUP_CHAT",
+	"dm":             "DIRECT_MESSAGE",
+	"direct_message": "DIRECT_MESSAGE",
+}
+
 func chatSpaceType(space *chat.Space) string {
 	if space == nil {
 		return ""
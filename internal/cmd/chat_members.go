@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/chat/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type ChatMembersCmd struct {
+	Space  string `arg:"" name:"space" help:"Space name (spaces/...)"`
+	Max    int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
+	Page   string `name:"page" help:"Page token"`
+	All    bool   `name:"all" help:"Fetch all pages (uses --max as page size)"`
+	Role   string `name:"role" help:"Only members with this role: member|manager"`
+	Groups bool   `name:"groups" help:"Include Google Group memberships"`
+}
+
+func (c *ChatMembersCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if err = requireWorkspaceAccount(account); err != nil {
+		return err
+	}
+
+	space, err := normalizeSpace(c.Space)
+	if err != nil {
+		return usage("required: space")
+	}
+	filter := ""
+	switch strings.ToLower(strings.TrimSpace(c.Role)) {
+	case "":
+	case "member":
+		filter = `role = "ROLE_MEMBER"`
+	case "manager":
+		filter = `role = "ROLE_MANAGER"`
+	default:
+		return usagef("invalid --role %q (expected member|manager)", c.Role)
+	}
+
+	svc, err := newChatService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var memberships []*chat.Membership
+	nextPageToken := ""
+	for page := c.Page; ; {
+		call := svc.Spaces.Members.List(space).PageSize(c.Max).PageToken(page)
+		if filter != "" {
+			call = call.Filter(filter)
+		}
+		if c.Groups {
+			call = call.ShowGroups(true)
+		}
+		resp, listErr := call.Do()
+		if listErr != nil {
+			return listErr
+		}
+		memberships = append(memberships, resp.Memberships...)
+		nextPageToken = resp.NextPageToken
+		if !c.All || nextPageToken == "" {
+			break
+		}
+		page = nextPageToken
+	}
+
+	if outfmt.IsJSON(ctx) {
+		type item struct {
+			Resource string `json:"resource"`
+			Member   string `json:"member,omitempty"`
+			Name     string `json:"name,omitempty"`
+			Type     string `json:"type,omitempty"`
+			Role     string `json:"role,omitempty"`
+			State    string `json:"state,omitempty"`
+			Joined   string `json:"joined,omitempty"`
+		}
+		items := make([]item, 0, len(memberships))
+		for _, m := range memberships {
+			if m == nil {
+				continue
+			}
+			member, name, kind := chatMembershipMember(m)
+			items = append(items, item{
+				Resource: m.Name,
+				Member:   member,
+				Name:     name,
+				Type:     kind,
+				Role:     m.Role,
+				State:    m.State,
+				Joined:   m.CreateTime,
+			})
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"members":       items,
+			"nextPageToken": nextPageToken,
+		})
+	}
+
+	if len(memberships) == 0 {
+		u.Err().Println("No members")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "MEMBER\tNAME\tTYPE\tROLE")
+	for _, m := range memberships {
+		if m == nil {
+			continue
+		}
+		member, name, kind := chatMembershipMember(m)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", member, sanitizeTab(name), kind, strings.TrimPrefix(m.Role, "ROLE_"))
+	}
+	printNextPageHint(u, nextPageToken)
+	return nil
+}
+
+// chatMembershipMember returns the resource, display name and type of the
+// user or group behind a membership. Display names are only filled in for
+// app-authenticated calls.
+func chatMembershipMember(m *chat.Membership) (string, string, string) {
+	switch {
+	case m.Member != nil:
+		return m.Member.Name, m.Member.DisplayName, m.Member.Type
+	case m.GroupMember != nil:
+		return m.GroupMember.Name, "", "GROUP"
+	}
+	return "", "", ""
+}
+
+type ChatFindDMCmd struct {
+	Email string `arg:"" name:"email" help:"User email"`
+}
+
+func (c *ChatFindDMCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if err = requireWorkspaceAccount(account); err != nil {
+		return err
+	}
+
+	user := normalizeUser(c.Email)
+	if user == "" {
+		return usage("required: email")
+	}
+
+	svc, err := newChatService(ctx, account)
+	if err != nil {
+		return err
+	}
+	// Unlike `dm space`, this never creates a DM; a missing one is a 404.
+	space, err := svc.Spaces.FindDirectMessage().Name(user).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"space": space})
+	}
+	u.Out().Printf("resource\t%s", space.Name)
+	if space.SpaceUri != "" {
+		u.Out().Printf("uri\t%s", space.SpaceUri)
+	}
+	return nil
+}
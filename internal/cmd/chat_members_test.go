@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/chat/v1"
+	"google.golang.org/api/option"
+)
+
+func TestExecute_ChatDiscovery(t *testing.T) {
+	origNew := newChatService
+	t.Cleanup(func() { newChatService = origNew })
+
+	var spaceFilters, memberFilters []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v1/spaces":
+			spaceFilters = append(spaceFilters, r.URL.Query().Get("filter"))
+			if r.URL.Query().Get("pageToken") == "" {
+				_ = json.NewEncoder(w).Encode(map[string]any{"spaces": []map[string]any{{"name": "spaces/d1", "spaceType": "DIRECT_MESSAGE"}}, "nextPageToken": "p2"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"spaces": []map[string]any{{"name": "spaces/d2", "spaceType": "DIRECT_MESSAGE"}}})
+		case r.URL.Path == "/v1/spaces/aaa/members":
+			memberFilters = append(memberFilters, r.URL.Query().Get("filter"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"memberships": []map[string]any{
+				{"name": "spaces/aaa/members/1", "role": "ROLE_MANAGER", "member": map[string]any{"name": "users/1", "type": "HUMAN"}},
+				{"name": "spaces/aaa/members/2", "role": "ROLE_MEMBER", "groupMember": map[string]any{"name": "groups/eng"}},
+			}})
+		case r.URL.Path == "/v1/spaces:findDirectMessage":
+			if r.URL.Query().Get("name") != "users/bob@company.com" {
+				http.NotFound(w, r)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "spaces/dm1", "spaceType": "DIRECT_MESSAGE"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := chat.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newChatService = func(context.Context, string) (*chat.Service, error) { return svc, nil }
+
+	run := func(args ...string) string {
+		t.Helper()
+		return captureStdout(t, func() {
+			if err := Execute(append([]string{"--json", "--account", "a@b.com", "chat"}, args...)); err != nil {
+				t.Fatalf("%v: %v", args, err)
+			}
+		})
+	}
+
+	out := run("spaces", "list", "--type", "dm", "--all")
+	if !strings.Contains(out, "spaces/d1") || !strings.Contains(out, "spaces/d2") {
+		t.Fatalf("unexpected spaces out=%q", out)
+	}
+	if len(spaceFilters) != 2 || spaceFilters[0] != `spaceType = "DIRECT_MESSAGE"` {
+		t.Fatalf("unexpected filters: %v", spaceFilters)
+	}
+
+	out = run("members", "aaa", "--role", "manager", "--groups")
+	var members struct {
+		Members []struct {
+			Member string `json:"member"`
+			Type   string `json:"type"`
+			Role   string `json:"role"`
+		} `json:"members"`
+	}
+	if err := json.Unmarshal([]byte(out), &members); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(members.Members) != 2 || members.Members[0].Member != "users/1" || members.Members[1].Type != "GROUP" {
+		t.Fatalf("unexpected members out=%q", out)
+	}
+	if len(memberFilters) != 1 || memberFilters[0] != `role = "ROLE_MANAGER"` {
+		t.Fatalf("unexpected member filters: %v", memberFilters)
+	}
+
+	out = run("find-dm", "bob@company.com")
+	if !strings.Contains(out, "spaces/dm1") {
+		t.Fatalf("unexpected find-dm out=%q", out)
+	}
+}
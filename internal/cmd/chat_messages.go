@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"google.golang.org/api/chat/v1"
 
@@ -24,6 +25,7 @@ type ChatMessagesListCmd struct {
 	Order  string `name:"order" help:"Order by (e.g. createTime desc)"`
 	Thread string `name:"thread" help:"Filter by thread (spaces/.../threads/...)"`
 	Unread bool   `name:"unread" help:"Only messages after last read time"`
+	Since  string `name:"since" help:"Only messages newer than this (e.g. 1h, 2d, 2024-01-01, RFC3339)"`
 }
 
 func (c *ChatMessagesListCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -46,6 +48,17 @@ func (c *ChatMessagesListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
+	// Chat accepts a single lower bound on createTime, so --since and --unread
+	// resolve to whichever is later.
+	var after time.Time
+	if strings.TrimSpace(c.Since) != "" {
+		since, sinceErr := parseTrackingSince(c.Since)
+		if sinceErr != nil {
+			return sinceErr
+		}
+		after, _ = time.Parse(time.RFC3339Nano, since)
+	}
+
 	filters := make([]string, 0, 2)
 	thread := strings.TrimSpace(c.Thread)
 	if thread != "" {
@@ -60,10 +73,13 @@ func (c *ChatMessagesListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		if readErr != nil {
 			return readErr
 		}
-		if readState.LastReadTime != "" {
-			filters = append(filters, fmt.Sprintf("createTime > \"%s\"", readState.LastReadTime))
+		if lastRead, parseErr := time.Parse(time.RFC3339Nano, readState.LastReadTime); parseErr == nil && lastRead.After(after) {
+			after = lastRead
 		}
 	}
+	if !after.IsZero() {
+		filters = append(filters, fmt.Sprintf("createTime > \"%s\"", after.UTC().Format(time.RFC3339Nano)))
+	}
 	filter := strings.Join(filters, " AND ")
 
 	call := svc.Spaces.Messages.List(space).
@@ -198,3 +214,48 @@ func (c *ChatMessagesSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 	return nil
 }
+
+type ChatReplyCmd struct {
+	Space  string `arg:"" name:"space" help:"Space name (spaces/...)"`
+	Thread string `arg:"" name:"thread" help:"Thread ID or resource (spaces/.../threads/...)"`
+	AppKey string `name:"app-key" help:"Chat app service account key (JSON); reply as the app instead of the user (or GOG_CHAT_APP_KEY)"`
+	chatMessageFlags
+}
+
+func (c *ChatReplyCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	space, err := normalizeSpace(c.Space)
+	if err != nil {
+		return usage("required: space")
+	}
+	threadName, err := normalizeThread(space, c.Thread)
+	if err != nil {
+		return usage(fmt.Sprintf("invalid thread: %v", err))
+	}
+	message, err := c.message()
+	if err != nil {
+		return err
+	}
+	message.Thread = &chat.Thread{Name: threadName}
+	if err = requireChatCardAuth(message, c.AppKey); err != nil {
+		return err
+	}
+
+	svc, err := chatSendService(ctx, flags, c.AppKey)
+	if err != nil {
+		return err
+	}
+	resp, err := svc.Spaces.Messages.Create(space, message).MessageReplyOption(chatReplyOrFail).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"message": resp})
+	}
+	if resp != nil && resp.Name != "" {
+		u.Out().Printf("resource\t%s", resp.Name)
+	}
+	u.Out().Printf("thread\t%s", threadName)
+	return nil
+}
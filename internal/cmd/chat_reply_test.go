@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/chat/v1"
+	"google.golang.org/api/option"
+)
+
+func TestExecute_ChatMessagesSinceAndReply(t *testing.T) {
+	origNew := newChatService
+	t.Cleanup(func() { newChatService = origNew })
+
+	var filter, replyOption string
+	var reply chat.Message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/spaces/aaa/messages":
+			filter = r.URL.Query().Get("filter")
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": []map[string]any{{"name": "spaces/aaa/messages/m1", "text": "deploy?"}}})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/spaces/aaa/messages":
+			replyOption = r.URL.Query().Get("messageReplyOption")
+			_ = json.NewDecoder(r.Body).Decode(&reply)
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "spaces/aaa/messages/m2", "thread": reply.Thread})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := chat.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newChatService = func(context.Context, string) (*chat.Service, error) { return svc, nil }
+
+	before := time.Now().Add(-time.Hour - time.Minute)
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "chat", "messages", "list", "aaa", "--since", "1h"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	ts, ok := strings.CutPrefix(filter, `createTime > "`)
+	if !ok {
+		t.Fatalf("unexpected filter %q", filter)
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, strings.TrimSuffix(ts, `"`))
+	if err != nil || parsed.Before(before) || parsed.After(time.Now()) {
+		t.Fatalf("unexpected since timestamp %q (%v)", ts, err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "chat", "reply", "aaa", "t1", "--text", "on it"}); err != nil {
+			t.Fatalf("reply: %v", err)
+		}
+	})
+	if reply.Text != "on it" || reply.Thread == nil || reply.Thread.Name != "spaces/aaa/threads/t1" || replyOption != chatReplyOrFail {
+		t.Fatalf("unexpected reply: %#v option=%q", reply, replyOption)
+	}
+	if !strings.Contains(out, "spaces/aaa/messages/m2") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+}
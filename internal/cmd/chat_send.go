@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"google.golang.org/api/chat/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const (
+	chatReplyFallbackToNewThread = "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD"
+	chatReplyOrFail              = "REPLY_MESSAGE_OR_FAIL"
+)
+
+// chatMessageFlags builds the message body shared by the send commands.
+type chatMessageFlags struct {
+	Text     string `name:"text" help:"Message text"`
+	Markdown string `name:"markdown" help:"Message in Markdown (converted to Chat formatting)"`
+	chatCardFlags
+}
+
+func (f chatMessageFlags) message() (*chat.Message, error) {
+	text := strings.TrimSpace(f.Text)
+	markdown := strings.TrimSpace(f.Markdown)
+	cards, err := f.cards()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case text != "" && markdown != "":
+		return nil, usage("use either --text or --markdown")
+	case markdown != "":
+		text = chatMarkdown(markdown)
+	case text == "" && len(cards) == 0:
+		return nil, usage("required: --text, --markdown or a card")
+	}
+	return &chat.Message{Text: text, CardsV2: cards}, nil
+}
+
+// requireChatCardAuth rejects cards for user-authenticated sends; the Chat
+// API only accepts them from apps and webhooks.
+func requireChatCardAuth(message *chat.Message, appKey string) error {
+	if len(message.CardsV2) == 0 || strings.TrimSpace(appKey) != "" || strings.TrimSpace(os.Getenv("GOG_CHAT_APP_KEY")) != "" {
+		return nil
+	}
+	return usage("cards can only be sent as a Chat app (--app-key) or through a webhook URL")
+}
+
+type ChatSendCmd struct {
+	Space  string `arg:"" name:"space" help:"Space (spaces/... or ID) or incoming webhook URL"`
+	Thread string `name:"thread" help:"Thread key (starts the thread if new) or thread resource (spaces/.../threads/...)"`
+	AppKey string `name:"app-key" help:"Chat app service account key (JSON); post as the app instead of the user (or GOG_CHAT_APP_KEY)"`
+	chatMessageFlags
+}
+
+func (c *ChatSendCmd) Run(ctx context.Context, flags *RootFlags) error {
+	message, err := c.message()
+	if err != nil {
+		return err
+	}
+
+	target := strings.TrimSpace(c.Space)
+	thread := strings.TrimSpace(c.Thread)
+	var resp *chat.Message
+	if isChatWebhookURL(target) {
+		if strings.HasPrefix(thread, "spaces/") {
+			return usage("webhooks only accept a thread key for --thread")
+		}
+		if thread != "" {
+			message.Thread = &chat.Thread{ThreadKey: thread}
+		}
+		resp, err = postChatWebhook(ctx, target, message)
+		if err != nil {
+			return err
+		}
+	} else {
+		space, spaceErr := normalizeSpace(target)
+		if spaceErr != nil {
+			return usage("required: space")
+		}
+		if thread != "" {
+			message.Thread, err = chatThreadRef(space, thread)
+			if err != nil {
+				return err
+			}
+		}
+		if cardErr := requireChatCardAuth(message, c.AppKey); cardErr != nil {
+			return cardErr
+		}
+		svc, svcErr := chatSendService(ctx, flags, c.AppKey)
+		if svcErr != nil {
+			return svcErr
+		}
+		call := svc.Spaces.Messages.Create(space, message)
+		if thread != "" {
+			call = call.MessageReplyOption(chatReplyFallbackToNewThread)
+		}
+		resp, err = call.Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"message": resp})
+	}
+	u := ui.FromContext(ctx)
+	if resp == nil {
+		return nil
+	}
+	if resp.Name != "" {
+		u.Out().Printf("resource\t%s", resp.Name)
+	}
+	if resp.Thread != nil && resp.Thread.Name != "" {
+		u.Out().Printf("thread\t%s", resp.Thread.Name)
+	}
+	return nil
+}
+
+// chatSendService picks app auth (--app-key or GOG_CHAT_APP_KEY) over the
+// user account.
+func chatSendService(ctx context.Context, flags *RootFlags, appKey string) (*chat.Service, error) {
+	keyPath := strings.TrimSpace(appKey)
+	if keyPath == "" {
+		keyPath = strings.TrimSpace(os.Getenv("GOG_CHAT_APP_KEY"))
+	}
+	if keyPath != "" {
+		expanded, err := config.ExpandPath(keyPath)
+		if err != nil {
+			return nil, err
+		}
+		return newChatAppService(ctx, expanded)
+	}
+
+	account, err := requireAccount(flags)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireWorkspaceAccount(account); err != nil {
+		return nil, err
+	}
+	return newChatService(ctx, account)
+}
+
+// chatThreadRef treats full thread resources as thread names and anything
+// else as a client-chosen thread key.
+func chatThreadRef(space, thread string) (*chat.Thread, error) {
+	if strings.HasPrefix(thread, "spaces/") || strings.HasPrefix(thread, "threads/") {
+		name, err := normalizeThread(space, thread)
+		if err != nil {
+			return nil, usage(fmt.Sprintf("invalid thread: %v", err))
+		}
+		return &chat.Thread{Name: name}, nil
+	}
+	return &chat.Thread{ThreadKey: thread}, nil
+}
+
+func isChatWebhookURL(target string) bool {
+	return strings.HasPrefix(target, "https://") || strings.HasPrefix(target, "http://")
+}
+
+func postChatWebhook(ctx context.Context, webhookURL string, message *chat.Message) (*chat.Message, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, usagef("invalid webhook URL: %v", err)
+	}
+	if message.Thread != nil {
+		q := u.Query()
+		q.Set("messageReplyOption", chatReplyFallbackToNewThread)
+		u.RawQuery = q.Encode()
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	resp, err := chatWebhookClient.Do(req)
+	if err != nil {
+		// The key and token query parameters are the webhook's credentials;
+		// keep them out of the error.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			redacted := *u
+			redacted.RawQuery = ""
+			urlErr.URL = redacted.Redacted()
+		}
+		return nil, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read webhook response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("webhook returned %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	var created chat.Message
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &created); err != nil {
+			return nil, fmt.Errorf("decode webhook response: %w", err)
+		}
+	}
+	return &created, nil
+}
+
+var (
+	chatMarkdownHeading = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+	chatMarkdownBold    = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*|__(\S(?:.*?\S)?)__`)
+	chatMarkdownItalic  = regexp.MustCompile(`(^|[^*\w])\*(\S(?:[^*]*?\S)?)\*`)
+	chatMarkdownStrike  = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+	chatMarkdownLink    = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+)
+
+// chatMarkdown converts common Markdown to Chat's text formatting: **bold**
+// becomes *bold*, *italic* becomes _italic_, ~~strike~~ becomes ~strike~,
+// links become <url|text> and headings become bold lines. Code spans and
+// fenced blocks use the same syntax in both and are left untouched.
+func chatMarkdown(md string) string {
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if m := chatMarkdownHeading.FindStringSubmatch(line); m != nil {
+			// Chat can't nest bold, so the whole heading becomes one bold run.
+			lines[i] = "*" + chatMarkdownCodeAware(strings.ReplaceAll(m[1], "**", "")) + "*"
+			continue
+		}
+		lines[i] = chatMarkdownCodeAware(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func chatMarkdownCodeAware(line string) string {
+	parts := strings.Split(line, "`")
+	for j := 0; j < len(parts); j += 2 {
+		parts[j] = chatMarkdownInline(parts[j])
+	}
+	return strings.Join(parts, "`")
+}
+
+func chatMarkdownInline(s string) string {
+	// Bold is parked behind \x00 so the italic pass doesn't see its asterisks.
+	s = chatMarkdownBold.ReplaceAllStringFunc(s, func(m string) string {
+		return "\x00" + m[2:len(m)-2] + "\x00"
+	})
+	s = chatMarkdownItalic.ReplaceAllString(s, "${1}_${2}_")
+	s = strings.ReplaceAll(s, "\x00", "*")
+	s = chatMarkdownStrike.ReplaceAllString(s, "~${1}~")
+	return chatMarkdownLink.ReplaceAllString(s, "<${2}|${1}>")
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/chat/v1"
+	"google.golang.org/api/option"
+)
+
+func TestChatMarkdown(t *testing.T) {
+	in := "# Deploy **done**\n**Bold**, *italic*, ~~gone~~ and [docs](https://example.com)\n- item `**raw**`\n```\n**kept**\n```"
+	want := "*Deploy done*\n*Bold*, _italic_, ~gone~ and <https://example.com|docs>\n- item `**raw**`\n```\n**kept**\n```"
+	if got := chatMarkdown(in); got != want {
+		t.Fatalf("chatMarkdown:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestExecute_ChatSend_Webhook(t *testing.T) {
+	var got chat.Message
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": "spaces/aaa/messages/m1", "thread": map[string]any{"name": "spaces/aaa/threads/t1"}})
+	}))
+	defer srv.Close()
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"chat", "send", srv.URL + "/v1/spaces/aaa/messages?key=k&token=t", "--markdown", "**CI** passed", "--thread", "build-42"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if got.Text != "*CI* passed" || got.Thread == nil || got.Thread.ThreadKey != "build-42" {
+		t.Fatalf("unexpected webhook body: %#v", got)
+	}
+	if !strings.Contains(query, "token=t") || !strings.Contains(query, "messageReplyOption=REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD") {
+		t.Fatalf("unexpected query: %q", query)
+	}
+	if !strings.Contains(out, "spaces/aaa/messages/m1") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+}
+
+func TestPostChatWebhook_RedactsCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	target := srv.URL + "/v1/spaces/aaa/messages?key=secret-key&token=secret-token"
+	srv.Close()
+
+	_, err := postChatWebhook(context.Background(), target, &chat.Message{Text: "hi"})
+	if err == nil {
+		t.Fatalf("expected error for closed server")
+	}
+	if strings.Contains(err.Error(), "secret") || !strings.Contains(err.Error(), "/v1/spaces/aaa/messages") {
+		t.Fatalf("expected redacted URL in error, got %v", err)
+	}
+}
+
+func TestExecute_ChatSend_AppAuth(t *testing.T) {
+	origApp := newChatAppService
+	t.Cleanup(func() { newChatAppService = origApp })
+
+	var got chat.Message
+	var replyOption string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/spaces/aaa/messages" {
+			http.NotFound(w, r)
+			return
+		}
+		replyOption = r.URL.Query().Get("messageReplyOption")
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": "spaces/aaa/messages/m2"})
+	}))
+	defer srv.Close()
+
+	svc, err := chat.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	var keyPath string
+	newChatAppService = func(_ context.Context, path string) (*chat.Service, error) {
+		keyPath = path
+		return svc, nil
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "chat", "send", "aaa", "--text", "hello", "--thread", "spaces/aaa/threads/t1", "--app-key", "/tmp/app.json"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if keyPath != "/tmp/app.json" {
+		t.Fatalf("unexpected key path %q", keyPath)
+	}
+	if got.Text != "hello" || got.Thread == nil || got.Thread.Name != "spaces/aaa/threads/t1" || replyOption != chatReplyFallbackToNewThread {
+		t.Fatalf("unexpected request: %#v reply=%q", got, replyOption)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"chat", "send", "aaa", "--text", "a", "--markdown", "b", "--app-key", "/tmp/app.json"}); err == nil {
+			t.Fatalf("expected error for --text with --markdown")
+		}
+	})
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"google.golang.org/api/chat/v1"
 
@@ -9,3 +11,7 @@ import (
 )
 
 var newChatService func(ctx context.Context, email string) (*chat.Service, error) = googleapi.NewChat
+
+var newChatAppService func(ctx context.Context, keyPath string) (*chat.Service, error) = googleapi.NewChatApp
+
+var chatWebhookClient = &http.Client{Timeout: 30 * time.Second}
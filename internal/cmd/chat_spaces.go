@@ -21,6 +21,8 @@ type ChatSpacesCmd struct {
 type ChatSpacesListCmd struct {
 	Max  int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
 	Page string `name:"page" help:"Page token"`
+	All  bool   `name:"all" help:"Fetch all pages (uses --max as page size)"`
+	Type string `name:"type" help:"Only spaces of this type: space|group|dm"`
 }
 
 func (c *ChatSpacesListCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -38,12 +40,31 @@ func (c *ChatSpacesListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	resp, err := svc.Spaces.List().
-		PageSize(c.Max).
-		PageToken(c.Page).
-		Do()
-	if err != nil {
-		return err
+	filter := ""
+	if t := strings.TrimSpace(c.Type); t != "" {
+		spaceType, ok := chatSpaceTypes[strings.ToLower(t)]
+		if !ok {
+			return usagef("invalid --type %q (expected space|group|dm)", c.Type)
+		}
+		filter = fmt.Sprintf("spaceType = \"%s\"", spaceType)
+	}
+
+	resp := &chat.ListSpacesResponse{}
+	for page := c.Page; ; {
+		call := svc.Spaces.List().PageSize(c.Max).PageToken(page)
+		if filter != "" {
+			call = call.Filter(filter)
+		}
+		pageResp, listErr := call.Do()
+		if listErr != nil {
+			return listErr
+		}
+		resp.Spaces = append(resp.Spaces, pageResp.Spaces...)
+		resp.NextPageToken = pageResp.NextPageToken
+		if !c.All || pageResp.NextPageToken == "" {
+			break
+		}
+		page = pageResp.NextPageToken
 	}
 
 	if outfmt.IsJSON(ctx) {
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/classroom/v1"
+	"google.golang.org/api/option"
+)
+
+func newTestClassroomService(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	svc, err := classroom.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	orig := newClassroomService
+	t.Cleanup(func() { newClassroomService = orig })
+	newClassroomService = func(context.Context, string) (*classroom.Service, error) { return svc, nil }
+}
+
+func TestClassroomSubmissionsList_AllCourseworkAllPages(t *testing.T) {
+	var paths, states []string
+	newTestClassroomService(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		states = append(states, strings.Join(r.URL.Query()["states"], ","))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("pageToken") == "" {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"studentSubmissions": []any{map[string]any{"id": "s1", "courseWorkId": "cw1", "userId": "u1", "state": "TURNED_IN"}},
+				"nextPageToken":      "p2",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"studentSubmissions": []any{map[string]any{"id": "s2", "courseWorkId": "cw2", "userId": "u2", "state": "TURNED_IN"}},
+		})
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "classroom", "submissions", "list", "c1", "--state", "turned_in", "--all"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if len(paths) != 2 || paths[0] != "/v1/courses/c1/courseWork/-/studentSubmissions" {
+		t.Fatalf("unexpected requests: %v", paths)
+	}
+	if states[0] != "TURNED_IN" || states[1] != "TURNED_IN" {
+		t.Fatalf("state filter not kept across pages: %v", states)
+	}
+	if !strings.Contains(out, "COURSEWORK_ID") || !strings.Contains(out, "cw1") || !strings.Contains(out, "cw2") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestClassroomCourseworkCreate_MultipleCourses(t *testing.T) {
+	var created []string
+	newTestClassroomService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/courseWork") {
+			http.NotFound(w, r)
+			return
+		}
+		course := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/courses/"), "/courseWork")
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		created = append(created, course+":"+body["title"].(string))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "cw-" + course, "courseId": course, "title": body["title"], "state": "DRAFT"})
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "classroom", "coursework", "create", "c1,c2", "c3", "--title", "Essay", "--state", "draft"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if strings.Join(created, " ") != "c1:Essay c2:Essay c3:Essay" {
+		t.Fatalf("unexpected creates: %v", created)
+	}
+	if got := decodeJSONArrayLen(t, out, "coursework"); got != 3 {
+		t.Fatalf("expected 3 coursework items, got %d", got)
+	}
+}
+
+func TestClassroomCoursesList_RoleTeacher(t *testing.T) {
+	var teacherID string
+	newTestClassroomService(t, func(w http.ResponseWriter, r *http.Request) {
+		teacherID = r.URL.Query().Get("teacherId")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"courses": []any{map[string]any{"id": "c1", "name": "Math"}}})
+	})
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "classroom", "courses", "list", "--role", "teacher"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if teacherID != "me" {
+		t.Fatalf("expected teacherId=me, got %q", teacherID)
+	}
+	if err := Execute([]string{"--account", "a@b.com", "classroom", "courses", "list", "--role", "parent"}); err == nil {
+		t.Fatalf("expected invalid role error")
+	}
+}
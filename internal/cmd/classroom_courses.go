@@ -29,8 +29,10 @@ type ClassroomCoursesListCmd struct {
 	States    string `name:"state" help:"Course states filter (comma-separated: ACTIVE,ARCHIVED,PROVISIONED,DECLINED)"`
 	TeacherID string `name:"teacher" help:"Filter by teacher user ID or email"`
 	StudentID string `name:"student" help:"Filter by student user ID or email"`
+	Role      string `name:"role" help:"Only courses you teach or attend: teacher|student"`
 	Max       int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
 	Page      string `name:"page" help:"Page token"`
+	All       bool   `name:"all" help:"Fetch all pages"`
 }
 
 func (c *ClassroomCoursesListCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -40,12 +42,28 @@ func (c *ClassroomCoursesListCmd) Run(ctx context.Context, flags *RootFlags) err
 		return err
 	}
 
+	teacherID := strings.TrimSpace(c.TeacherID)
+	studentID := strings.TrimSpace(c.StudentID)
+	switch strings.ToLower(strings.TrimSpace(c.Role)) {
+	case "":
+	case "teacher":
+		if teacherID == "" {
+			teacherID = "me"
+		}
+	case "student":
+		if studentID == "" {
+			studentID = "me"
+		}
+	default:
+		return usagef("invalid --role %q (expected teacher|student)", c.Role)
+	}
+
 	svc, err := newClassroomService(ctx, account)
 	if err != nil {
 		return wrapClassroomError(err)
 	}
 
-	call := svc.Courses.List().PageSize(c.Max).PageToken(c.Page).Context(ctx)
+	call := svc.Courses.List().PageSize(c.Max).Context(ctx)
 	if states := splitCSV(c.States); len(states) > 0 {
 		upper := make([]string, 0, len(states))
 		for _, state := range states {
@@ -53,26 +71,35 @@ func (c *ClassroomCoursesListCmd) Run(ctx context.Context, flags *RootFlags) err
 		}
 		call.CourseStates(upper...)
 	}
-	if v := strings.TrimSpace(c.TeacherID); v != "" {
-		call.TeacherId(v)
+	if teacherID != "" {
+		call.TeacherId(teacherID)
 	}
-	if v := strings.TrimSpace(c.StudentID); v != "" {
-		call.StudentId(v)
+	if studentID != "" {
+		call.StudentId(studentID)
 	}
 
-	resp, err := call.Do()
-	if err != nil {
-		return wrapClassroomError(err)
+	var courses []*classroom.Course
+	pageToken := c.Page
+	for {
+		resp, err := call.PageToken(pageToken).Do()
+		if err != nil {
+			return wrapClassroomError(err)
+		}
+		courses = append(courses, resp.Courses...)
+		pageToken = resp.NextPageToken
+		if !c.All || pageToken == "" {
+			break
+		}
 	}
 
 	if outfmt.IsJSON(ctx) {
 		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"courses":       resp.Courses,
-			"nextPageToken": resp.NextPageToken,
+			"courses":       courses,
+			"nextPageToken": pageToken,
 		})
 	}
 
-	if len(resp.Courses) == 0 {
+	if len(courses) == 0 {
 		u.Err().Println("No courses")
 		return nil
 	}
@@ -80,7 +107,7 @@ func (c *ClassroomCoursesListCmd) Run(ctx context.Context, flags *RootFlags) err
 	w, flush := tableWriter(ctx)
 	defer flush()
 	fmt.Fprintln(w, "ID\tNAME\tSECTION\tSTATE\tOWNER")
-	for _, course := range resp.Courses {
+	for _, course := range courses {
 		if course == nil {
 			continue
 		}
@@ -92,7 +119,7 @@ func (c *ClassroomCoursesListCmd) Run(ctx context.Context, flags *RootFlags) err
 			sanitizeTab(course.OwnerId),
 		)
 	}
-	printNextPageHint(u, resp.NextPageToken)
+	printNextPageHint(u, pageToken)
 	return nil
 }
 
@@ -177,17 +177,17 @@ func (c *ClassroomCourseworkGetCmd) Run(ctx context.Context, flags *RootFlags) e
 }
 
 type ClassroomCourseworkCreateCmd struct {
-	CourseID    string  `arg:"" name:"courseId" help:"Course ID or alias"`
-	Title       string  `name:"title" help:"Title" required:""`
-	Description string  `name:"description" help:"Description"`
-	WorkType    string  `name:"type" help:"Work type: ASSIGNMENT, SHORT_ANSWER_QUESTION, MULTIPLE_CHOICE_QUESTION" default:"ASSIGNMENT"`
-	State       string  `name:"state" help:"State: PUBLISHED, DRAFT"`
-	MaxPoints   float64 `name:"max-points" help:"Max points"`
-	Due         string  `name:"due" help:"Due date/time (RFC3339 or YYYY-MM-DD [HH:MM])"`
-	DueDate     string  `name:"due-date" help:"Due date (YYYY-MM-DD)"`
-	DueTime     string  `name:"due-time" help:"Due time (HH:MM or HH:MM:SS)"`
-	Scheduled   string  `name:"scheduled" help:"Scheduled publish time (RFC3339)"`
-	TopicID     string  `name:"topic" help:"Topic ID"`
+	CourseIDs   []string `arg:"" name:"courseId" help:"Course IDs or aliases (several create the same coursework in each course)"`
+	Title       string   `name:"title" help:"Title" required:""`
+	Description string   `name:"description" help:"Description"`
+	WorkType    string   `name:"type" help:"Work type: ASSIGNMENT, SHORT_ANSWER_QUESTION, MULTIPLE_CHOICE_QUESTION" default:"ASSIGNMENT"`
+	State       string   `name:"state" help:"State: PUBLISHED, DRAFT"`
+	MaxPoints   float64  `name:"max-points" help:"Max points"`
+	Due         string   `name:"due" help:"Due date/time (RFC3339 or YYYY-MM-DD [HH:MM])"`
+	DueDate     string   `name:"due-date" help:"Due date (YYYY-MM-DD)"`
+	DueTime     string   `name:"due-time" help:"Due time (HH:MM or HH:MM:SS)"`
+	Scheduled   string   `name:"scheduled" help:"Scheduled publish time (RFC3339)"`
+	TopicID     string   `name:"topic" help:"Topic ID"`
 }
 
 func (c *ClassroomCourseworkCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -196,8 +196,8 @@ func (c *ClassroomCourseworkCreateCmd) Run(ctx context.Context, flags *RootFlags
 	if err != nil {
 		return err
 	}
-	courseID := strings.TrimSpace(c.CourseID)
-	if courseID == "" {
+	courseIDs := parseCommaArgs(c.CourseIDs)
+	if len(courseIDs) == 0 {
 		return usage("empty courseId")
 	}
 	if strings.TrimSpace(c.Title) == "" {
@@ -258,17 +258,37 @@ func (c *ClassroomCourseworkCreateCmd) Run(ctx context.Context, flags *RootFlags
 		work.DueTime = dueTime
 	}
 
-	created, err := svc.Courses.CourseWork.Create(courseID, work).Context(ctx).Do()
-	if err != nil {
-		return wrapClassroomError(err)
+	created := make([]*classroom.CourseWork, 0, len(courseIDs))
+	for _, courseID := range courseIDs {
+		cw, createErr := svc.Courses.CourseWork.Create(courseID, work).Context(ctx).Do()
+		if createErr != nil {
+			if len(created) > 0 {
+				return fmt.Errorf("course %s (created in %d earlier course(s)): %w", courseID, len(created), wrapClassroomError(createErr))
+			}
+			return wrapClassroomError(createErr)
+		}
+		created = append(created, cw)
+	}
+
+	if len(created) == 1 {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, map[string]any{"coursework": created[0]})
+		}
+		u.Out().Printf("id\t%s", created[0].Id)
+		u.Out().Printf("title\t%s", created[0].Title)
+		u.Out().Printf("state\t%s", created[0].State)
+		return nil
 	}
 
 	if outfmt.IsJSON(ctx) {
 		return outfmt.WriteJSON(os.Stdout, map[string]any{"coursework": created})
 	}
-	u.Out().Printf("id\t%s", created.Id)
-	u.Out().Printf("title\t%s", created.Title)
-	u.Out().Printf("state\t%s", created.State)
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "COURSE_ID\tID\tTITLE\tSTATE")
+	for _, cw := range created {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", sanitizeTab(cw.CourseId), sanitizeTab(cw.Id), sanitizeTab(cw.Title), sanitizeTab(cw.State))
+	}
 	return nil
 }
 
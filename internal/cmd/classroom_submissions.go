@@ -23,12 +23,13 @@ type ClassroomSubmissionsCmd struct {
 
 type ClassroomSubmissionsListCmd struct {
 	CourseID     string `arg:"" name:"courseId" help:"Course ID or alias"`
-	CourseworkID string `arg:"" name:"courseworkId" help:"Coursework ID"`
+	CourseworkID string `arg:"" name:"courseworkId" optional:"" help:"Coursework ID (default: - for all coursework in the course)"`
 	States       string `name:"state" help:"Submission states filter (comma-separated: NEW,CREATED,TURNED_IN,RETURNED,RECLAIMED_BY_STUDENT)"`
 	Late         string `name:"late" help:"Late filter: late|not-late"`
 	UserID       string `name:"user" help:"Filter by user ID or email"`
 	Max          int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
 	Page         string `name:"page" help:"Page token"`
+	All          bool   `name:"all" help:"Fetch all pages"`
 }
 
 func (c *ClassroomSubmissionsListCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -42,8 +43,9 @@ func (c *ClassroomSubmissionsListCmd) Run(ctx context.Context, flags *RootFlags)
 	if courseID == "" {
 		return usage("empty courseId")
 	}
+	// "-" asks the API for submissions across all coursework in the course.
 	if courseworkID == "" {
-		return usage("empty courseworkId")
+		courseworkID = "-"
 	}
 
 	svc, err := newClassroomService(ctx, account)
@@ -51,7 +53,7 @@ func (c *ClassroomSubmissionsListCmd) Run(ctx context.Context, flags *RootFlags)
 		return wrapClassroomError(err)
 	}
 
-	call := svc.Courses.CourseWork.StudentSubmissions.List(courseID, courseworkID).PageSize(c.Max).PageToken(c.Page).Context(ctx)
+	call := svc.Courses.CourseWork.StudentSubmissions.List(courseID, courseworkID).PageSize(c.Max).Context(ctx)
 	if states := splitCSV(c.States); len(states) > 0 {
 		upper := make([]string, 0, len(states))
 		for _, state := range states {
@@ -73,32 +75,42 @@ func (c *ClassroomSubmissionsListCmd) Run(ctx context.Context, flags *RootFlags)
 		}
 	}
 
-	resp, err := call.Do()
-	if err != nil {
-		return wrapClassroomError(err)
+	var submissions []*classroom.StudentSubmission
+	pageToken := c.Page
+	for {
+		resp, err := call.PageToken(pageToken).Do()
+		if err != nil {
+			return wrapClassroomError(err)
+		}
+		submissions = append(submissions, resp.StudentSubmissions...)
+		pageToken = resp.NextPageToken
+		if !c.All || pageToken == "" {
+			break
+		}
 	}
 
 	if outfmt.IsJSON(ctx) {
 		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"submissions":   resp.StudentSubmissions,
-			"nextPageToken": resp.NextPageToken,
+			"submissions":   submissions,
+			"nextPageToken": pageToken,
 		})
 	}
 
-	if len(resp.StudentSubmissions) == 0 {
+	if len(submissions) == 0 {
 		u.Err().Println("No submissions")
 		return nil
 	}
 
 	w, flush := tableWriter(ctx)
 	defer flush()
-	fmt.Fprintln(w, "ID\tUSER_ID\tSTATE\tLATE\tDRAFT\tASSIGNED\tUPDATED")
-	for _, sub := range resp.StudentSubmissions {
+	fmt.Fprintln(w, "ID\tCOURSEWORK_ID\tUSER_ID\tSTATE\tLATE\tDRAFT\tASSIGNED\tUPDATED")
+	for _, sub := range submissions {
 		if sub == nil {
 			continue
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\t%s\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\t%s\t%s\n",
 			sanitizeTab(sub.Id),
+			sanitizeTab(sub.CourseWorkId),
 			sanitizeTab(sub.UserId),
 			sanitizeTab(sub.State),
 			sub.Late,
@@ -107,7 +119,7 @@ func (c *ClassroomSubmissionsListCmd) Run(ctx context.Context, flags *RootFlags)
 			sanitizeTab(sub.UpdateTime),
 		)
 	}
-	printNextPageHint(u, resp.NextPageToken)
+	printNextPageHint(u, pageToken)
 	return nil
 }
 
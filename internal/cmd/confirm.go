@@ -37,3 +37,27 @@ func confirmDestructive(ctx context.Context, flags *RootFlags, action string) er
 	}
 	return &ExitError{Code: 1, Err: errors.New("cancelled")}
 }
+
+// confirmTyped asks the user to type expected back before a large destructive
+// action. --force skips the prompt; non-interactive runs without it are refused.
+func confirmTyped(ctx context.Context, flags *RootFlags, action, expected string) error {
+	if flags.Force {
+		return nil
+	}
+	if flags.NoInput || !term.IsTerminal(int(os.Stdin.Fd())) {
+		return usagef("refusing to %s without --force (non-interactive)", action)
+	}
+
+	prompt := fmt.Sprintf("This will %s. Type %q to confirm: ", action, expected)
+	line, readErr := input.PromptLine(ctx, prompt)
+	if readErr != nil && !errors.Is(readErr, os.ErrClosed) {
+		if errors.Is(readErr, io.EOF) {
+			return &ExitError{Code: 1, Err: errors.New("cancelled")}
+		}
+		return fmt.Errorf("read confirmation: %w", readErr)
+	}
+	if strings.TrimSpace(line) == expected {
+		return nil
+	}
+	return &ExitError{Code: 1, Err: errors.New("cancelled")}
+}
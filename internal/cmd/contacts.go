@@ -3,13 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"google.golang.org/api/people/v1"
-
-	"github.com/steipete/gogcli/internal/outfmt"
-	"github.com/steipete/gogcli/internal/ui"
 )
 
 type ContactsCmd struct {
@@ -20,21 +16,35 @@ type ContactsCmd struct {
 	Update    ContactsUpdateCmd    `cmd:"" name:"update" help:"Update a contact"`
 	Delete    ContactsDeleteCmd    `cmd:"" name:"delete" help:"Delete a contact"`
 	Directory ContactsDirectoryCmd `cmd:"" name:"directory" help:"Directory contacts"`
-	Other     ContactsOtherCmd     `cmd:"" name:"other" help:"Other contacts"`
+	Other     ContactsOtherCmd     `cmd:"" name:"other" aliases:"others" help:"Other contacts (addresses you have emailed)"`
+	Photo     ContactsPhotoCmd     `cmd:"" name:"photo" help:"Set or remove contact photos"`
+	Export    ContactsExportCmd    `cmd:"" name:"export" help:"Export contacts as vCard or CSV"`
+	Import    ContactsImportCmd    `cmd:"" name:"import" help:"Import contacts from vCard or CSV"`
+	ImportCSV ContactsImportCSVCmd `cmd:"" name:"import-csv" help:"Bulk create or update contacts from CSV in batches (resumable)"`
+	Sync      ContactsSyncCmd      `cmd:"" name:"sync" help:"Mirror contacts into a directory of vCards (incremental)"`
+	Diff      ContactsDiffCmd      `cmd:"" name:"diff" help:"Compare a local vCard mirror with Google Contacts"`
 }
 
 type ContactsSearchCmd struct {
-	Query []string `arg:"" name:"query" help:"Search query"`
-	Max   int64    `name:"max" aliases:"limit" help:"Max results" default:"50"`
+	Query  []string `arg:"" name:"query" help:"Search query"`
+	Max    int64    `name:"max" aliases:"limit" help:"Max results (the API returns at most 30)" default:"30"`
+	Fields string   `name:"fields" help:"Columns: names, emails, phones, organizations, birthdays, addresses, urls, notes" default:"names,emails,phones"`
+	CSV    bool     `name:"csv" help:"Write CSV instead of a table"`
 }
 
+// People API maximum for people.searchContacts.
+const maxSearchContactsPageSize = 30
+
 func (c *ContactsSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
 	if err != nil {
 		return err
 	}
 	query := strings.Join(c.Query, " ")
+	fields, err := parseContactFields(c.Fields)
+	if err != nil {
+		return err
+	}
 
 	svc, err := newPeopleContactsService(ctx, account)
 	if err != nil {
@@ -43,57 +53,20 @@ func (c *ContactsSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 	resp, err := svc.People.SearchContacts().
 		Query(query).
-		PageSize(c.Max).
-		ReadMask(contactsReadMask).
+		PageSize(min(c.Max, maxSearchContactsPageSize)).
+		ReadMask(contactFieldsMask(fields)).
+		Context(ctx).
 		Do()
 	if err != nil {
 		return err
 	}
-	if outfmt.IsJSON(ctx) {
-		type item struct {
-			Resource string `json:"resource"`
-			Name     string `json:"name,omitempty"`
-			Email    string `json:"email,omitempty"`
-			Phone    string `json:"phone,omitempty"`
-		}
-		items := make([]item, 0, len(resp.Results))
-		for _, r := range resp.Results {
-			p := r.Person
-			if p == nil {
-				continue
-			}
-			items = append(items, item{
-				Resource: p.ResourceName,
-				Name:     primaryName(p),
-				Email:    primaryEmail(p),
-				Phone:    primaryPhone(p),
-			})
-		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"contacts": items})
-	}
-	if len(resp.Results) == 0 {
-		u.Err().Println("No results")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "RESOURCE\tNAME\tEMAIL\tPHONE")
+	contacts := make([]*people.Person, 0, len(resp.Results))
 	for _, r := range resp.Results {
-		p := r.Person
-		if p == nil {
-			continue
+		if r.Person != nil {
+			contacts = append(contacts, r.Person)
 		}
-		fmt.Fprintf(
-			w,
-			"%s\t%s\t%s\t%s\n",
-			p.ResourceName,
-			sanitizeTab(primaryName(p)),
-			sanitizeTab(primaryEmail(p)),
-			sanitizeTab(primaryPhone(p)),
-		)
-	}
-	return nil
+	}
+	return writeContacts(ctx, "contacts", contacts, fields, c.CSV, "")
 }
 
 func primaryName(p *people.Person) string {
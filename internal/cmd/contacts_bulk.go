@@ -0,0 +1,417 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// maxContactsBatchSize is the People API limit for batchCreate/batchUpdateContacts.
+const maxContactsBatchSize = 200
+
+type ContactsImportCSVCmd struct {
+	File             string `arg:"" name:"file" help:"CSV file (- for stdin)"`
+	Mapping          string `name:"mapping" help:"Column mapping as field=Column,... (fields: name, given, family, email, phone, organization, title, birthday, address, url, note)"`
+	UpdateExistingBy string `name:"update-existing-by" help:"Update existing contacts matched by email or phone instead of creating new ones"`
+	BatchSize        int    `name:"batch-size" help:"Contacts per batch request (max 200)" default:"200"`
+	Progress         string `name:"progress" help:"Progress file (JSONL of row -> contact) used to resume (default: <file>.gog-progress.jsonl)"`
+	DryRun           bool   `name:"dry-run" help:"Show what would be created or updated without writing"`
+}
+
+type contactsProgressEntry struct {
+	Entry    int    `json:"entry"`
+	Key      string `json:"key"`
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+}
+
+func (c *ContactsImportCSVCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	by := strings.ToLower(strings.TrimSpace(c.UpdateExistingBy))
+	switch by {
+	case "", "none":
+		by = ""
+	case "email", "phone":
+	default:
+		return usagef("invalid --update-existing-by %q (want email or phone)", c.UpdateExistingBy)
+	}
+	if c.BatchSize <= 0 || c.BatchSize > maxContactsBatchSize {
+		return usagef("--batch-size must be between 1 and %d", maxContactsBatchSize)
+	}
+
+	path := strings.TrimSpace(c.File)
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		if path, err = config.ExpandPath(path); err != nil {
+			return err
+		}
+		f, openErr := os.Open(path) //nolint:gosec // user-provided path
+		if openErr != nil {
+			return openErr
+		}
+		defer f.Close()
+		in = f
+	}
+	entries, err := parseContactsCSV(in, c.Mapping)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return errors.New("no contacts found in input")
+	}
+
+	progressPath := strings.TrimSpace(c.Progress)
+	if progressPath != "" {
+		if progressPath, err = config.ExpandPath(progressPath); err != nil {
+			return err
+		}
+	} else if path != "-" {
+		progressPath = path + ".gog-progress.jsonl"
+	}
+	done, err := readContactsProgress(progressPath)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newPeopleContactsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	existing := map[string]*people.Person{}
+	if by != "" {
+		contacts, listErr := listAllConnections(ctx, svc, contactsReadMask)
+		if listErr != nil {
+			return listErr
+		}
+		for _, p := range contacts {
+			for _, key := range contactMatchKeys(p, by) {
+				existing[key] = p
+			}
+		}
+	}
+
+	results := make([]contactImportResult, len(entries))
+	keys := make([]string, len(entries))
+	var creates, updates []int
+	updated := map[string]int{}
+	for i, p := range entries {
+		r := &results[i]
+		*r = contactImportResult{Entry: i + 1, Name: primaryName(p), Email: primaryEmail(p)}
+		keys[i] = contactRowKey(p)
+		if prev, ok := done[r.Entry]; ok && prev.Key == keys[i] {
+			r.Action, r.Resource = "skipped", prev.Resource
+			continue
+		}
+		if keys[i] == "" {
+			r.Action, r.Error = "error", "no name, email or phone"
+			continue
+		}
+		var target *people.Person
+		for _, key := range contactMatchKeys(p, by) {
+			if target = existing[key]; target != nil {
+				break
+			}
+		}
+		switch {
+		case target == nil:
+			r.Action = "create"
+			creates = append(creates, i)
+		case updated[target.ResourceName] > 0:
+			r.Action, r.Resource = "duplicate", target.ResourceName
+		default:
+			r.Action, r.Resource = "update", target.ResourceName
+			updated[target.ResourceName] = r.Entry
+			entries[i] = contactForUpdate(p, target)
+			updates = append(updates, i)
+		}
+	}
+
+	if !c.DryRun && len(creates)+len(updates) > 0 {
+		var progress io.Writer = io.Discard
+		if progressPath != "" {
+			f, openErr := os.OpenFile(progressPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gosec // user-provided path
+			if openErr != nil {
+				return fmt.Errorf("open progress file: %w", openErr)
+			}
+			defer f.Close()
+			progress = f
+		}
+		record := func(batch []int) error {
+			var buf bytes.Buffer
+			for _, i := range batch {
+				r := results[i]
+				if r.Action != "created" && r.Action != "updated" {
+					continue
+				}
+				line, marshalErr := json.Marshal(contactsProgressEntry{Entry: r.Entry, Key: keys[i], Action: r.Action, Resource: r.Resource})
+				if marshalErr != nil {
+					return marshalErr
+				}
+				buf.Write(append(line, '\n'))
+			}
+			if _, writeErr := progress.Write(buf.Bytes()); writeErr != nil {
+				return fmt.Errorf("write progress file: %w", writeErr)
+			}
+			return nil
+		}
+
+		for start := 0; start < len(creates); start += c.BatchSize {
+			batch := creates[start:min(start+c.BatchSize, len(creates))]
+			batchCreateContacts(ctx, svc, entries, batch, results)
+			if err := record(batch); err != nil {
+				return err
+			}
+			u.Err().Printf("created %d/%d", start+len(batch), len(creates))
+		}
+		for _, group := range groupContactUpdates(entries, updates) {
+			for start := 0; start < len(group.rows); start += c.BatchSize {
+				batch := group.rows[start:min(start+c.BatchSize, len(group.rows))]
+				batchUpdateContacts(ctx, svc, entries, batch, group.mask, results)
+				if err := record(batch); err != nil {
+					return err
+				}
+			}
+		}
+		if len(updates) > 0 {
+			u.Err().Printf("updated %d", len(updates))
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{"dryRun": c.DryRun, "progress": progressPath, "results": results}); err != nil {
+			return err
+		}
+	} else {
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "ENTRY\tACTION\tNAME\tEMAIL\tRESOURCE\tERROR")
+		for _, r := range results {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", r.Entry, r.Action, sanitizeTab(r.Name), sanitizeTab(r.Email), r.Resource, sanitizeTab(r.Error))
+		}
+		flush()
+	}
+
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Action]++
+	}
+	if c.DryRun {
+		u.Err().Printf("%d to create, %d to update, %d already done, %d duplicate(s), %d invalid", counts["create"], counts["update"], counts["skipped"], counts["duplicate"], counts["error"])
+		return nil
+	}
+	u.Err().Printf("%d created, %d updated, %d already done, %d duplicate(s), %d failed", counts["created"], counts["updated"], counts["skipped"], counts["duplicate"], counts["error"])
+	if counts["error"] > 0 {
+		return fmt.Errorf("%d contact(s) failed", counts["error"])
+	}
+	return nil
+}
+
+func batchCreateContacts(ctx context.Context, svc *people.Service, entries []*people.Person, batch []int, results []contactImportResult) {
+	req := &people.BatchCreateContactsRequest{ReadMask: "names"}
+	for _, i := range batch {
+		req.Contacts = append(req.Contacts, &people.ContactToCreate{ContactPerson: contactForCreate(entries[i])})
+	}
+	resp, err := svc.People.BatchCreateContacts(req).Context(ctx).Do()
+	for j, i := range batch {
+		r := &results[i]
+		switch {
+		case err != nil:
+			r.Action, r.Error = "error", err.Error()
+		case j >= len(resp.CreatedPeople) || resp.CreatedPeople[j] == nil:
+			r.Action, r.Error = "error", "missing from batch response"
+		default:
+			created := resp.CreatedPeople[j]
+			if created.Status != nil && created.Status.Code != 0 {
+				r.Action, r.Error = "error", created.Status.Message
+				break
+			}
+			r.Action = "created"
+			if created.Person != nil {
+				r.Resource = created.Person.ResourceName
+			}
+		}
+	}
+}
+
+func batchUpdateContacts(ctx context.Context, svc *people.Service, entries []*people.Person, batch []int, mask string, results []contactImportResult) {
+	req := &people.BatchUpdateContactsRequest{
+		Contacts:   map[string]people.Person{},
+		UpdateMask: mask,
+		ReadMask:   "names",
+	}
+	for _, i := range batch {
+		req.Contacts[entries[i].ResourceName] = *entries[i]
+	}
+	resp, err := svc.People.BatchUpdateContacts(req).Context(ctx).Do()
+	for _, i := range batch {
+		r := &results[i]
+		if err != nil {
+			r.Action, r.Error = "error", err.Error()
+			continue
+		}
+		res, ok := resp.UpdateResult[entries[i].ResourceName]
+		if !ok {
+			r.Action, r.Error = "error", "missing from batch response"
+			continue
+		}
+		if res.Status != nil && res.Status.Code != 0 {
+			r.Action, r.Error = "error", res.Status.Message
+			continue
+		}
+		r.Action = "updated"
+	}
+}
+
+type contactUpdateGroup struct {
+	mask string
+	rows []int
+}
+
+// groupContactUpdates batches updates by the fields each row sets, since
+// batchUpdateContacts applies one updateMask to the whole batch and empty CSV
+// cells must not clear existing data.
+func groupContactUpdates(entries []*people.Person, rows []int) []contactUpdateGroup {
+	byMask := map[string][]int{}
+	for _, i := range rows {
+		mask := contactUpdateMask(entries[i])
+		byMask[mask] = append(byMask[mask], i)
+	}
+	groups := make([]contactUpdateGroup, 0, len(byMask))
+	for mask, rows := range byMask {
+		groups = append(groups, contactUpdateGroup{mask: mask, rows: rows})
+	}
+	sort.Slice(groups, func(a, b int) bool { return groups[a].rows[0] < groups[b].rows[0] })
+	return groups
+}
+
+func contactUpdateMask(p *people.Person) string {
+	var fields []string
+	add := func(set bool, field string) {
+		if set {
+			fields = append(fields, field)
+		}
+	}
+	add(len(p.Names) > 0, "names")
+	add(len(p.EmailAddresses) > 0, "emailAddresses")
+	add(len(p.PhoneNumbers) > 0, "phoneNumbers")
+	add(len(p.Organizations) > 0, "organizations")
+	add(len(p.Birthdays) > 0, "birthdays")
+	add(len(p.Addresses) > 0, "addresses")
+	add(len(p.Urls) > 0, "urls")
+	add(len(p.Biographies) > 0, "biographies")
+	return strings.Join(fields, ",")
+}
+
+// contactForUpdate targets an existing contact, keeping its other emails and
+// phone numbers so a CSV row only adds to those lists.
+func contactForUpdate(p, existing *people.Person) *people.Person {
+	out := contactForCreate(p)
+	out.ResourceName = existing.ResourceName
+	out.Etag = existing.Etag
+	if len(out.EmailAddresses) > 0 {
+		merged := make([]*people.EmailAddress, 0, len(existing.EmailAddresses)+len(out.EmailAddresses))
+		seen := map[string]bool{}
+		for _, list := range [][]*people.EmailAddress{existing.EmailAddresses, out.EmailAddresses} {
+			for _, e := range list {
+				if e == nil || seen[strings.ToLower(e.Value)] {
+					continue
+				}
+				seen[strings.ToLower(e.Value)] = true
+				merged = append(merged, &people.EmailAddress{Value: e.Value, Type: e.Type})
+			}
+		}
+		out.EmailAddresses = merged
+	}
+	if len(out.PhoneNumbers) > 0 {
+		merged := make([]*people.PhoneNumber, 0, len(existing.PhoneNumbers)+len(out.PhoneNumbers))
+		seen := map[string]bool{}
+		for _, list := range [][]*people.PhoneNumber{existing.PhoneNumbers, out.PhoneNumbers} {
+			for _, t := range list {
+				if t == nil || seen[phoneDigits(t.Value)] {
+					continue
+				}
+				seen[phoneDigits(t.Value)] = true
+				merged = append(merged, &people.PhoneNumber{Value: t.Value, Type: t.Type})
+			}
+		}
+		out.PhoneNumbers = merged
+	}
+	return out
+}
+
+// contactMatchKeys returns the --update-existing-by keys for p.
+func contactMatchKeys(p *people.Person, by string) []string {
+	var keys []string
+	switch by {
+	case "email":
+		for _, e := range p.EmailAddresses {
+			if e != nil && strings.TrimSpace(e.Value) != "" {
+				keys = append(keys, "email:"+strings.ToLower(strings.TrimSpace(e.Value)))
+			}
+		}
+	case "phone":
+		for _, t := range p.PhoneNumbers {
+			if t != nil {
+				if d := phoneDigits(t.Value); d != "" {
+					keys = append(keys, "phone:"+d)
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// contactRowKey identifies a CSV row in the progress file so an edited file
+// does not skip rows that moved.
+func contactRowKey(p *people.Person) string {
+	if e := primaryEmail(p); e != "" {
+		return strings.ToLower(e)
+	}
+	if t := phoneDigits(primaryPhone(p)); t != "" {
+		return t
+	}
+	return strings.ToLower(primaryName(p))
+}
+
+func readContactsProgress(path string) (map[int]contactsProgressEntry, error) {
+	done := map[int]contactsProgressEntry{}
+	if path == "" {
+		return done, nil
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // user-provided path
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return done, nil
+		}
+		return nil, fmt.Errorf("read progress file: %w", err)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry contactsProgressEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			// A partially written last line from an interrupted run is retried.
+			continue
+		}
+		if entry.Entry > 0 && entry.Resource != "" {
+			done[entry.Entry] = entry
+		}
+	}
+	return done, nil
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/people/v1"
+)
+
+func TestExecute_ContactsImportCSV_UpdateExistingAndResume(t *testing.T) {
+	var creates, updates int
+	var updateMask string
+	var updated map[string]people.Person
+	svc, closeSrv := newPeopleService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/people/me/connections") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"connections": []map[string]any{{
+					"resourceName":   "people/c1",
+					"etag":           "e1",
+					"names":          []map[string]any{{"displayName": "Ada Lovelace"}},
+					"emailAddresses": []map[string]any{{"value": "ada@example.com"}, {"value": "ada@work.example"}},
+				}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/people:batchCreateContacts") && r.Method == http.MethodPost:
+			creates++
+			var body people.BatchCreateContactsRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			out := make([]map[string]any, 0, len(body.Contacts))
+			for i := range body.Contacts {
+				out = append(out, map[string]any{"person": map[string]any{"resourceName": "people/new" + string(rune('1'+i))}})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"createdPeople": out})
+		case strings.HasSuffix(r.URL.Path, "/people:batchUpdateContacts") && r.Method == http.MethodPost:
+			updates++
+			var body people.BatchUpdateContactsRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			updateMask, updated = body.UpdateMask, body.Contacts
+			result := map[string]any{}
+			for name := range body.Contacts {
+				result[name] = map[string]any{"person": map[string]any{"resourceName": name}}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"updateResult": result})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(closeSrv)
+	stubPeopleServices(t, svc)
+
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "people.csv")
+	csvData := "Full Name,Mail,Company\nAda Lovelace,ADA@example.com,Analytical\nGrace Hopper,grace@example.com,\nAlan Turing,alan@example.com,Bletchley\n"
+	if err := os.WriteFile(csvPath, []byte(csvData), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	args := []string{"--json", "--account", "a@b.com", "contacts", "import-csv", csvPath,
+		"--mapping", "name=Full Name,email=Mail,organization=Company", "--update-existing-by", "email", "--batch-size", "1"}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute(args); err != nil {
+				t.Fatalf("import-csv: %v", err)
+			}
+		})
+	})
+	var parsed struct {
+		Results []contactImportResult `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\nout=%q", err, out)
+	}
+	if len(parsed.Results) != 3 || parsed.Results[0].Action != "updated" || parsed.Results[0].Resource != "people/c1" ||
+		parsed.Results[1].Action != "created" || parsed.Results[2].Action != "created" {
+		t.Fatalf("unexpected results: %#v", parsed.Results)
+	}
+	if creates != 2 || updates != 1 {
+		t.Fatalf("expected 2 create batches and 1 update batch, got %d/%d", creates, updates)
+	}
+	if updateMask != "names,emailAddresses,organizations" {
+		t.Fatalf("unexpected updateMask %q", updateMask)
+	}
+	ada := updated["people/c1"]
+	if ada.Etag != "e1" || len(ada.EmailAddresses) != 2 {
+		t.Fatalf("expected etag and merged emails, got %#v", ada)
+	}
+
+	// A second run resumes from the progress file and writes nothing.
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute(args); err != nil {
+				t.Fatalf("import-csv resume: %v", err)
+			}
+		})
+	})
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\nout=%q", err, out)
+	}
+	for _, r := range parsed.Results {
+		if r.Action != "skipped" || r.Resource == "" {
+			t.Fatalf("expected skipped rows on resume, got %#v", parsed.Results)
+		}
+	}
+	if creates != 2 || updates != 1 {
+		t.Fatalf("resume issued writes: %d/%d", creates, updates)
+	}
+	if _, err := os.Stat(csvPath + ".gog-progress.jsonl"); err != nil {
+		t.Fatalf("progress file: %v", err)
+	}
+}
+
+func TestContactsImportCSV_InvalidFlags(t *testing.T) {
+	flags := &RootFlags{Account: "a@b.com"}
+	if err := runKong(t, &ContactsImportCSVCmd{}, []string{"x.csv", "--update-existing-by", "name"}, context.Background(), flags); err == nil || !strings.Contains(err.Error(), "--update-existing-by") {
+		t.Fatalf("expected --update-existing-by error, got %v", err)
+	}
+	if err := runKong(t, &ContactsImportCSVCmd{}, []string{"x.csv", "--batch-size", "500"}, context.Background(), flags); err == nil || !strings.Contains(err.Error(), "--batch-size") {
+		t.Fatalf("expected --batch-size error, got %v", err)
+	}
+}
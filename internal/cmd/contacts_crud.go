@@ -19,76 +19,59 @@ const (
 )
 
 type ContactsListCmd struct {
-	Max  int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
-	Page string `name:"page" help:"Page token"`
+	Max    int64  `name:"max" aliases:"limit" help:"Max results (fetched in pages as needed)" default:"100"`
+	All    bool   `name:"all" help:"Fetch every contact (ignores --max)"`
+	Page   string `name:"page" help:"Page token"`
+	Fields string `name:"fields" help:"Columns: names, emails, phones, organizations, birthdays, addresses, urls, notes" default:"names,emails,phones"`
+	CSV    bool   `name:"csv" help:"Write CSV instead of a table"`
 }
 
+// People API maximum for connections.list.
+const maxConnectionsPageSize = 1000
+
 func (c *ContactsListCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
 	if err != nil {
 		return err
 	}
-
-	svc, err := newPeopleContactsService(ctx, account)
+	fields, err := parseContactFields(c.Fields)
 	if err != nil {
 		return err
 	}
 
-	resp, err := svc.People.Connections.List(peopleMeResource).
-		PersonFields(contactsReadMask).
-		PageSize(c.Max).
-		PageToken(c.Page).
-		Do()
+	svc, err := newPeopleContactsService(ctx, account)
 	if err != nil {
 		return err
 	}
-	if outfmt.IsJSON(ctx) {
-		type item struct {
-			Resource string `json:"resource"`
-			Name     string `json:"name,omitempty"`
-			Email    string `json:"email,omitempty"`
-			Phone    string `json:"phone,omitempty"`
+
+	var contacts []*people.Person
+	page := c.Page
+	for {
+		pageSize := int64(maxConnectionsPageSize)
+		if !c.All && c.Max > 0 {
+			pageSize = min(c.Max-int64(len(contacts)), maxConnectionsPageSize)
+		}
+		resp, err := svc.People.Connections.List(peopleMeResource).
+			PersonFields(contactFieldsMask(fields)).
+			PageSize(pageSize).
+			PageToken(page).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return err
 		}
-		items := make([]item, 0, len(resp.Connections))
 		for _, p := range resp.Connections {
-			if p == nil {
-				continue
+			if p != nil {
+				contacts = append(contacts, p)
 			}
-			items = append(items, item{
-				Resource: p.ResourceName,
-				Name:     primaryName(p),
-				Email:    primaryEmail(p),
-				Phone:    primaryPhone(p),
-			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"contacts":      items,
-			"nextPageToken": resp.NextPageToken,
-		})
-	}
-	if len(resp.Connections) == 0 {
-		u.Err().Println("No contacts")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "RESOURCE\tNAME\tEMAIL\tPHONE")
-	for _, p := range resp.Connections {
-		if p == nil {
-			continue
+		page = resp.NextPageToken
+		if page == "" || (!c.All && c.Max > 0 && int64(len(contacts)) >= c.Max) {
+			break
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
-			p.ResourceName,
-			sanitizeTab(primaryName(p)),
-			sanitizeTab(primaryEmail(p)),
-			sanitizeTab(primaryPhone(p)),
-		)
 	}
 
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
+	return writeContacts(ctx, "contacts", contacts, fields, c.CSV, page)
 }
 
 type ContactsGetCmd struct {
@@ -305,3 +305,51 @@ func TestContactsOtherDelete_DeleteFailure(t *testing.T) {
 		t.Fatalf("expected error to contain 'delete copied contact', got: %v", err)
 	}
 }
+
+func TestContactsOtherPromote_JSON(t *testing.T) {
+	var copyMask string
+	svc, closeSrv := newPeopleService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "otherContacts/abc123:copyOtherContactToMyContactsGroup") || r.Method != http.MethodPost {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		copyMask, _ = body["copyMask"].(string)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"resourceName":   "people/c42",
+			"emailAddresses": []map[string]any{{"value": "pal@example.com"}},
+		})
+	}))
+	t.Cleanup(closeSrv)
+	stubPeopleServices(t, svc)
+
+	flags := &RootFlags{Account: "a@b.com"}
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &ContactsOtherPromoteCmd{}, []string{"otherContacts/abc123"}, ctx, flags); err != nil {
+			t.Fatalf("promote: %v", err)
+		}
+	})
+	var result struct {
+		From    string `json:"from"`
+		Contact struct {
+			ResourceName string `json:"resourceName"`
+		} `json:"contact"`
+	}
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("json unmarshal: %v (output: %q)", err, out)
+	}
+	if result.From != "otherContacts/abc123" || result.Contact.ResourceName != "people/c42" {
+		t.Fatalf("unexpected result: %#v", result)
+	}
+	if copyMask != contactsReadMask {
+		t.Fatalf("unexpected copyMask %q", copyMask)
+	}
+
+	if err := runKong(t, &ContactsOtherPromoteCmd{}, []string{"people/c42"}, ctx, flags); err == nil || !strings.Contains(err.Error(), "resourceName must start with otherContacts/") {
+		t.Fatalf("expected otherContacts/ error, got %v", err)
+	}
+}
@@ -14,8 +14,10 @@ import (
 )
 
 const (
-	directoryReadMask       = "names,emailAddresses"
 	directoryRequestTimeout = 20 * time.Second
+	directoryReadMask       = "names,emailAddresses"
+	directorySourceProfile  = "DIRECTORY_SOURCE_TYPE_DOMAIN_PROFILE"
+	directorySourceContact  = "DIRECTORY_SOURCE_TYPE_DOMAIN_CONTACT"
 )
 
 type ContactsDirectoryCmd struct {
@@ -23,17 +25,61 @@ type ContactsDirectoryCmd struct {
 	Search ContactsDirectorySearchCmd `cmd:"" name:"search" help:"Search people in the Workspace directory"`
 }
 
+// ContactsDirectoryOptions are shared by directory list and search.
+type ContactsDirectoryOptions struct {
+	Max             int64  `name:"max" aliases:"limit" help:"Max results (fetched in pages as needed)" default:"50"`
+	All             bool   `name:"all" help:"Fetch every result (ignores --max)"`
+	Page            string `name:"page" help:"Page token"`
+	Fields          string `name:"fields" help:"Columns: names, emails, phones, organizations, addresses, urls" default:"names,emails"`
+	IncludeContacts bool   `name:"include-contacts" help:"Also include shared domain contacts (not just user profiles)"`
+	CSV             bool   `name:"csv" help:"Write CSV instead of a table"`
+}
+
+func (o ContactsDirectoryOptions) sources() []string {
+	if o.IncludeContacts {
+		return []string{directorySourceProfile, directorySourceContact}
+	}
+	return []string{directorySourceProfile}
+}
+
+// collect pages through fetch until --max (or everything with --all) is reached.
+func (o ContactsDirectoryOptions) collect(maxPageSize int64, fetch func(pageSize int64, page string) ([]*people.Person, string, error)) ([]*people.Person, string, error) {
+	var out []*people.Person
+	page := o.Page
+	for {
+		pageSize := maxPageSize
+		if !o.All && o.Max > 0 {
+			pageSize = min(o.Max-int64(len(out)), maxPageSize)
+		}
+		items, next, err := fetch(pageSize, page)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, p := range items {
+			if p != nil {
+				out = append(out, p)
+			}
+		}
+		page = next
+		if page == "" || (!o.All && o.Max > 0 && int64(len(out)) >= o.Max) {
+			return out, page, nil
+		}
+	}
+}
+
 type ContactsDirectoryListCmd struct {
-	Max  int64  `name:"max" aliases:"limit" help:"Max results" default:"50"`
-	Page string `name:"page" help:"Page token"`
+	Options ContactsDirectoryOptions `embed:""`
 }
 
 func (c *ContactsDirectoryListCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
 	if err != nil {
 		return err
 	}
+	fields, err := parseContactFields(c.Options.Fields)
+	if err != nil {
+		return err
+	}
 
 	svc, err := newPeopleDirectoryService(ctx, account)
 	if err != nil {
@@ -43,74 +89,40 @@ func (c *ContactsDirectoryListCmd) Run(ctx context.Context, flags *RootFlags) er
 	ctxTimeout, cancel := context.WithTimeout(ctx, directoryRequestTimeout)
 	defer cancel()
 
-	resp, err := svc.People.ListDirectoryPeople().
-		Sources("DIRECTORY_SOURCE_TYPE_DOMAIN_PROFILE").
-		ReadMask(directoryReadMask).
-		PageSize(c.Max).
-		PageToken(c.Page).
-		Context(ctxTimeout).
-		Do()
+	found, next, err := c.Options.collect(1000, func(pageSize int64, page string) ([]*people.Person, string, error) {
+		resp, err := svc.People.ListDirectoryPeople().
+			Sources(c.Options.sources()...).
+			ReadMask(contactFieldsMask(fields)).
+			PageSize(pageSize).
+			PageToken(page).
+			Context(ctxTimeout).
+			Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.People, resp.NextPageToken, nil
+	})
 	if err != nil {
 		return err
 	}
-	if outfmt.IsJSON(ctx) {
-		type item struct {
-			Resource string `json:"resource"`
-			Name     string `json:"name,omitempty"`
-			Email    string `json:"email,omitempty"`
-		}
-		items := make([]item, 0, len(resp.People))
-		for _, p := range resp.People {
-			if p == nil {
-				continue
-			}
-			items = append(items, item{
-				Resource: p.ResourceName,
-				Name:     primaryName(p),
-				Email:    primaryEmail(p),
-			})
-		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"people":        items,
-			"nextPageToken": resp.NextPageToken,
-		})
-	}
-
-	if len(resp.People) == 0 {
-		u.Err().Println("No results")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "RESOURCE\tNAME\tEMAIL")
-	for _, p := range resp.People {
-		if p == nil {
-			continue
-		}
-		fmt.Fprintf(w, "%s\t%s\t%s\n",
-			p.ResourceName,
-			sanitizeTab(primaryName(p)),
-			sanitizeTab(primaryEmail(p)),
-		)
-	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
+	return writeContacts(ctx, "people", found, fields, c.Options.CSV, next)
 }
 
 type ContactsDirectorySearchCmd struct {
-	Query []string `arg:"" name:"query" help:"Search query"`
-	Max   int64    `name:"max" aliases:"limit" help:"Max results" default:"50"`
-	Page  string   `name:"page" help:"Page token"`
+	Query   []string                 `arg:"" name:"query" help:"Search query"`
+	Options ContactsDirectoryOptions `embed:""`
 }
 
 func (c *ContactsDirectorySearchCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
 	if err != nil {
 		return err
 	}
 	query := strings.Join(c.Query, " ")
+	fields, err := parseContactFields(c.Options.Fields)
+	if err != nil {
+		return err
+	}
 
 	svc, err := newPeopleDirectoryService(ctx, account)
 	if err != nil {
@@ -120,66 +132,31 @@ func (c *ContactsDirectorySearchCmd) Run(ctx context.Context, flags *RootFlags)
 	ctxTimeout, cancel := context.WithTimeout(ctx, directoryRequestTimeout)
 	defer cancel()
 
-	resp, err := svc.People.SearchDirectoryPeople().
-		Query(query).
-		Sources("DIRECTORY_SOURCE_TYPE_DOMAIN_PROFILE").
-		ReadMask(directoryReadMask).
-		PageSize(c.Max).
-		PageToken(c.Page).
-		Context(ctxTimeout).
-		Do()
+	found, next, err := c.Options.collect(500, func(pageSize int64, page string) ([]*people.Person, string, error) {
+		resp, err := svc.People.SearchDirectoryPeople().
+			Query(query).
+			Sources(c.Options.sources()...).
+			ReadMask(contactFieldsMask(fields)).
+			PageSize(pageSize).
+			PageToken(page).
+			Context(ctxTimeout).
+			Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.People, resp.NextPageToken, nil
+	})
 	if err != nil {
 		return err
 	}
-	if outfmt.IsJSON(ctx) {
-		type item struct {
-			Resource string `json:"resource"`
-			Name     string `json:"name,omitempty"`
-			Email    string `json:"email,omitempty"`
-		}
-		items := make([]item, 0, len(resp.People))
-		for _, p := range resp.People {
-			if p == nil {
-				continue
-			}
-			items = append(items, item{
-				Resource: p.ResourceName,
-				Name:     primaryName(p),
-				Email:    primaryEmail(p),
-			})
-		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"people":        items,
-			"nextPageToken": resp.NextPageToken,
-		})
-	}
-
-	if len(resp.People) == 0 {
-		u.Err().Println("No results")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "RESOURCE\tNAME\tEMAIL")
-	for _, p := range resp.People {
-		if p == nil {
-			continue
-		}
-		fmt.Fprintf(w, "%s\t%s\t%s\n",
-			p.ResourceName,
-			sanitizeTab(primaryName(p)),
-			sanitizeTab(primaryEmail(p)),
-		)
-	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
+	return writeContacts(ctx, "people", found, fields, c.Options.CSV, next)
 }
 
 type ContactsOtherCmd struct {
-	List   ContactsOtherListCmd   `cmd:"" name:"list" help:"List other contacts"`
-	Search ContactsOtherSearchCmd `cmd:"" name:"search" help:"Search other contacts"`
-	Delete ContactsOtherDeleteCmd `cmd:"" name:"delete" help:"Delete an other contact"`
+	List    ContactsOtherListCmd    `cmd:"" name:"list" help:"List other contacts"`
+	Search  ContactsOtherSearchCmd  `cmd:"" name:"search" help:"Search other contacts"`
+	Promote ContactsOtherPromoteCmd `cmd:"" name:"promote" help:"Copy an other contact into My Contacts"`
+	Delete  ContactsOtherDeleteCmd  `cmd:"" name:"delete" help:"Delete an other contact"`
 }
 
 type ContactsOtherListCmd struct {
@@ -327,6 +304,39 @@ func (c *ContactsOtherSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 	return nil
 }
 
+type ContactsOtherPromoteCmd struct {
+	ResourceName string `arg:"" name:"resourceName" help:"Resource name (otherContacts/...)"`
+}
+
+func (c *ContactsOtherPromoteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	resourceName := strings.TrimSpace(c.ResourceName)
+	if !strings.HasPrefix(resourceName, "otherContacts/") {
+		return usage("resourceName must start with otherContacts/")
+	}
+
+	created, err := copyOtherContact(ctx, account, resourceName)
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"from": resourceName, "contact": created})
+	}
+	u.Out().Printf("from\t%s", resourceName)
+	u.Out().Printf("resource\t%s", created.ResourceName)
+	if name := primaryName(created); name != "" {
+		u.Out().Printf("name\t%s", name)
+	}
+	if email := primaryEmail(created); email != "" {
+		u.Out().Printf("email\t%s", email)
+	}
+	return nil
+}
+
 type ContactsOtherDeleteCmd struct {
 	ResourceName string `arg:"" name:"resourceName" help:"Resource name (otherContacts/...)"`
 }
@@ -352,25 +362,32 @@ func (c *ContactsOtherDeleteCmd) Run(ctx context.Context, flags *RootFlags) erro
 	return writeDeleteResult(ctx, u, resourceName)
 }
 
-func deleteOtherContact(ctx context.Context, account, resourceName string) error {
+// copyOtherContact copies an other contact into the "myContacts" group and
+// returns the new contact.
+func copyOtherContact(ctx context.Context, account, resourceName string) (*people.Person, error) {
 	otherSvc, err := newPeopleOtherContactsService(ctx, account)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	copied, err := otherSvc.OtherContacts.CopyOtherContactToMyContactsGroup(
 		resourceName,
-		&people.CopyOtherContactToMyContactsGroupRequest{},
+		&people.CopyOtherContactToMyContactsGroupRequest{CopyMask: contactsReadMask},
 	).Do()
 	if err != nil {
-		return fmt.Errorf("copy to my contacts: %w", err)
+		return nil, fmt.Errorf("copy to my contacts: %w", err)
 	}
-	copiedResource := ""
-	if copied != nil {
-		copiedResource = strings.TrimSpace(copied.ResourceName)
+	if copied == nil || strings.TrimSpace(copied.ResourceName) == "" {
+		return nil, fmt.Errorf("copy to my contacts: empty resource name")
 	}
-	if copiedResource == "" {
-		return fmt.Errorf("copy to my contacts: empty resource name")
+	return copied, nil
+}
+
+func deleteOtherContact(ctx context.Context, account, resourceName string) error {
+	copied, err := copyOtherContact(ctx, account, resourceName)
+	if err != nil {
+		return err
 	}
+	copiedResource := strings.TrimSpace(copied.ResourceName)
 
 	contactsSvc, err := newPeopleContactsService(ctx, account)
 	if err != nil {
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const defaultContactFields = "names,emails,phones"
+
+// contactField is one selectable --fields column; mask is the People API personField.
+type contactField struct {
+	name   string
+	mask   string
+	header string
+	key    string
+	value  func(*people.Person) string
+}
+
+var contactFieldList = []contactField{
+	{"names", "names", "NAME", "name", primaryName},
+	{"emails", "emailAddresses", "EMAIL", "email", primaryEmail},
+	{"phones", "phoneNumbers", "PHONE", "phone", primaryPhone},
+	{"organizations", "organizations", "ORGANIZATION", "organization", primaryOrganization},
+	{"birthdays", "birthdays", "BIRTHDAY", "birthday", primaryBirthday},
+	{"addresses", "addresses", "ADDRESS", "address", primaryAddress},
+	{"urls", "urls", "URL", "url", primaryURL},
+	{"notes", "biographies", "NOTE", "note", primaryNote},
+}
+
+var contactFieldAliases = map[string]string{
+	"name":         "names",
+	"email":        "emails",
+	"phone":        "phones",
+	"org":          "organizations",
+	"orgs":         "organizations",
+	"organization": "organizations",
+	"birthday":     "birthdays",
+	"address":      "addresses",
+	"url":          "urls",
+	"note":         "notes",
+}
+
+func parseContactFields(value string) ([]contactField, error) {
+	if strings.TrimSpace(value) == "" {
+		value = defaultContactFields
+	}
+	var out []contactField
+	seen := map[string]bool{}
+	for _, name := range splitCSV(strings.ToLower(value)) {
+		if alias, ok := contactFieldAliases[name]; ok {
+			name = alias
+		}
+		found := false
+		for _, f := range contactFieldList {
+			if f.name == name || f.mask == name {
+				found = true
+				if !seen[f.name] {
+					seen[f.name] = true
+					out = append(out, f)
+				}
+				break
+			}
+		}
+		if !found {
+			return nil, usagef("unknown field %q (valid: names, emails, phones, organizations, birthdays, addresses, urls, notes)", name)
+		}
+	}
+	return out, nil
+}
+
+func contactFieldsMask(fields []contactField) string {
+	masks := make([]string, 0, len(fields))
+	for _, f := range fields {
+		masks = append(masks, f.mask)
+	}
+	return strings.Join(masks, ",")
+}
+
+// writeContacts prints contacts as JSON (under key), CSV or a table with the selected fields.
+func writeContacts(ctx context.Context, key string, contacts []*people.Person, fields []contactField, asCSV bool, nextPageToken string) error {
+	u := ui.FromContext(ctx)
+	if outfmt.IsJSON(ctx) {
+		items := make([]map[string]string, 0, len(contacts))
+		for _, p := range contacts {
+			item := map[string]string{"resource": p.ResourceName}
+			for _, f := range fields {
+				if v := f.value(p); v != "" {
+					item[f.key] = v
+				}
+			}
+			items = append(items, item)
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			key:             items,
+			"nextPageToken": nextPageToken,
+		})
+	}
+
+	if asCSV {
+		return writeContactsCSV(os.Stdout, contacts, fields)
+	}
+
+	if len(contacts) == 0 {
+		u.Err().Println("No results")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	headers := []string{"RESOURCE"}
+	for _, f := range fields {
+		headers = append(headers, f.header)
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, p := range contacts {
+		values := []string{p.ResourceName}
+		for _, f := range fields {
+			values = append(values, sanitizeTab(strings.ReplaceAll(f.value(p), "\n", " ")))
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	flush()
+	printNextPageHint(u, nextPageToken)
+	return nil
+}
+
+func primaryOrganization(p *people.Person) string {
+	if p == nil || len(p.Organizations) == 0 || p.Organizations[0] == nil {
+		return ""
+	}
+	o := p.Organizations[0]
+	if o.Title != "" && o.Name != "" {
+		return o.Title + ", " + o.Name
+	}
+	return o.Name + o.Title
+}
+
+func primaryAddress(p *people.Person) string {
+	if p == nil || len(p.Addresses) == 0 || p.Addresses[0] == nil {
+		return ""
+	}
+	a := p.Addresses[0]
+	if a.FormattedValue != "" {
+		return a.FormattedValue
+	}
+	parts := make([]string, 0, 4)
+	for _, s := range []string{a.StreetAddress, a.PostalCode + " " + a.City, a.Region, a.Country} {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func primaryURL(p *people.Person) string {
+	if p == nil || len(p.Urls) == 0 || p.Urls[0] == nil {
+		return ""
+	}
+	return p.Urls[0].Value
+}
+
+func primaryNote(p *people.Person) string {
+	if p == nil || len(p.Biographies) == 0 || p.Biographies[0] == nil {
+		return ""
+	}
+	return p.Biographies[0].Value
+}
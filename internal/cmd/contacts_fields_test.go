@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/people/v1"
+)
+
+func TestParseContactFields(t *testing.T) {
+	fields, err := parseContactFields("name,emails,org,notes,names")
+	if err != nil {
+		t.Fatalf("parseContactFields: %v", err)
+	}
+	if got := contactFieldsMask(fields); got != "names,emailAddresses,organizations,biographies" {
+		t.Fatalf("unexpected mask %q", got)
+	}
+	if _, err := parseContactFields("names,shoe-size"); err == nil {
+		t.Fatalf("expected unknown field error")
+	}
+}
+
+func TestExecute_ContactsList_PagesFieldsCSV(t *testing.T) {
+	origNew := newPeopleContactsService
+	t.Cleanup(func() { newPeopleContactsService = origNew })
+
+	var masks, sizes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/people/me/connections") {
+			http.NotFound(w, r)
+			return
+		}
+		masks = append(masks, r.URL.Query().Get("personFields"))
+		sizes = append(sizes, r.URL.Query().Get("pageSize"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("pageToken") == "" {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"connections": []map[string]any{{
+					"resourceName":  "people/c1",
+					"names":         []map[string]any{{"displayName": "Ada Lovelace"}},
+					"organizations": []map[string]any{{"name": "Analytical Engines", "title": "Programmer"}},
+				}},
+				"nextPageToken": "p2",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"connections": []map[string]any{{
+				"resourceName": "people/c2",
+				"names":        []map[string]any{{"displayName": "Grace Hopper"}},
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := people.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newPeopleContactsService = func(context.Context, string) (*people.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "contacts", "list", "--all", "--fields", "names,organizations", "--csv"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if len(masks) != 2 || masks[0] != "names,organizations" || sizes[0] != "1000" {
+		t.Fatalf("unexpected requests: masks=%v sizes=%v", masks, sizes)
+	}
+	want := "RESOURCE,NAME,ORGANIZATION\npeople/c1,Ada Lovelace,\"Programmer, Analytical Engines\"\npeople/c2,Grace Hopper,\n"
+	if out != want {
+		t.Fatalf("unexpected csv:\n%s", out)
+	}
+}
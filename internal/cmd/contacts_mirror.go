@@ -0,0 +1,398 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/people/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const (
+	contactsMirrorStateFile = ".gog-contacts-sync.json"
+	contactsMirrorFields    = contactsExportFields + ",metadata"
+)
+
+type ContactsSyncCmd struct {
+	Dir  string `name:"dir" help:"Mirror directory (one .vcf file per contact)" default:"./contacts"`
+	Full bool   `name:"full" help:"Ignore the stored sync token and resync every contact"`
+}
+
+type contactsMirrorState struct {
+	Account   string `json:"account"`
+	SyncToken string `json:"syncToken"`
+	SyncedAt  string `json:"syncedAt"`
+	// Files are the .vcf files sync wrote; only these are ever deleted, so
+	// other vCards in the directory are left alone.
+	Files []string `json:"files"`
+}
+
+type contactsSyncSummary struct {
+	Dir       string `json:"dir"`
+	Full      bool   `json:"full"`
+	Added     int    `json:"added"`
+	Updated   int    `json:"updated"`
+	Deleted   int    `json:"deleted"`
+	Unchanged int    `json:"unchanged"`
+}
+
+func (c *ContactsSyncCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	dir, err := config.ExpandPath(strings.TrimSpace(c.Dir))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	statePath := filepath.Join(dir, contactsMirrorStateFile)
+	state, _, err := readContactsMirrorState(dir)
+	if err != nil {
+		return err
+	}
+	token := state.SyncToken
+	if c.Full || !strings.EqualFold(state.Account, account) {
+		token = ""
+	}
+
+	svc, err := newPeopleContactsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var summary contactsSyncSummary
+	owned := map[string]bool{}
+	for _, name := range state.Files {
+		owned[name] = true
+	}
+	seen := map[string]bool{}
+	apply := func(p *people.Person) error {
+		name := contactMirrorFileName(p.ResourceName)
+		path := filepath.Join(dir, name)
+		if p.Metadata != nil && p.Metadata.Deleted {
+			if !owned[name] {
+				return nil
+			}
+			delete(owned, name)
+			if err := os.Remove(path); err == nil {
+				summary.Deleted++
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			return nil
+		}
+		seen[name] = true
+		owned[name] = true
+		var buf bytes.Buffer
+		if err := writeVCard(&buf, p); err != nil {
+			return err
+		}
+		old, readErr := os.ReadFile(path) //nolint:gosec // path inside the mirror dir
+		switch {
+		case readErr == nil && bytes.Equal(old, buf.Bytes()):
+			summary.Unchanged++
+			return nil
+		case readErr == nil:
+			summary.Updated++
+		case errors.Is(readErr, os.ErrNotExist):
+			summary.Added++
+		default:
+			return readErr
+		}
+		return os.WriteFile(path, buf.Bytes(), 0o600)
+	}
+
+	next, err := listContactChanges(ctx, svc, token, apply)
+	if token != "" && isContactsSyncTokenExpired(err) {
+		u.Err().Println("Sync token expired; running a full sync")
+		token = ""
+		summary = contactsSyncSummary{}
+		next, err = listContactChanges(ctx, svc, "", apply)
+	}
+	if err != nil {
+		return err
+	}
+
+	summary.Dir, summary.Full = dir, token == ""
+	if summary.Full {
+		for name := range owned {
+			if seen[name] {
+				continue
+			}
+			delete(owned, name)
+			if err := os.Remove(filepath.Join(dir, name)); err == nil {
+				summary.Deleted++
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	files := make([]string, 0, len(owned))
+	for name := range owned {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	state = contactsMirrorState{Account: account, SyncToken: next, SyncedAt: time.Now().UTC().Format(time.RFC3339), Files: files}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(statePath, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, summary)
+	}
+	u.Out().Printf("dir\t%s", summary.Dir)
+	u.Out().Printf("full\t%t", summary.Full)
+	u.Out().Printf("added\t%d", summary.Added)
+	u.Out().Printf("updated\t%d", summary.Updated)
+	u.Out().Printf("deleted\t%d", summary.Deleted)
+	u.Out().Printf("unchanged\t%d", summary.Unchanged)
+	return nil
+}
+
+type ContactsDiffCmd struct {
+	Dir   string `name:"dir" help:"Mirror directory written by contacts sync" default:"./contacts"`
+	Patch bool   `name:"patch" help:"Show changed vCard lines (- local, + Google)"`
+}
+
+type contactsDiffEntry struct {
+	Status  string   `json:"status"`
+	File    string   `json:"file"`
+	Name    string   `json:"name,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	Added   []string `json:"added,omitempty"`
+}
+
+func (c *ContactsDiffCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	dir, err := config.ExpandPath(strings.TrimSpace(c.Dir))
+	if err != nil {
+		return err
+	}
+	local, err := contactMirrorFiles(dir)
+	if err != nil {
+		return err
+	}
+	// Compare only the files sync wrote when the directory is a mirror.
+	if state, ok, stateErr := readContactsMirrorState(dir); stateErr != nil {
+		return stateErr
+	} else if ok {
+		local = slices.DeleteFunc(local, func(name string) bool { return !slices.Contains(state.Files, name) })
+	}
+
+	svc, err := newPeopleContactsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	contacts, err := listAllConnections(ctx, svc, contactsExportFields)
+	if err != nil {
+		return err
+	}
+
+	remote := map[string]*people.Person{}
+	rendered := map[string][]byte{}
+	for _, p := range contacts {
+		name := contactMirrorFileName(p.ResourceName)
+		var buf bytes.Buffer
+		if err := writeVCard(&buf, p); err != nil {
+			return err
+		}
+		remote[name], rendered[name] = p, buf.Bytes()
+	}
+
+	var changes []contactsDiffEntry
+	for _, name := range local {
+		data, readErr := os.ReadFile(filepath.Join(dir, name)) //nolint:gosec // path inside the mirror dir
+		if readErr != nil {
+			return readErr
+		}
+		p, ok := remote[name]
+		if !ok {
+			entry := contactsDiffEntry{Status: "deleted", File: name}
+			if parsed, parseErr := parseVCards(bytes.NewReader(data)); parseErr == nil && len(parsed) > 0 {
+				entry.Name = primaryName(parsed[0])
+			}
+			changes = append(changes, entry)
+			continue
+		}
+		if !bytes.Equal(data, rendered[name]) {
+			removed, added := diffVCardLines(string(data), string(rendered[name]))
+			changes = append(changes, contactsDiffEntry{Status: "modified", File: name, Name: primaryName(p), Removed: removed, Added: added})
+		}
+		delete(remote, name)
+	}
+	for name, p := range remote {
+		changes = append(changes, contactsDiffEntry{Status: "added", File: name, Name: primaryName(p)})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].File < changes[j].File })
+
+	if outfmt.IsJSON(ctx) {
+		if changes == nil {
+			changes = []contactsDiffEntry{}
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"dir": dir, "changes": changes})
+	}
+	if len(changes) == 0 {
+		u.Err().Println("No differences")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	fmt.Fprintln(w, "STATUS\tFILE\tNAME")
+	for _, ch := range changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", ch.Status, ch.File, sanitizeTab(ch.Name))
+	}
+	flush()
+	if c.Patch {
+		for _, ch := range changes {
+			if ch.Status != "modified" {
+				continue
+			}
+			u.Out().Printf("\n%s", ch.File)
+			for _, line := range ch.Removed {
+				u.Out().Printf("- %s", line)
+			}
+			for _, line := range ch.Added {
+				u.Out().Printf("+ %s", line)
+			}
+		}
+	}
+	return nil
+}
+
+// listContactChanges pages through connections.list (incremental when
+// syncToken is set) and returns the next sync token.
+func listContactChanges(ctx context.Context, svc *people.Service, syncToken string, fn func(*people.Person) error) (string, error) {
+	page := ""
+	for {
+		call := svc.People.Connections.List(peopleMeResource).
+			PersonFields(contactsMirrorFields).
+			PageSize(maxConnectionsPageSize).
+			RequestSyncToken(true).
+			PageToken(page)
+		if syncToken != "" {
+			call = call.SyncToken(syncToken)
+		}
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return "", err
+		}
+		for _, p := range resp.Connections {
+			if p == nil || p.ResourceName == "" {
+				continue
+			}
+			if err := fn(p); err != nil {
+				return "", err
+			}
+		}
+		if resp.NextPageToken == "" {
+			if resp.NextSyncToken == "" {
+				return "", errors.New("people API returned no sync token")
+			}
+			return resp.NextSyncToken, nil
+		}
+		page = resp.NextPageToken
+	}
+}
+
+// isContactsSyncTokenExpired matches the People API's EXPIRED_SYNC_TOKEN
+// failure (sync tokens last seven days) as well as a plain 410.
+func isContactsSyncTokenExpired(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	return gerr.Code == http.StatusGone ||
+		(gerr.Code == http.StatusBadRequest && strings.Contains(gerr.Body+gerr.Message, "EXPIRED_SYNC_TOKEN"))
+}
+
+func contactMirrorFileName(resourceName string) string {
+	id := strings.TrimPrefix(resourceName, "people/")
+	return strings.NewReplacer("/", "_", `\`, "_").Replace(id) + ".vcf"
+}
+
+// readContactsMirrorState loads the sync state of dir; ok is false when dir
+// has not been synced yet.
+func readContactsMirrorState(dir string) (contactsMirrorState, bool, error) {
+	var state contactsMirrorState
+	path := filepath.Join(dir, contactsMirrorStateFile)
+	data, err := os.ReadFile(path) //nolint:gosec // path inside the mirror dir
+	if errors.Is(err, os.ErrNotExist) {
+		return state, false, nil
+	}
+	if err != nil {
+		return state, false, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, false, fmt.Errorf("read %s: %w", path, err)
+	}
+	return state, true, nil
+}
+
+func contactMirrorFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.EqualFold(filepath.Ext(e.Name()), ".vcf") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// diffVCardLines returns the lines only in a and only in b; vCards are short
+// and unordered enough that a set difference reads better than an LCS diff.
+func diffVCardLines(a, b string) (removed, added []string) {
+	split := func(s string) []string {
+		return strings.Split(strings.TrimRight(strings.ReplaceAll(s, "\r\n", "\n"), "\n"), "\n")
+	}
+	left, right := split(a), split(b)
+	inLeft, inRight := map[string]bool{}, map[string]bool{}
+	for _, l := range left {
+		inLeft[l] = true
+	}
+	for _, l := range right {
+		inRight[l] = true
+	}
+	for _, l := range left {
+		if !inRight[l] {
+			removed = append(removed, l)
+		}
+	}
+	for _, l := range right {
+		if !inLeft[l] {
+			added = append(added, l)
+		}
+	}
+	return removed, added
+}
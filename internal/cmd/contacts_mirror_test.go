@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExecute_ContactsSyncAndDiff(t *testing.T) {
+	var syncTokens []string
+	svc, closeSrv := newPeopleService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/people/me/connections") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		token := r.URL.Query().Get("syncToken")
+		syncTokens = append(syncTokens, token)
+		switch token {
+		case "":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"connections": []map[string]any{
+					{"resourceName": "people/c1", "names": []map[string]any{{"displayName": "Ada Lovelace"}}, "emailAddresses": []map[string]any{{"value": "ada@example.com"}}},
+					{"resourceName": "people/c2", "names": []map[string]any{{"displayName": "Grace Hopper"}}},
+				},
+				"nextSyncToken": "t1",
+			})
+		case "t1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"connections": []map[string]any{
+					{"resourceName": "people/c1", "names": []map[string]any{{"displayName": "Ada Lovelace"}}, "emailAddresses": []map[string]any{{"value": "ada@new.example"}}},
+					{"resourceName": "people/c2", "metadata": map[string]any{"deleted": true}},
+				},
+				"nextSyncToken": "t2",
+			})
+		default:
+			http.Error(w, "unexpected sync token", http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(closeSrv)
+	stubPeopleServices(t, svc)
+
+	dir := filepath.Join(t.TempDir(), "contacts")
+	// A vCard gog did not write must survive every kind of sync.
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	friend := filepath.Join(dir, "friend.vcf")
+	if err := os.WriteFile(friend, []byte("BEGIN:VCARD\r\nEND:VCARD\r\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	sync := func(extra ...string) contactsSyncSummary {
+		t.Helper()
+		var summary contactsSyncSummary
+		out := captureStdout(t, func() {
+			if err := Execute(append([]string{"--json", "--account", "a@b.com", "contacts", "sync", "--dir", dir}, extra...)); err != nil {
+				t.Fatalf("sync: %v", err)
+			}
+		})
+		if err := json.Unmarshal([]byte(out), &summary); err != nil {
+			t.Fatalf("json: %v\nout=%q", err, out)
+		}
+		return summary
+	}
+
+	if got := sync(); !got.Full || got.Added != 2 || got.Deleted != 0 {
+		t.Fatalf("unexpected full sync summary: %#v", got)
+	}
+	if got := sync(); got.Full || got.Updated != 1 || got.Deleted != 1 {
+		t.Fatalf("unexpected incremental summary: %#v", got)
+	}
+	if len(syncTokens) != 2 || syncTokens[1] != "t1" {
+		t.Fatalf("unexpected sync tokens: %#v", syncTokens)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "c1.vcf"))
+	if err != nil || !strings.Contains(string(data), "ada@new.example") {
+		t.Fatalf("c1.vcf not updated: %v %q", err, data)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "c2.vcf")); !os.IsNotExist(err) {
+		t.Fatalf("c2.vcf should be deleted: %v", err)
+	}
+
+	// diff does a full listing: the mirror has ada@new.example, Google (full list) still the old address + c2.
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "contacts", "diff", "--dir", dir}); err != nil {
+			t.Fatalf("diff: %v", err)
+		}
+	})
+	var parsed struct {
+		Changes []contactsDiffEntry `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\nout=%q", err, out)
+	}
+	if len(parsed.Changes) != 2 || parsed.Changes[0].Status != "modified" || parsed.Changes[1].Status != "added" ||
+		len(parsed.Changes[0].Added) != 1 || !strings.Contains(parsed.Changes[0].Added[0], "ada@example.com") {
+		t.Fatalf("unexpected changes: %#v", parsed.Changes)
+	}
+
+	if got := sync("--full"); !got.Full || got.Deleted != 0 {
+		t.Fatalf("unexpected --full summary: %#v", got)
+	}
+	if _, err := os.Stat(friend); err != nil {
+		t.Fatalf("unrelated vCard removed: %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // register GIF decoding for photo uploads
+	"image/jpeg"
+	_ "image/png" // register PNG decoding for photo uploads
+	"os"
+	"strings"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const contactPhotoMaxBytes = 2 << 20
+
+type ContactsPhotoCmd struct {
+	Set    ContactsPhotoSetCmd    `cmd:"" name:"set" help:"Upload a contact photo (cropped to a square and resized)"`
+	Delete ContactsPhotoDeleteCmd `cmd:"" name:"delete" help:"Remove a contact photo"`
+}
+
+type ContactsPhotoSetCmd struct {
+	ResourceName string `arg:"" name:"resourceName" help:"Resource name (people/...)"`
+	File         string `arg:"" name:"file" help:"Image file (JPEG, PNG or GIF; - for stdin)"`
+	Size         int    `name:"size" help:"Edge length in pixels of the uploaded square photo" default:"720"`
+	NoCrop       bool   `name:"no-crop" help:"Keep the aspect ratio instead of center-cropping to a square"`
+}
+
+func (c *ContactsPhotoSetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	resourceName := strings.TrimSpace(c.ResourceName)
+	if !strings.HasPrefix(resourceName, "people/") {
+		return usage("resourceName must start with people/")
+	}
+	if c.Size <= 0 {
+		return usage("--size must be positive")
+	}
+
+	data, err := readInputFile(strings.TrimSpace(c.File))
+	if err != nil {
+		return err
+	}
+	photo, err := prepareContactPhoto(data, c.Size, !c.NoCrop)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newPeopleContactsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	resp, err := svc.People.UpdateContactPhoto(resourceName, &people.UpdateContactPhotoRequest{
+		PhotoBytes:   base64.StdEncoding.EncodeToString(photo),
+		PersonFields: "photos",
+	}).Do()
+	if err != nil {
+		return err
+	}
+
+	photoURL := ""
+	if resp != nil && resp.Person != nil {
+		for _, p := range resp.Person.Photos {
+			if p != nil && !p.Default {
+				photoURL = p.Url
+				break
+			}
+		}
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"resource": resourceName,
+			"bytes":    len(photo),
+			"url":      photoURL,
+		})
+	}
+	u.Out().Printf("resource\t%s", resourceName)
+	u.Out().Printf("bytes\t%d", len(photo))
+	if photoURL != "" {
+		u.Out().Printf("url\t%s", photoURL)
+	}
+	return nil
+}
+
+type ContactsPhotoDeleteCmd struct {
+	ResourceName string `arg:"" name:"resourceName" help:"Resource name (people/...)"`
+}
+
+func (c *ContactsPhotoDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	resourceName := strings.TrimSpace(c.ResourceName)
+	if !strings.HasPrefix(resourceName, "people/") {
+		return usage("resourceName must start with people/")
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete photo of contact %s", resourceName)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newPeopleContactsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if _, err := svc.People.DeleteContactPhoto(resourceName).Do(); err != nil {
+		return err
+	}
+	return writeDeleteResult(ctx, u, resourceName)
+}
+
+// prepareContactPhoto decodes an image, optionally center-crops it to a square,
+// scales it down to fit size and re-encodes it as JPEG for updateContactPhoto.
+func prepareContactPhoto(data []byte, size int, crop bool) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode photo: %w", err)
+	}
+
+	src := img.Bounds()
+	if crop {
+		side := min(src.Dx(), src.Dy())
+		x0 := src.Min.X + (src.Dx()-side)/2
+		y0 := src.Min.Y + (src.Dy()-side)/2
+		src = image.Rect(x0, y0, x0+side, y0+side)
+	}
+	w, h := src.Dx(), src.Dy()
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, max(1, h*size/w)
+		} else {
+			w, h = max(1, w*size/h), size
+		}
+	}
+
+	out := scaleImage(img, src, w, h)
+	for quality := 90; ; quality -= 15 {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, out, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, fmt.Errorf("encode photo: %w", err)
+		}
+		if buf.Len() <= contactPhotoMaxBytes || quality <= 30 {
+			return buf.Bytes(), nil
+		}
+	}
+}
+
+// scaleImage box-filters the src rectangle of img into a w×h RGBA image,
+// flattening transparency onto white since JPEG has no alpha channel.
+func scaleImage(img image.Image, src image.Rectangle, w, h int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		sy0 := src.Min.Y + y*src.Dy()/h
+		sy1 := max(sy0+1, src.Min.Y+(y+1)*src.Dy()/h)
+		for x := 0; x < w; x++ {
+			sx0 := src.Min.X + x*src.Dx()/w
+			sx1 := max(sx0+1, src.Min.X+(x+1)*src.Dx()/w)
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+			bg := 0xffff - a/n
+			dst.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r/n + bg),
+				G: uint16(g/n + bg),
+				B: uint16(b/n + bg),
+				A: 0xffff,
+			})
+		}
+	}
+	return dst
+}
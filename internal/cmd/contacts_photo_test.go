@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+)
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestPrepareContactPhoto_CropAndResize(t *testing.T) {
+	out, err := prepareContactPhoto(testPNG(t, 300, 200), 100, true)
+	if err != nil {
+		t.Fatalf("prepareContactPhoto: %v", err)
+	}
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(out))
+	if err != nil {
+		t.Fatalf("decode jpeg: %v", err)
+	}
+	if cfg.Width != 100 || cfg.Height != 100 {
+		t.Fatalf("unexpected size %dx%d", cfg.Width, cfg.Height)
+	}
+
+	out, err = prepareContactPhoto(testPNG(t, 300, 200), 150, false)
+	if err != nil {
+		t.Fatalf("prepareContactPhoto: %v", err)
+	}
+	if cfg, _ = jpeg.DecodeConfig(bytes.NewReader(out)); cfg.Width != 150 || cfg.Height != 100 {
+		t.Fatalf("unexpected no-crop size %dx%d", cfg.Width, cfg.Height)
+	}
+
+	if _, err := prepareContactPhoto([]byte("not an image"), 100, true); err == nil {
+		t.Fatalf("expected decode error")
+	}
+}
+
+func TestContactsPhotoSet_JSON(t *testing.T) {
+	var uploaded []byte
+	svc, closeSrv := newPeopleService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "people/c1:updateContactPhoto") || r.Method != http.MethodPatch {
+			http.NotFound(w, r)
+			return
+		}
+		var body struct {
+			PhotoBytes string `json:"photoBytes"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		uploaded, _ = base64.StdEncoding.DecodeString(body.PhotoBytes)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"person": map[string]any{
+				"resourceName": "people/c1",
+				"photos":       []map[string]any{{"url": "https://example.com/p.jpg"}},
+			},
+		})
+	}))
+	t.Cleanup(closeSrv)
+	stubPeopleServices(t, svc)
+
+	path := filepath.Join(t.TempDir(), "me.png")
+	if err := os.WriteFile(path, testPNG(t, 40, 60), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	flags := &RootFlags{Account: "a@b.com"}
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
+	out := captureStdout(t, func() {
+		if err := runKong(t, &ContactsPhotoSetCmd{}, []string{"people/c1", path}, ctx, flags); err != nil {
+			t.Fatalf("photo set: %v", err)
+		}
+	})
+	if !strings.Contains(out, "https://example.com/p.jpg") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(uploaded))
+	if err != nil {
+		t.Fatalf("uploaded photo is not a JPEG: %v", err)
+	}
+	if cfg.Width != 40 || cfg.Height != 40 {
+		t.Fatalf("unexpected uploaded size %dx%d", cfg.Width, cfg.Height)
+	}
+
+	if err := runKong(t, &ContactsPhotoDeleteCmd{}, []string{"otherContacts/x"}, ctx, flags); err == nil || !strings.Contains(err.Error(), "resourceName must start with people/") {
+		t.Fatalf("expected people/ error, got %v", err)
+	}
+}
@@ -0,0 +1,493 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const contactsExportFields = "names,emailAddresses,phoneNumbers,organizations,birthdays,addresses,urls,biographies"
+
+type ContactsExportCmd struct {
+	Format string `name:"format" help:"vcf or csv (default: from --out extension, else vcf). CSV keeps one value per field."`
+	Out    string `name:"out" short:"o" aliases:"output" help:"Output file (default: stdout)"`
+}
+
+func (c *ContactsExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	format, err := contactsFileFormat(c.Format, c.Out)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newPeopleContactsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	contacts, err := listAllConnections(ctx, svc, contactsExportFields)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if format == "csv" {
+		if err := writeContactsCSV(&buf, contacts, contactFieldList); err != nil {
+			return err
+		}
+	} else {
+		for _, p := range contacts {
+			if err := writeVCard(&buf, p); err != nil {
+				return err
+			}
+		}
+	}
+
+	outPath := strings.TrimSpace(c.Out)
+	if outPath == "" {
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	if outPath, err = config.ExpandPath(outPath); err != nil {
+		return err
+	}
+	if err := os.WriteFile(outPath, buf.Bytes(), 0o600); err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"path": outPath, "format": format, "contacts": len(contacts)})
+	}
+	u.Out().Printf("path\t%s", outPath)
+	u.Out().Printf("contacts\t%d", len(contacts))
+	return nil
+}
+
+type ContactsImportCmd struct {
+	File            string `arg:"" name:"file" help:"vCard or CSV file (- for stdin)"`
+	Format          string `name:"format" help:"vcf or csv (default: from the file extension, else vcf)"`
+	Mapping         string `name:"mapping" help:"CSV column mapping as field=Column,... (fields: name, given, family, email, phone, organization, title, birthday, address, url, note)"`
+	DryRun          bool   `name:"dry-run" help:"Show what would be imported; do not create contacts"`
+	AllowDuplicates bool   `name:"allow-duplicates" help:"Create contacts even when an email, phone or name already exists"`
+}
+
+type contactImportResult struct {
+	Entry    int    `json:"entry"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Action   string `json:"action"`
+	Resource string `json:"resource,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (c *ContactsImportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	path := strings.TrimSpace(c.File)
+	format, err := contactsFileFormat(c.Format, path)
+	if err != nil {
+		return err
+	}
+	if format != "csv" && strings.TrimSpace(c.Mapping) != "" {
+		return usage("--mapping only applies to CSV files")
+	}
+
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		if path, err = config.ExpandPath(path); err != nil {
+			return err
+		}
+		f, openErr := os.Open(path) //nolint:gosec // user-provided path
+		if openErr != nil {
+			return openErr
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var entries []*people.Person
+	if format == "csv" {
+		entries, err = parseContactsCSV(in, c.Mapping)
+	} else {
+		entries, err = parseVCards(in)
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return errors.New("no contacts found in input")
+	}
+
+	svc, err := newPeopleContactsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	index := newContactIndex()
+	if !c.AllowDuplicates {
+		existing, listErr := listAllConnections(ctx, svc, "names,emailAddresses,phoneNumbers")
+		if listErr != nil {
+			return listErr
+		}
+		for _, p := range existing {
+			index.add(p, p.ResourceName)
+		}
+	}
+
+	results := make([]contactImportResult, 0, len(entries))
+	failed := 0
+	for i, p := range entries {
+		r := contactImportResult{Entry: i + 1, Name: primaryName(p), Email: primaryEmail(p)}
+		switch dup := index.match(p); {
+		case r.Name == "" && r.Email == "" && primaryPhone(p) == "":
+			r.Action, r.Error = "error", "no name, email or phone"
+		case dup != "" && !c.AllowDuplicates:
+			r.Action, r.Resource = "duplicate", dup
+		case c.DryRun:
+			r.Action = "create"
+		default:
+			created, createErr := svc.People.CreateContact(contactForCreate(p)).PersonFields("names").Context(ctx).Do()
+			if createErr != nil {
+				r.Action, r.Error = "error", createErr.Error()
+				break
+			}
+			r.Action, r.Resource = "created", created.ResourceName
+		}
+		if r.Action == "error" {
+			failed++
+			u.Err().Printf("entry %d: %s", r.Entry, r.Error)
+		} else if r.Action != "duplicate" {
+			ref := r.Resource
+			if ref == "" {
+				ref = fmt.Sprintf("(entry %d)", r.Entry)
+			}
+			index.add(p, ref)
+		}
+		results = append(results, r)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{"dryRun": c.DryRun, "results": results}); err != nil {
+			return err
+		}
+	} else {
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "ENTRY\tACTION\tNAME\tEMAIL\tRESOURCE")
+		for _, r := range results {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", r.Entry, r.Action, sanitizeTab(r.Name), sanitizeTab(r.Email), r.Resource)
+		}
+		flush()
+	}
+
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Action]++
+	}
+	u.Err().Printf("%d to create, %d created, %d duplicate(s), %d failed", counts["create"], counts["created"], counts["duplicate"], failed)
+	if failed > 0 {
+		return fmt.Errorf("%d contact(s) failed", failed)
+	}
+	return nil
+}
+
+func contactsFileFormat(format, path string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(strings.TrimSpace(path))) {
+		case ".csv":
+			format = "csv"
+		default:
+			format = "vcf"
+		}
+	}
+	switch format {
+	case "vcf", "vcard":
+		return "vcf", nil
+	case "csv":
+		return "csv", nil
+	default:
+		return "", usagef("invalid --format %q (want vcf or csv)", format)
+	}
+}
+
+func listAllConnections(ctx context.Context, svc *people.Service, personFields string) ([]*people.Person, error) {
+	var out []*people.Person
+	err := svc.People.Connections.List(peopleMeResource).
+		PersonFields(personFields).
+		PageSize(maxConnectionsPageSize).
+		Pages(ctx, func(resp *people.ListConnectionsResponse) error {
+			for _, p := range resp.Connections {
+				if p != nil {
+					out = append(out, p)
+				}
+			}
+			return nil
+		})
+	return out, err
+}
+
+// contactForCreate drops output-only name fields, falling back to an
+// unstructured name the API parses itself.
+func contactForCreate(p *people.Person) *people.Person {
+	for _, n := range p.Names {
+		if n == nil {
+			continue
+		}
+		if n.GivenName == "" && n.FamilyName == "" && n.UnstructuredName == "" {
+			n.UnstructuredName = n.DisplayName
+		}
+		n.DisplayName = ""
+	}
+	return p
+}
+
+// contactIndex finds existing contacts by email, phone digits, or (for
+// contacts with neither) name.
+type contactIndex struct {
+	emails, phones, names map[string]string
+}
+
+func newContactIndex() *contactIndex {
+	return &contactIndex{emails: map[string]string{}, phones: map[string]string{}, names: map[string]string{}}
+}
+
+func (x *contactIndex) add(p *people.Person, ref string) {
+	for _, e := range p.EmailAddresses {
+		if e != nil && e.Value != "" {
+			x.emails[strings.ToLower(strings.TrimSpace(e.Value))] = ref
+		}
+	}
+	for _, t := range p.PhoneNumbers {
+		if t != nil {
+			if d := phoneDigits(t.Value); d != "" {
+				x.phones[d] = ref
+			}
+		}
+	}
+	if name := strings.ToLower(primaryName(p)); name != "" {
+		x.names[name] = ref
+	}
+}
+
+func (x *contactIndex) match(p *people.Person) string {
+	for _, e := range p.EmailAddresses {
+		if e == nil {
+			continue
+		}
+		if ref, ok := x.emails[strings.ToLower(strings.TrimSpace(e.Value))]; ok {
+			return ref
+		}
+	}
+	for _, t := range p.PhoneNumbers {
+		if t == nil {
+			continue
+		}
+		if ref, ok := x.phones[phoneDigits(t.Value)]; ok {
+			return ref
+		}
+	}
+	if len(p.EmailAddresses) == 0 && len(p.PhoneNumbers) == 0 {
+		if ref, ok := x.names[strings.ToLower(primaryName(p))]; ok {
+			return ref
+		}
+	}
+	return ""
+}
+
+// phoneDigits keeps the last 10 digits so +1 555... and (555)... compare equal.
+func phoneDigits(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	d := b.String()
+	if len(d) > 10 {
+		d = d[len(d)-10:]
+	}
+	if len(d) < 5 {
+		return ""
+	}
+	return d
+}
+
+func writeContactsCSV(w io.Writer, contacts []*people.Person, fields []contactField) error {
+	cw := csv.NewWriter(w)
+	headers := []string{"RESOURCE"}
+	for _, f := range fields {
+		headers = append(headers, f.header)
+	}
+	_ = cw.Write(headers)
+	for _, p := range contacts {
+		row := []string{p.ResourceName}
+		for _, f := range fields {
+			row = append(row, f.value(p))
+		}
+		_ = cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// contactCSVFields maps normalized CSV headers (including Google Contacts
+// export headers) to import fields.
+var contactCSVFields = map[string]string{
+	"name":                   "name",
+	"full-name":              "name",
+	"display-name":           "name",
+	"given":                  "given",
+	"given-name":             "given",
+	"first-name":             "given",
+	"first":                  "given",
+	"family":                 "family",
+	"family-name":            "family",
+	"last-name":              "family",
+	"last":                   "family",
+	"surname":                "family",
+	"email":                  "email",
+	"emails":                 "email",
+	"e-mail":                 "email",
+	"email-address":          "email",
+	"e-mail-1---value":       "email",
+	"phone":                  "phone",
+	"phones":                 "phone",
+	"phone-number":           "phone",
+	"mobile":                 "phone",
+	"phone-1---value":        "phone",
+	"organization":           "organization",
+	"company":                "organization",
+	"organization-1---name":  "organization",
+	"title":                  "title",
+	"job-title":              "title",
+	"organization-1---title": "title",
+	"birthday":               "birthday",
+	"address":                "address",
+	"address-1---formatted":  "address",
+	"url":                    "url",
+	"website":                "url",
+	"website-1---value":      "url",
+	"note":                   "note",
+	"notes":                  "note",
+}
+
+func parseContactsCSV(r io.Reader, mapping string) ([]*people.Person, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read CSV header: %w", err)
+	}
+
+	columns := map[string]int{}
+	byHeader := map[string]int{}
+	for i, h := range header {
+		byHeader[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+		if field := contactCSVFields[normalizeCSVField(h)]; field != "" {
+			if _, seen := columns[field]; !seen {
+				columns[field] = i
+			}
+		}
+	}
+	for _, pair := range splitCSV(mapping) {
+		key, column, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, usagef("invalid --mapping entry %q (want field=Column)", pair)
+		}
+		field := contactCSVFields[normalizeCSVField(key)]
+		if field == "" {
+			return nil, usagef("unknown --mapping field %q", key)
+		}
+		idx, found := byHeader[strings.ToLower(strings.TrimSpace(column))]
+		if !found {
+			return nil, usagef("--mapping %s: no column %q in header", key, column)
+		}
+		columns[field] = idx
+	}
+	if len(columns) == 0 {
+		return nil, usage("no recognized columns (use --mapping, e.g. name=Full Name,email=Mail)")
+	}
+
+	var out []*people.Person
+	for {
+		record, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("read CSV: %w", readErr)
+		}
+		get := func(field string) string {
+			idx, ok := columns[field]
+			if !ok || idx >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[idx])
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		out = append(out, contactFromCSV(get))
+	}
+	return out, nil
+}
+
+func contactFromCSV(get func(string) string) *people.Person {
+	p := &people.Person{}
+	if given, family, name := get("given"), get("family"), get("name"); given != "" || family != "" || name != "" {
+		n := &people.Name{GivenName: given, FamilyName: family, DisplayName: name}
+		if n.DisplayName == "" {
+			n.DisplayName = strings.TrimSpace(given + " " + family)
+		}
+		p.Names = []*people.Name{n}
+	}
+	for _, e := range splitMultiValue(get("email")) {
+		p.EmailAddresses = append(p.EmailAddresses, &people.EmailAddress{Value: e})
+	}
+	for _, t := range splitMultiValue(get("phone")) {
+		p.PhoneNumbers = append(p.PhoneNumbers, &people.PhoneNumber{Value: t})
+	}
+	if org, title := get("organization"), get("title"); org != "" || title != "" {
+		p.Organizations = []*people.Organization{{Name: org, Title: title}}
+	}
+	if d := parseVCardDate(get("birthday")); d != nil {
+		p.Birthdays = []*people.Birthday{{Date: d}}
+	}
+	if a := get("address"); a != "" {
+		p.Addresses = []*people.Address{{FormattedValue: a}}
+	}
+	for _, u := range splitMultiValue(get("url")) {
+		p.Urls = append(p.Urls, &people.Url{Value: u})
+	}
+	if note := get("note"); note != "" {
+		p.Biographies = []*people.Biography{{Value: note, ContentType: "TEXT_PLAIN"}}
+	}
+	return p
+}
+
+// splitMultiValue splits Google CSV " ::: " lists and semicolon lists.
+func splitMultiValue(s string) []string {
+	var out []string
+	for _, part := range strings.Split(strings.ReplaceAll(s, ":::", ";"), ";") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
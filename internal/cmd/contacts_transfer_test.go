@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/people/v1"
+)
+
+func TestExecute_ContactsImportExport(t *testing.T) {
+	origNew := newPeopleContactsService
+	t.Cleanup(func() { newPeopleContactsService = origNew })
+
+	var created []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/people/me/connections") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"connections": []map[string]any{{
+					"resourceName":   "people/c1",
+					"names":          []map[string]any{{"displayName": "Ada Lovelace", "givenName": "Ada", "familyName": "Lovelace"}},
+					"emailAddresses": []map[string]any{{"value": "ada@example.com"}},
+					"phoneNumbers":   []map[string]any{{"value": "+1 (555) 010-0000"}},
+				}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/people:createContact") && r.Method == http.MethodPost:
+			var body people.Person
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			created = append(created, primaryEmail(&body))
+			_ = json.NewEncoder(w).Encode(map[string]any{"resourceName": "people/new" + string(rune('0'+len(created)))})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := people.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newPeopleContactsService = func(context.Context, string) (*people.Service, error) { return svc, nil }
+
+	dir := t.TempDir()
+	vcfPath := filepath.Join(dir, "contacts.vcf")
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "contacts", "export", "-o", vcfPath}); err != nil {
+			t.Fatalf("export: %v", err)
+		}
+	})
+	data, err := os.ReadFile(vcfPath)
+	if err != nil || !strings.Contains(string(data), "EMAIL:ada@example.com") {
+		t.Fatalf("unexpected export: %q, %v", data, err)
+	}
+
+	csvPath := filepath.Join(dir, "import.csv")
+	csvData := "First Name,Last Name,E-mail 1 - Value,Mobile\n" +
+		"Ada,L,ADA@example.com,\n" + // duplicate by email
+		"Charles,Babbage,,555-010-0000\n" + // duplicate by phone
+		"Grace,Hopper,grace@example.com ::: gh@example.com,\n" +
+		"Grace,Hopper,gh@example.com,\n" + // duplicate within the file
+		",,,\n"
+	if err := os.WriteFile(csvPath, []byte(csvData), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	var out string
+	_ = captureStderr(t, func() {
+		out = captureStdout(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "contacts", "import", csvPath, "--dry-run"}); err != nil {
+				t.Fatalf("import dry-run: %v", err)
+			}
+		})
+	})
+	var parsed struct {
+		Results []contactImportResult `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	var actions []string
+	for _, r := range parsed.Results {
+		actions = append(actions, r.Action+":"+r.Resource)
+	}
+	if got := strings.Join(actions, ","); got != "duplicate:people/c1,duplicate:people/c1,create:,duplicate:(entry 3)" {
+		t.Fatalf("unexpected dry-run actions: %s", got)
+	}
+	if len(created) != 0 {
+		t.Fatalf("dry run created contacts: %v", created)
+	}
+
+	_ = captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "contacts", "import", csvPath}); err != nil {
+				t.Fatalf("import: %v", err)
+			}
+		})
+	})
+	if strings.Join(created, ",") != "grace@example.com" {
+		t.Fatalf("unexpected creates: %v", created)
+	}
+}
@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/api/people/v1"
+)
+
+// Minimal vCard 3.0 support: FN, N, EMAIL, TEL, ORG, TITLE, BDAY, ADR, URL, NOTE.
+
+var vcardEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, ",", `\,`, ";", `\;`)
+
+func vcardEscape(s string) string {
+	return vcardEscaper.Replace(strings.ReplaceAll(s, "\r\n", "\n"))
+}
+
+// writeVCard writes p as one vCard, folding lines at 75 octets.
+func writeVCard(w io.Writer, p *people.Person) error {
+	var lines []string
+	add := func(format string, args ...any) { lines = append(lines, fmt.Sprintf(format, args...)) }
+
+	add("BEGIN:VCARD")
+	add("VERSION:3.0")
+	fn := primaryName(p)
+	if fn == "" {
+		fn = primaryEmail(p)
+	}
+	add("FN:%s", vcardEscape(fn))
+	if len(p.Names) > 0 && p.Names[0] != nil {
+		n := p.Names[0]
+		add("N:%s;%s;%s;%s;%s", vcardEscape(n.FamilyName), vcardEscape(n.GivenName), vcardEscape(n.MiddleName),
+			vcardEscape(n.HonorificPrefix), vcardEscape(n.HonorificSuffix))
+	} else {
+		add("N:;;;;")
+	}
+	for _, e := range p.EmailAddresses {
+		if e != nil && e.Value != "" {
+			add("EMAIL%s:%s", vcardTypeParam(e.Type), vcardEscape(e.Value))
+		}
+	}
+	for _, t := range p.PhoneNumbers {
+		if t != nil && t.Value != "" {
+			add("TEL%s:%s", vcardTypeParam(t.Type), vcardEscape(t.Value))
+		}
+	}
+	for _, o := range p.Organizations {
+		if o == nil {
+			continue
+		}
+		if o.Name != "" || o.Department != "" {
+			add("ORG:%s;%s", vcardEscape(o.Name), vcardEscape(o.Department))
+		}
+		if o.Title != "" {
+			add("TITLE:%s", vcardEscape(o.Title))
+		}
+		break
+	}
+	if bday := primaryBirthday(p); bday != "" {
+		if len(bday) == 5 { // MM-DD without a year
+			bday = "--" + strings.ReplaceAll(bday, "-", "")
+		}
+		add("BDAY:%s", bday)
+	}
+	for _, a := range p.Addresses {
+		if a == nil {
+			continue
+		}
+		add("ADR%s:%s;%s;%s;%s;%s;%s;%s", vcardTypeParam(a.Type), vcardEscape(a.PoBox), vcardEscape(a.ExtendedAddress),
+			vcardEscape(a.StreetAddress), vcardEscape(a.City), vcardEscape(a.Region), vcardEscape(a.PostalCode), vcardEscape(a.Country))
+	}
+	for _, u := range p.Urls {
+		if u != nil && u.Value != "" {
+			add("URL:%s", vcardEscape(u.Value))
+		}
+	}
+	if note := primaryNote(p); note != "" {
+		add("NOTE:%s", vcardEscape(note))
+	}
+	add("END:VCARD")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, foldVCardLine(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func vcardTypeParam(t string) string {
+	switch strings.ToLower(t) {
+	case "":
+		return ""
+	case "mobile":
+		return ";TYPE=CELL"
+	default:
+		return ";TYPE=" + strings.ToUpper(t)
+	}
+}
+
+func foldVCardLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		n := utf8.RuneLen(r)
+		if width+n > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	return b.String()
+}
+
+// parseVCards reads every vCard in r.
+func parseVCards(r io.Reader) ([]*people.Person, error) {
+	var (
+		out     []*people.Person
+		current *people.Person
+		lines   []string
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		name, params, value, ok := splitVCardLine(line)
+		if !ok {
+			return nil, fmt.Errorf("line %d: invalid vCard line %q", i+1, line)
+		}
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCARD"):
+			current = &people.Person{}
+			continue
+		case name == "END" && strings.EqualFold(value, "VCARD"):
+			if current != nil {
+				out = append(out, current)
+			}
+			current = nil
+			continue
+		case current == nil:
+			continue
+		}
+		applyVCardProperty(current, name, params, value)
+	}
+	return out, nil
+}
+
+// splitVCardLine splits "item1.EMAIL;TYPE=HOME:x" into EMAIL, [TYPE=HOME], x.
+func splitVCardLine(line string) (string, []string, string, bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", nil, "", false
+	}
+	parts := strings.Split(head, ";")
+	name := strings.ToUpper(parts[0])
+	if _, after, grouped := strings.Cut(name, "."); grouped {
+		name = after
+	}
+	return name, parts[1:], value, true
+}
+
+func applyVCardProperty(p *people.Person, name string, params []string, value string) {
+	switch name {
+	case "FN":
+		if len(p.Names) == 0 {
+			p.Names = []*people.Name{{}}
+		}
+		p.Names[0].DisplayName = vcardUnescape(value)
+		if p.Names[0].GivenName == "" && p.Names[0].FamilyName == "" {
+			p.Names[0].UnstructuredName = p.Names[0].DisplayName
+		}
+	case "N":
+		f := vcardFields(value, 5)
+		if len(p.Names) == 0 {
+			p.Names = []*people.Name{{}}
+		}
+		n := p.Names[0]
+		n.FamilyName, n.GivenName, n.MiddleName, n.HonorificPrefix, n.HonorificSuffix = f[0], f[1], f[2], f[3], f[4]
+		if n.GivenName != "" || n.FamilyName != "" {
+			n.UnstructuredName = ""
+		}
+	case "EMAIL":
+		if v := vcardUnescape(value); v != "" {
+			p.EmailAddresses = append(p.EmailAddresses, &people.EmailAddress{Value: v, Type: vcardType(params)})
+		}
+	case "TEL":
+		if v := strings.TrimPrefix(vcardUnescape(value), "tel:"); v != "" {
+			p.PhoneNumbers = append(p.PhoneNumbers, &people.PhoneNumber{Value: v, Type: vcardType(params)})
+		}
+	case "ORG":
+		f := vcardFields(value, 2)
+		org := firstOrganization(p)
+		org.Name, org.Department = f[0], f[1]
+	case "TITLE":
+		firstOrganization(p).Title = vcardUnescape(value)
+	case "BDAY":
+		if d := parseVCardDate(value); d != nil {
+			p.Birthdays = []*people.Birthday{{Date: d}}
+		}
+	case "ADR":
+		f := vcardFields(value, 7)
+		p.Addresses = append(p.Addresses, &people.Address{
+			PoBox: f[0], ExtendedAddress: f[1], StreetAddress: f[2], City: f[3], Region: f[4], PostalCode: f[5], Country: f[6],
+			Type: vcardType(params),
+		})
+	case "URL":
+		if v := vcardUnescape(value); v != "" {
+			p.Urls = append(p.Urls, &people.Url{Value: v})
+		}
+	case "NOTE":
+		if v := vcardUnescape(value); v != "" {
+			p.Biographies = []*people.Biography{{Value: v, ContentType: "TEXT_PLAIN"}}
+		}
+	}
+}
+
+func firstOrganization(p *people.Person) *people.Organization {
+	if len(p.Organizations) == 0 {
+		p.Organizations = []*people.Organization{{}}
+	}
+	return p.Organizations[0]
+}
+
+// vcardType maps TYPE params to People types (home, work, mobile, other).
+func vcardType(params []string) string {
+	for _, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			key, value = "TYPE", param // vCard 2.1 bare types: TEL;CELL:...
+		}
+		if !strings.EqualFold(key, "TYPE") {
+			continue
+		}
+		for _, t := range strings.Split(strings.Trim(value, `"`), ",") {
+			switch strings.ToLower(t) {
+			case "home":
+				return "home"
+			case "work":
+				return "work"
+			case "cell", "mobile":
+				return "mobile"
+			case "other":
+				return "other"
+			}
+		}
+	}
+	return ""
+}
+
+// vcardFields splits a structured value on unescaped semicolons, padded to n.
+func vcardFields(value string, n int) []string {
+	var (
+		out []string
+		cur strings.Builder
+	)
+	escaped := false
+	for _, r := range value {
+		switch {
+		case escaped:
+			cur.WriteRune('\\')
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ';':
+			out = append(out, vcardUnescape(cur.String()))
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	out = append(out, vcardUnescape(cur.String()))
+	for len(out) < n {
+		out = append(out, "")
+	}
+	return out
+}
+
+func vcardUnescape(s string) string {
+	var b strings.Builder
+	escaped := false
+	for _, r := range s {
+		if escaped {
+			if r == 'n' || r == 'N' {
+				b.WriteRune('\n')
+			} else {
+				b.WriteRune(r)
+			}
+			escaped = false
+			continue
+		}
+		if r == '\\' {
+			escaped = true
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// parseVCardDate accepts YYYY-MM-DD, YYYYMMDD, --MMDD and --MM-DD.
+func parseVCardDate(value string) *people.Date {
+	value = strings.TrimSpace(value)
+	if t, _, ok := strings.Cut(value, "T"); ok {
+		value = t
+	}
+	year := int64(0)
+	if strings.HasPrefix(value, "--") {
+		value = strings.ReplaceAll(strings.TrimPrefix(value, "--"), "-", "")
+	} else {
+		value = strings.ReplaceAll(value, "-", "")
+		if len(value) != 8 {
+			return nil
+		}
+		y, err := strconv.ParseInt(value[:4], 10, 64)
+		if err != nil {
+			return nil
+		}
+		year, value = y, value[4:]
+	}
+	if len(value) != 4 {
+		return nil
+	}
+	month, errM := strconv.ParseInt(value[:2], 10, 64)
+	day, errD := strconv.ParseInt(value[2:], 10, 64)
+	if errM != nil || errD != nil || month < 1 || month > 12 || day < 1 || day > 31 {
+		return nil
+	}
+	return &people.Date{Year: year, Month: month, Day: day}
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/people/v1"
+)
+
+func TestVCardRoundTrip(t *testing.T) {
+	in := &people.Person{
+		Names:          []*people.Name{{DisplayName: "Ada Lovelace", GivenName: "Ada", FamilyName: "Lovelace"}},
+		EmailAddresses: []*people.EmailAddress{{Value: "ada@example.com", Type: "work"}, {Value: "ada@home.example"}},
+		PhoneNumbers:   []*people.PhoneNumber{{Value: "+44 20 1234 5678", Type: "mobile"}},
+		Organizations:  []*people.Organization{{Name: "Analytical Engines; Ltd", Title: "Programmer"}},
+		Birthdays:      []*people.Birthday{{Date: &people.Date{Month: 12, Day: 10}}},
+		Addresses:      []*people.Address{{StreetAddress: "12 St James's Square", City: "London", Country: "UK", Type: "home"}},
+		Biographies:    []*people.Biography{{Value: "First programmer.\nNotes, with commas; and semicolons. " + strings.Repeat("long ", 20)}},
+	}
+
+	var buf bytes.Buffer
+	if err := writeVCard(&buf, in); err != nil {
+		t.Fatalf("writeVCard: %v", err)
+	}
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Fatalf("unfolded line (%d octets): %q", len(line), line)
+		}
+	}
+
+	out, err := parseVCards(&buf)
+	if err != nil {
+		t.Fatalf("parseVCards: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 card, got %d", len(out))
+	}
+	p := out[0]
+	if p.Names[0].GivenName != "Ada" || p.Names[0].FamilyName != "Lovelace" || primaryName(p) != "Ada Lovelace" {
+		t.Fatalf("unexpected name: %+v", p.Names[0])
+	}
+	if len(p.EmailAddresses) != 2 || p.EmailAddresses[0].Type != "work" || p.PhoneNumbers[0].Type != "mobile" {
+		t.Fatalf("unexpected emails/phones: %+v %+v", p.EmailAddresses, p.PhoneNumbers)
+	}
+	if p.Organizations[0].Name != "Analytical Engines; Ltd" || p.Organizations[0].Title != "Programmer" {
+		t.Fatalf("unexpected org: %+v", p.Organizations[0])
+	}
+	if primaryBirthday(p) != "12-10" {
+		t.Fatalf("unexpected birthday: %q", primaryBirthday(p))
+	}
+	if p.Addresses[0].City != "London" || p.Addresses[0].StreetAddress != "12 St James's Square" {
+		t.Fatalf("unexpected address: %+v", p.Addresses[0])
+	}
+	if primaryNote(p) != strings.TrimSpace(in.Biographies[0].Value) {
+		t.Fatalf("unexpected note: %q", primaryNote(p))
+	}
+}
+
+func TestParseVCards_Variants(t *testing.T) {
+	data := "BEGIN:VCARD\nVERSION:2.1\nFN:Grace Hopper\nitem1.EMAIL;type=INTERNET:grace@example.com\nTEL;CELL:555-0100\nBDAY:1906-12-09\nEND:VCARD\n" +
+		"BEGIN:VCARD\r\nVERSION:3.0\r\nFN:Only\r\n  Name\r\nEND:VCARD\r\n"
+	cards, err := parseVCards(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("parseVCards: %v", err)
+	}
+	if len(cards) != 2 {
+		t.Fatalf("expected 2 cards, got %d", len(cards))
+	}
+	if primaryEmail(cards[0]) != "grace@example.com" || cards[0].PhoneNumbers[0].Type != "mobile" || primaryBirthday(cards[0]) != "1906-12-09" {
+		t.Fatalf("unexpected first card: %+v", cards[0])
+	}
+	if primaryName(cards[1]) != "Only Name" || contactForCreate(cards[1]).Names[0].UnstructuredName != "Only Name" {
+		t.Fatalf("unexpected second card name: %+v", cards[1].Names[0])
+	}
+}
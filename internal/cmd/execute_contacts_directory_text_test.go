@@ -61,3 +61,73 @@ func TestExecute_ContactsDirectoryList_Text(t *testing.T) {
 		t.Fatalf("unexpected out=%q", out)
 	}
 }
+
+func TestExecute_ContactsDirectorySearch_AllPagesJSON(t *testing.T) {
+	origDir := newPeopleDirectoryService
+	t.Cleanup(func() { newPeopleDirectoryService = origDir })
+
+	var sources, masks []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "people:searchDirectoryPeople") {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		sources = append(sources, strings.Join(q["sources"], "+"))
+		masks = append(masks, q.Get("readMask"))
+		resp := map[string]any{
+			"people": []map[string]any{{
+				"resourceName":  "people/d1",
+				"names":         []map[string]any{{"displayName": "Jane"}},
+				"organizations": []map[string]any{{"name": "Acme", "title": "CTO"}},
+			}},
+			"nextPageToken": "p2",
+		}
+		if q.Get("pageToken") == "p2" {
+			resp = map[string]any{
+				"people": []map[string]any{{
+					"resourceName":   "people/d2",
+					"emailAddresses": []map[string]any{{"value": "jane2@example.com"}},
+				}},
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	svc, err := people.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newPeopleDirectoryService = func(context.Context, string) (*people.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "contacts", "directory", "search", "jane",
+				"--all", "--include-contacts", "--fields", "names,emails,org"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var parsed struct {
+		People []map[string]string `json:"people"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\nout=%q", err, out)
+	}
+	if len(parsed.People) != 2 || parsed.People[0]["organization"] != "CTO, Acme" || parsed.People[1]["email"] != "jane2@example.com" {
+		t.Fatalf("unexpected people: %#v", parsed.People)
+	}
+	if len(sources) != 2 || sources[0] != directorySourceProfile+"+"+directorySourceContact {
+		t.Fatalf("unexpected sources: %#v", sources)
+	}
+	if masks[0] != "names,emailAddresses,organizations" {
+		t.Fatalf("unexpected readMask: %q", masks[0])
+	}
+}
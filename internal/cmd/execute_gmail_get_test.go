@@ -297,3 +297,82 @@ func TestExecute_GmailGet_Metadata_Text(t *testing.T) {
 		t.Fatalf("unexpected out=%q", out)
 	}
 }
+
+func TestExecute_GmailGet_Raw_OutStdout(t *testing.T) {
+	origNew := newGmailService
+	t.Cleanup(func() { newGmailService = origNew })
+
+	raw := "From: a@example.com\r\nSubject: hi\r\n\r\nbody\r\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":       "m1",
+			"threadId": "t1",
+			"raw":      base64.RawURLEncoding.EncodeToString([]byte(raw)),
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newGmailService = func(context.Context, string) (*gmail.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "get", "m1", "--format", "raw", "--out", "-"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if out != raw {
+		t.Fatalf("expected verbatim RFC822 source, got %q", out)
+	}
+
+	if err := Execute([]string{"--account", "a@b.com", "gmail", "get", "m1", "--out", "-"}); err == nil {
+		t.Fatalf("expected --out to require --format=raw")
+	}
+}
+
+func TestExecute_GmailGet_Full_SelectedHeaders(t *testing.T) {
+	origNew := newGmailService
+	t.Cleanup(func() { newGmailService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":       "m1",
+			"threadId": "t1",
+			"payload": map[string]any{
+				"headers": []map[string]any{
+					{"name": "From", "value": "Me <me@example.com>"},
+					{"name": "Subject", "value": "Hello"},
+					{"name": "X-Mailer", "value": "gog"},
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newGmailService = func(context.Context, string) (*gmail.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "get", "m1", "--headers", "subject,x-mailer"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !strings.Contains(out, "x-mailer\tgog") || !strings.Contains(out, "subject\tHello") || strings.Contains(out, "from\t") {
+		t.Fatalf("unexpected out=%q", out)
+	}
+}
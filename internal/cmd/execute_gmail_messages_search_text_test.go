@@ -175,3 +175,93 @@ func TestExecute_GmailMessagesSearch_JSON_IncludeBody(t *testing.T) {
 		t.Fatalf("expected decoded body, got: %q", out)
 	}
 }
+
+func TestExecute_GmailMessagesSearch_JSON_AllPages(t *testing.T) {
+	origNew := newGmailService
+	t.Cleanup(func() { newGmailService = origNew })
+
+	listCalls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(path, "/users/me/messages") && !strings.Contains(path, "/users/me/messages/"):
+			listCalls++
+			if r.URL.Query().Get("pageToken") == "" {
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"messages":      []map[string]any{{"id": "m1", "threadId": "t1"}},
+					"nextPageToken": "p2",
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"messages": []map[string]any{{"id": "m2", "threadId": "t2"}},
+			})
+			return
+		case strings.Contains(path, "/users/me/messages/"):
+			id := path[strings.LastIndex(path, "/")+1:]
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":       id,
+				"threadId": "t-" + id,
+				"labelIds": []string{"INBOX"},
+				"snippet":  "snippet " + id,
+				"payload": map[string]any{
+					"headers": []map[string]any{
+						{"name": "From", "value": "a@example.com"},
+						{"name": "Subject", "value": "Hi"},
+						{"name": "Date", "value": "Mon, 02 Jan 2006 15:04:05 -0700"},
+					},
+				},
+			})
+			return
+		case strings.Contains(path, "/users/me/labels"):
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"labels": []map[string]any{{"id": "INBOX", "name": "INBOX", "type": "system"}},
+			})
+			return
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newGmailService = func(context.Context, string) (*gmail.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "gmail", "messages", "search", "in:inbox", "--all", "--max", "1"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if listCalls != 2 {
+		t.Fatalf("expected 2 list calls, got %d", listCalls)
+	}
+
+	var parsed struct {
+		Messages []struct {
+			ID       string   `json:"id"`
+			Snippet  string   `json:"snippet"`
+			LabelIDs []string `json:"labelIds"`
+		} `json:"messages"`
+		NextPageToken string `json:"nextPageToken"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Messages) != 2 || parsed.Messages[1].ID != "m2" {
+		t.Fatalf("unexpected messages: %#v", parsed.Messages)
+	}
+	if parsed.Messages[0].Snippet != "snippet m1" || len(parsed.Messages[0].LabelIDs) != 1 {
+		t.Fatalf("missing snippet/labelIds: %#v", parsed.Messages[0])
+	}
+	if parsed.NextPageToken != "" {
+		t.Fatalf("expected empty nextPageToken, got %q", parsed.NextPageToken)
+	}
+}
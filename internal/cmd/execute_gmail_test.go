@@ -23,7 +23,7 @@ func TestExecute_GmailSearch_JSON(t *testing.T) {
 			// threads.list
 			w.Header().Set("Content-Type", "application/json")
 			_ = json.NewEncoder(w).Encode(map[string]any{
-				"threads":       []map[string]any{{"id": "t1"}},
+				"threads":       []map[string]any{{"id": "t1", "snippet": "Hello there"}},
 				"nextPageToken": "npt",
 			})
 			return
@@ -88,6 +88,7 @@ func TestExecute_GmailSearch_JSON(t *testing.T) {
 			From         string   `json:"from"`
 			Subject      string   `json:"subject"`
 			Labels       []string `json:"labels"`
+			Snippet      string   `json:"snippet"`
 			MessageCount int      `json:"messageCount"`
 		} `json:"threads"`
 		NextPageToken string `json:"nextPageToken"`
@@ -98,7 +99,7 @@ func TestExecute_GmailSearch_JSON(t *testing.T) {
 	if parsed.NextPageToken != "npt" || len(parsed.Threads) != 1 {
 		t.Fatalf("unexpected: %#v", parsed)
 	}
-	if parsed.Threads[0].ID != "t1" || parsed.Threads[0].Subject != "Hello" {
+	if parsed.Threads[0].ID != "t1" || parsed.Threads[0].Subject != "Hello" || parsed.Threads[0].Snippet != "Hello there" {
 		t.Fatalf("unexpected thread: %#v", parsed.Threads[0])
 	}
 	if parsed.Threads[0].MessageCount != 1 {
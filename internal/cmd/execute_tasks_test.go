@@ -7,7 +7,9 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/option"
 	"google.golang.org/api/tasks/v1"
 )
@@ -121,6 +123,73 @@ func TestExecute_TasksListsCreate_JSON(t *testing.T) {
 	}
 }
 
+func TestExecute_TasksListsRenameDelete_JSON(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/tasks/v1/users/@me/lists/l1" && r.Method == http.MethodPatch:
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["title"] != "Home chores" {
+				http.Error(w, "expected title Home chores", http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "l1", "title": "Home chores"})
+		case r.URL.Path == "/tasks/v1/users/@me/lists/l1" && r.Method == http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "tasks", "lists", "rename", "l1", "Home", "chores"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	var renamed struct {
+		Tasklist struct {
+			ID    string `json:"id"`
+			Title string `json:"title"`
+		} `json:"tasklist"`
+	}
+	if err := json.Unmarshal([]byte(out), &renamed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if renamed.Tasklist.ID != "l1" || renamed.Tasklist.Title != "Home chores" {
+		t.Fatalf("unexpected tasklist: %#v", renamed.Tasklist)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--force", "--account", "a@b.com", "tasks", "lists", "delete", "l1"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !deleted || !strings.Contains(out, `"deleted": true`) {
+		t.Fatalf("unexpected delete: deleted=%v out=%q", deleted, out)
+	}
+}
+
 func TestExecute_TasksList_JSON(t *testing.T) {
 	origNew := newTasksService
 	t.Cleanup(func() { newTasksService = origNew })
@@ -545,3 +614,332 @@ func TestExecute_TasksClear_JSON(t *testing.T) {
 		t.Fatalf("unexpected response: %#v", parsed)
 	}
 }
+
+func TestExecute_TasksEverydayFlags(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var inserted map[string]any
+	var listQuery, patched string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t9", "title": inserted["title"]})
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodGet:
+			listQuery = r.URL.RawQuery
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{}})
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks/t9" && r.Method == http.MethodPatch:
+			patched = "t9"
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t9", "status": "completed"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	run := func(args ...string) {
+		t.Helper()
+		_ = captureStdout(t, func() {
+			_ = captureStderr(t, func() {
+				if err := Execute(append([]string{"--json", "--account", "a@b.com", "tasks"}, args...)); err != nil {
+					t.Fatalf("Execute %v: %v", args, err)
+				}
+			})
+		})
+	}
+
+	run("add", "Buy", "milk", "--list", "l1", "--due", "tomorrow", "--notes", "2%")
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	wantDue := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	if inserted["title"] != "Buy milk" || inserted["due"] != wantDue || inserted["notes"] != "2%" {
+		t.Fatalf("unexpected insert: %#v (want due %s)", inserted, wantDue)
+	}
+
+	run("done", "t9", "--list", "l1")
+	if patched != "t9" {
+		t.Fatalf("expected done to patch t9")
+	}
+
+	run("list", "--list", "l1", "--show-completed", "--due-before", "2030-01-02")
+	for _, want := range []string{"showCompleted=true", "showHidden=true", "dueMax=2030-01-02T00%3A00%3A00Z"} {
+		if !strings.Contains(listQuery, want) {
+			t.Fatalf("list query %q missing %q", listQuery, want)
+		}
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "tasks", "done", "t9", "l1", "--list", "l2"}); err == nil || !strings.Contains(err.Error(), "conflicts") {
+			t.Fatalf("expected --list conflict, got %v", err)
+		}
+	})
+}
+
+func TestExecute_TasksMoveAndTree(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var moveQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks/t3/move" && r.Method == http.MethodPost:
+			moveQuery = r.URL.RawQuery
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t3", "title": "Child", "parent": "t1", "position": "00000000000000000001"})
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "t2", "title": "Second", "position": "00000000000000000002"},
+				{"id": "t3", "title": "Child", "parent": "t1", "position": "00000000000000000000"},
+				{"id": "t1", "title": "First", "position": "00000000000000000001"},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "tasks", "move", "t3", "--list", "l1", "--parent", "t1", "--after", "t4"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(moveQuery, "parent=t1") || !strings.Contains(moveQuery, "previous=t4") {
+		t.Fatalf("unexpected move query: %q", moveQuery)
+	}
+	if !strings.Contains(out, `"parent": "t1"`) {
+		t.Fatalf("unexpected move output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "tasks", "list", "l1"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	first, child, second := strings.Index(out, "First"), strings.Index(out, "  Child"), strings.Index(out, "Second")
+	if first < 0 || child < first || second < child {
+		t.Fatalf("expected tree order First, Child, Second:\n%s", out)
+	}
+}
+
+func TestExecute_TasksRecurRun_JSON(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var inserted, patched map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "t1", "title": "Trash", "status": "completed", "due": "2020-01-06T00:00:00Z", "notes": "Bins out\n\ngog-repeat: every monday"},
+				{"id": "t2", "title": "Plain", "status": "completed"},
+				{"id": "t3", "title": "Open", "status": "needsAction", "notes": "gog-repeat: every day"},
+			}})
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t9", "title": inserted["title"], "due": inserted["due"]})
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks/t1" && r.Method == http.MethodPatch:
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "tasks", "recur", "run", "--list", "l1"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var parsed struct {
+		Created []struct {
+			From string `json:"from"`
+			Due  string `json:"due"`
+		} `json:"created"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Created) != 1 || parsed.Created[0].From != "t1" {
+		t.Fatalf("unexpected result: %#v", parsed)
+	}
+	due, err := time.Parse(time.RFC3339, parsed.Created[0].Due)
+	if err != nil || due.Weekday() != time.Monday || due.Before(time.Now().AddDate(0, 0, -1)) {
+		t.Fatalf("expected an upcoming monday, got %q", parsed.Created[0].Due)
+	}
+	if inserted["title"] != "Trash" || !strings.Contains(inserted["notes"].(string), "gog-repeat: every monday") {
+		t.Fatalf("unexpected insert: %#v", inserted)
+	}
+	if patched["notes"] != "Bins out" {
+		t.Fatalf("expected rule cleared on completed task, got %#v", patched)
+	}
+}
+
+func TestExecute_TasksFromEmail_JSON(t *testing.T) {
+	origTasks, origGmail := newTasksService, newGmailService
+	t.Cleanup(func() { newTasksService, newGmailService = origTasks, origGmail })
+
+	var inserted map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/gmail/v1/users/me/messages/m1" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":       "m1",
+				"threadId": "th1",
+				"payload": map[string]any{"headers": []map[string]any{
+					{"name": "Subject", "value": "Invoice due"},
+					{"name": "From", "value": "Billing <billing@example.com>"},
+				}},
+			})
+		case r.URL.Path == "/tasks/v1/lists/@default/tasks" && r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1", "title": inserted["title"]})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	tsvc, err := tasks.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	gsvc, err := gmail.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return tsvc, nil }
+	newGmailService = func(context.Context, string) (*gmail.Service, error) { return gsvc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "tasks", "from-email", "m1"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	if inserted["title"] != "Invoice due" {
+		t.Fatalf("unexpected title: %#v", inserted)
+	}
+	notes, _ := inserted["notes"].(string)
+	if !strings.Contains(notes, "From: Billing <billing@example.com>") || !strings.Contains(notes, "#all/th1") {
+		t.Fatalf("unexpected notes: %q", notes)
+	}
+	if !strings.Contains(out, `"threadId": "th1"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestExecute_TasksBulk_JSON(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var listQuery string
+	var moved []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodGet:
+			listQuery = r.URL.RawQuery
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "t1", "title": "Late", "status": "needsAction", "due": "2020-01-01T00:00:00Z"},
+				{"id": "t2", "title": "No due", "status": "needsAction"},
+				{"id": "t3", "title": "Today", "status": "needsAction", "due": time.Now().UTC().Format("2006-01-02") + "T00:00:00Z"},
+			}})
+		case strings.HasSuffix(r.URL.Path, "/move") && r.Method == http.MethodPost:
+			moved = append(moved, r.URL.Path+"?"+r.URL.RawQuery)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "tasks", "bulk", "--list", "l1", "--overdue", "--move-to", "l2"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var parsed struct {
+		Action  string `json:"action"`
+		Matched int    `json:"matched"`
+		Changed int    `json:"changed"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Action != "move" || parsed.Matched != 1 || parsed.Changed != 1 {
+		t.Fatalf("unexpected summary: %#v", parsed)
+	}
+	if len(moved) != 1 || !strings.Contains(moved[0], "/lists/l1/tasks/t1/move") || !strings.Contains(moved[0], "destinationTasklist=l2") {
+		t.Fatalf("unexpected moves: %v", moved)
+	}
+	if !strings.Contains(listQuery, "dueMax=") || !strings.Contains(listQuery, "showCompleted=false") {
+		t.Fatalf("unexpected list query: %q", listQuery)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "tasks", "bulk", "--list", "l1", "--overdue"}); err == nil || !strings.Contains(err.Error(), "exactly one action") {
+			t.Fatalf("expected missing action error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/forms/v1"
+
+	"github.com/steipete/gogcli/internal/googleapi"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var newFormsService = googleapi.NewForms
+
+type FormsCmd struct {
+	Create    FormsCreateCmd    `cmd:"" name:"create" help:"Create a form from a YAML/JSON definition"`
+	Questions FormsQuestionsCmd `cmd:"" name:"questions" aliases:"items" help:"List, add, update and delete questions"`
+	LinkSheet FormsLinkSheetCmd `cmd:"" name:"link-sheet" help:"Show the linked response sheet, or write responses into a spreadsheet"`
+	Quiz      FormsQuizCmd      `cmd:"" name:"quiz" help:"Quiz mode, points, correct answers and feedback"`
+	Watch     FormsWatchCmd     `cmd:"" name:"watch" help:"Manage Pub/Sub watches and stream new responses"`
+}
+
+type FormsCreateCmd struct {
+	From  string `name:"from" help:"Form definition (YAML or JSON; - for stdin)" required:""`
+	Title string `name:"title" help:"Override the title from the definition"`
+}
+
+func (c *FormsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	data, err := readInputFile(strings.TrimSpace(c.From))
+	if err != nil {
+		return err
+	}
+	spec, err := parseFormSpec(data)
+	if err != nil {
+		return err
+	}
+	if title := strings.TrimSpace(c.Title); title != "" {
+		spec.Title = title
+	}
+	if spec.Title == "" {
+		return usage("form definition needs a title (or pass --title)")
+	}
+	items, err := spec.items()
+	if err != nil {
+		return err
+	}
+
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	// The API only accepts the title on create; everything else goes through
+	// batchUpdate.
+	created, err := svc.Forms.Create(&forms.Form{Info: &forms.Info{Title: spec.Title, DocumentTitle: spec.DocumentTitle}}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var reqs []*forms.Request
+	if strings.TrimSpace(spec.Description) != "" {
+		reqs = append(reqs, &forms.Request{UpdateFormInfo: &forms.UpdateFormInfoRequest{
+			Info:       &forms.Info{Description: spec.Description},
+			UpdateMask: "description",
+		}})
+	}
+	reqs = append(reqs, formCreateItemRequests(items, 0)...)
+	if len(reqs) > 0 {
+		if _, err := svc.Forms.BatchUpdate(created.FormId, &forms.BatchUpdateFormRequest{Requests: reqs}).Context(ctx).Do(); err != nil {
+			return fmt.Errorf("form %s created, but adding items failed: %w", created.FormId, err)
+		}
+	}
+
+	editURL := formEditURL(created.FormId)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"formId":       created.FormId,
+			"title":        spec.Title,
+			"items":        len(items),
+			"responderUri": created.ResponderUri,
+			"editUri":      editURL,
+		})
+	}
+	u.Out().Printf("id\t%s", created.FormId)
+	u.Out().Printf("title\t%s", spec.Title)
+	u.Out().Printf("items\t%d", len(items))
+	u.Out().Printf("responder_url\t%s", created.ResponderUri)
+	u.Out().Printf("edit_url\t%s", editURL)
+	return nil
+}
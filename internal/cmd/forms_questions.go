@@ -0,0 +1,493 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	"google.golang.org/api/forms/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type FormsQuestionsCmd struct {
+	List   FormsQuestionsListCmd   `cmd:"" name:"list" aliases:"ls" help:"List form items"`
+	Add    FormsQuestionsAddCmd    `cmd:"" name:"add" help:"Add a question"`
+	Update FormsQuestionsUpdateCmd `cmd:"" name:"update" help:"Update a question"`
+	Delete FormsQuestionsDeleteCmd `cmd:"" name:"delete" aliases:"rm" help:"Delete a question"`
+}
+
+// formQuestionFlags describes one question; add uses all of them, update only
+// the ones given on the command line.
+type formQuestionFlags struct {
+	Title       string   `name:"title" help:"Question title"`
+	Description string   `name:"description" help:"Help text shown under the title"`
+	Required    bool     `name:"required" help:"Require an answer"`
+	Options     []string `name:"option" sep:"none" help:"Choice option (repeatable; radio|checkbox|dropdown)"`
+	Other       bool     `name:"other" help:"Add an \"Other\" option (radio|checkbox)"`
+	Shuffle     bool     `name:"shuffle" help:"Shuffle options (or grid rows)"`
+	Low         int64    `name:"low" help:"Scale minimum (0 or 1)" default:"1"`
+	High        int64    `name:"high" help:"Scale maximum (2-10)" default:"5"`
+	LowLabel    string   `name:"low-label" help:"Scale label for the minimum"`
+	HighLabel   string   `name:"high-label" help:"Scale label for the maximum"`
+	Rows        []string `name:"row" sep:"none" help:"Grid row (repeatable)"`
+	Columns     []string `name:"column" sep:"none" help:"Grid column (repeatable)"`
+}
+
+type formItemSummary struct {
+	Index    int      `json:"index"`
+	ItemID   string   `json:"itemId"`
+	Type     string   `json:"type"`
+	Title    string   `json:"title,omitempty"`
+	Required bool     `json:"required,omitempty"`
+	Options  []string `json:"options,omitempty"`
+	Rows     []string `json:"rows,omitempty"`
+}
+
+type FormsQuestionsListCmd struct {
+	FormID string `arg:"" name:"formId" help:"Form ID"`
+}
+
+func (c *FormsQuestionsListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	form, err := svc.Forms.Get(formID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	items := make([]formItemSummary, 0, len(form.Items))
+	for i, item := range form.Items {
+		items = append(items, summarizeFormItem(i+1, item))
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"formId": form.FormId, "items": items})
+	}
+	if len(items) == 0 {
+		u.Err().Println("No items")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "#\tITEM_ID\tTYPE\tREQUIRED\tTITLE")
+	for _, item := range items {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%t\t%s\n", item.Index, item.ItemID, item.Type, item.Required, item.Title)
+	}
+	return nil
+}
+
+type FormsQuestionsAddCmd struct {
+	FormID string            `arg:"" name:"formId" help:"Form ID"`
+	Type   string            `name:"type" help:"Question type: text|paragraph|radio|checkbox|dropdown|scale|date|time|grid|checkbox_grid" default:"text"`
+	At     int               `name:"at" help:"Insert as item N (1-based; default: append)"`
+	Flags  formQuestionFlags `embed:""`
+}
+
+func (c *FormsQuestionsAddCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+	if c.At < 0 {
+		return usage("--at must be >= 1")
+	}
+	q := c.Flags
+	low, high := q.Low, q.High
+	item, err := formItemSpec{
+		Title:       q.Title,
+		Description: q.Description,
+		Type:        c.Type,
+		Required:    q.Required,
+		Options:     q.Options,
+		Other:       q.Other,
+		Shuffle:     q.Shuffle,
+		Low:         &low,
+		High:        &high,
+		LowLabel:    q.LowLabel,
+		HighLabel:   q.HighLabel,
+		Rows:        q.Rows,
+		Columns:     q.Columns,
+	}.item()
+	if err != nil {
+		return err
+	}
+
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	form, err := svc.Forms.Get(formID).Fields("items(itemId)").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	index := int64(len(form.Items))
+	if c.At > 0 && int64(c.At-1) < index {
+		index = int64(c.At - 1)
+	}
+	resp, err := svc.Forms.BatchUpdate(formID, &forms.BatchUpdateFormRequest{
+		Requests: formCreateItemRequests([]*forms.Item{item}, index),
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	itemID := ""
+	if len(resp.Replies) > 0 && resp.Replies[0].CreateItem != nil {
+		itemID = resp.Replies[0].CreateItem.ItemId
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"formId": formID, "itemId": itemID, "index": index + 1})
+	}
+	u.Out().Printf("item_id\t%s", itemID)
+	u.Out().Printf("index\t%d", index+1)
+	return nil
+}
+
+type FormsQuestionsUpdateCmd struct {
+	FormID string            `arg:"" name:"formId" help:"Form ID"`
+	ItemID string            `arg:"" name:"itemId" help:"Item ID (see forms questions list)"`
+	Flags  formQuestionFlags `embed:""`
+}
+
+func (c *FormsQuestionsUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID, itemID := strings.TrimSpace(c.FormID), strings.TrimSpace(c.ItemID)
+	if formID == "" || itemID == "" {
+		return usage("empty formId or itemId")
+	}
+
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	form, err := svc.Forms.Get(formID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	index, item := findFormItem(form, itemID)
+	if item == nil {
+		return usagef("item %s not found in form %s", itemID, formID)
+	}
+	mask, err := applyFormQuestionFlags(kctx, item, c.Flags)
+	if err != nil {
+		return err
+	}
+	if len(mask) == 0 {
+		return usage("nothing to update (pass --title, --required, --option, ...)")
+	}
+
+	loc := &forms.Location{Index: int64(index)}
+	loc.ForceSendFields = []string{"Index"}
+	if _, err := svc.Forms.BatchUpdate(formID, &forms.BatchUpdateFormRequest{Requests: []*forms.Request{{
+		UpdateItem: &forms.UpdateItemRequest{Item: item, Location: loc, UpdateMask: strings.Join(mask, ",")},
+	}}}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"formId": formID, "itemId": itemID, "updated": mask})
+	}
+	u.Out().Printf("item_id\t%s", itemID)
+	u.Out().Printf("updated\t%s", strings.Join(mask, ","))
+	return nil
+}
+
+type FormsQuestionsDeleteCmd struct {
+	FormID string `arg:"" name:"formId" help:"Form ID"`
+	ItemID string `arg:"" name:"itemId" help:"Item ID (see forms questions list)"`
+}
+
+func (c *FormsQuestionsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID, itemID := strings.TrimSpace(c.FormID), strings.TrimSpace(c.ItemID)
+	if formID == "" || itemID == "" {
+		return usage("empty formId or itemId")
+	}
+
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	form, err := svc.Forms.Get(formID).Fields("items(itemId,title)").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	index, item := findFormItem(form, itemID)
+	if item == nil {
+		return usagef("item %s not found in form %s", itemID, formID)
+	}
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete item %q (%s) from form %s", item.Title, itemID, formID)); confirmErr != nil {
+		return confirmErr
+	}
+	loc := &forms.Location{Index: int64(index)}
+	loc.ForceSendFields = []string{"Index"}
+	if _, err := svc.Forms.BatchUpdate(formID, &forms.BatchUpdateFormRequest{Requests: []*forms.Request{{
+		DeleteItem: &forms.DeleteItemRequest{Location: loc},
+	}}}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"formId": formID, "deleted": itemID})
+	}
+	u.Out().Printf("deleted\t%s", itemID)
+	return nil
+}
+
+func findFormItem(form *forms.Form, itemID string) (int, *forms.Item) {
+	for i, item := range form.Items {
+		if item != nil && item.ItemId == itemID {
+			return i, item
+		}
+	}
+	return -1, nil
+}
+
+// applyFormQuestionFlags changes the fields given on the command line and
+// returns the matching update mask paths.
+func applyFormQuestionFlags(kctx *kong.Context, item *forms.Item, q formQuestionFlags) ([]string, error) {
+	var mask []string
+	if flagProvided(kctx, "title") {
+		item.Title = strings.TrimSpace(q.Title)
+		mask = append(mask, "title")
+	}
+	if flagProvided(kctx, "description") {
+		item.Description = q.Description
+		mask = append(mask, "description")
+	}
+
+	var question *forms.Question
+	if item.QuestionItem != nil {
+		question = item.QuestionItem.Question
+	}
+	group := item.QuestionGroupItem
+	kind := formItemKind(item)
+	wrongKind := func(flag string) error {
+		return usagef("--%s does not apply to %s items", flag, kind)
+	}
+
+	if flagProvided(kctx, "required") {
+		switch {
+		case question != nil:
+			question.Required = q.Required
+			question.ForceSendFields = append(question.ForceSendFields, "Required")
+			mask = append(mask, "questionItem.question.required")
+		case group != nil:
+			for _, row := range group.Questions {
+				row.Required = q.Required
+				row.ForceSendFields = append(row.ForceSendFields, "Required")
+			}
+			mask = append(mask, "questionGroupItem.questions")
+		default:
+			return nil, wrongKind("required")
+		}
+	}
+
+	if flagProvidedAny(kctx, "option", "other", "shuffle") {
+		switch {
+		case question != nil && question.ChoiceQuestion != nil:
+			choice := question.ChoiceQuestion
+			allowOther := choice.Type != "DROP_DOWN"
+			values := q.Options
+			if !flagProvided(kctx, "option") {
+				values = nil
+				for _, opt := range choice.Options {
+					if !opt.IsOther {
+						values = append(values, opt.Value)
+					}
+				}
+			}
+			other := formChoiceHasOther(choice)
+			if flagProvided(kctx, "other") {
+				other = q.Other
+			}
+			choice.Options = formOptions(values, other && allowOther)
+			if flagProvided(kctx, "shuffle") {
+				choice.Shuffle = q.Shuffle
+				choice.ForceSendFields = append(choice.ForceSendFields, "Shuffle")
+			}
+			mask = append(mask, "questionItem.question.choiceQuestion")
+		case group != nil && group.Grid != nil && !flagProvidedAny(kctx, "option", "other"):
+			group.Grid.ShuffleQuestions = q.Shuffle
+			group.Grid.ForceSendFields = append(group.Grid.ForceSendFields, "ShuffleQuestions")
+			mask = append(mask, "questionGroupItem.grid")
+		default:
+			return nil, wrongKind("option")
+		}
+	}
+
+	if flagProvidedAny(kctx, "low", "high", "low-label", "high-label") {
+		if question == nil || question.ScaleQuestion == nil {
+			return nil, wrongKind("low/--high")
+		}
+		scale := question.ScaleQuestion
+		if flagProvided(kctx, "low") {
+			scale.Low = q.Low
+		}
+		if flagProvided(kctx, "high") {
+			scale.High = q.High
+		}
+		if flagProvided(kctx, "low-label") {
+			scale.LowLabel = q.LowLabel
+		}
+		if flagProvided(kctx, "high-label") {
+			scale.HighLabel = q.HighLabel
+		}
+		if scale.Low < 0 || scale.Low > 1 || scale.High < 2 || scale.High > 10 {
+			return nil, usagef("scale needs low 0..1 and high 2..10 (got %d..%d)", scale.Low, scale.High)
+		}
+		scale.ForceSendFields = append(scale.ForceSendFields, "Low")
+		mask = append(mask, "questionItem.question.scaleQuestion")
+	}
+
+	if flagProvidedAny(kctx, "row", "column") {
+		if group == nil || group.Grid == nil {
+			return nil, wrongKind("row/--column")
+		}
+		if flagProvided(kctx, "row") {
+			// Keep question IDs for rows that survive so existing answers stay linked.
+			existing := map[string]*forms.Question{}
+			for _, row := range group.Questions {
+				if row.RowQuestion != nil {
+					existing[row.RowQuestion.Title] = row
+				}
+			}
+			required := len(group.Questions) > 0 && group.Questions[0].Required
+			rows := make([]*forms.Question, 0, len(q.Rows))
+			for _, title := range q.Rows {
+				if prev, ok := existing[title]; ok {
+					rows = append(rows, prev)
+					continue
+				}
+				rows = append(rows, &forms.Question{Required: required, RowQuestion: &forms.RowQuestion{Title: title}})
+			}
+			group.Questions = rows
+			if !slices.Contains(mask, "questionGroupItem.questions") {
+				mask = append(mask, "questionGroupItem.questions")
+			}
+		}
+		if flagProvided(kctx, "column") {
+			group.Grid.Columns.Options = formOptions(q.Columns, false)
+			if !slices.Contains(mask, "questionGroupItem.grid") {
+				mask = append(mask, "questionGroupItem.grid")
+			}
+		}
+	}
+	return mask, nil
+}
+
+func formChoiceHasOther(choice *forms.ChoiceQuestion) bool {
+	for _, opt := range choice.Options {
+		if opt.IsOther {
+			return true
+		}
+	}
+	return false
+}
+
+func formItemKind(item *forms.Item) string {
+	switch {
+	case item.QuestionItem != nil && item.QuestionItem.Question != nil:
+		q := item.QuestionItem.Question
+		switch {
+		case q.ChoiceQuestion != nil:
+			switch q.ChoiceQuestion.Type {
+			case "CHECKBOX":
+				return "checkbox"
+			case "DROP_DOWN":
+				return "dropdown"
+			default:
+				return "radio"
+			}
+		case q.TextQuestion != nil:
+			if q.TextQuestion.Paragraph {
+				return "paragraph"
+			}
+			return "text"
+		case q.ScaleQuestion != nil:
+			return "scale"
+		case q.DateQuestion != nil:
+			return "date"
+		case q.TimeQuestion != nil:
+			return "time"
+		case q.RatingQuestion != nil:
+			return "rating"
+		case q.FileUploadQuestion != nil:
+			return "file_upload"
+		}
+		return "question"
+	case item.QuestionGroupItem != nil:
+		if g := item.QuestionGroupItem.Grid; g != nil && g.Columns != nil && g.Columns.Type == "CHECKBOX" {
+			return "checkbox_grid"
+		}
+		return "grid"
+	case item.PageBreakItem != nil:
+		return "section"
+	case item.TextItem != nil:
+		return "header"
+	case item.ImageItem != nil:
+		return "image"
+	case item.VideoItem != nil:
+		return "video"
+	}
+	return "unknown"
+}
+
+func summarizeFormItem(index int, item *forms.Item) formItemSummary {
+	s := formItemSummary{Index: index, ItemID: item.ItemId, Type: formItemKind(item), Title: item.Title}
+	if item.QuestionItem != nil && item.QuestionItem.Question != nil {
+		q := item.QuestionItem.Question
+		s.Required = q.Required
+		if q.ChoiceQuestion != nil {
+			for _, opt := range q.ChoiceQuestion.Options {
+				if opt.IsOther {
+					s.Options = append(s.Options, "(other)")
+					continue
+				}
+				s.Options = append(s.Options, opt.Value)
+			}
+		}
+	}
+	if g := item.QuestionGroupItem; g != nil {
+		for _, row := range g.Questions {
+			s.Required = s.Required || row.Required
+			if row.RowQuestion != nil {
+				s.Rows = append(s.Rows, row.RowQuestion.Title)
+			}
+		}
+		if g.Grid != nil && g.Grid.Columns != nil {
+			for _, opt := range g.Grid.Columns.Options {
+				s.Options = append(s.Options, opt.Value)
+			}
+		}
+	}
+	return s
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/forms/v1"
+	"google.golang.org/api/option"
+)
+
+func TestExecute_FormsQuestions(t *testing.T) {
+	origForms := newFormsService
+	t.Cleanup(func() { newFormsService = origForms })
+
+	form := map[string]any{
+		"formId": "form1",
+		"items": []map[string]any{
+			{"itemId": "i1", "title": "Name", "questionItem": map[string]any{"question": map[string]any{"questionId": "q1", "textQuestion": map[string]any{}}}},
+			{"itemId": "i2", "title": "Team", "questionItem": map[string]any{"question": map[string]any{
+				"questionId": "q2",
+				"choiceQuestion": map[string]any{"type": "RADIO", "options": []map[string]any{
+					{"value": "Ops"}, {"isOther": true},
+				}},
+			}}},
+			{"itemId": "i3", "title": "Tools", "questionGroupItem": map[string]any{
+				"grid": map[string]any{"columns": map[string]any{"type": "RADIO", "options": []map[string]any{{"value": "Bad"}, {"value": "Good"}}}},
+				"questions": []map[string]any{
+					{"questionId": "r1", "rowQuestion": map[string]any{"title": "Chat"}},
+					{"questionId": "r2", "rowQuestion": map[string]any{"title": "Docs"}},
+				},
+			}},
+		},
+	}
+	var batches []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/forms/form1":
+			_ = json.NewEncoder(w).Encode(form)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/forms/form1:batchUpdate":
+			data, _ := io.ReadAll(r.Body)
+			batches = append(batches, string(data))
+			_ = json.NewEncoder(w).Encode(map[string]any{"replies": []map[string]any{{"createItem": map[string]any{"itemId": "new1"}}}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := forms.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newFormsService = func(context.Context, string) (*forms.Service, error) { return svc, nil }
+
+	run := func(args ...string) string {
+		t.Helper()
+		return captureStdout(t, func() {
+			if err := Execute(append([]string{"--json", "--force", "--account", "a@b.com", "forms", "questions"}, args...)); err != nil {
+				t.Fatalf("%v: %v", args, err)
+			}
+		})
+	}
+
+	out := run("list", "form1")
+	var listed struct {
+		Items []formItemSummary `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(out), &listed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(listed.Items) != 3 || listed.Items[1].Type != "radio" || strings.Join(listed.Items[1].Options, ",") != "Ops,(other)" || listed.Items[2].Type != "grid" {
+		t.Fatalf("unexpected list: %#v", listed.Items)
+	}
+
+	out = run("add", "form1", "--type", "scale", "--title", "Mood", "--high", "10", "--at", "1")
+	if !strings.Contains(out, `"itemId": "new1"`) {
+		t.Fatalf("unexpected add output: %q", out)
+	}
+	var add forms.BatchUpdateFormRequest
+	_ = json.Unmarshal([]byte(batches[0]), &add)
+	if ci := add.Requests[0].CreateItem; ci.Location.Index != 0 || ci.Item.QuestionItem.Question.ScaleQuestion.High != 10 || !strings.Contains(batches[0], `"index":0`) {
+		t.Fatalf("unexpected add request: %s", batches[0])
+	}
+
+	run("update", "form1", "i2", "--option", "Ops", "--option", "Eng, EMEA", "--required")
+	var upd forms.BatchUpdateFormRequest
+	_ = json.Unmarshal([]byte(batches[1]), &upd)
+	ui := upd.Requests[0].UpdateItem
+	if ui.Location.Index != 1 || ui.UpdateMask != "questionItem.question.required,questionItem.question.choiceQuestion" {
+		t.Fatalf("unexpected update request: %s", batches[1])
+	}
+	if opts := ui.Item.QuestionItem.Question.ChoiceQuestion.Options; len(opts) != 3 || opts[1].Value != "Eng, EMEA" || !opts[2].IsOther {
+		t.Fatalf("unexpected options: %s", batches[1])
+	}
+
+	run("update", "form1", "i3", "--row", "Docs", "--row", "Mail")
+	var grid forms.BatchUpdateFormRequest
+	_ = json.Unmarshal([]byte(batches[2]), &grid)
+	rows := grid.Requests[0].UpdateItem.Item.QuestionGroupItem.Questions
+	if len(rows) != 2 || rows[0].QuestionId != "r2" || rows[1].QuestionId != "" || rows[1].RowQuestion.Title != "Mail" {
+		t.Fatalf("unexpected grid update: %s", batches[2])
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "forms", "questions", "update", "form1", "i1", "--low", "0"}); err == nil {
+			t.Fatalf("expected error updating scale fields on a text question")
+		}
+	})
+
+	run("delete", "form1", "i3")
+	if !strings.Contains(batches[3], `"deleteItem":{"location":{"index":2}}`) {
+		t.Fatalf("unexpected delete request: %s", batches[3])
+	}
+}
@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/forms/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type FormsQuizCmd struct {
+	Enable  FormsQuizEnableCmd  `cmd:"" name:"enable" help:"Turn a form into a quiz and set points, answers and feedback"`
+	Disable FormsQuizDisableCmd `cmd:"" name:"disable" help:"Turn quiz mode off"`
+}
+
+type FormsQuizEnableCmd struct {
+	FormID        string   `arg:"" name:"formId" help:"Form ID"`
+	Points        []string `name:"points" sep:"none" help:"Point value as QUESTION=N (repeatable)"`
+	Answers       []string `name:"answer" sep:"none" help:"Correct answer as QUESTION=VALUE (repeatable; repeat for several correct answers; A/B/C... pick choice options by position)"`
+	FeedbackRight []string `name:"feedback-right" sep:"none" help:"Feedback for correct answers as QUESTION=TEXT (repeatable)"`
+	FeedbackWrong []string `name:"feedback-wrong" sep:"none" help:"Feedback for wrong answers as QUESTION=TEXT (repeatable)"`
+}
+
+type formGradingSummary struct {
+	ItemID  string   `json:"itemId"`
+	Title   string   `json:"title,omitempty"`
+	Points  int64    `json:"points"`
+	Answers []string `json:"answers,omitempty"`
+}
+
+func (c *FormsQuizEnableCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	form, err := svc.Forms.Get(formID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	// Start from each question's current grading so only the given parts change.
+	gradings := map[int]*forms.Grading{}
+	grading := func(key string) (int, *forms.Grading, error) {
+		index, err := resolveFormQuestion(form, key)
+		if err != nil {
+			return 0, nil, err
+		}
+		if g, ok := gradings[index]; ok {
+			return index, g, nil
+		}
+		g := &forms.Grading{}
+		if existing := form.Items[index].QuestionItem.Question.Grading; existing != nil {
+			copied := *existing
+			g = &copied
+		}
+		g.ForceSendFields = []string{"PointValue"}
+		gradings[index] = g
+		return index, g, nil
+	}
+
+	for _, entry := range c.Points {
+		key, value, err := splitFormQuizEntry("--points", entry)
+		if err != nil {
+			return err
+		}
+		points, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || points < 0 {
+			return usagef("invalid --points %q (expected QUESTION=N)", entry)
+		}
+		_, g, err := grading(key)
+		if err != nil {
+			return err
+		}
+		g.PointValue = points
+	}
+	answered := map[int]bool{}
+	for _, entry := range c.Answers {
+		key, value, err := splitFormQuizEntry("--answer", entry)
+		if err != nil {
+			return err
+		}
+		index, g, err := grading(key)
+		if err != nil {
+			return err
+		}
+		value, err = formQuizAnswerValue(form.Items[index], value)
+		if err != nil {
+			return err
+		}
+		// The first --answer for a question replaces its existing answers.
+		if !answered[index] {
+			g.CorrectAnswers = &forms.CorrectAnswers{}
+			answered[index] = true
+		}
+		g.CorrectAnswers.Answers = append(g.CorrectAnswers.Answers, &forms.CorrectAnswer{Value: value})
+	}
+	for _, fb := range []struct {
+		flag    string
+		entries []string
+		set     func(*forms.Grading, *forms.Feedback)
+	}{
+		{"--feedback-right", c.FeedbackRight, func(g *forms.Grading, f *forms.Feedback) { g.WhenRight = f }},
+		{"--feedback-wrong", c.FeedbackWrong, func(g *forms.Grading, f *forms.Feedback) { g.WhenWrong = f }},
+	} {
+		for _, entry := range fb.entries {
+			key, value, err := splitFormQuizEntry(fb.flag, entry)
+			if err != nil {
+				return err
+			}
+			_, g, err := grading(key)
+			if err != nil {
+				return err
+			}
+			fb.set(g, &forms.Feedback{Text: value})
+		}
+	}
+
+	settings := &forms.FormSettings{QuizSettings: &forms.QuizSettings{IsQuiz: true}}
+	reqs := []*forms.Request{{UpdateSettings: &forms.UpdateSettingsRequest{Settings: settings, UpdateMask: "quizSettings.isQuiz"}}}
+	indexes := slices.Sorted(maps.Keys(gradings))
+	graded := make([]formGradingSummary, 0, len(indexes))
+	for _, index := range indexes {
+		item := form.Items[index]
+		item.QuestionItem.Question.Grading = gradings[index]
+		loc := &forms.Location{Index: int64(index)}
+		loc.ForceSendFields = []string{"Index"}
+		reqs = append(reqs, &forms.Request{UpdateItem: &forms.UpdateItemRequest{
+			Item:       item,
+			Location:   loc,
+			UpdateMask: "questionItem.question.grading",
+		}})
+		graded = append(graded, summarizeFormGrading(item))
+	}
+	if _, err := svc.Forms.BatchUpdate(formID, &forms.BatchUpdateFormRequest{Requests: reqs}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"formId": formID, "isQuiz": true, "graded": graded})
+	}
+	u.Out().Printf("quiz\ttrue")
+	if len(graded) == 0 {
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ITEM\tPOINTS\tANSWERS\tTITLE")
+	for _, g := range graded {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", g.ItemID, g.Points, strings.Join(g.Answers, " | "), g.Title)
+	}
+	return nil
+}
+
+type FormsQuizDisableCmd struct {
+	FormID string `arg:"" name:"formId" help:"Form ID"`
+}
+
+func (c *FormsQuizDisableCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	quiz := &forms.QuizSettings{IsQuiz: false}
+	quiz.ForceSendFields = []string{"IsQuiz"}
+	if _, err := svc.Forms.BatchUpdate(formID, &forms.BatchUpdateFormRequest{Requests: []*forms.Request{{
+		UpdateSettings: &forms.UpdateSettingsRequest{Settings: &forms.FormSettings{QuizSettings: quiz}, UpdateMask: "quizSettings.isQuiz"},
+	}}}).Context(ctx).Do(); err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"formId": formID, "isQuiz": false})
+	}
+	u.Out().Printf("quiz\tfalse")
+	return nil
+}
+
+func splitFormQuizEntry(flag, entry string) (string, string, error) {
+	key, value, ok := strings.Cut(entry, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return "", "", usagef("invalid %s %q (expected QUESTION=VALUE)", flag, entry)
+	}
+	return key, strings.TrimSpace(value), nil
+}
+
+// resolveFormQuestion finds a gradable question by item ID, question ID,
+// position (q1, q2, ... counting questions only) or title (case-insensitive).
+func resolveFormQuestion(form *forms.Form, key string) (int, error) {
+	var questions []int
+	for i, item := range form.Items {
+		if item != nil && item.QuestionItem != nil && item.QuestionItem.Question != nil {
+			questions = append(questions, i)
+		}
+	}
+	for _, i := range questions {
+		if item := form.Items[i]; item.ItemId == key || item.QuestionItem.Question.QuestionId == key {
+			return i, nil
+		}
+	}
+	if rest, ok := strings.CutPrefix(strings.ToLower(key), "q"); ok {
+		if n, err := strconv.Atoi(rest); err == nil {
+			if n < 1 || n > len(questions) {
+				return 0, usagef("question %s out of range (form has %d questions)", key, len(questions))
+			}
+			return questions[n-1], nil
+		}
+	}
+	match := -1
+	for _, i := range questions {
+		if strings.EqualFold(strings.TrimSpace(form.Items[i].Title), key) {
+			if match >= 0 {
+				return 0, usagef("question title %q is ambiguous; use the item ID", key)
+			}
+			match = i
+		}
+	}
+	if match < 0 {
+		if slices.ContainsFunc(form.Items, func(item *forms.Item) bool { return item != nil && item.ItemId == key }) {
+			return 0, usagef("item %s is not a gradable question", key)
+		}
+		return 0, usagef("question %q not found (use an item ID, question ID, q<N> or title)", key)
+	}
+	return match, nil
+}
+
+// formQuizAnswerValue checks a correct answer against the choice options. A
+// single letter that isn't itself an option picks the option at that position.
+func formQuizAnswerValue(item *forms.Item, value string) (string, error) {
+	choice := item.QuestionItem.Question.ChoiceQuestion
+	if choice == nil {
+		if value == "" {
+			return "", usagef("question %q: empty answer", item.Title)
+		}
+		return value, nil
+	}
+	var options []string
+	for _, opt := range choice.Options {
+		if opt != nil && !opt.IsOther {
+			options = append(options, opt.Value)
+		}
+	}
+	if slices.Contains(options, value) {
+		return value, nil
+	}
+	if len(value) == 1 {
+		if n := int(strings.ToUpper(value)[0] - 'A'); n >= 0 && n < len(options) {
+			return options[n], nil
+		}
+	}
+	return "", usagef("question %q: answer %q is not one of the options (%s)", item.Title, value, strings.Join(options, ", "))
+}
+
+func summarizeFormGrading(item *forms.Item) formGradingSummary {
+	s := formGradingSummary{ItemID: item.ItemId, Title: item.Title}
+	if g := item.QuestionItem.Question.Grading; g != nil {
+		s.Points = g.PointValue
+		if g.CorrectAnswers != nil {
+			for _, a := range g.CorrectAnswers.Answers {
+				s.Answers = append(s.Answers, a.Value)
+			}
+		}
+	}
+	return s
+}
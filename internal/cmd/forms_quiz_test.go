@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/forms/v1"
+)
+
+func TestExecute_FormsQuizEnable(t *testing.T) {
+	origForms := newFormsService
+	t.Cleanup(func() { newFormsService = origForms })
+
+	form := map[string]any{
+		"formId": "form1",
+		"items": []map[string]any{
+			{"itemId": "h1", "title": "Intro", "textItem": map[string]any{}},
+			{"itemId": "i1", "title": "Capital of France", "questionItem": map[string]any{"question": map[string]any{
+				"questionId": "q-a",
+				"choiceQuestion": map[string]any{"type": "RADIO", "options": []map[string]any{
+					{"value": "Berlin"}, {"value": "Paris"}, {"isOther": true},
+				}},
+			}}},
+			{"itemId": "i2", "title": "Largest planet", "questionItem": map[string]any{"question": map[string]any{
+				"questionId":   "q-b",
+				"textQuestion": map[string]any{},
+				"grading":      map[string]any{"pointValue": 2, "whenWrong": map[string]any{"text": "Hint: gas giant"}},
+			}}},
+		},
+	}
+	var batch forms.BatchUpdateFormRequest
+	svc := newTestFormsService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/forms/form1":
+			_ = json.NewEncoder(w).Encode(form)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/forms/form1:batchUpdate":
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	newFormsService = func(context.Context, string) (*forms.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "forms", "quiz", "enable", "form1",
+			"--points", "q1=5", "--answer", "q1=B",
+			"--answer", "largest planet=Jupiter",
+		}); err != nil {
+			t.Fatalf("enable: %v", err)
+		}
+	})
+
+	if len(batch.Requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(batch.Requests))
+	}
+	if s := batch.Requests[0].UpdateSettings; s == nil || !s.Settings.QuizSettings.IsQuiz || s.UpdateMask != "quizSettings.isQuiz" {
+		t.Fatalf("unexpected settings request: %#v", batch.Requests[0])
+	}
+	first := batch.Requests[1].UpdateItem
+	if first == nil || first.Location.Index != 1 || first.UpdateMask != "questionItem.question.grading" {
+		t.Fatalf("unexpected first update: %#v", first)
+	}
+	g := first.Item.QuestionItem.Question.Grading
+	if g.PointValue != 5 || len(g.CorrectAnswers.Answers) != 1 || g.CorrectAnswers.Answers[0].Value != "Paris" {
+		t.Fatalf("unexpected grading: %#v", g)
+	}
+	g = batch.Requests[2].UpdateItem.Item.QuestionItem.Question.Grading
+	if g.PointValue != 2 || g.CorrectAnswers.Answers[0].Value != "Jupiter" || g.WhenWrong == nil || g.WhenWrong.Text != "Hint: gas giant" {
+		t.Fatalf("existing grading not kept: %#v", g)
+	}
+
+	var parsed struct {
+		IsQuiz bool                 `json:"isQuiz"`
+		Graded []formGradingSummary `json:"graded"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !parsed.IsQuiz || len(parsed.Graded) != 2 || parsed.Graded[0].ItemID != "i1" || parsed.Graded[0].Points != 5 {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "forms", "quiz", "enable", "form1", "--answer", "q1=Rome"}); err == nil {
+			t.Fatalf("expected error for unknown option")
+		}
+		if err := Execute([]string{"--account", "a@b.com", "forms", "quiz", "enable", "form1", "--points", "q3=1"}); err == nil {
+			t.Fatalf("expected error for out-of-range question")
+		}
+	})
+}
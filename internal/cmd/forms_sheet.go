@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/forms/v1"
+	"google.golang.org/api/sheets/v4"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// FormsLinkSheetCmd fills a spreadsheet tab with a form's responses. The Forms
+// API exposes the native response destination (linkedSheetId) read-only, so
+// this writes the responses itself; re-run it (or call it from `forms watch
+// serve`) to refresh.
+type FormsLinkSheetCmd struct {
+	FormID      string `arg:"" name:"formId" help:"Form ID"`
+	Spreadsheet string `name:"spreadsheet" help:"Write responses into this spreadsheet ID"`
+	Create      bool   `name:"create" help:"Create a new spreadsheet for the responses"`
+	Sheet       string `name:"sheet" help:"Tab name (created if missing; its contents are replaced)" default:"Form Responses"`
+}
+
+func (c *FormsLinkSheetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+	spreadsheetID := strings.TrimSpace(c.Spreadsheet)
+	if spreadsheetID != "" && c.Create {
+		return usage("use either --spreadsheet or --create")
+	}
+	tab := strings.TrimSpace(c.Sheet)
+	if tab == "" {
+		return usage("empty --sheet")
+	}
+
+	fsvc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	form, err := fsvc.Forms.Get(formID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if spreadsheetID == "" && !c.Create {
+		if form.LinkedSheetId == "" {
+			return usage("form has no linked sheet; pass --spreadsheet <id> or --create")
+		}
+		url := spreadsheetURL(form.LinkedSheetId)
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, map[string]any{"formId": formID, "spreadsheetId": form.LinkedSheetId, "spreadsheetUrl": url, "linked": true})
+		}
+		u.Out().Printf("spreadsheet_id\t%s", form.LinkedSheetId)
+		u.Out().Printf("url\t%s", url)
+		return nil
+	}
+
+	var responses []*forms.FormResponse
+	for page := ""; ; {
+		resp, listErr := fsvc.Forms.Responses.List(formID).PageToken(page).Context(ctx).Do()
+		if listErr != nil {
+			return listErr
+		}
+		responses = append(responses, resp.Responses...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		page = resp.NextPageToken
+	}
+	rows := formResponseRows(form, responses)
+
+	ssvc, err := newSheetsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if c.Create {
+		title := "Form responses"
+		if form.Info != nil && strings.TrimSpace(form.Info.Title) != "" {
+			title = form.Info.Title + " (Responses)"
+		}
+		created, createErr := ssvc.Spreadsheets.Create(&sheets.Spreadsheet{
+			Properties: &sheets.SpreadsheetProperties{Title: title},
+			Sheets:     []*sheets.Sheet{{Properties: &sheets.SheetProperties{Title: tab}}},
+		}).Context(ctx).Do()
+		if createErr != nil {
+			return createErr
+		}
+		spreadsheetID = created.SpreadsheetId
+	} else {
+		existing, getErr := ssvc.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties.title").Context(ctx).Do()
+		if getErr != nil {
+			return getErr
+		}
+		found := false
+		for _, sh := range existing.Sheets {
+			if sh.Properties != nil && sh.Properties.Title == tab {
+				found = true
+				break
+			}
+		}
+		if found {
+			if _, clearErr := ssvc.Spreadsheets.Values.Clear(spreadsheetID, quoteSheetName(tab), &sheets.ClearValuesRequest{}).Context(ctx).Do(); clearErr != nil {
+				return clearErr
+			}
+		} else if _, addErr := ssvc.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{Requests: []*sheets.Request{{
+			AddSheet: &sheets.AddSheetRequest{Properties: &sheets.SheetProperties{Title: tab}},
+		}}}).Context(ctx).Do(); addErr != nil {
+			return addErr
+		}
+	}
+
+	if _, err := ssvc.Spreadsheets.Values.Update(spreadsheetID, quoteSheetName(tab)+"!A1", &sheets.ValueRange{Values: rows}).
+		ValueInputOption("RAW").Context(ctx).Do(); err != nil {
+		return fmt.Errorf("write responses to %s: %w", spreadsheetID, err)
+	}
+
+	url := spreadsheetURL(spreadsheetID)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"formId":         formID,
+			"spreadsheetId":  spreadsheetID,
+			"sheet":          tab,
+			"responses":      len(responses),
+			"spreadsheetUrl": url,
+			"linked":         false,
+		})
+	}
+	u.Out().Printf("spreadsheet_id\t%s", spreadsheetID)
+	u.Out().Printf("sheet\t%s", tab)
+	u.Out().Printf("responses\t%d", len(responses))
+	u.Out().Printf("url\t%s", url)
+	return nil
+}
+
+// formResponseRows lays responses out like the Forms UI export: a timestamp
+// and respondent email, then one column per question (one per row for grids).
+func formResponseRows(form *forms.Form, responses []*forms.FormResponse) [][]any {
+	header := []any{"Timestamp", "Email Address"}
+	var questionIDs []string
+	for _, item := range form.Items {
+		switch {
+		case item == nil:
+		case item.QuestionItem != nil && item.QuestionItem.Question != nil:
+			header = append(header, item.Title)
+			questionIDs = append(questionIDs, item.QuestionItem.Question.QuestionId)
+		case item.QuestionGroupItem != nil:
+			for _, q := range item.QuestionGroupItem.Questions {
+				title := item.Title
+				if q.RowQuestion != nil {
+					title += " [" + q.RowQuestion.Title + "]"
+				}
+				header = append(header, title)
+				questionIDs = append(questionIDs, q.QuestionId)
+			}
+		}
+	}
+
+	rows := [][]any{header}
+	for _, r := range responses {
+		row := []any{r.LastSubmittedTime, r.RespondentEmail}
+		for _, id := range questionIDs {
+			row = append(row, formAnswerText(r.Answers[id]))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func formAnswerText(answer forms.Answer) string {
+	var values []string
+	if answer.TextAnswers != nil {
+		for _, a := range answer.TextAnswers.Answers {
+			values = append(values, a.Value)
+		}
+	}
+	if answer.FileUploadAnswers != nil {
+		for _, a := range answer.FileUploadAnswers.Answers {
+			values = append(values, a.FileName)
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+func spreadsheetURL(id string) string {
+	return "https://docs.google.com/spreadsheets/d/" + id + "/edit"
+}
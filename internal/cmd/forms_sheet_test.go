@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/forms/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestExecute_FormsLinkSheet(t *testing.T) {
+	origForms, origSheets := newFormsService, newSheetsService
+	t.Cleanup(func() { newFormsService, newSheetsService = origForms, origSheets })
+
+	fsvc := newTestFormsService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/forms/form1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"formId": "form1",
+				"info":   map[string]any{"title": "Survey"},
+				"items": []map[string]any{
+					{"itemId": "i1", "title": "Name", "questionItem": map[string]any{"question": map[string]any{"questionId": "q1"}}},
+					{"itemId": "i2", "title": "Rate", "questionGroupItem": map[string]any{"questions": []map[string]any{
+						{"questionId": "r1", "rowQuestion": map[string]any{"title": "Docs"}},
+					}}},
+				},
+			})
+		case "/v1/forms/form1/responses":
+			_ = json.NewEncoder(w).Encode(map[string]any{"responses": []map[string]any{{
+				"responseId":        "resp1",
+				"lastSubmittedTime": "2026-01-02T10:00:00Z",
+				"answers": map[string]any{
+					"q1": map[string]any{"textAnswers": map[string]any{"answers": []map[string]any{{"value": "Ada"}}}},
+					"r1": map[string]any{"textAnswers": map[string]any{"answers": []map[string]any{{"value": "Good"}}}},
+				},
+			}}})
+		case "/v1/forms/form2":
+			_ = json.NewEncoder(w).Encode(map[string]any{"formId": "form2", "linkedSheetId": "linked1"})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	newFormsService = func(context.Context, string) (*forms.Service, error) { return fsvc, nil }
+
+	var created bool
+	var written sheets.ValueRange
+	var writtenRange string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/spreadsheets":
+			created = true
+			_ = json.NewEncoder(w).Encode(map[string]any{"spreadsheetId": "ss1"})
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/v4/spreadsheets/ss1/values/"):
+			writtenRange = strings.TrimPrefix(r.URL.Path, "/v4/spreadsheets/ss1/values/")
+			_ = json.NewDecoder(r.Body).Decode(&written)
+			_ = json.NewEncoder(w).Encode(map[string]any{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	ssvc, err := sheets.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSheetsService = func(context.Context, string) (*sheets.Service, error) { return ssvc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "forms", "link-sheet", "form1", "--create"}); err != nil {
+			t.Fatalf("link-sheet: %v", err)
+		}
+	})
+	if !created || writtenRange != "'Form Responses'!A1" {
+		t.Fatalf("unexpected sheet calls: created=%v range=%q", created, writtenRange)
+	}
+	if len(written.Values) != 2 || len(written.Values[0]) != 4 || written.Values[0][3] != "Rate [Docs]" || written.Values[1][2] != "Ada" || written.Values[1][3] != "Good" {
+		t.Fatalf("unexpected rows: %#v", written.Values)
+	}
+	if !strings.Contains(out, `"spreadsheetId": "ss1"`) || !strings.Contains(out, `"responses": 1`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "forms", "link-sheet", "form2"}); err != nil {
+			t.Fatalf("link-sheet linked: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"spreadsheetId": "linked1"`) || !strings.Contains(out, `"linked": true`) {
+		t.Fatalf("unexpected linked output: %s", out)
+	}
+}
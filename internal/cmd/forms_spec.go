@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/api/forms/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// formSpec is the YAML/JSON definition accepted by `gog forms create --from`.
+// Items may be listed at the top level, inside sections (each section starts
+// a new page), or both.
+type formSpec struct {
+	Title         string            `yaml:"title"`
+	DocumentTitle string            `yaml:"documentTitle"`
+	Description   string            `yaml:"description"`
+	Items         []formItemSpec    `yaml:"items"`
+	Sections      []formSectionSpec `yaml:"sections"`
+}
+
+type formSectionSpec struct {
+	Title       string         `yaml:"title"`
+	Description string         `yaml:"description"`
+	Items       []formItemSpec `yaml:"items"`
+}
+
+type formItemSpec struct {
+	Title       string   `yaml:"title"`
+	Description string   `yaml:"description"`
+	Type        string   `yaml:"type"`
+	Required    bool     `yaml:"required"`
+	Options     []string `yaml:"options"`
+	Other       bool     `yaml:"other"`
+	Shuffle     bool     `yaml:"shuffle"`
+	Low         *int64   `yaml:"low"`
+	High        *int64   `yaml:"high"`
+	LowLabel    string   `yaml:"lowLabel"`
+	HighLabel   string   `yaml:"highLabel"`
+	Rows        []string `yaml:"rows"`
+	Columns     []string `yaml:"columns"`
+	IncludeTime bool     `yaml:"includeTime"`
+}
+
+// parseFormSpec reads a YAML or JSON form definition (JSON is valid YAML).
+// Unknown keys are rejected so typos don't silently drop settings.
+func parseFormSpec(data []byte) (formSpec, error) {
+	var spec formSpec
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&spec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return spec, usage("empty form definition")
+		}
+		return spec, fmt.Errorf("parse form definition: %w", err)
+	}
+	spec.Title = strings.TrimSpace(spec.Title)
+	return spec, nil
+}
+
+// items flattens the spec into form items, turning sections into page breaks.
+func (s formSpec) items() ([]*forms.Item, error) {
+	var out []*forms.Item
+	add := func(specs []formItemSpec) error {
+		for _, is := range specs {
+			item, err := is.item()
+			if err != nil {
+				return err
+			}
+			out = append(out, item)
+		}
+		return nil
+	}
+	if err := add(s.Items); err != nil {
+		return nil, err
+	}
+	for i, section := range s.Sections {
+		if i > 0 || len(out) > 0 {
+			out = append(out, &forms.Item{
+				Title:         strings.TrimSpace(section.Title),
+				Description:   section.Description,
+				PageBreakItem: &forms.PageBreakItem{},
+			})
+		}
+		if err := add(section.Items); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+var formChoiceTypes = map[string]string{
+	"radio":           "RADIO",
+	"choice":          "RADIO",
+	"multiple_choice": "RADIO",
+	"checkbox":        "CHECKBOX",
+	"checkboxes":      "CHECKBOX",
+	"dropdown":        "DROP_DOWN",
+	"drop_down":       "DROP_DOWN",
+}
+
+// item converts one spec entry into a Forms API item. Supported types: text,
+// paragraph, radio/choice, checkbox, dropdown, scale, date, time, grid,
+// checkbox_grid, section (page break) and header (title/description only).
+func (is formItemSpec) item() (*forms.Item, error) {
+	title := strings.TrimSpace(is.Title)
+	kind := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(is.Type), "-", "_"))
+	if kind == "" {
+		kind = "text"
+		if len(is.Options) > 0 {
+			kind = "radio"
+		}
+	}
+	item := &forms.Item{Title: title, Description: is.Description}
+	question := &forms.Question{Required: is.Required}
+
+	switch kind {
+	case "section", "page_break":
+		item.PageBreakItem = &forms.PageBreakItem{}
+		return item, nil
+	case "header", "text_item":
+		item.TextItem = &forms.TextItem{}
+		return item, nil
+	case "text", "short_answer":
+		question.TextQuestion = &forms.TextQuestion{}
+	case "paragraph", "long_answer":
+		question.TextQuestion = &forms.TextQuestion{Paragraph: true}
+	case "date":
+		question.DateQuestion = &forms.DateQuestion{IncludeYear: true, IncludeTime: is.IncludeTime}
+	case "time":
+		question.TimeQuestion = &forms.TimeQuestion{}
+	case "scale":
+		low, high := int64(1), int64(5)
+		if is.Low != nil {
+			low = *is.Low
+		}
+		if is.High != nil {
+			high = *is.High
+		}
+		if low < 0 || low > 1 || high < 2 || high > 10 {
+			return nil, usagef("item %q: scale needs low 0..1 and high 2..10 (got %d..%d)", title, low, high)
+		}
+		question.ScaleQuestion = &forms.ScaleQuestion{Low: low, High: high, LowLabel: is.LowLabel, HighLabel: is.HighLabel}
+		question.ScaleQuestion.ForceSendFields = []string{"Low"}
+	case "grid", "radio_grid", "checkbox_grid":
+		if len(is.Rows) == 0 || len(is.Columns) == 0 {
+			return nil, usagef("item %q: grid needs rows and columns", title)
+		}
+		columnType := "RADIO"
+		if kind == "checkbox_grid" {
+			columnType = "CHECKBOX"
+		}
+		group := &forms.QuestionGroupItem{Grid: &forms.Grid{
+			Columns:          &forms.ChoiceQuestion{Type: columnType, Options: formOptions(is.Columns, false)},
+			ShuffleQuestions: is.Shuffle,
+		}}
+		for _, row := range is.Rows {
+			group.Questions = append(group.Questions, &forms.Question{
+				Required:    is.Required,
+				RowQuestion: &forms.RowQuestion{Title: row},
+			})
+		}
+		item.QuestionGroupItem = group
+		return item, nil
+	default:
+		choiceType, ok := formChoiceTypes[kind]
+		if !ok {
+			return nil, usagef("item %q: unknown type %q", title, is.Type)
+		}
+		if len(is.Options) == 0 {
+			return nil, usagef("item %q: %s needs options", title, kind)
+		}
+		question.ChoiceQuestion = &forms.ChoiceQuestion{
+			Type:    choiceType,
+			Options: formOptions(is.Options, is.Other && choiceType != "DROP_DOWN"),
+			Shuffle: is.Shuffle,
+		}
+	}
+	if title == "" {
+		return nil, usage("question items need a title")
+	}
+	item.QuestionItem = &forms.QuestionItem{Question: question}
+	return item, nil
+}
+
+func formOptions(values []string, other bool) []*forms.Option {
+	out := make([]*forms.Option, 0, len(values)+1)
+	for _, v := range values {
+		out = append(out, &forms.Option{Value: v})
+	}
+	if other {
+		out = append(out, &forms.Option{IsOther: true})
+	}
+	return out
+}
+
+// formCreateItemRequests inserts items starting at the given 0-based index.
+func formCreateItemRequests(items []*forms.Item, start int64) []*forms.Request {
+	reqs := make([]*forms.Request, 0, len(items))
+	for i, item := range items {
+		loc := &forms.Location{Index: start + int64(i)}
+		loc.ForceSendFields = []string{"Index"}
+		reqs = append(reqs, &forms.Request{CreateItem: &forms.CreateItemRequest{Item: item, Location: loc}})
+	}
+	return reqs
+}
+
+func formEditURL(formID string) string {
+	return "https://docs.google.com/forms/d/" + formID + "/edit"
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/forms/v1"
+	"google.golang.org/api/option"
+)
+
+const testFormSpec = `
+title: Team survey
+description: Quarterly pulse
+items:
+  - title: Name
+    required: true
+  - title: Team
+    options: [Ops, Eng]
+    other: true
+sections:
+  - title: Feedback
+    items:
+      - title: Happiness
+        type: scale
+        low: 0
+        high: 10
+        lowLabel: meh
+      - title: Rate tools
+        type: grid
+        rows: [Chat, Docs]
+        columns: [Bad, Good]
+      - title: Comments
+        type: paragraph
+`
+
+func TestParseFormSpec(t *testing.T) {
+	spec, err := parseFormSpec([]byte(testFormSpec))
+	if err != nil {
+		t.Fatalf("parseFormSpec: %v", err)
+	}
+	items, err := spec.items()
+	if err != nil {
+		t.Fatalf("items: %v", err)
+	}
+	if len(items) != 6 {
+		t.Fatalf("unexpected items: %d", len(items))
+	}
+	if q := items[0].QuestionItem.Question; !q.Required || q.TextQuestion == nil {
+		t.Fatalf("unexpected text item: %#v", q)
+	}
+	if c := items[1].QuestionItem.Question.ChoiceQuestion; c.Type != "RADIO" || len(c.Options) != 3 || !c.Options[2].IsOther {
+		t.Fatalf("unexpected choice item: %#v", c)
+	}
+	if items[2].PageBreakItem == nil || items[2].Title != "Feedback" {
+		t.Fatalf("expected section page break: %#v", items[2])
+	}
+	if s := items[3].QuestionItem.Question.ScaleQuestion; s.Low != 0 || s.High != 10 || s.LowLabel != "meh" {
+		t.Fatalf("unexpected scale: %#v", s)
+	}
+	if g := items[4].QuestionGroupItem; g == nil || len(g.Questions) != 2 || g.Grid.Columns.Type != "RADIO" {
+		t.Fatalf("unexpected grid: %#v", g)
+	}
+
+	jsonSpec, err := parseFormSpec([]byte(`{"title":"J","items":[{"title":"Pick","type":"checkbox","options":["a","b"]}]}`))
+	if err != nil || jsonSpec.Title != "J" {
+		t.Fatalf("json spec: %v %#v", err, jsonSpec)
+	}
+
+	for _, bad := range []string{
+		"title: X\nitems:\n  - title: Q\n    tpye: text\n",
+		"title: X\nitems:\n  - title: Q\n    type: dropdown\n",
+		"title: X\nitems:\n  - title: Q\n    type: scale\n    high: 20\n",
+		"title: X\nitems:\n  - title: Q\n    type: wat\n",
+	} {
+		spec, err := parseFormSpec([]byte(bad))
+		if err == nil {
+			_, err = spec.items()
+		}
+		if err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestExecute_FormsCreate_JSON(t *testing.T) {
+	origForms := newFormsService
+	t.Cleanup(func() { newFormsService = origForms })
+
+	var created forms.Form
+	var batch forms.BatchUpdateFormRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/forms":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"formId":       "form1",
+				"responderUri": "https://docs.google.com/forms/d/e/xyz/viewform",
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/forms/form1:batchUpdate":
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := forms.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newFormsService = func(context.Context, string) (*forms.Service, error) { return svc, nil }
+
+	path := filepath.Join(t.TempDir(), "survey.yaml")
+	if err := os.WriteFile(path, []byte(testFormSpec), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "forms", "create", "--from", path}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+
+	if created.Info == nil || created.Info.Title != "Team survey" || len(created.Items) != 0 {
+		t.Fatalf("unexpected create body: %#v", created)
+	}
+	if len(batch.Requests) != 7 || batch.Requests[0].UpdateFormInfo == nil || batch.Requests[0].UpdateFormInfo.UpdateMask != "description" {
+		t.Fatalf("unexpected batch: %#v", batch.Requests)
+	}
+	if loc := batch.Requests[6].CreateItem.Location; loc.Index != 5 {
+		t.Fatalf("unexpected location: %#v", loc)
+	}
+	if !strings.Contains(out, `"editUri": "https://docs.google.com/forms/d/form1/edit"`) || !strings.Contains(out, `"responderUri": "https://docs.google.com/forms/d/e/xyz/viewform"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
@@ -0,0 +1,393 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/forms/v1"
+	"google.golang.org/api/idtoken"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type FormsWatchCmd struct {
+	Create FormsWatchCreateCmd `cmd:"" name:"create" help:"Create a Pub/Sub watch for responses or schema changes"`
+	List   FormsWatchListCmd   `cmd:"" name:"list" aliases:"ls" help:"List watches on a form"`
+	Renew  FormsWatchRenewCmd  `cmd:"" name:"renew" help:"Renew a watch for another 7 days"`
+	Delete FormsWatchDeleteCmd `cmd:"" name:"delete" aliases:"rm" help:"Delete a watch"`
+	Serve  FormsWatchServeCmd  `cmd:"" name:"serve" help:"Run a Pub/Sub push handler that emits new responses as JSON lines"`
+}
+
+type FormsWatchCreateCmd struct {
+	FormID    string `arg:"" name:"formId" help:"Form ID"`
+	Topic     string `name:"topic" help:"Pub/Sub topic (projects/.../topics/...)" required:""`
+	EventType string `name:"event-type" help:"responses|schema" default:"responses"`
+}
+
+func (c *FormsWatchCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+	topic := strings.TrimSpace(c.Topic)
+	if !strings.HasPrefix(topic, "projects/") || !strings.Contains(topic, "/topics/") {
+		return usagef("invalid --topic %q (expected projects/<project>/topics/<topic>)", c.Topic)
+	}
+	eventType := strings.ToUpper(strings.TrimSpace(c.EventType))
+	if eventType != "RESPONSES" && eventType != "SCHEMA" {
+		return usagef("invalid --event-type %q (expected responses|schema)", c.EventType)
+	}
+
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	watch, err := svc.Forms.Watches.Create(formID, &forms.CreateWatchRequest{Watch: &forms.Watch{
+		EventType: eventType,
+		Target:    &forms.WatchTarget{Topic: &forms.CloudPubsubTopic{TopicName: topic}},
+	}}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return writeFormsWatch(ctx, watch)
+}
+
+type FormsWatchListCmd struct {
+	FormID string `arg:"" name:"formId" help:"Form ID"`
+}
+
+func (c *FormsWatchListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	resp, err := svc.Forms.Watches.List(formID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		watches := resp.Watches
+		if watches == nil {
+			watches = []*forms.Watch{}
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"watches": watches})
+	}
+	if len(resp.Watches) == 0 {
+		u.Err().Println("No watches")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ID\tEVENT\tSTATE\tEXPIRES\tTOPIC")
+	for _, watch := range resp.Watches {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", watch.Id, watch.EventType, watch.State, watch.ExpireTime, formsWatchTopic(watch))
+	}
+	return nil
+}
+
+type FormsWatchRenewCmd struct {
+	FormID  string `arg:"" name:"formId" help:"Form ID"`
+	WatchID string `arg:"" name:"watchId" help:"Watch ID"`
+}
+
+func (c *FormsWatchRenewCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID, watchID := strings.TrimSpace(c.FormID), strings.TrimSpace(c.WatchID)
+	if formID == "" || watchID == "" {
+		return usage("empty formId or watchId")
+	}
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	watch, err := svc.Forms.Watches.Renew(formID, watchID, &forms.RenewWatchRequest{}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return writeFormsWatch(ctx, watch)
+}
+
+type FormsWatchDeleteCmd struct {
+	FormID  string `arg:"" name:"formId" help:"Form ID"`
+	WatchID string `arg:"" name:"watchId" help:"Watch ID"`
+}
+
+func (c *FormsWatchDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID, watchID := strings.TrimSpace(c.FormID), strings.TrimSpace(c.WatchID)
+	if formID == "" || watchID == "" {
+		return usage("empty formId or watchId")
+	}
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete watch %s on form %s", watchID, formID)); confirmErr != nil {
+		return confirmErr
+	}
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if _, err := svc.Forms.Watches.Delete(formID, watchID).Context(ctx).Do(); err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"deleted": true, "watchId": watchID})
+	}
+	u.Out().Printf("deleted\t%s", watchID)
+	return nil
+}
+
+type FormsWatchServeCmd struct {
+	FormID       string `arg:"" name:"formId" help:"Form ID"`
+	Bind         string `name:"bind" help:"Bind address" default:"127.0.0.1"`
+	Port         int    `name:"port" help:"Listen port" default:"8789"`
+	Path         string `name:"path" help:"Push handler path" default:"/forms-pubsub"`
+	Since        string `name:"since" help:"Also emit responses submitted after this time (RFC3339 or duration like 1h; default: now)"`
+	VerifyOIDC   bool   `name:"verify-oidc" help:"Verify Pub/Sub OIDC tokens"`
+	OIDCEmail    string `name:"oidc-email" help:"Expected service account email"`
+	OIDCAudience string `name:"oidc-audience" help:"Expected OIDC audience"`
+	SharedToken  string `name:"token" help:"Shared token for x-gog-token or ?token="`
+}
+
+func (c *FormsWatchServeCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	formID := strings.TrimSpace(c.FormID)
+	if formID == "" {
+		return usage("empty formId")
+	}
+	if !strings.HasPrefix(c.Path, "/") {
+		return usage("--path must start with '/'")
+	}
+	if c.Port <= 0 {
+		return usage("--port must be > 0")
+	}
+	if !c.VerifyOIDC && c.SharedToken == "" && !isLoopbackHost(c.Bind) {
+		return usage("--verify-oidc or --token required when binding non-loopback")
+	}
+	if (c.OIDCEmail != "" || c.OIDCAudience != "") && !c.VerifyOIDC {
+		return usage("--oidc-email/--oidc-audience require --verify-oidc")
+	}
+	cursor, err := formsWatchSince(c.Since, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var validator *idtoken.Validator
+	if c.VerifyOIDC {
+		validator, err = newOIDCValidator(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	svc, err := newFormsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	server := &formsWatchServer{
+		formID:       formID,
+		path:         c.Path,
+		verifyOIDC:   c.VerifyOIDC,
+		oidcEmail:    c.OIDCEmail,
+		oidcAudience: c.OIDCAudience,
+		sharedToken:  c.SharedToken,
+		validator:    validator,
+		svc:          svc,
+		out:          os.Stdout,
+		warnf:        u.Err().Printf,
+		cursor:       cursor,
+		seen:         map[string]bool{},
+	}
+	addr := net.JoinHostPort(c.Bind, strconv.Itoa(c.Port))
+	u.Err().Printf("forms watch: listening on %s%s (responses after %s)", addr, c.Path, cursor.Format(time.RFC3339))
+	return listenAndServe(&http.Server{
+		Addr:              addr,
+		Handler:           server,
+		ReadHeaderTimeout: 5 * time.Second,
+	})
+}
+
+// formsWatchServer receives Forms Pub/Sub pushes (which carry no payload,
+// only formId/eventType attributes) and fetches the responses submitted since
+// the last push.
+type formsWatchServer struct {
+	formID       string
+	path         string
+	verifyOIDC   bool
+	oidcEmail    string
+	oidcAudience string
+	sharedToken  string
+	validator    *idtoken.Validator
+	svc          *forms.Service
+	out          io.Writer
+	warnf        func(string, ...any)
+
+	mu     sync.Mutex
+	cursor time.Time
+	seen   map[string]bool
+}
+
+func (s *formsWatchServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !pathMatches(s.path, r.URL.Path) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.authorize(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	defer r.Body.Close()
+	var envelope pubsubPushEnvelope
+	if err := json.NewDecoder(io.LimitReader(r.Body, defaultPushBodyLimitBytes)).Decode(&envelope); err != nil {
+		s.warnf("forms watch: invalid push payload: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	attrs := envelope.Message.Attributes
+	if formID := attrs["formId"]; formID != "" && formID != s.formID {
+		s.warnf("forms watch: ignoring push for form %s", formID)
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	if eventType := attrs["eventType"]; eventType != "" && eventType != "RESPONSES" {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if err := s.emitNewResponses(r.Context()); err != nil {
+		s.warnf("forms watch: fetch responses failed: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *formsWatchServer) authorize(r *http.Request) bool {
+	if s.verifyOIDC {
+		if bearer := bearerToken(r); bearer != "" {
+			ok, err := verifyOIDCToken(r.Context(), s.validator, bearer, pushOIDCAudience(r, s.oidcAudience), s.oidcEmail)
+			if ok {
+				return true
+			}
+			if err != nil {
+				s.warnf("forms watch: oidc verify failed: %v", err)
+			}
+		}
+		return s.sharedToken != "" && sharedTokenMatches(r, s.sharedToken)
+	}
+	return s.sharedToken == "" || sharedTokenMatches(r, s.sharedToken)
+}
+
+// emitNewResponses writes every response submitted after the cursor as one
+// JSON line and advances the cursor. Responses already emitted are skipped,
+// since pushes can be redelivered.
+func (s *formsWatchServer) emitNewResponses(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	filter := "timestamp > " + s.cursor.UTC().Format(time.RFC3339Nano)
+	latest := s.cursor
+	for page := ""; ; {
+		resp, err := s.svc.Forms.Responses.List(s.formID).Filter(filter).PageToken(page).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.Responses {
+			if r == nil || s.seen[r.ResponseId] {
+				continue
+			}
+			data, err := json.Marshal(r)
+			if err != nil {
+				return err
+			}
+			if _, err := s.out.Write(append(data, '\n')); err != nil {
+				return err
+			}
+			s.seen[r.ResponseId] = true
+			if t, err := time.Parse(time.RFC3339Nano, r.LastSubmittedTime); err == nil && t.After(latest) {
+				latest = t
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		page = resp.NextPageToken
+	}
+	s.cursor = latest
+	return nil
+}
+
+func formsWatchSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return now, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, usagef("invalid --since %q (expected RFC3339 or duration like 1h)", value)
+	}
+	return t, nil
+}
+
+func formsWatchTopic(watch *forms.Watch) string {
+	if watch.Target == nil || watch.Target.Topic == nil {
+		return ""
+	}
+	return watch.Target.Topic.TopicName
+}
+
+func writeFormsWatch(ctx context.Context, watch *forms.Watch) error {
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"watch": watch})
+	}
+	u := ui.FromContext(ctx)
+	u.Out().Printf("id\t%s", watch.Id)
+	u.Out().Printf("event_type\t%s", watch.EventType)
+	u.Out().Printf("state\t%s", watch.State)
+	u.Out().Printf("topic\t%s", formsWatchTopic(watch))
+	if watch.ExpireTime != "" {
+		u.Out().Printf("expires\t%s", watch.ExpireTime)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/forms/v1"
+	"google.golang.org/api/option"
+)
+
+func newTestFormsService(t *testing.T, handler http.HandlerFunc) *forms.Service {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	svc, err := forms.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	return svc
+}
+
+func TestExecute_FormsWatchCreateList(t *testing.T) {
+	origForms := newFormsService
+	t.Cleanup(func() { newFormsService = origForms })
+
+	var created string
+	svc := newTestFormsService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/forms/form1/watches":
+			data, _ := io.ReadAll(r.Body)
+			created = string(data)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "w1", "eventType": "RESPONSES", "state": "ACTIVE"})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/forms/form1/watches":
+			_ = json.NewEncoder(w).Encode(map[string]any{"watches": []map[string]any{{"id": "w1", "eventType": "RESPONSES"}}})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	newFormsService = func(context.Context, string) (*forms.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "forms", "watch", "create", "form1", "--topic", "projects/p/topics/forms"}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"id": "w1"`) {
+		t.Fatalf("unexpected create output: %s", out)
+	}
+	if !strings.Contains(created, `"eventType":"RESPONSES"`) || !strings.Contains(created, `"topicName":"projects/p/topics/forms"`) {
+		t.Fatalf("unexpected create body: %s", created)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "forms", "watch", "list", "form1"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	var listed struct {
+		Watches []forms.Watch `json:"watches"`
+	}
+	if err := json.Unmarshal([]byte(out), &listed); err != nil || len(listed.Watches) != 1 {
+		t.Fatalf("unexpected list output: %s (%v)", out, err)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "forms", "watch", "create", "form1", "--topic", "forms"}); err == nil {
+			t.Fatalf("expected invalid topic error")
+		}
+	})
+}
+
+func TestFormsWatchServer_EmitsNewResponses(t *testing.T) {
+	var filters []string
+	svc := newTestFormsService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/forms/form1/responses" {
+			http.NotFound(w, r)
+			return
+		}
+		filters = append(filters, r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"responses": []map[string]any{
+			{"responseId": "r1", "lastSubmittedTime": "2026-01-02T10:00:00Z"},
+			{"responseId": "r2", "lastSubmittedTime": "2026-01-02T11:00:00Z"},
+		}})
+	})
+
+	var out bytes.Buffer
+	s := &formsWatchServer{
+		formID: "form1",
+		path:   "/forms-pubsub",
+		svc:    svc,
+		out:    &out,
+		warnf:  func(string, ...any) {},
+		cursor: time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+		seen:   map[string]bool{},
+	}
+	push := func(attrs string) int {
+		body := `{"message":{"attributes":` + attrs + `,"messageId":"m1"},"subscription":"s"}`
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/forms-pubsub", strings.NewReader(body)))
+		return rec.Code
+	}
+
+	if code := push(`{"formId":"form1","eventType":"RESPONSES"}`); code != http.StatusOK {
+		t.Fatalf("unexpected status %d", code)
+	}
+	if code := push(`{"formId":"form1","eventType":"RESPONSES"}`); code != http.StatusOK {
+		t.Fatalf("unexpected status %d", code)
+	}
+	if code := push(`{"formId":"other","eventType":"RESPONSES"}`); code != http.StatusAccepted {
+		t.Fatalf("unexpected status for other form %d", code)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"responseId":"r1"`) || !strings.Contains(lines[1], `"responseId":"r2"`) {
+		t.Fatalf("unexpected output: %q", out.String())
+	}
+	if len(filters) != 2 || filters[0] != "timestamp > 2026-01-02T09:00:00Z" || filters[1] != "timestamp > 2026-01-02T11:00:00Z" {
+		t.Fatalf("unexpected filters: %v", filters)
+	}
+}
+
+func TestFormsWatchSince(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	if got, err := formsWatchSince("", now); err != nil || !got.Equal(now) {
+		t.Fatalf("empty: %v %v", got, err)
+	}
+	if got, err := formsWatchSince("2h", now); err != nil || !got.Equal(now.Add(-2*time.Hour)) {
+		t.Fatalf("duration: %v %v", got, err)
+	}
+	if _, err := formsWatchSince("yesterday", now); err == nil {
+		t.Fatalf("expected error")
+	}
+}
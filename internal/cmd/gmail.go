@@ -23,16 +23,32 @@ type GmailCmd struct {
 	Messages   GmailMessagesCmd   `cmd:"" name:"messages" group:"Read" help:"Message operations"`
 	Thread     GmailThreadCmd     `cmd:"" name:"thread" aliases:"read" group:"Organize" help:"Thread operations (get, modify)"`
 	Get        GmailGetCmd        `cmd:"" name:"get" group:"Read" help:"Get a message (full|metadata|raw)"`
+	Parts      GmailPartsCmd      `cmd:"" name:"parts" group:"Read" help:"Show a message's MIME tree or extract one part"`
 	Attachment GmailAttachmentCmd `cmd:"" name:"attachment" group:"Read" help:"Download a single attachment"`
 	URL        GmailURLCmd        `cmd:"" name:"url" group:"Read" help:"Print Gmail web URLs for threads"`
 	History    GmailHistoryCmd    `cmd:"" name:"history" group:"Read" help:"Gmail history"`
-
-	Labels GmailLabelsCmd `cmd:"" name:"labels" group:"Organize" help:"Label operations"`
-	Batch  GmailBatchCmd  `cmd:"" name:"batch" group:"Organize" help:"Batch operations"`
-
-	Send   GmailSendCmd   `cmd:"" name:"send" group:"Write" help:"Send an email"`
-	Track  GmailTrackCmd  `cmd:"" name:"track" group:"Write" help:"Email open tracking"`
-	Drafts GmailDraftsCmd `cmd:"" name:"drafts" group:"Write" help:"Draft operations"`
+	Index      GmailIndexCmd      `cmd:"" name:"index" group:"Read" help:"Local message index for offline search"`
+	Export     GmailExportCmd     `cmd:"" name:"export" group:"Read" help:"Export messages matching a query as mbox or .eml files"`
+	Stats      GmailStatsCmd      `cmd:"" name:"stats" group:"Read" help:"Message counts and sizes by sender, domain, or label"`
+
+	Labels     GmailLabelsCmd     `cmd:"" name:"labels" group:"Organize" help:"Label operations"`
+	Label      GmailLabelApplyCmd `cmd:"" name:"label" group:"Organize" help:"Add/remove labels on messages by query or ID"`
+	Batch      GmailBatchCmd      `cmd:"" name:"batch" group:"Organize" help:"Batch operations"`
+	Bulk       GmailBulkCmd       `cmd:"" name:"bulk" group:"Organize" help:"Archive/label/trash every message matching a query"`
+	Delete     GmailDeleteCmd     `cmd:"" name:"delete" group:"Organize" help:"Trash (or --hard delete) every message matching a query, with preview, typed confirmation, and undo"`
+	Mute       GmailMuteCmd       `cmd:"" name:"mute" group:"Organize" help:"Mute threads (label + archive) by thread ID or query"`
+	Unmute     GmailUnmuteCmd     `cmd:"" name:"unmute" group:"Organize" help:"Unmute threads by thread ID or query"`
+	ReportSpam GmailReportSpamCmd `cmd:"" name:"report-spam" aliases:"spam" group:"Organize" help:"Report messages as spam (by message ID or query)"`
+	NotSpam    GmailNotSpamCmd    `cmd:"" name:"not-spam" group:"Organize" help:"Move messages out of spam back to the inbox"`
+	Import     GmailImportCmd     `cmd:"" name:"import" group:"Organize" help:"Import messages from an mbox file or .eml files"`
+	Dedupe     GmailDedupeCmd     `cmd:"" name:"dedupe" group:"Organize" help:"Find (and trash) duplicate messages"`
+
+	Send     GmailSendCmd     `cmd:"" name:"send" group:"Write" help:"Send an email"`
+	Reply    GmailReplyCmd    `cmd:"" name:"reply" group:"Write" help:"Reply (or reply-all) to a message, quoting the original"`
+	Forward  GmailForwardCmd  `cmd:"" name:"forward" aliases:"fwd" group:"Write" help:"Forward a message with its attachments"`
+	Track    GmailTrackCmd    `cmd:"" name:"track" group:"Write" help:"Email open tracking"`
+	Drafts   GmailDraftsCmd   `cmd:"" name:"drafts" group:"Write" help:"Draft operations"`
+	Template GmailTemplateCmd `cmd:"" name:"template" aliases:"templates" group:"Write" help:"Local message templates and mail merge"`
 
 	Settings GmailSettingsCmd `cmd:"" name:"settings" group:"Admin" help:"Settings and admin"`
 
@@ -43,6 +59,7 @@ type GmailCmd struct {
 	Filters     GmailFiltersCmd     `cmd:"" name:"filters" hidden:"" help:"Filter operations"`
 	Forwarding  GmailForwardingCmd  `cmd:"" name:"forwarding" hidden:"" help:"Forwarding addresses"`
 	SendAs      GmailSendAsCmd      `cmd:"" name:"sendas" hidden:"" help:"Send-as settings"`
+	Signature   GmailSignatureCmd   `cmd:"" name:"signature" hidden:"" help:"Signature management"`
 	Vacation    GmailVacationCmd    `cmd:"" name:"vacation" hidden:"" help:"Vacation responder"`
 }
 
@@ -52,6 +69,7 @@ type GmailSettingsCmd struct {
 	Forwarding  GmailForwardingCmd  `cmd:"" name:"forwarding" group:"Admin" help:"Forwarding addresses"`
 	AutoForward GmailAutoForwardCmd `cmd:"" name:"autoforward" group:"Admin" help:"Auto-forwarding settings"`
 	SendAs      GmailSendAsCmd      `cmd:"" name:"sendas" group:"Admin" help:"Send-as settings"`
+	Signature   GmailSignatureCmd   `cmd:"" name:"signature" group:"Admin" help:"Signature management"`
 	Vacation    GmailVacationCmd    `cmd:"" name:"vacation" group:"Admin" help:"Vacation responder"`
 	Watch       GmailWatchCmd       `cmd:"" name:"watch" group:"Admin" help:"Manage Gmail watch"`
 }
@@ -60,9 +78,11 @@ type GmailSearchCmd struct {
 	Query    []string `arg:"" name:"query" help:"Search query"`
 	Max      int64    `name:"max" aliases:"limit" help:"Max results" default:"10"`
 	Page     string   `name:"page" help:"Page token"`
+	All      bool     `name:"all" help:"Fetch all pages (uses --max as page size)"`
 	Oldest   bool     `name:"oldest" help:"Show first message date instead of last"`
 	Timezone string   `name:"timezone" short:"z" help:"Output timezone (IANA name, e.g. America/New_York, UTC). Default: local"`
 	Local    bool     `name:"local" help:"Use local timezone (default behavior, useful to override --timezone)"`
+	Offline  bool     `name:"offline" aliases:"cached" help:"Search the local index (see 'gmail index') instead of the API"`
 }
 
 func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -75,18 +95,16 @@ func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if query == "" {
 		return usage("missing query")
 	}
+	if c.Offline {
+		return c.runOffline(ctx, u, account, query)
+	}
 
 	svc, err := newGmailService(ctx, account)
 	if err != nil {
 		return err
 	}
 
-	resp, err := svc.Users.Threads.List("me").
-		Q(query).
-		MaxResults(c.Max).
-		PageToken(c.Page).
-		Context(ctx).
-		Do()
+	threads, nextPageToken, err := listThreadPages(ctx, svc, query, c.Max, c.Page, c.All)
 	if err != nil {
 		return err
 	}
@@ -102,7 +120,7 @@ func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	// Fetch thread details concurrently (fixes N+1 query pattern)
-	items, err := fetchThreadDetails(ctx, svc, resp.Threads, idToName, c.Oldest, loc)
+	items, err := fetchThreadDetails(ctx, svc, threads, idToName, c.Oldest, loc)
 	if err != nil {
 		return err
 	}
@@ -110,7 +128,7 @@ func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if outfmt.IsJSON(ctx) {
 		return outfmt.WriteJSON(os.Stdout, map[string]any{
 			"threads":       items,
-			"nextPageToken": resp.NextPageToken,
+			"nextPageToken": nextPageToken,
 		})
 	}
 
@@ -130,10 +148,78 @@ func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 		}
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", it.ID, it.Date, it.From, it.Subject, strings.Join(it.Labels, ","), threadInfo)
 	}
-	printNextPageHint(u, resp.NextPageToken)
+	printNextPageHint(u, nextPageToken)
+	return nil
+}
+
+func (c *GmailSearchCmd) runOffline(ctx context.Context, u *ui.UI, account, query string) error {
+	if c.Page != "" {
+		return usage("--page is not supported with --offline")
+	}
+	idx, _, err := loadGmailIndex(account)
+	if err != nil {
+		return err
+	}
+	loc, err := resolveOutputLocation(c.Timezone, c.Local)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	items, err := idx.searchThreads(query, c.Oldest, loc, now)
+	if err != nil {
+		return err
+	}
+	if !c.All && c.Max > 0 && int64(len(items)) > c.Max {
+		items = items[:c.Max]
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"threads":        items,
+			"offline":        true,
+			"indexUpdatedAt": idx.UpdatedAt,
+		})
+	}
+	u.Err().Printf("offline results (index updated %s)", formatLocalIndexAge(idx.UpdatedAt, now))
+	if len(items) == 0 {
+		u.Err().Println("No results")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ID\tDATE\tFROM\tSUBJECT\tLABELS\tTHREAD")
+	for _, it := range items {
+		threadInfo := "-"
+		if it.MessageCount > 1 {
+			threadInfo = fmt.Sprintf("[%d msgs]", it.MessageCount)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", it.ID, it.Date, it.From, it.Subject, strings.Join(it.Labels, ","), threadInfo)
+	}
 	return nil
 }
 
+// listThreadPages lists threads for query, following nextPageToken when all is set.
+func listThreadPages(ctx context.Context, svc *gmail.Service, query string, pageSize int64, pageToken string, all bool) ([]*gmail.Thread, string, error) {
+	var threads []*gmail.Thread
+	for {
+		resp, err := svc.Users.Threads.List("me").
+			Q(query).
+			MaxResults(pageSize).
+			PageToken(pageToken).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, "", err
+		}
+		threads = append(threads, resp.Threads...)
+		if !all || resp.NextPageToken == "" {
+			return threads, resp.NextPageToken, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
 func firstMessage(t *gmail.Thread) *gmail.Message {
 	if t == nil || len(t.Messages) == 0 {
 		return nil
@@ -326,6 +412,7 @@ type threadItem struct {
 	From         string   `json:"from,omitempty"`
 	Subject      string   `json:"subject,omitempty"`
 	Labels       []string `json:"labels,omitempty"`
+	Snippet      string   `json:"snippet,omitempty"`
 	MessageCount int      `json:"messageCount,omitempty"` // Number of messages in the thread
 }
 
@@ -356,7 +443,7 @@ func fetchThreadDetails(ctx context.Context, svc *gmail.Service, threads []*gmai
 		}
 
 		wg.Add(1)
-		go func(idx int, threadID string) {
+		go func(idx int, threadID string, snippet string) {
 			defer wg.Done()
 
 			// Acquire semaphore
@@ -378,7 +465,10 @@ func fetchThreadDetails(ctx context.Context, svc *gmail.Service, threads []*gmai
 				return
 			}
 
-			item := threadItem{ID: threadID, MessageCount: len(thread.Messages)}
+			if snippet == "" {
+				snippet = thread.Snippet
+			}
+			item := threadItem{ID: threadID, Snippet: snippet, MessageCount: len(thread.Messages)}
 			if first := firstMessage(thread); first != nil {
 				item.From = sanitizeTab(headerValue(first.Payload, "From"))
 				item.Subject = sanitizeTab(headerValue(first.Payload, "Subject"))
@@ -404,7 +494,7 @@ func fetchThreadDetails(ctx context.Context, svc *gmail.Service, threads []*gmai
 			}
 
 			results <- result{index: idx, item: item}
-		}(i, t.Id)
+		}(i, t.Id, t.Snippet)
 	}
 
 	// Close results channel when all goroutines complete
@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"strings"
 
 	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/markdown"
 )
 
 func resolveBodyInput(body, bodyFile string) (string, error) {
@@ -35,3 +37,22 @@ func resolveBodyInput(body, bodyFile string) (string, error) {
 	}
 	return string(b), nil
 }
+
+// renderMarkdownBody turns a markdown body into an inline-styled HTML part,
+// keeping the markdown source as the plain-text alternative.
+func renderMarkdownBody(body, bodyHTML string, useMarkdown bool) (string, string, error) {
+	if !useMarkdown {
+		return body, bodyHTML, nil
+	}
+	if strings.TrimSpace(bodyHTML) != "" {
+		return "", "", usage("--markdown cannot be combined with --body-html")
+	}
+	if strings.TrimSpace(body) == "" {
+		return "", "", usage("--markdown requires --body or --body-file")
+	}
+	html, err := markdown.EmailHTML(body)
+	if err != nil {
+		return "", "", fmt.Errorf("render markdown: %w", err)
+	}
+	return body, html, nil
+}
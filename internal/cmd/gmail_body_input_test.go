@@ -57,3 +57,28 @@ func TestResolveBodyInput_Conflict(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestRenderMarkdownBody(t *testing.T) {
+	body, html, err := renderMarkdownBody("Hi **there**", "", true)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if body != "Hi **there**" {
+		t.Fatalf("expected markdown source as plain text, got %q", body)
+	}
+	if !strings.Contains(html, "<strong>there</strong>") {
+		t.Fatalf("unexpected html: %q", html)
+	}
+
+	body, html, err = renderMarkdownBody("plain", "<p>x</p>", false)
+	if err != nil || body != "plain" || html != "<p>x</p>" {
+		t.Fatalf("expected passthrough, got %q %q %v", body, html, err)
+	}
+
+	if _, _, err = renderMarkdownBody("x", "<p>x</p>", true); err == nil {
+		t.Fatalf("expected conflict with --body-html")
+	}
+	if _, _, err = renderMarkdownBody("  ", "", true); err == nil {
+		t.Fatalf("expected error for empty markdown body")
+	}
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailBulkCmd applies one set of label changes to every message matching a query.
+type GmailBulkCmd struct {
+	Query       string `name:"query" short:"q" help:"Gmail search query selecting messages" required:""`
+	Archive     bool   `name:"archive" help:"Remove from inbox"`
+	MarkRead    bool   `name:"mark-read" help:"Mark as read"`
+	MarkUnread  bool   `name:"mark-unread" help:"Mark as unread"`
+	Trash       bool   `name:"trash" help:"Move to trash"`
+	AddLabel    string `name:"add-label" help:"Labels to add (comma-separated, name or ID)"`
+	RemoveLabel string `name:"remove-label" help:"Labels to remove (comma-separated, name or ID)"`
+	DryRun      bool   `name:"dry-run" help:"Only count matching messages; do not modify"`
+	BatchSize   int    `name:"batch-size" help:"Messages per batchModify call (max 1000)" default:"1000"`
+}
+
+type gmailBulkSummary struct {
+	Query         string   `json:"query"`
+	Matched       int      `json:"matched"`
+	Modified      int      `json:"modified"`
+	Batches       int      `json:"batches"`
+	DryRun        bool     `json:"dryRun,omitempty"`
+	AddedLabels   []string `json:"addedLabels,omitempty"`
+	RemovedLabels []string `json:"removedLabels,omitempty"`
+}
+
+func (c *GmailBulkCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	query := strings.TrimSpace(c.Query)
+	if query == "" {
+		return usage("empty --query")
+	}
+	if c.MarkRead && c.MarkUnread {
+		return usage("--mark-read and --mark-unread are mutually exclusive")
+	}
+	if c.BatchSize <= 0 || c.BatchSize > gmailBatchModifyLimit {
+		return usagef("--batch-size must be between 1 and %d", gmailBatchModifyLimit)
+	}
+
+	addNames, removeNames := c.labelChanges()
+	if len(addNames) == 0 && len(removeNames) == 0 {
+		return usage("must specify an action (--archive, --mark-read, --mark-unread, --trash, --add-label, --remove-label)")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	idMap, err := fetchLabelNameToID(svc)
+	if err != nil {
+		return err
+	}
+	addIDs := resolveLabelIDs(addNames, idMap)
+	removeIDs := resolveLabelIDs(removeNames, idMap)
+
+	ids, err := searchMessageIDs(ctx, svc, query)
+	if err != nil {
+		return err
+	}
+
+	chunks := chunkIDs(ids, c.BatchSize)
+	summary := gmailBulkSummary{
+		Query:         query,
+		Matched:       len(ids),
+		Batches:       len(chunks),
+		DryRun:        c.DryRun,
+		AddedLabels:   addIDs,
+		RemovedLabels: removeIDs,
+	}
+
+	if !c.DryRun {
+		for i, chunk := range chunks {
+			err = svc.Users.Messages.BatchModify("me", &gmail.BatchModifyMessagesRequest{
+				Ids:            chunk,
+				AddLabelIds:    addIDs,
+				RemoveLabelIds: removeIDs,
+			}).Context(ctx).Do()
+			if err != nil {
+				return err
+			}
+			summary.Modified += len(chunk)
+			u.Err().Printf("batch %d/%d: %d/%d messages", i+1, len(chunks), summary.Modified, len(ids))
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, summary)
+	}
+	if c.DryRun {
+		u.Out().Printf("Dry run: %d messages match %q (%d batches)", summary.Matched, query, summary.Batches)
+		return nil
+	}
+	u.Out().Printf("Modified %d messages in %d batches", summary.Modified, summary.Batches)
+	return nil
+}
+
+func (c *GmailBulkCmd) labelChanges() ([]string, []string) {
+	add := splitCSV(c.AddLabel)
+	remove := splitCSV(c.RemoveLabel)
+	if c.Archive {
+		remove = append(remove, "INBOX")
+	}
+	if c.MarkRead {
+		remove = append(remove, "UNREAD")
+	}
+	if c.MarkUnread {
+		add = append(add, "UNREAD")
+	}
+	if c.Trash {
+		add = append(add, "TRASH")
+	}
+	return add, remove
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func newBulkServer(t *testing.T, ids []string, batches *[][]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/labels"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": []map[string]any{
+				{"id": "Label_9", "name": "Old", "type": "user"},
+			}})
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages") && r.Method == http.MethodGet:
+			msgs := make([]map[string]any, 0, len(ids))
+			for _, id := range ids {
+				msgs = append(msgs, map[string]any{"id": id})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": msgs})
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages/batchModify"):
+			var body struct {
+				IDs    []string `json:"ids"`
+				Add    []string `json:"addLabelIds"`
+				Remove []string `json:"removeLabelIds"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if strings.Join(body.Add, ",") != "Label_9" || strings.Join(body.Remove, ",") != "INBOX,UNREAD" {
+				http.Error(w, "unexpected labels", http.StatusBadRequest)
+				return
+			}
+			*batches = append(*batches, body.IDs)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestGmailBulkCmd_BatchesAndSummary(t *testing.T) {
+	var batches [][]string
+	srv := newBulkServer(t, []string{"m1", "m2", "m3"}, &batches)
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	out := captureStdout(t, func() {
+		u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+		if uiErr != nil {
+			t.Fatalf("ui.New: %v", uiErr)
+		}
+		ctx := ui.WithUI(context.Background(), u)
+		ctx = outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+		args := []string{"--query", "older_than:1y", "--archive", "--mark-read", "--add-label", "old", "--batch-size", "2"}
+		if err := runKong(t, &GmailBulkCmd{}, args, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("execute: %v", err)
+		}
+	})
+
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Fatalf("unexpected batches: %#v", batches)
+	}
+	var summary gmailBulkSummary
+	if err := json.Unmarshal([]byte(out), &summary); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if summary.Matched != 3 || summary.Modified != 3 || summary.Batches != 2 {
+		t.Fatalf("unexpected summary: %#v", summary)
+	}
+}
+
+func TestGmailBulkCmd_DryRunDoesNotModify(t *testing.T) {
+	var batches [][]string
+	srv := newBulkServer(t, []string{"m1"}, &batches)
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	var buf strings.Builder
+	u, uiErr := ui.New(ui.Options{Stdout: &buf, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	if err := runKong(t, &GmailBulkCmd{}, []string{"--query", "x:y", "--trash", "--dry-run"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if len(batches) != 0 {
+		t.Fatalf("expected no modifications, got %#v", batches)
+	}
+	if !strings.Contains(buf.String(), "Dry run: 1 messages") {
+		t.Fatalf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestGmailBulkCmd_Validation(t *testing.T) {
+	ctx := context.Background()
+	flags := &RootFlags{Account: "a@b.com"}
+	if err := runKong(t, &GmailBulkCmd{}, []string{"--query", "a:b"}, ctx, flags); err == nil {
+		t.Fatalf("expected missing action error")
+	}
+	if err := runKong(t, &GmailBulkCmd{}, []string{"--query", "a:b", "--archive", "--batch-size", "5000"}, ctx, flags); err == nil {
+		t.Fatalf("expected batch-size error")
+	}
+	if err := runKong(t, &GmailBulkCmd{}, []string{"--query", "a:b", "--mark-read", "--mark-unread"}, ctx, flags); err == nil {
+		t.Fatalf("expected mutually exclusive error")
+	}
+}
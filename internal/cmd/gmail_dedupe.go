@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const (
+	dedupeByMessageID   = "message-id"
+	dedupeByContentHash = "content-hash"
+)
+
+// GmailDedupeCmd finds duplicate messages (from imports or forwarding loops)
+// and optionally trashes all but the oldest copy.
+type GmailDedupeCmd struct {
+	Query  string `name:"query" short:"q" required:"" help:"Gmail search query selecting messages to check"`
+	By     string `name:"by" help:"Duplicate key: message-id|content-hash" default:"message-id" enum:"message-id,content-hash"`
+	Trash  bool   `name:"trash" help:"Move duplicates to trash (keeps the oldest copy)"`
+	DryRun bool   `name:"dry-run" help:"Only report duplicates; do not trash"`
+}
+
+type dedupeMessage struct {
+	ID           string `json:"id"`
+	ThreadID     string `json:"threadId,omitempty"`
+	InternalDate int64  `json:"-"`
+	From         string `json:"from,omitempty"`
+	Subject      string `json:"subject,omitempty"`
+	Key          string `json:"-"`
+}
+
+type dedupeGroup struct {
+	Key        string          `json:"key"`
+	Keep       dedupeMessage   `json:"keep"`
+	Duplicates []dedupeMessage `json:"duplicates"`
+}
+
+func (c *GmailDedupeCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	query := strings.TrimSpace(c.Query)
+	if query == "" {
+		return usage("empty --query")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	ids, err := searchMessageIDs(ctx, svc, query)
+	if err != nil {
+		return err
+	}
+	msgs, err := fetchDedupeMessages(ctx, svc, ids, c.By)
+	if err != nil {
+		return err
+	}
+	groups := groupDuplicates(msgs)
+
+	var dupIDs []string
+	for _, g := range groups {
+		for _, d := range g.Duplicates {
+			dupIDs = append(dupIDs, d.ID)
+		}
+	}
+
+	trashed := 0
+	if c.Trash && !c.DryRun && len(dupIDs) > 0 {
+		if err := batchModifyMessages(ctx, svc, dupIDs, []string{"TRASH"}, nil); err != nil {
+			return err
+		}
+		trashed = len(dupIDs)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"query":      query,
+			"by":         c.By,
+			"scanned":    len(msgs),
+			"groups":     groups,
+			"duplicates": len(dupIDs),
+			"trashed":    trashed,
+			"dryRun":     c.DryRun || !c.Trash,
+		})
+	}
+
+	if len(groups) == 0 {
+		u.Err().Printf("No duplicates among %d messages", len(msgs))
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	fmt.Fprintln(w, "KEEP\tDUPLICATE\tFROM\tSUBJECT")
+	for _, g := range groups {
+		for _, d := range g.Duplicates {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", g.Keep.ID, d.ID, d.From, d.Subject)
+		}
+	}
+	flush()
+	switch {
+	case trashed > 0:
+		u.Err().Printf("Trashed %d duplicates in %d groups", trashed, len(groups))
+	case c.Trash:
+		u.Err().Printf("Dry run: would trash %d duplicates in %d groups", len(dupIDs), len(groups))
+	default:
+		u.Err().Printf("Found %d duplicates in %d groups (use --trash to remove)", len(dupIDs), len(groups))
+	}
+	return nil
+}
+
+func fetchDedupeMessages(ctx context.Context, svc *gmail.Service, ids []string, by string) ([]dedupeMessage, error) {
+	const maxConcurrency = 10
+	sem := make(chan struct{}, maxConcurrency)
+	out := make([]dedupeMessage, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Add(1)
+		go func(idx int, messageID string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[idx] = ctx.Err()
+				return
+			}
+
+			call := svc.Users.Messages.Get("me", messageID)
+			if by == dedupeByContentHash {
+				call = call.Format(gmailFormatFull)
+			} else {
+				call = call.Format(gmailFormatMetadata).MetadataHeaders("Message-ID", "From", "Subject")
+			}
+			msg, err := call.Context(ctx).Do()
+			if err != nil {
+				errs[idx] = fmt.Errorf("message %s: %w", messageID, err)
+				return
+			}
+			out[idx] = dedupeMessage{
+				ID:           messageID,
+				ThreadID:     msg.ThreadId,
+				InternalDate: msg.InternalDate,
+				From:         sanitizeTab(headerValue(msg.Payload, "From")),
+				Subject:      sanitizeTab(headerValue(msg.Payload, "Subject")),
+				Key:          dedupeKey(msg, by),
+			}
+		}(i, id)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// dedupeKey returns the grouping key for msg, or "" when it cannot be grouped.
+func dedupeKey(msg *gmail.Message, by string) string {
+	if by != dedupeByContentHash {
+		return strings.TrimSpace(headerValue(msg.Payload, "Message-ID"))
+	}
+	h := sha256.New()
+	for _, name := range []string{"From", "To", "Cc", "Subject", "Date"} {
+		fmt.Fprintf(h, "%s:%s\n", strings.ToLower(name), strings.Join(strings.Fields(headerValue(msg.Payload, name)), " "))
+	}
+	body := strings.ReplaceAll(bestBodyText(msg.Payload), "\r\n", "\n")
+	h.Write([]byte(strings.TrimSpace(body)))
+	for _, a := range collectAttachments(msg.Payload) {
+		fmt.Fprintf(h, "\nattachment:%s:%d", a.Filename, a.Size)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// groupDuplicates groups messages by key and keeps the oldest copy of each group.
+func groupDuplicates(msgs []dedupeMessage) []dedupeGroup {
+	byKey := map[string][]dedupeMessage{}
+	var keys []string
+	for _, m := range msgs {
+		if m.Key == "" {
+			continue
+		}
+		if _, ok := byKey[m.Key]; !ok {
+			keys = append(keys, m.Key)
+		}
+		byKey[m.Key] = append(byKey[m.Key], m)
+	}
+
+	groups := make([]dedupeGroup, 0)
+	for _, key := range keys {
+		members := byKey[key]
+		if len(members) < 2 {
+			continue
+		}
+		sort.SliceStable(members, func(i, j int) bool {
+			if members[i].InternalDate != members[j].InternalDate {
+				return members[i].InternalDate < members[j].InternalDate
+			}
+			return members[i].ID < members[j].ID
+		})
+		groups = append(groups, dedupeGroup{Key: key, Keep: members[0], Duplicates: members[1:]})
+	}
+	return groups
+}
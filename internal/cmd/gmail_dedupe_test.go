@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestGroupDuplicates_KeepsOldest(t *testing.T) {
+	groups := groupDuplicates([]dedupeMessage{
+		{ID: "b", InternalDate: 20, Key: "k1"},
+		{ID: "a", InternalDate: 10, Key: "k1"},
+		{ID: "c", InternalDate: 5, Key: "k2"},
+		{ID: "d", InternalDate: 1, Key: ""},
+		{ID: "e", InternalDate: 1, Key: ""},
+	})
+	if len(groups) != 1 {
+		t.Fatalf("expected 1 group, got %#v", groups)
+	}
+	if groups[0].Keep.ID != "a" || len(groups[0].Duplicates) != 1 || groups[0].Duplicates[0].ID != "b" {
+		t.Fatalf("unexpected group: %#v", groups[0])
+	}
+}
+
+func TestDedupeKey_ContentHashIgnoresTransportHeaders(t *testing.T) {
+	mk := func(received string) *gmail.Message {
+		return &gmail.Message{Payload: &gmail.MessagePart{
+			MimeType: "text/plain",
+			Headers: []*gmail.MessagePartHeader{
+				{Name: "From", Value: "a@example.com"},
+				{Name: "Subject", Value: "Hi"},
+				{Name: "Received", Value: received},
+			},
+			Body: &gmail.MessagePartBody{Data: "aGVsbG8"},
+		}}
+	}
+	if dedupeKey(mk("x"), dedupeByContentHash) != dedupeKey(mk("y"), dedupeByContentHash) {
+		t.Fatalf("expected identical content hash")
+	}
+}
+
+func TestGmailDedupeCmd_TrashesDuplicates(t *testing.T) {
+	headers := map[string]string{"m1": "<x@a>", "m2": "<x@a>", "m3": "<y@a>"}
+	dates := map[string]string{"m1": "300", "m2": "100", "m3": "200"}
+	var trashed []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": []map[string]any{{"id": "m1"}, {"id": "m2"}, {"id": "m3"}}})
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages/batchModify"):
+			var body struct {
+				IDs []string `json:"ids"`
+				Add []string `json:"addLabelIds"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if strings.Join(body.Add, ",") != "TRASH" {
+				http.Error(w, "expected TRASH", http.StatusBadRequest)
+				return
+			}
+			trashed = append(trashed, body.IDs...)
+			w.WriteHeader(http.StatusNoContent)
+		case strings.Contains(r.URL.Path, "/users/me/messages/"):
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":           id,
+				"internalDate": dates[id],
+				"payload": map[string]any{"headers": []map[string]any{
+					{"name": "Message-ID", "value": headers[id]},
+					{"name": "Subject", "value": "s"},
+				}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &GmailDedupeCmd{}, []string{"-q", "label:imported", "--trash", "--dry-run"}, ctx, flags); err != nil {
+			t.Fatalf("dry run: %v", err)
+		}
+	})
+	if len(trashed) != 0 {
+		t.Fatalf("dry run trashed messages: %v", trashed)
+	}
+	var parsed struct {
+		Duplicates int           `json:"duplicates"`
+		Groups     []dedupeGroup `json:"groups"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Duplicates != 1 || parsed.Groups[0].Keep.ID != "m2" {
+		t.Fatalf("unexpected result: %#v", parsed)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailDedupeCmd{}, []string{"-q", "label:imported", "--trash"}, ctx, flags); err != nil {
+			t.Fatalf("trash: %v", err)
+		}
+	})
+	if strings.Join(trashed, ",") != "m1" {
+		t.Fatalf("unexpected trashed ids: %v", trashed)
+	}
+}
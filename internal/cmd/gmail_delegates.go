@@ -2,12 +2,15 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"text/tabwriter"
 
 	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/googleapi"
 
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/ui"
@@ -28,6 +31,9 @@ func (c *GmailDelegatesListCmd) Run(ctx context.Context, flags *RootFlags) error
 	if err != nil {
 		return err
 	}
+	if err = requireDelegatesAccount(account); err != nil {
+		return err
+	}
 
 	svc, err := newGmailService(ctx, account)
 	if err != nil {
@@ -36,7 +42,7 @@ func (c *GmailDelegatesListCmd) Run(ctx context.Context, flags *RootFlags) error
 
 	resp, err := svc.Users.Settings.Delegates.List("me").Do()
 	if err != nil {
-		return err
+		return wrapDelegatesError(err)
 	}
 
 	if outfmt.IsJSON(ctx) {
@@ -69,6 +75,9 @@ func (c *GmailDelegatesGetCmd) Run(ctx context.Context, flags *RootFlags) error
 	if err != nil {
 		return err
 	}
+	if err = requireDelegatesAccount(account); err != nil {
+		return err
+	}
 
 	svc, err := newGmailService(ctx, account)
 	if err != nil {
@@ -81,7 +90,7 @@ func (c *GmailDelegatesGetCmd) Run(ctx context.Context, flags *RootFlags) error
 	}
 	delegate, err := svc.Users.Settings.Delegates.Get("me", delegateEmail).Do()
 	if err != nil {
-		return err
+		return wrapDelegatesError(err)
 	}
 
 	if outfmt.IsJSON(ctx) {
@@ -103,6 +112,9 @@ func (c *GmailDelegatesAddCmd) Run(ctx context.Context, flags *RootFlags) error
 	if err != nil {
 		return err
 	}
+	if err = requireDelegatesAccount(account); err != nil {
+		return err
+	}
 
 	svc, err := newGmailService(ctx, account)
 	if err != nil {
@@ -119,7 +131,7 @@ func (c *GmailDelegatesAddCmd) Run(ctx context.Context, flags *RootFlags) error
 
 	created, err := svc.Users.Settings.Delegates.Create("me", delegate).Do()
 	if err != nil {
-		return err
+		return wrapDelegatesError(err)
 	}
 
 	if outfmt.IsJSON(ctx) {
@@ -143,6 +155,9 @@ func (c *GmailDelegatesRemoveCmd) Run(ctx context.Context, flags *RootFlags) err
 	if err != nil {
 		return err
 	}
+	if err = requireDelegatesAccount(account); err != nil {
+		return err
+	}
 
 	svc, err := newGmailService(ctx, account)
 	if err != nil {
@@ -155,7 +170,7 @@ func (c *GmailDelegatesRemoveCmd) Run(ctx context.Context, flags *RootFlags) err
 	}
 	err = svc.Users.Settings.Delegates.Delete("me", delegateEmail).Do()
 	if err != nil {
-		return err
+		return wrapDelegatesError(err)
 	}
 
 	if outfmt.IsJSON(ctx) {
@@ -168,3 +183,20 @@ func (c *GmailDelegatesRemoveCmd) Run(ctx context.Context, flags *RootFlags) err
 	u.Out().Printf("Delegate %s removed successfully", delegateEmail)
 	return nil
 }
+
+func requireDelegatesAccount(account string) error {
+	if isConsumerAccount(account) {
+		return usage("gmail delegates require a Google Workspace account (non-gmail.com)")
+	}
+	return nil
+}
+
+// wrapDelegatesError adds a hint for the common 403: the delegates API only
+// accepts service-account credentials with domain-wide delegation.
+func wrapDelegatesError(err error) error {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) && gerr.Code == http.StatusForbidden {
+		return fmt.Errorf("%w (delegate management requires a Workspace service account with domain-wide delegation; see gog auth service-account set)", err)
+	}
+	return err
+}
@@ -1,6 +1,13 @@
 package cmd
 
-import "testing"
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+)
 
 func TestDelegatesCommandsExist(t *testing.T) {
 	// Unit tests for the actual API calls live in integration; here we just ensure
@@ -11,3 +18,27 @@ func TestDelegatesCommandsExist(t *testing.T) {
 	_ = GmailDelegatesAddCmd{}
 	_ = GmailDelegatesRemoveCmd{}
 }
+
+func TestRequireDelegatesAccount(t *testing.T) {
+	if err := requireDelegatesAccount("someone@gmail.com"); err == nil {
+		t.Fatalf("expected consumer account to be rejected")
+	}
+	if err := requireDelegatesAccount("someone@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWrapDelegatesError(t *testing.T) {
+	forbidden := &googleapi.Error{Code: http.StatusForbidden, Message: "Access restricted to service accounts"}
+	wrapped := wrapDelegatesError(forbidden)
+	if !errors.Is(wrapped, forbidden) {
+		t.Fatalf("expected wrapped error to match original")
+	}
+	if !strings.Contains(wrapped.Error(), "domain-wide delegation") {
+		t.Fatalf("expected hint, got %q", wrapped.Error())
+	}
+	other := &googleapi.Error{Code: http.StatusNotFound}
+	if got := wrapDelegatesError(other); got != other {
+		t.Fatalf("expected non-403 errors unchanged")
+	}
+}
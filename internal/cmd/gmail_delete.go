@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// Gmail keeps trashed messages for 30 days; that is the undo window for
+// non-hard deletes.
+const gmailTrashRetention = 30 * 24 * time.Hour
+
+const gmailDeletePreviewSize = 10
+
+// GmailDeleteCmd deletes every message matching a query. It always prints a
+// preview first and asks for a typed confirmation before touching anything.
+type GmailDeleteCmd struct {
+	Query     string `name:"query" short:"q" help:"Gmail search query selecting messages"`
+	DryRun    bool   `name:"dry-run" help:"Only show what would be deleted"`
+	Hard      bool   `name:"hard" help:"Permanently delete via batchDelete instead of moving to trash (cannot be undone)"`
+	Undo      bool   `name:"undo" help:"Restore the messages trashed by the last delete run"`
+	BatchSize int    `name:"batch-size" help:"Messages per API call (max 1000)" default:"1000"`
+}
+
+type gmailDeleteUndo struct {
+	Query     string    `json:"query"`
+	IDs       []string  `json:"ids"`
+	TrashedAt time.Time `json:"trashedAt"`
+}
+
+type gmailDeletePreview struct {
+	ID      string `json:"id"`
+	Date    string `json:"date,omitempty"`
+	From    string `json:"from,omitempty"`
+	Subject string `json:"subject,omitempty"`
+}
+
+type gmailDeleteSummary struct {
+	Query   string               `json:"query"`
+	Matched int                  `json:"matched"`
+	Deleted int                  `json:"deleted"`
+	Batches int                  `json:"batches"`
+	Hard    bool                 `json:"hard,omitempty"`
+	DryRun  bool                 `json:"dryRun,omitempty"`
+	Sample  []gmailDeletePreview `json:"sample,omitempty"`
+}
+
+func (c *GmailDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if c.BatchSize <= 0 || c.BatchSize > gmailBatchModifyLimit {
+		return usagef("--batch-size must be between 1 and %d", gmailBatchModifyLimit)
+	}
+	if c.Undo {
+		if strings.TrimSpace(c.Query) != "" || c.Hard {
+			return usage("--undo cannot be combined with --query or --hard")
+		}
+		return c.runUndo(ctx, u, flags, account)
+	}
+
+	query := strings.TrimSpace(c.Query)
+	if query == "" {
+		return usage("required: --query")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	ids, err := searchMessageIDs(ctx, svc, query)
+	if err != nil {
+		return err
+	}
+	chunks := chunkIDs(ids, c.BatchSize)
+	summary := gmailDeleteSummary{
+		Query:   query,
+		Matched: len(ids),
+		Batches: len(chunks),
+		Hard:    c.Hard,
+		DryRun:  c.DryRun,
+	}
+	summary.Sample, err = fetchDeletePreview(ctx, svc, ids)
+	if err != nil {
+		return err
+	}
+
+	// The preview always goes out first; with --json it is the result of a dry run.
+	if !outfmt.IsJSON(ctx) {
+		writeDeletePreview(ctx, u, summary)
+	}
+	if c.DryRun || len(ids) == 0 {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, summary)
+		}
+		if len(ids) == 0 {
+			u.Err().Println("No messages match")
+		}
+		return nil
+	}
+
+	action := fmt.Sprintf("move %d messages to trash", len(ids))
+	if c.Hard {
+		action = fmt.Sprintf("permanently delete %d messages", len(ids))
+	}
+	if confirmErr := confirmTyped(ctx, flags, action, strconv.Itoa(len(ids))); confirmErr != nil {
+		return confirmErr
+	}
+
+	for i, chunk := range chunks {
+		if c.Hard {
+			err = svc.Users.Messages.BatchDelete("me", &gmail.BatchDeleteMessagesRequest{Ids: chunk}).Context(ctx).Do()
+		} else {
+			err = svc.Users.Messages.BatchModify("me", &gmail.BatchModifyMessagesRequest{
+				Ids:         chunk,
+				AddLabelIds: []string{"TRASH"},
+			}).Context(ctx).Do()
+		}
+		if err != nil {
+			if !c.Hard && summary.Deleted > 0 {
+				_ = saveGmailDeleteUndo(account, gmailDeleteUndo{Query: query, IDs: ids[:summary.Deleted], TrashedAt: time.Now().UTC()})
+			}
+			return fmt.Errorf("batch %d/%d: %w", i+1, len(chunks), err)
+		}
+		summary.Deleted += len(chunk)
+		u.Err().Printf("batch %d/%d: %d/%d messages", i+1, len(chunks), summary.Deleted, len(ids))
+	}
+
+	if !c.Hard {
+		if err := saveGmailDeleteUndo(account, gmailDeleteUndo{Query: query, IDs: ids, TrashedAt: time.Now().UTC()}); err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, summary)
+	}
+	if c.Hard {
+		u.Out().Printf("Permanently deleted %d messages", summary.Deleted)
+		return nil
+	}
+	u.Out().Printf("Moved %d messages to trash", summary.Deleted)
+	u.Err().Printf("Undo within 30 days: gog gmail delete --undo")
+	return nil
+}
+
+func (c *GmailDeleteCmd) runUndo(ctx context.Context, u *ui.UI, flags *RootFlags, account string) error {
+	path, err := gmailDeleteUndoPath(account)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path is derived from the config dir
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return usage("nothing to undo")
+		}
+		return fmt.Errorf("read undo state: %w", err)
+	}
+	var undo gmailDeleteUndo
+	if err := json.Unmarshal(data, &undo); err != nil {
+		return fmt.Errorf("parse undo state %s: %w", path, err)
+	}
+	if time.Since(undo.TrashedAt) > gmailTrashRetention {
+		return fmt.Errorf("last delete (%s) is older than 30 days; Gmail has already emptied those messages from trash", undo.TrashedAt.Local().Format(time.RFC3339))
+	}
+	if err := confirmDestructive(ctx, flags, fmt.Sprintf("restore %d messages deleted by %q", len(undo.IDs), undo.Query)); err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	chunks := chunkIDs(undo.IDs, c.BatchSize)
+	restored := 0
+	for i, chunk := range chunks {
+		err = svc.Users.Messages.BatchModify("me", &gmail.BatchModifyMessagesRequest{
+			Ids:            chunk,
+			RemoveLabelIds: []string{"TRASH"},
+		}).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("batch %d/%d: %w", i+1, len(chunks), err)
+		}
+		restored += len(chunk)
+		u.Err().Printf("batch %d/%d: %d/%d messages", i+1, len(chunks), restored, len(undo.IDs))
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("clear undo state: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"query": undo.Query, "restored": restored})
+	}
+	u.Out().Printf("Restored %d messages from trash", restored)
+	return nil
+}
+
+func writeDeletePreview(ctx context.Context, u *ui.UI, summary gmailDeleteSummary) {
+	verb := "move to trash"
+	if summary.Hard {
+		verb = "permanently delete"
+	}
+	u.Out().Printf("Would %s %d messages matching %q (%d batches)", verb, summary.Matched, summary.Query, summary.Batches)
+	if len(summary.Sample) == 0 {
+		return
+	}
+	w, flush := tableWriter(ctx)
+	fmt.Fprintln(w, "ID\tDATE\tFROM\tSUBJECT")
+	for _, p := range summary.Sample {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.ID, p.Date, sanitizeTab(p.From), sanitizeTab(p.Subject))
+	}
+	flush()
+	if more := summary.Matched - len(summary.Sample); more > 0 {
+		u.Out().Printf("... and %d more", more)
+	}
+}
+
+// fetchDeletePreview loads headers for the first few matches so the user can
+// sanity-check the query before confirming.
+func fetchDeletePreview(ctx context.Context, svc *gmail.Service, ids []string) ([]gmailDeletePreview, error) {
+	if len(ids) > gmailDeletePreviewSize {
+		ids = ids[:gmailDeletePreviewSize]
+	}
+	out := make([]gmailDeletePreview, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(idx int, messageID string) {
+			defer wg.Done()
+			msg, err := svc.Users.Messages.Get("me", messageID).
+				Format(gmailFormatMetadata).
+				MetadataHeaders("From", "Subject", "Date").
+				Context(ctx).
+				Do()
+			if err != nil {
+				errs[idx] = fmt.Errorf("message %s: %w", messageID, err)
+				return
+			}
+			out[idx] = gmailDeletePreview{
+				ID:      messageID,
+				Date:    formatGmailDateInLocation(headerValue(msg.Payload, "Date"), nil),
+				From:    headerValue(msg.Payload, "From"),
+				Subject: headerValue(msg.Payload, "Subject"),
+			}
+		}(i, id)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func gmailDeleteUndoPath(account string) (string, error) {
+	dir, err := config.EnsureGmailDeleteUndoDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, sanitizeAccountForPath(account)+".json"), nil
+}
+
+func saveGmailDeleteUndo(account string, undo gmailDeleteUndo) error {
+	path, err := gmailDeleteUndoPath(account)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(undo)
+	if err != nil {
+		return fmt.Errorf("encode undo state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("write undo state: %w", err)
+	}
+	return nil
+}
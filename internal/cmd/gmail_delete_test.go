@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestGmailDeleteCmd_PreviewTrashUndoAndHard(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	var modifies []gmail.BatchModifyMessagesRequest
+	var deletes [][]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/messages/batchModify"):
+			var req gmail.BatchModifyMessagesRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			modifies = append(modifies, req)
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasSuffix(r.URL.Path, "/messages/batchDelete"):
+			var req gmail.BatchDeleteMessagesRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			deletes = append(deletes, req.Ids)
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasSuffix(r.URL.Path, "/messages"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": []map[string]any{{"id": "m1"}, {"id": "m2"}, {"id": "m3"}}})
+		case strings.Contains(r.URL.Path, "/messages/"):
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": id, "payload": map[string]any{"headers": []map[string]any{
+				{"name": "From", "value": "news@example.com"},
+				{"name": "Subject", "value": "Deal " + id},
+			}}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	jsonCtx := outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &GmailDeleteCmd{}, []string{"-q", "from:news", "--dry-run"}, jsonCtx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("dry run: %v", err)
+		}
+	})
+	var summary gmailDeleteSummary
+	if err := json.Unmarshal([]byte(out), &summary); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if summary.Matched != 3 || !summary.DryRun || len(summary.Sample) != 3 || summary.Sample[1].Subject != "Deal m2" {
+		t.Fatalf("unexpected dry-run summary: %#v", summary)
+	}
+	if len(modifies) != 0 || len(deletes) != 0 {
+		t.Fatalf("dry run modified messages")
+	}
+
+	if err := runKong(t, &GmailDeleteCmd{}, []string{"-q", "from:news"}, ctx, &RootFlags{Account: "a@b.com", NoInput: true}); err == nil {
+		t.Fatalf("expected refusal without --force in non-interactive mode")
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailDeleteCmd{}, []string{"-q", "from:news", "--batch-size", "2"}, ctx, &RootFlags{Account: "a@b.com", Force: true}); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+	})
+	if len(modifies) != 2 || !slices.Equal(modifies[0].AddLabelIds, []string{"TRASH"}) || len(modifies[1].Ids) != 1 {
+		t.Fatalf("unexpected trash batches: %#v", modifies)
+	}
+
+	modifies = nil
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailDeleteCmd{}, []string{"--undo"}, ctx, &RootFlags{Account: "a@b.com", Force: true}); err != nil {
+			t.Fatalf("undo: %v", err)
+		}
+	})
+	if len(modifies) != 1 || !slices.Equal(modifies[0].RemoveLabelIds, []string{"TRASH"}) || !slices.Equal(modifies[0].Ids, []string{"m1", "m2", "m3"}) {
+		t.Fatalf("unexpected undo request: %#v", modifies)
+	}
+	if err := runKong(t, &GmailDeleteCmd{}, []string{"--undo"}, ctx, &RootFlags{Account: "a@b.com", Force: true}); err == nil {
+		t.Fatalf("expected nothing to undo after restoring")
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailDeleteCmd{}, []string{"-q", "from:news", "--hard"}, ctx, &RootFlags{Account: "a@b.com", Force: true}); err != nil {
+			t.Fatalf("hard delete: %v", err)
+		}
+	})
+	if len(deletes) != 1 || len(deletes[0]) != 3 {
+		t.Fatalf("unexpected batchDelete calls: %#v", deletes)
+	}
+}
@@ -253,9 +253,11 @@ type GmailDraftsCreateCmd struct {
 	Body             string   `name:"body" help:"Body (plain text; required unless --body-html is set)"`
 	BodyFile         string   `name:"body-file" help:"Body file path (plain text; '-' for stdin)"`
 	BodyHTML         string   `name:"body-html" help:"Body (HTML; optional)"`
+	Markdown         bool     `name:"markdown" help:"Treat --body/--body-file as Markdown and send it as styled HTML (plus plain text); raw HTML in the Markdown is omitted"`
 	ReplyToMessageID string   `name:"reply-to-message-id" help:"Reply to Gmail message ID (sets In-Reply-To/References and thread)"`
 	ReplyTo          string   `name:"reply-to" help:"Reply-To header address"`
 	Attach           []string `name:"attach" help:"Attachment file path (repeatable)"`
+	AttachInline     []string `name:"attach-inline" help:"Inline image for the HTML body as cid:<id>=<path>, referenced via <img src=\"cid:<id>\"> (repeatable)"`
 	From             string   `name:"from" help:"Send from this email address (must be a verified send-as alias)"`
 }
 
@@ -270,6 +272,7 @@ type draftComposeInput struct {
 	ReplyToThreadID  string
 	ReplyTo          string
 	Attach           []string
+	AttachInline     []string
 	From             string
 }
 
@@ -280,6 +283,9 @@ func (c draftComposeInput) validate() error {
 	if strings.TrimSpace(c.Body) == "" && strings.TrimSpace(c.BodyHTML) == "" {
 		return usage("required: --body, --body-file, or --body-html")
 	}
+	if len(c.AttachInline) > 0 && strings.TrimSpace(c.BodyHTML) == "" {
+		return usage("--attach-inline requires an HTML body (--body-html or --markdown)")
+	}
 	return nil
 }
 
@@ -307,14 +313,15 @@ func buildDraftMessage(ctx context.Context, svc *gmail.Service, account string,
 	references := info.References
 	threadID := info.ThreadID
 
-	atts := make([]mailAttachment, 0, len(input.Attach))
-	for _, p := range input.Attach {
-		expanded, expandErr := config.ExpandPath(p)
-		if expandErr != nil {
-			return nil, "", expandErr
-		}
-		atts = append(atts, mailAttachment{Path: expanded})
+	atts, err := expandAttachments(input.Attach)
+	if err != nil {
+		return nil, "", err
+	}
+	inline, err := parseInlineAttachments(input.AttachInline)
+	if err != nil {
+		return nil, "", err
 	}
+	atts = append(atts, inline...)
 
 	raw, err := buildRFC822(mailOptions{
 		From:        fromAddr,
@@ -375,6 +382,10 @@ func (c *GmailDraftsCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 	if err != nil {
 		return err
 	}
+	body, bodyHTML, err := renderMarkdownBody(body, c.BodyHTML, c.Markdown)
+	if err != nil {
+		return err
+	}
 
 	input := draftComposeInput{
 		To:               c.To,
@@ -382,11 +393,12 @@ func (c *GmailDraftsCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 		Bcc:              c.Bcc,
 		Subject:          c.Subject,
 		Body:             body,
-		BodyHTML:         c.BodyHTML,
+		BodyHTML:         bodyHTML,
 		ReplyToMessageID: c.ReplyToMessageID,
 		ReplyToThreadID:  "",
 		ReplyTo:          c.ReplyTo,
 		Attach:           c.Attach,
+		AttachInline:     c.AttachInline,
 		From:             c.From,
 	}
 	if validateErr := input.validate(); validateErr != nil {
@@ -419,9 +431,11 @@ type GmailDraftsUpdateCmd struct {
 	Body             string   `name:"body" help:"Body (plain text; required unless --body-html is set)"`
 	BodyFile         string   `name:"body-file" help:"Body file path (plain text; '-' for stdin)"`
 	BodyHTML         string   `name:"body-html" help:"Body (HTML; optional)"`
+	Markdown         bool     `name:"markdown" help:"Treat --body/--body-file as Markdown and send it as styled HTML (plus plain text); raw HTML in the Markdown is omitted"`
 	ReplyToMessageID string   `name:"reply-to-message-id" help:"Reply to Gmail message ID (sets In-Reply-To/References and thread)"`
 	ReplyTo          string   `name:"reply-to" help:"Reply-To header address"`
 	Attach           []string `name:"attach" help:"Attachment file path (repeatable)"`
+	AttachInline     []string `name:"attach-inline" help:"Inline image for the HTML body as cid:<id>=<path>, referenced via <img src=\"cid:<id>\"> (repeatable)"`
 	From             string   `name:"from" help:"Send from this email address (must be a verified send-as alias)"`
 }
 
@@ -470,6 +484,10 @@ func (c *GmailDraftsUpdateCmd) Run(ctx context.Context, flags *RootFlags) error
 	if err != nil {
 		return err
 	}
+	body, bodyHTML, err := renderMarkdownBody(body, c.BodyHTML, c.Markdown)
+	if err != nil {
+		return err
+	}
 
 	replyToThreadID := ""
 	if strings.TrimSpace(c.ReplyToMessageID) == "" {
@@ -482,11 +500,12 @@ func (c *GmailDraftsUpdateCmd) Run(ctx context.Context, flags *RootFlags) error
 		Bcc:              c.Bcc,
 		Subject:          c.Subject,
 		Body:             body,
-		BodyHTML:         c.BodyHTML,
+		BodyHTML:         bodyHTML,
 		ReplyToMessageID: c.ReplyToMessageID,
 		ReplyToThreadID:  replyToThreadID,
 		ReplyTo:          c.ReplyTo,
 		Attach:           c.Attach,
+		AttachInline:     c.AttachInline,
 		From:             c.From,
 	}
 	if validateErr := input.validate(); validateErr != nil {
@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const (
+	gmailExportFormatMbox = "mbox"
+	gmailExportFormatEML  = "eml"
+
+	gmailExportWindow = 100
+)
+
+var mboxFromLine = regexp.MustCompile(`^>*From `)
+
+type GmailExportCmd struct {
+	Query       string `name:"query" short:"q" required:"" help:"Gmail search query selecting messages to export (use 'in:anywhere' for everything)"`
+	Format      string `name:"format" help:"Archive format: mbox|eml" default:"mbox" enum:"mbox,eml"`
+	Out         string `name:"out" aliases:"output" required:"" help:"Output directory (or a .mbox file path for --format mbox)"`
+	Max         int64  `name:"max" help:"Export at most this many messages (0 = no limit)" default:"0"`
+	Concurrency int    `name:"concurrency" help:"Parallel message fetches" default:"5"`
+}
+
+type gmailExportSummary struct {
+	Query    string `json:"query"`
+	Format   string `json:"format"`
+	Path     string `json:"path"`
+	Matched  int    `json:"matched"`
+	Exported int    `json:"exported"`
+	Skipped  int    `json:"skipped,omitempty"`
+}
+
+func (c *GmailExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	query := strings.TrimSpace(c.Query)
+	if query == "" {
+		return usage("required: --query")
+	}
+	if c.Concurrency <= 0 {
+		return usage("--concurrency must be > 0")
+	}
+	if c.Max < 0 {
+		return usage("--max must be >= 0")
+	}
+	outPath, err := config.ExpandPath(strings.TrimSpace(c.Out))
+	if err != nil {
+		return err
+	}
+	if outPath == "" {
+		return usage("required: --out")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	ids, err := searchMessageIDs(ctx, svc, query)
+	if err != nil {
+		return err
+	}
+	if c.Max > 0 && int64(len(ids)) > c.Max {
+		ids = ids[:c.Max]
+	}
+
+	summary := gmailExportSummary{Query: query, Format: c.Format, Matched: len(ids)}
+	switch c.Format {
+	case gmailExportFormatEML:
+		summary.Path = outPath
+		summary.Exported, summary.Skipped, err = exportGmailEML(ctx, u, svc, ids, outPath, c.Concurrency)
+	default:
+		if !strings.EqualFold(filepath.Ext(outPath), ".mbox") {
+			outPath = filepath.Join(outPath, "gmail-export.mbox")
+		}
+		summary.Path = outPath
+		summary.Exported, err = exportGmailMbox(ctx, u, svc, ids, outPath, c.Concurrency)
+	}
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, summary)
+	}
+	u.Out().Printf("format\t%s", summary.Format)
+	u.Out().Printf("path\t%s", summary.Path)
+	u.Out().Printf("matched\t%d", summary.Matched)
+	u.Out().Printf("exported\t%d", summary.Exported)
+	if summary.Skipped > 0 {
+		u.Out().Printf("skipped\t%d", summary.Skipped)
+	}
+	return nil
+}
+
+type rawGmailMessage struct {
+	ID           string
+	InternalDate int64
+	Raw          []byte
+}
+
+// fetchRawMessages fetches messages in RFC822 form, preserving the order of ids.
+func fetchRawMessages(ctx context.Context, svc *gmail.Service, ids []string, concurrency int) ([]rawGmailMessage, error) {
+	out := make([]rawGmailMessage, len(ids))
+	errs := make([]error, len(ids))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(idx int, messageID string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[idx] = ctx.Err()
+				return
+			}
+			msg, err := svc.Users.Messages.Get("me", messageID).Format(gmailFormatRaw).Context(ctx).Do()
+			if err != nil {
+				errs[idx] = fmt.Errorf("fetch message %s: %w", messageID, err)
+				return
+			}
+			raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(msg.Raw, "="))
+			if err != nil {
+				errs[idx] = fmt.Errorf("decode message %s: %w", messageID, err)
+				return
+			}
+			out[idx] = rawGmailMessage{ID: messageID, InternalDate: msg.InternalDate, Raw: raw}
+		}(i, id)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func exportGmailEML(ctx context.Context, u *ui.UI, svc *gmail.Service, ids []string, dir string, concurrency int) (int, int, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return 0, 0, fmt.Errorf("create output dir: %w", err)
+	}
+
+	// Files already on disk are skipped so an interrupted export can be resumed.
+	pending := make([]string, 0, len(ids))
+	skipped := 0
+	for _, id := range ids {
+		if _, err := os.Stat(filepath.Join(dir, id+".eml")); err == nil {
+			skipped++
+			continue
+		}
+		pending = append(pending, id)
+	}
+
+	exported := 0
+	for _, window := range chunkIDs(pending, gmailExportWindow) {
+		msgs, err := fetchRawMessages(ctx, svc, window, concurrency)
+		if err != nil {
+			return exported, skipped, err
+		}
+		for _, m := range msgs {
+			if err := writeEMLFile(dir, m.ID+".eml", m.Raw); err != nil {
+				return exported, skipped, fmt.Errorf("write %s.eml: %w", m.ID, err)
+			}
+			exported++
+		}
+		if u != nil {
+			u.Err().Printf("exported %d/%d", exported+skipped, len(ids))
+		}
+	}
+	return exported, skipped, nil
+}
+
+// writeEMLFile writes data to a temp file in dir and renames it into place,
+// so a partially written file is never mistaken for a finished one on resume.
+func writeEMLFile(dir, name string, data []byte) error {
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, name))
+}
+
+func exportGmailMbox(ctx context.Context, u *ui.UI, svc *gmail.Service, ids []string, path string, concurrency int) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return 0, fmt.Errorf("create output dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600) //nolint:gosec // user-provided path
+	if err != nil {
+		return 0, fmt.Errorf("create mbox: %w", err)
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	exported := 0
+	for _, window := range chunkIDs(ids, gmailExportWindow) {
+		msgs, fetchErr := fetchRawMessages(ctx, svc, window, concurrency)
+		if fetchErr != nil {
+			return exported, fetchErr
+		}
+		for _, m := range msgs {
+			if err := writeMboxMessage(w, m); err != nil {
+				return exported, fmt.Errorf("write mbox: %w", err)
+			}
+			exported++
+		}
+		if u != nil {
+			u.Err().Printf("exported %d/%d", exported, len(ids))
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return exported, fmt.Errorf("write mbox: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return exported, fmt.Errorf("close mbox: %w", err)
+	}
+	return exported, nil
+}
+
+// writeMboxMessage appends one message in mboxrd format: a "From " separator
+// line, the message with LF line endings and ">"-escaped "From " lines, and a
+// trailing blank line.
+func writeMboxMessage(w io.Writer, m rawGmailMessage) error {
+	sender := "MAILER-DAEMON"
+	if parsed, err := mail.ReadMessage(bytes.NewReader(m.Raw)); err == nil {
+		if addr, addrErr := mail.ParseAddress(parsed.Header.Get("From")); addrErr == nil && addr.Address != "" {
+			sender = addr.Address
+		}
+	}
+	date := time.Unix(0, 0).UTC()
+	if m.InternalDate > 0 {
+		date = time.UnixMilli(m.InternalDate).UTC()
+	}
+	if _, err := fmt.Fprintf(w, "From %s %s\n", sender, date.Format(time.ANSIC)); err != nil {
+		return err
+	}
+
+	body := strings.ReplaceAll(string(m.Raw), "\r\n", "\n")
+	body = strings.TrimSuffix(body, "\n")
+	for _, line := range strings.Split(body, "\n") {
+		if mboxFromLine.MatchString(line) {
+			line = ">" + line
+		}
+		if _, err := io.WriteString(w, line+"\n"); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
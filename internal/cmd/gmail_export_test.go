@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestWriteMboxMessage(t *testing.T) {
+	raw := "From: Ada <ada@example.com>\r\nSubject: Hi\r\n\r\nFrom the start\r\n>From quoted\r\nbody\r\n"
+	var buf bytes.Buffer
+	if err := writeMboxMessage(&buf, rawGmailMessage{ID: "m1", InternalDate: 1700000000000, Raw: []byte(raw)}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	want := "From ada@example.com Tue Nov 14 22:13:20 2023\n" +
+		"From: Ada <ada@example.com>\nSubject: Hi\n\n>From the start\n>>From quoted\nbody\n\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected mbox:\n%q\nwant:\n%q", buf.String(), want)
+	}
+}
+
+func TestGmailExportCmd_EMLAndMbox(t *testing.T) {
+	raws := map[string]string{
+		"m1": "From: a@example.com\r\nSubject: One\r\n\r\nfirst\r\n",
+		"m2": "From: b@example.com\r\nSubject: Two\r\n\r\nsecond\r\n",
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages") && r.Method == http.MethodGet:
+			if r.URL.Query().Get("q") != "label:project-x" {
+				http.Error(w, "unexpected query", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": []map[string]any{{"id": "m1"}, {"id": "m2"}}})
+		case strings.Contains(r.URL.Path, "/users/me/messages/"):
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			if r.URL.Query().Get("format") != "raw" {
+				http.Error(w, "expected raw format", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":           id,
+				"internalDate": "1700000000000",
+				"raw":          base64.RawURLEncoding.EncodeToString([]byte(raws[id])),
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com"}
+	dir := t.TempDir()
+
+	emlDir := filepath.Join(dir, "eml")
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailExportCmd{}, []string{"-q", "label:project-x", "--format", "eml", "--out", emlDir}, ctx, flags); err != nil {
+			t.Fatalf("export eml: %v", err)
+		}
+	})
+	data, err := os.ReadFile(filepath.Join(emlDir, "m2.eml"))
+	if err != nil {
+		t.Fatalf("read eml: %v", err)
+	}
+	if string(data) != raws["m2"] {
+		t.Fatalf("unexpected eml: %q", data)
+	}
+	if entries, _ := os.ReadDir(emlDir); len(entries) != 2 {
+		t.Fatalf("expected only the two .eml files, got %v", entries)
+	}
+
+	mboxPath := filepath.Join(dir, "archive.mbox")
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailExportCmd{}, []string{"-q", "label:project-x", "--out", mboxPath}, ctx, flags); err != nil {
+			t.Fatalf("export mbox: %v", err)
+		}
+	})
+	data, err = os.ReadFile(mboxPath)
+	if err != nil {
+		t.Fatalf("read mbox: %v", err)
+	}
+	if strings.Count(string(data), "\nFrom ")+1 != 2 || !strings.HasPrefix(string(data), "From a@example.com ") {
+		t.Fatalf("unexpected mbox:\n%s", data)
+	}
+	if strings.Index(string(data), "Subject: One") > strings.Index(string(data), "Subject: Two") {
+		t.Fatalf("expected messages in query order:\n%s", data)
+	}
+}
@@ -19,6 +19,8 @@ type GmailFiltersCmd struct {
 	Get    GmailFiltersGetCmd    `cmd:"" name:"get" help:"Get a specific filter"`
 	Create GmailFiltersCreateCmd `cmd:"" name:"create" help:"Create a new email filter"`
 	Delete GmailFiltersDeleteCmd `cmd:"" name:"delete" help:"Delete a filter"`
+	Export GmailFiltersExportCmd `cmd:"" name:"export" help:"Export filters as Gmail filter XML"`
+	Import GmailFiltersImportCmd `cmd:"" name:"import" help:"Import filters from Gmail filter XML"`
 }
 
 type GmailFiltersListCmd struct{}
@@ -152,7 +154,7 @@ func (c *GmailFiltersGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 type GmailFiltersCreateCmd struct {
 	From          string `name:"from" help:"Match messages from this sender"`
 	To            string `name:"to" help:"Match messages to this recipient"`
-	Subject       string `name:"subject" help:"Match messages with this subject"`
+	Subject       string `name:"subject" aliases:"subject-contains" help:"Match messages with this subject"`
 	Query         string `name:"query" help:"Advanced Gmail search query for matching"`
 	HasAttachment bool   `name:"has-attachment" help:"Match messages with attachments"`
 	AddLabel      string `name:"add-label" help:"Label(s) to add to matching messages (comma-separated, name or ID)"`
@@ -0,0 +1,374 @@
+package cmd
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// Gmail's "Export filters" feature writes an Atom feed of apps:property
+// entries; we read and write the same shape so backups round-trip through
+// the Gmail web UI.
+
+const (
+	filterXMLAtomNS = "http://www.w3.org/2005/Atom"
+	filterXMLAppsNS = "http://schemas.google.com/apps/2006"
+)
+
+type filterXMLProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type filterXMLFeedOut struct {
+	XMLName xml.Name            `xml:"feed"`
+	Xmlns   string              `xml:"xmlns,attr"`
+	Apps    string              `xml:"xmlns:apps,attr"`
+	Title   string              `xml:"title"`
+	Entries []filterXMLEntryOut `xml:"entry"`
+}
+
+type filterXMLEntryOut struct {
+	Category   filterXMLCategory   `xml:"category"`
+	Title      string              `xml:"title"`
+	Content    string              `xml:"content"`
+	Properties []filterXMLProperty `xml:"apps:property"`
+}
+
+type filterXMLCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type filterXMLFeedIn struct {
+	Entries []struct {
+		Properties []filterXMLProperty `xml:"property"`
+	} `xml:"entry"`
+}
+
+// filterToXMLProperties converts an API filter into Gmail XML properties.
+// Label IDs are written as names so the file is portable between accounts.
+func filterToXMLProperties(f *gmail.Filter, idToName map[string]string) []filterXMLProperty {
+	var props []filterXMLProperty
+	add := func(name, value string) {
+		if value != "" {
+			props = append(props, filterXMLProperty{Name: name, Value: value})
+		}
+	}
+	addBool := func(name string, value bool) {
+		if value {
+			add(name, "true")
+		}
+	}
+
+	if c := f.Criteria; c != nil {
+		add("from", c.From)
+		add("to", c.To)
+		add("subject", c.Subject)
+		add("hasTheWord", c.Query)
+		add("doesNotHaveTheWord", c.NegatedQuery)
+		addBool("hasAttachment", c.HasAttachment)
+		addBool("excludeChats", c.ExcludeChats)
+		if c.Size > 0 {
+			add("size", strconv.FormatInt(c.Size, 10))
+			add("sizeUnit", "s_sb")
+			switch c.SizeComparison {
+			case "larger":
+				add("sizeOperator", "s_sl")
+			case "smaller":
+				add("sizeOperator", "s_ss")
+			}
+		}
+	}
+
+	if a := f.Action; a != nil {
+		add("forwardTo", a.Forward)
+		for _, id := range a.AddLabelIds {
+			switch id {
+			case "STARRED":
+				addBool("shouldStar", true)
+			case "TRASH":
+				addBool("shouldTrash", true)
+			case "IMPORTANT":
+				addBool("shouldAlwaysMarkAsImportant", true)
+			default:
+				name := id
+				if n, ok := idToName[id]; ok {
+					name = n
+				}
+				add("label", name)
+			}
+		}
+		for _, id := range a.RemoveLabelIds {
+			switch id {
+			case "INBOX":
+				addBool("shouldArchive", true)
+			case "UNREAD":
+				addBool("shouldMarkAsRead", true)
+			case "SPAM":
+				addBool("shouldNeverSpam", true)
+			case "IMPORTANT":
+				addBool("shouldNeverMarkAsImportant", true)
+			}
+		}
+	}
+	return props
+}
+
+// xmlPropertiesToFilter converts Gmail XML properties into an API filter.
+// Label names are returned separately so callers can resolve/create them.
+func xmlPropertiesToFilter(props []filterXMLProperty) (*gmail.Filter, []string, error) {
+	criteria := &gmail.FilterCriteria{}
+	action := &gmail.FilterAction{}
+	var labels []string
+	var sizeOperator, sizeUnit string
+
+	for _, p := range props {
+		isTrue := strings.EqualFold(p.Value, "true")
+		switch p.Name {
+		case "from":
+			criteria.From = p.Value
+		case "to":
+			criteria.To = p.Value
+		case "subject":
+			criteria.Subject = p.Value
+		case "hasTheWord":
+			criteria.Query = p.Value
+		case "doesNotHaveTheWord":
+			criteria.NegatedQuery = p.Value
+		case "hasAttachment":
+			criteria.HasAttachment = isTrue
+		case "excludeChats":
+			criteria.ExcludeChats = isTrue
+		case "size":
+			n, err := strconv.ParseInt(p.Value, 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid size %q: %w", p.Value, err)
+			}
+			criteria.Size = n
+		case "sizeOperator":
+			sizeOperator = p.Value
+		case "sizeUnit":
+			sizeUnit = p.Value
+		case "label":
+			labels = append(labels, p.Value)
+		case "forwardTo":
+			action.Forward = p.Value
+		case "shouldStar":
+			if isTrue {
+				action.AddLabelIds = append(action.AddLabelIds, "STARRED")
+			}
+		case "shouldTrash":
+			if isTrue {
+				action.AddLabelIds = append(action.AddLabelIds, "TRASH")
+			}
+		case "shouldAlwaysMarkAsImportant":
+			if isTrue {
+				action.AddLabelIds = append(action.AddLabelIds, "IMPORTANT")
+			}
+		case "shouldArchive":
+			if isTrue {
+				action.RemoveLabelIds = append(action.RemoveLabelIds, "INBOX")
+			}
+		case "shouldMarkAsRead":
+			if isTrue {
+				action.RemoveLabelIds = append(action.RemoveLabelIds, "UNREAD")
+			}
+		case "shouldNeverSpam":
+			if isTrue {
+				action.RemoveLabelIds = append(action.RemoveLabelIds, "SPAM")
+			}
+		case "shouldNeverMarkAsImportant":
+			if isTrue {
+				action.RemoveLabelIds = append(action.RemoveLabelIds, "IMPORTANT")
+			}
+		}
+	}
+	if criteria.Size > 0 {
+		// The web UI exports sizes in the unit picked in the filter dialog.
+		switch sizeUnit {
+		case "", "s_sb":
+		case "s_skb":
+			criteria.Size *= 1 << 10
+		case "s_smb":
+			criteria.Size *= 1 << 20
+		default:
+			return nil, nil, fmt.Errorf("unknown sizeUnit %q (expected s_sb, s_skb or s_smb)", sizeUnit)
+		}
+		switch sizeOperator {
+		case "s_sl":
+			criteria.SizeComparison = "larger"
+		case "s_ss":
+			criteria.SizeComparison = "smaller"
+		}
+	}
+	return &gmail.Filter{Criteria: criteria, Action: action}, labels, nil
+}
+
+type GmailFiltersExportCmd struct {
+	Out string `name:"out" aliases:"output" help:"Output file path (default: stdout)"`
+}
+
+func (c *GmailFiltersExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	resp, err := svc.Users.Settings.Filters.List("me").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	idToName, err := fetchLabelIDToName(svc)
+	if err != nil {
+		return err
+	}
+
+	feed := filterXMLFeedOut{
+		Xmlns: filterXMLAtomNS,
+		Apps:  filterXMLAppsNS,
+		Title: "Mail Filters",
+	}
+	for _, f := range resp.Filter {
+		if f == nil {
+			continue
+		}
+		feed.Entries = append(feed.Entries, filterXMLEntryOut{
+			Category:   filterXMLCategory{Term: "filter"},
+			Title:      "Mail Filter",
+			Properties: filterToXMLProperties(f, idToName),
+		})
+	}
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append([]byte(xml.Header), data...)
+	data = append(data, '\n')
+
+	outPath := strings.TrimSpace(c.Out)
+	if outPath == "" || outPath == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	outPath, err = config.ExpandPath(outPath)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(outPath, data, 0o600); err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"path": outPath, "count": len(feed.Entries)})
+	}
+	u.Out().Printf("Exported %d filters to %s", len(feed.Entries), outPath)
+	return nil
+}
+
+type GmailFiltersImportCmd struct {
+	Path   string `arg:"" name:"file" help:"Gmail filter XML file (or - for stdin)"`
+	DryRun bool   `name:"dry-run" help:"Parse and print filters without creating them"`
+}
+
+func (c *GmailFiltersImportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	inPath := strings.TrimSpace(c.Path)
+	if inPath == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		inPath, err = config.ExpandPath(inPath)
+		if err != nil {
+			return err
+		}
+		data, err = os.ReadFile(inPath) //nolint:gosec // user-provided path
+	}
+	if err != nil {
+		return err
+	}
+
+	var feed filterXMLFeedIn
+	if err = xml.Unmarshal(data, &feed); err != nil {
+		return fmt.Errorf("parse filter XML: %w", err)
+	}
+	if len(feed.Entries) == 0 {
+		return usage("no filters found in file")
+	}
+
+	type parsedFilter struct {
+		filter *gmail.Filter
+		labels []string
+	}
+	parsed := make([]parsedFilter, 0, len(feed.Entries))
+	for i, entry := range feed.Entries {
+		f, labels, parseErr := xmlPropertiesToFilter(entry.Properties)
+		if parseErr != nil {
+			return fmt.Errorf("filter %d: %w", i+1, parseErr)
+		}
+		parsed = append(parsed, parsedFilter{filter: f, labels: labels})
+	}
+
+	if c.DryRun {
+		if outfmt.IsJSON(ctx) {
+			filters := make([]*gmail.Filter, 0, len(parsed))
+			for _, p := range parsed {
+				filters = append(filters, p.filter)
+			}
+			return outfmt.WriteJSON(os.Stdout, map[string]any{"dryRun": true, "filters": filters})
+		}
+		u.Out().Printf("Would create %d filters", len(parsed))
+		return nil
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	nameToID, err := fetchLabelNameToID(svc)
+	if err != nil {
+		return err
+	}
+
+	created := make([]*gmail.Filter, 0, len(parsed))
+	for _, p := range parsed {
+		for _, name := range p.labels {
+			id, labelErr := resolveOrCreateLabelID(ctx, svc, nameToID, name)
+			if labelErr != nil {
+				return labelErr
+			}
+			p.filter.Action.AddLabelIds = append(p.filter.Action.AddLabelIds, id)
+		}
+		f, createErr := svc.Users.Settings.Filters.Create("me", p.filter).Context(ctx).Do()
+		if createErr != nil {
+			return createErr
+		}
+		created = append(created, f)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"filters": created, "count": len(created)})
+	}
+	u.Out().Printf("Imported %d filters", len(created))
+	return nil
+}
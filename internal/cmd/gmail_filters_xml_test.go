@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+func TestFilterXML_RoundTrip(t *testing.T) {
+	in := &gmail.Filter{
+		Criteria: &gmail.FilterCriteria{
+			From:           "news@example.com",
+			Subject:        "Weekly",
+			Query:          "unsubscribe",
+			HasAttachment:  true,
+			Size:           1024,
+			SizeComparison: "larger",
+		},
+		Action: &gmail.FilterAction{
+			AddLabelIds:    []string{"Label_1", "STARRED"},
+			RemoveLabelIds: []string{"INBOX", "SPAM"},
+			Forward:        "archive@example.com",
+		},
+	}
+
+	props := filterToXMLProperties(in, map[string]string{"Label_1": "Newsletters"})
+	feed := filterXMLFeedOut{Xmlns: filterXMLAtomNS, Apps: filterXMLAppsNS, Title: "Mail Filters"}
+	feed.Entries = append(feed.Entries, filterXMLEntryOut{Category: filterXMLCategory{Term: "filter"}, Properties: props})
+	data, err := xml.Marshal(feed)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `<apps:property name="label" value="Newsletters">`) {
+		t.Fatalf("expected label name in XML, got %s", data)
+	}
+
+	var parsed filterXMLFeedIn
+	if err = xml.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(parsed.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(parsed.Entries))
+	}
+	out, labels, err := xmlPropertiesToFilter(parsed.Entries[0].Properties)
+	if err != nil {
+		t.Fatalf("xmlPropertiesToFilter: %v", err)
+	}
+	if len(labels) != 1 || labels[0] != "Newsletters" {
+		t.Fatalf("unexpected labels: %#v", labels)
+	}
+	c := out.Criteria
+	if c.From != in.Criteria.From || c.Subject != "Weekly" || c.Query != "unsubscribe" || !c.HasAttachment {
+		t.Fatalf("criteria mismatch: %#v", c)
+	}
+	if c.Size != 1024 || c.SizeComparison != "larger" {
+		t.Fatalf("size mismatch: %#v", c)
+	}
+	a := out.Action
+	if a.Forward != "archive@example.com" {
+		t.Fatalf("forward mismatch: %#v", a)
+	}
+	if strings.Join(a.AddLabelIds, ",") != "STARRED" || strings.Join(a.RemoveLabelIds, ",") != "INBOX,SPAM" {
+		t.Fatalf("action mismatch: add=%v remove=%v", a.AddLabelIds, a.RemoveLabelIds)
+	}
+}
+
+func TestFilterXML_ParseGmailExport(t *testing.T) {
+	raw := `<?xml version='1.0' encoding='UTF-8'?><feed xmlns='http://www.w3.org/2005/Atom' xmlns:apps='http://schemas.google.com/apps/2006'>
+	<title>Mail Filters</title>
+	<entry>
+		<category term='filter'></category>
+		<title>Mail Filter</title>
+		<apps:property name='from' value='boss@example.com'/>
+		<apps:property name='shouldMarkAsRead' value='true'/>
+		<apps:property name='size' value='oops'/>
+	</entry>
+</feed>`
+	var feed filterXMLFeedIn
+	if err := xml.Unmarshal([]byte(raw), &feed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(feed.Entries) != 1 || len(feed.Entries[0].Properties) != 3 {
+		t.Fatalf("unexpected feed: %#v", feed)
+	}
+	if _, _, err := xmlPropertiesToFilter(feed.Entries[0].Properties); err == nil {
+		t.Fatalf("expected invalid size error")
+	}
+}
+
+func TestFilterXML_SizeUnit(t *testing.T) {
+	raw := `<?xml version='1.0' encoding='UTF-8'?><feed xmlns='http://www.w3.org/2005/Atom' xmlns:apps='http://schemas.google.com/apps/2006'>
+	<entry>
+		<category term='filter'></category>
+		<apps:property name='size' value='5'/>
+		<apps:property name='sizeOperator' value='s_sl'/>
+		<apps:property name='sizeUnit' value='s_smb'/>
+	</entry>
+</feed>`
+	var feed filterXMLFeedIn
+	if err := xml.Unmarshal([]byte(raw), &feed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	f, _, err := xmlPropertiesToFilter(feed.Entries[0].Properties)
+	if err != nil {
+		t.Fatalf("xmlPropertiesToFilter: %v", err)
+	}
+	if f.Criteria.Size != 5<<20 || f.Criteria.SizeComparison != "larger" {
+		t.Fatalf("size mismatch: %#v", f.Criteria)
+	}
+
+	// Re-export (always in bytes) and import again.
+	data, err := xml.Marshal(filterXMLFeedOut{
+		Xmlns:   filterXMLAtomNS,
+		Apps:    filterXMLAppsNS,
+		Entries: []filterXMLEntryOut{{Category: filterXMLCategory{Term: "filter"}, Properties: filterToXMLProperties(f, nil)}},
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var again filterXMLFeedIn
+	if err = xml.Unmarshal(data, &again); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	f2, _, err := xmlPropertiesToFilter(again.Entries[0].Properties)
+	if err != nil {
+		t.Fatalf("xmlPropertiesToFilter: %v", err)
+	}
+	if f2.Criteria.Size != 5<<20 || f2.Criteria.SizeComparison != "larger" {
+		t.Fatalf("round-trip size mismatch: %#v", f2.Criteria)
+	}
+
+	props := []filterXMLProperty{{Name: "size", Value: "5"}, {Name: "sizeUnit", Value: "s_sgb"}}
+	if _, _, err = xmlPropertiesToFilter(props); err == nil || !strings.Contains(err.Error(), "sizeUnit") {
+		t.Fatalf("expected unknown sizeUnit error, got %v", err)
+	}
+}
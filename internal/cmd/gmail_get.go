@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/steipete/gogcli/internal/config"
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/ui"
 )
@@ -14,7 +15,8 @@ import (
 type GmailGetCmd struct {
 	MessageID string `arg:"" name:"messageId" help:"Message ID"`
 	Format    string `name:"format" help:"Message format: full|metadata|raw" default:"full"`
-	Headers   string `name:"headers" help:"Metadata headers (comma-separated; only for --format=metadata)"`
+	Headers   string `name:"headers" help:"Headers to show (comma-separated, e.g. from,to,subject; with --format=metadata also limits the fetched headers)"`
+	Out       string `name:"out" aliases:"output" help:"Write the RFC822 source to this file ('-' for stdout without other output; only for --format=raw)"`
 }
 
 const (
@@ -44,6 +46,11 @@ func (c *GmailGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return fmt.Errorf("invalid --format: %q (expected full|metadata|raw)", format)
 	}
 
+	outPath := strings.TrimSpace(c.Out)
+	if outPath != "" && format != gmailFormatRaw {
+		return usage("--out requires --format=raw")
+	}
+
 	svc, err := newGmailService(ctx, account)
 	if err != nil {
 		return err
@@ -66,6 +73,11 @@ func (c *GmailGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
+	if outPath != "" {
+		return writeRawMessage(ctx, u, msg.Id, msg.Raw, outPath)
+	}
+
+	selected := splitCSV(c.Headers)
 	unsubscribe := bestUnsubscribeLink(msg.Payload)
 	if outfmt.IsJSON(ctx) {
 		// Include a flattened headers map for easier querying
@@ -78,6 +90,9 @@ func (c *GmailGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 			"subject": headerValue(msg.Payload, "Subject"),
 			"date":    headerValue(msg.Payload, "Date"),
 		}
+		for _, name := range selected {
+			headers[strings.ToLower(name)] = headerValue(msg.Payload, name)
+		}
 		payload := map[string]any{
 			"message": msg,
 			"headers": headers,
@@ -117,10 +132,12 @@ func (c *GmailGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		u.Out().Println(string(decoded))
 		return nil
 	case gmailFormatMetadata, gmailFormatFull:
-		u.Out().Printf("from\t%s", headerValue(msg.Payload, "From"))
-		u.Out().Printf("to\t%s", headerValue(msg.Payload, "To"))
-		u.Out().Printf("subject\t%s", headerValue(msg.Payload, "Subject"))
-		u.Out().Printf("date\t%s", headerValue(msg.Payload, "Date"))
+		if len(selected) == 0 {
+			selected = []string{"From", "To", "Subject", "Date"}
+		}
+		for _, name := range selected {
+			u.Out().Printf("%s\t%s", strings.ToLower(name), headerValue(msg.Payload, name))
+		}
 		if unsubscribe != "" {
 			u.Out().Printf("unsubscribe\t%s", unsubscribe)
 		}
@@ -141,3 +158,33 @@ func (c *GmailGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return nil
 	}
 }
+
+// writeRawMessage writes the decoded RFC822 source to path ("-" = stdout), so it
+// can be piped straight into MIME tooling.
+func writeRawMessage(ctx context.Context, u *ui.UI, id, raw, path string) error {
+	if raw == "" {
+		return fmt.Errorf("message %s has no raw content", id)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(raw, "="))
+	if err != nil {
+		return fmt.Errorf("decode raw message: %w", err)
+	}
+	if path == "-" {
+		_, err = os.Stdout.Write(decoded)
+		return err
+	}
+	path, err = config.ExpandPath(path)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, decoded, 0o600); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"id": id, "path": path, "bytes": len(decoded)})
+	}
+	u.Out().Printf("id\t%s", id)
+	u.Out().Printf("path\t%s", path)
+	u.Out().Printf("bytes\t%d", len(decoded))
+	return nil
+}
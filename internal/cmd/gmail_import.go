@@ -0,0 +1,314 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+	gapi "google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type GmailImportCmd struct {
+	Path        string   `arg:"" name:"path" help:"mbox file, .eml file, or directory of .eml files"`
+	Label       []string `name:"label" help:"Label to apply to imported messages (repeatable; created if missing; use INBOX to show in inbox)"`
+	NoSpamCheck bool     `name:"no-spam-check" help:"Never mark imported messages as spam (messages.import only)"`
+	Insert      bool     `name:"insert" help:"Use messages.insert (no scanning or classification) instead of messages.import"`
+	Map         string   `name:"map" help:"Mapping file (JSONL of source -> Gmail message ID) used to resume (default: <path>.gog-import.jsonl)"`
+	Max         int      `name:"max" help:"Import at most this many new messages (0 = no limit)" default:"0"`
+	DryRun      bool     `name:"dry-run" help:"List messages that would be imported without uploading"`
+}
+
+type gmailImportMapEntry struct {
+	Source    string `json:"source"`
+	MessageID string `json:"messageIdHeader,omitempty"`
+	GmailID   string `json:"gmailId"`
+	ThreadID  string `json:"threadId,omitempty"`
+}
+
+type gmailImportSummary struct {
+	Path     string `json:"path"`
+	Map      string `json:"map"`
+	Imported int    `json:"imported"`
+	Skipped  int    `json:"skipped"`
+	DryRun   bool   `json:"dryRun,omitempty"`
+}
+
+var errImportLimitReached = errors.New("import limit reached")
+
+func (c *GmailImportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if c.Max < 0 {
+		return usage("--max must be >= 0")
+	}
+	if c.Insert && c.NoSpamCheck {
+		return usage("--no-spam-check has no effect with --insert (messages.insert never classifies)")
+	}
+	inPath, err := config.ExpandPath(strings.TrimSpace(c.Path))
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(inPath)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", inPath, err)
+	}
+
+	mapPath := strings.TrimSpace(c.Map)
+	if mapPath == "" {
+		mapPath = strings.TrimSuffix(inPath, string(filepath.Separator)) + ".gog-import.jsonl"
+	} else if mapPath, err = config.ExpandPath(mapPath); err != nil {
+		return err
+	}
+	done, err := readGmailImportMap(mapPath)
+	if err != nil {
+		return err
+	}
+
+	summary := gmailImportSummary{Path: inPath, Map: mapPath, DryRun: c.DryRun}
+
+	var (
+		svc      *gmail.Service
+		labelIDs []string
+		mapFile  *os.File
+	)
+	if !c.DryRun {
+		svc, err = newGmailService(ctx, account)
+		if err != nil {
+			return err
+		}
+		if len(c.Label) > 0 {
+			nameToID, labelErr := fetchLabelNameToID(svc)
+			if labelErr != nil {
+				return labelErr
+			}
+			for _, name := range c.Label {
+				name = strings.TrimSpace(name)
+				if name == "" {
+					continue
+				}
+				id, resolveErr := resolveOrCreateLabelID(ctx, svc, nameToID, name)
+				if resolveErr != nil {
+					return resolveErr
+				}
+				labelIDs = append(labelIDs, id)
+			}
+		}
+		mapFile, err = os.OpenFile(mapPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gosec // user-provided path
+		if err != nil {
+			return fmt.Errorf("open map file: %w", err)
+		}
+		defer mapFile.Close()
+	}
+
+	importOne := func(source string, raw []byte) error {
+		if done[source] {
+			summary.Skipped++
+			return nil
+		}
+		if c.Max > 0 && summary.Imported >= c.Max {
+			return errImportLimitReached
+		}
+		entry := gmailImportMapEntry{Source: source, MessageID: rawMessageIDHeader(raw)}
+		if c.DryRun {
+			summary.Imported++
+			if !outfmt.IsJSON(ctx) {
+				u.Out().Printf("%s\t%s", source, entry.MessageID)
+			}
+			return nil
+		}
+
+		// Upload the message as media rather than base64 in the JSON body, so
+		// large messages are not limited by the metadata request size.
+		msg := &gmail.Message{LabelIds: labelIDs}
+		media := gapi.ContentType("message/rfc822")
+		var created *gmail.Message
+		var uploadErr error
+		if c.Insert {
+			created, uploadErr = svc.Users.Messages.Insert("me", msg).Media(bytes.NewReader(raw), media).InternalDateSource("dateHeader").Context(ctx).Do()
+		} else {
+			call := svc.Users.Messages.Import("me", msg).Media(bytes.NewReader(raw), media).InternalDateSource("dateHeader").Context(ctx)
+			if c.NoSpamCheck {
+				call = call.NeverMarkSpam(true)
+			}
+			created, uploadErr = call.Do()
+		}
+		if uploadErr != nil {
+			return fmt.Errorf("import %s: %w", source, uploadErr)
+		}
+		entry.GmailID = created.Id
+		entry.ThreadID = created.ThreadId
+		line, marshalErr := json.Marshal(entry)
+		if marshalErr != nil {
+			return marshalErr
+		}
+		if _, writeErr := mapFile.Write(append(line, '\n')); writeErr != nil {
+			return fmt.Errorf("write map file: %w", writeErr)
+		}
+		done[source] = true
+		summary.Imported++
+		if summary.Imported%50 == 0 {
+			u.Err().Printf("imported %d", summary.Imported)
+		}
+		return nil
+	}
+
+	switch {
+	case info.IsDir():
+		err = importEMLDir(inPath, importOne)
+	case strings.EqualFold(filepath.Ext(inPath), ".eml"):
+		var raw []byte
+		raw, err = os.ReadFile(inPath) //nolint:gosec // user-provided path
+		if err == nil {
+			err = importOne(filepath.Base(inPath), raw)
+		}
+	default:
+		var f *os.File
+		f, err = os.Open(inPath) //nolint:gosec // user-provided path
+		if err != nil {
+			return err
+		}
+		base := filepath.Base(inPath)
+		err = scanMbox(f, func(index int, raw []byte) error {
+			return importOne(fmt.Sprintf("%s#%d", base, index), raw)
+		})
+		_ = f.Close()
+	}
+	if err != nil && !errors.Is(err, errImportLimitReached) {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, summary)
+	}
+	if c.DryRun {
+		u.Err().Printf("Would import %d messages (%d already imported)", summary.Imported, summary.Skipped)
+		return nil
+	}
+	u.Out().Printf("imported\t%d", summary.Imported)
+	u.Out().Printf("skipped\t%d", summary.Skipped)
+	u.Out().Printf("map\t%s", summary.Map)
+	return nil
+}
+
+func readGmailImportMap(path string) (map[string]bool, error) {
+	done := map[string]bool{}
+	data, err := os.ReadFile(path) //nolint:gosec // user-provided path
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return done, nil
+		}
+		return nil, fmt.Errorf("read map file: %w", err)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry gmailImportMapEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			// A partially written last line from an interrupted run is re-imported.
+			continue
+		}
+		if entry.Source != "" && entry.GmailID != "" {
+			done[entry.Source] = true
+		}
+	}
+	return done, nil
+}
+
+func importEMLDir(dir string, fn func(source string, raw []byte) error) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.EqualFold(filepath.Ext(e.Name()), ".eml") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		raw, readErr := os.ReadFile(filepath.Join(dir, name)) //nolint:gosec // user-provided path
+		if readErr != nil {
+			return readErr
+		}
+		if err := fn(name, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanMbox streams messages from an mbox file, calling fn with a 1-based index
+// and the message with mboxrd "From " escaping removed.
+func scanMbox(r io.Reader, fn func(index int, raw []byte) error) error {
+	br := bufio.NewReader(r)
+	var cur bytes.Buffer
+	index := 0
+	started := false
+
+	flush := func() error {
+		if !started {
+			return nil
+		}
+		raw := bytes.TrimRight(cur.Bytes(), "\r\n")
+		cur.Reset()
+		if len(raw) == 0 {
+			return nil
+		}
+		index++
+		return fn(index, append(append([]byte(nil), raw...), '\n'))
+	}
+
+	for {
+		line, err := br.ReadString('\n')
+		if len(line) > 0 {
+			if strings.HasPrefix(line, "From ") {
+				if flushErr := flush(); flushErr != nil {
+					return flushErr
+				}
+				started = true
+			} else if started {
+				if trimmed := strings.TrimLeft(line, ">"); len(trimmed) < len(line) && strings.HasPrefix(trimmed, "From ") {
+					line = line[1:]
+				}
+				cur.WriteString(line)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read mbox: %w", err)
+		}
+	}
+	if !started {
+		return usage("no messages found (expected an mbox file starting with a \"From \" line)")
+	}
+	return flush()
+}
+
+func rawMessageIDHeader(raw []byte) string {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(msg.Header.Get("Message-ID"))
+}
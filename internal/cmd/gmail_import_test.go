@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestScanMbox_RoundTripsExport(t *testing.T) {
+	msgs := []string{
+		"From: a@example.com\nMessage-ID: <one@x>\n\nFrom the top\n>From quoted\n",
+		"From: b@example.com\nMessage-ID: <two@x>\n\nsecond\n",
+	}
+	var buf bytes.Buffer
+	for i, m := range msgs {
+		if err := writeMboxMessage(&buf, rawGmailMessage{ID: string(rune('a' + i)), Raw: []byte(m)}); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	var got []string
+	err := scanMbox(&buf, func(index int, raw []byte) error {
+		if index != len(got)+1 {
+			t.Fatalf("unexpected index %d", index)
+		}
+		got = append(got, string(raw))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	if len(got) != 2 || got[0] != msgs[0] || got[1] != msgs[1] {
+		t.Fatalf("unexpected messages: %q", got)
+	}
+
+	if err := scanMbox(strings.NewReader("not an mbox\n"), func(int, []byte) error { return nil }); err == nil {
+		t.Fatalf("expected error for non-mbox input")
+	}
+}
+
+// readMediaUpload splits a multipart/related media upload into its JSON
+// metadata and the media body.
+func readMediaUpload(t *testing.T, r *http.Request) (gmail.Message, []byte) {
+	t.Helper()
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("content type: %v", err)
+	}
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	var msg gmail.Message
+	part, err := mr.NextPart()
+	if err != nil {
+		t.Fatalf("metadata part: %v", err)
+	}
+	_ = json.NewDecoder(part).Decode(&msg)
+	part, err = mr.NextPart()
+	if err != nil {
+		t.Fatalf("media part: %v", err)
+	}
+	if ct := part.Header.Get("Content-Type"); ct != "message/rfc822" {
+		t.Fatalf("unexpected media content type %q", ct)
+	}
+	media, _ := io.ReadAll(part)
+	return msg, media
+}
+
+func TestGmailImportCmd_MboxResume(t *testing.T) {
+	var imported []gmail.Message
+	var media [][]byte
+	var query []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/labels") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": []map[string]any{{"id": "INBOX", "name": "INBOX"}}})
+		case strings.HasSuffix(r.URL.Path, "/users/me/labels") && r.Method == http.MethodPost:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "Label_7", "name": "Imported"})
+		case strings.HasSuffix(r.URL.Path, "/upload/gmail/v1/users/me/messages/import"):
+			msg, raw := readMediaUpload(t, r)
+			imported = append(imported, msg)
+			media = append(media, raw)
+			query = append(query, r.URL.RawQuery)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "g" + string(rune('0'+len(imported))), "threadId": "t"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	dir := t.TempDir()
+	mboxPath := filepath.Join(dir, "old.mbox")
+	mbox := "From a@example.com Mon Jan  1 00:00:00 2024\nSubject: one\n\nbody1\n\n" +
+		"From b@example.com Mon Jan  1 00:00:00 2024\nSubject: two\n\nbody2\n\n"
+	if err := os.WriteFile(mboxPath, []byte(mbox), 0o600); err != nil {
+		t.Fatalf("write mbox: %v", err)
+	}
+	// Simulate an interrupted earlier run that imported the first message.
+	mapPath := mboxPath + ".gog-import.jsonl"
+	if err := os.WriteFile(mapPath, []byte(`{"source":"old.mbox#1","gmailId":"g0"}`+"\n"), 0o600); err != nil {
+		t.Fatalf("write map: %v", err)
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	_ = captureStdout(t, func() {
+		args := []string{mboxPath, "--label", "Imported", "--label", "INBOX", "--no-spam-check"}
+		if err := runKong(t, &GmailImportCmd{}, args, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("import: %v", err)
+		}
+	})
+
+	if len(imported) != 1 {
+		t.Fatalf("expected 1 import after resume, got %d", len(imported))
+	}
+	if imported[0].Raw != "" || !strings.Contains(string(media[0]), "Subject: two") {
+		t.Fatalf("unexpected upload: raw=%q media=%q", imported[0].Raw, media[0])
+	}
+	if strings.Join(imported[0].LabelIds, ",") != "Label_7,INBOX" {
+		t.Fatalf("unexpected labels: %#v", imported[0].LabelIds)
+	}
+	if !strings.Contains(query[0], "neverMarkSpam=true") || !strings.Contains(query[0], "internalDateSource=dateHeader") || !strings.Contains(query[0], "uploadType=multipart") {
+		t.Fatalf("unexpected query: %q", query[0])
+	}
+
+	data, err := os.ReadFile(mapPath)
+	if err != nil {
+		t.Fatalf("read map: %v", err)
+	}
+	if !strings.Contains(string(data), `"source":"old.mbox#2","gmailId":"g1"`) {
+		t.Fatalf("unexpected map file:\n%s", data)
+	}
+
+	err = runKong(t, &GmailImportCmd{}, []string{mboxPath, "--insert", "--no-spam-check"}, ctx, &RootFlags{Account: "a@b.com"})
+	if err == nil || ExitCode(err) != 2 {
+		t.Fatalf("expected usage error for --insert --no-spam-check, got %v", err)
+	}
+}
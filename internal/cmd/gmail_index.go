@@ -0,0 +1,607 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailIndexCmd manages the local message index used by `gmail search --offline`.
+type GmailIndexCmd struct {
+	Build  GmailIndexBuildCmd  `cmd:"" name:"build" help:"Build (or rebuild) the local index for selected labels"`
+	Update GmailIndexUpdateCmd `cmd:"" name:"update" help:"Apply changes since the last build/update (history API)"`
+	Status GmailIndexStatusCmd `cmd:"" name:"status" help:"Show local index status"`
+	Clear  GmailIndexClearCmd  `cmd:"" name:"clear" help:"Delete the local index"`
+}
+
+// gmailIndex is the index state kept in the meta bucket. Messages is only
+// filled when the whole index is loaded for an offline search.
+type gmailIndex struct {
+	Account    string                          `json:"account"`
+	LabelIDs   []string                        `json:"labelIds,omitempty"`
+	Bodies     bool                            `json:"bodies"`
+	HistoryID  uint64                          `json:"historyId"`
+	UpdatedAt  time.Time                       `json:"updatedAt"`
+	LabelNames map[string]string               `json:"labelNames,omitempty"`
+	Messages   map[string]*gmailIndexedMessage `json:"-"`
+}
+
+type gmailIndexedMessage struct {
+	ID            string   `json:"id"`
+	ThreadID      string   `json:"threadId,omitempty"`
+	Date          int64    `json:"date"`
+	From          string   `json:"from,omitempty"`
+	To            string   `json:"to,omitempty"`
+	Cc            string   `json:"cc,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	Snippet       string   `json:"snippet,omitempty"`
+	Body          string   `json:"body,omitempty"`
+	LabelIDs      []string `json:"labelIds,omitempty"`
+	HasAttachment bool     `json:"hasAttachment,omitempty"`
+}
+
+// The index is a bolt database per account: one bucket with the index state
+// and one with a record per message, so updates only rewrite what changed.
+var (
+	gmailIndexMetaBucket     = []byte("meta")
+	gmailIndexMessagesBucket = []byte("messages")
+	gmailIndexStateKey       = []byte("state")
+)
+
+func gmailIndexPath(account string) (string, error) {
+	dir, err := config.EnsureGmailIndexDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, sanitizeAccountForPath(account)+".db"), nil
+}
+
+// openGmailIndex opens the index database. Unless create is set, a missing
+// index is an error telling the user to build one.
+func openGmailIndex(account string, create bool) (*bolt.DB, string, error) {
+	path, err := gmailIndexPath(account)
+	if err != nil {
+		return nil, "", err
+	}
+	opts := &bolt.Options{Timeout: 2 * time.Second, ReadOnly: !create}
+	if !create {
+		if _, statErr := os.Stat(path); errors.Is(statErr, os.ErrNotExist) {
+			return nil, path, fmt.Errorf("no local index for %s; run 'gog gmail index build' first", account)
+		}
+	}
+	db, err := bolt.Open(path, 0o600, opts)
+	if err != nil {
+		return nil, path, fmt.Errorf("open index %s: %w", path, err)
+	}
+	return db, path, nil
+}
+
+func readGmailIndexState(tx *bolt.Tx, path string) (*gmailIndex, error) {
+	var data []byte
+	if b := tx.Bucket(gmailIndexMetaBucket); b != nil {
+		data = b.Get(gmailIndexStateKey)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("index %s is incomplete; run 'gog gmail index build'", path)
+	}
+	var idx gmailIndex
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("parse index %s: %w", path, err)
+	}
+	return &idx, nil
+}
+
+func writeGmailIndexState(tx *bolt.Tx, idx *gmailIndex) error {
+	b, err := tx.CreateBucketIfNotExists(gmailIndexMetaBucket)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return fmt.Errorf("encode index: %w", err)
+	}
+	return b.Put(gmailIndexStateKey, data)
+}
+
+func putGmailIndexedMessage(b *bolt.Bucket, m *gmailIndexedMessage) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("encode message %s: %w", m.ID, err)
+	}
+	return b.Put([]byte(m.ID), data)
+}
+
+func gmailIndexMessageCount(tx *bolt.Tx) int {
+	b := tx.Bucket(gmailIndexMessagesBucket)
+	if b == nil {
+		return 0
+	}
+	return b.Stats().KeyN
+}
+
+// loadGmailIndexState reads the index state and message count without
+// decoding the messages.
+func loadGmailIndexState(account string) (*gmailIndex, int, string, error) {
+	db, path, err := openGmailIndex(account, false)
+	if err != nil {
+		return nil, 0, path, err
+	}
+	defer db.Close()
+
+	var idx *gmailIndex
+	count := 0
+	err = db.View(func(tx *bolt.Tx) error {
+		var readErr error
+		idx, readErr = readGmailIndexState(tx, path)
+		count = gmailIndexMessageCount(tx)
+		return readErr
+	})
+	return idx, count, path, err
+}
+
+// loadGmailIndex reads the index state and every indexed message.
+func loadGmailIndex(account string) (*gmailIndex, string, error) {
+	db, path, err := openGmailIndex(account, false)
+	if err != nil {
+		return nil, path, err
+	}
+	defer db.Close()
+
+	var idx *gmailIndex
+	err = db.View(func(tx *bolt.Tx) error {
+		var readErr error
+		if idx, readErr = readGmailIndexState(tx, path); readErr != nil {
+			return readErr
+		}
+		idx.Messages = map[string]*gmailIndexedMessage{}
+		b := tx.Bucket(gmailIndexMessagesBucket)
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var m gmailIndexedMessage
+			if err := json.Unmarshal(v, &m); err != nil {
+				return fmt.Errorf("parse index %s: message %s: %w", path, k, err)
+			}
+			idx.Messages[string(k)] = &m
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, path, err
+	}
+	return idx, path, nil
+}
+
+// covers reports whether a message with labelIDs belongs in the index.
+func (idx *gmailIndex) covers(labelIDs []string) bool {
+	if slices.Contains(labelIDs, "SPAM") || slices.Contains(labelIDs, "TRASH") {
+		return false
+	}
+	if len(idx.LabelIDs) == 0 {
+		return true
+	}
+	for _, id := range idx.LabelIDs {
+		if slices.Contains(labelIDs, id) {
+			return true
+		}
+	}
+	return false
+}
+
+type GmailIndexBuildCmd struct {
+	Label    []string `name:"label" help:"Index messages with this label (repeatable; default: all mail except spam/trash)"`
+	Max      int      `name:"max" help:"Index at most this many messages per label (0 = no limit)" default:"0"`
+	NoBodies bool     `name:"no-bodies" help:"Store headers and snippets only (smaller index)"`
+}
+
+func (c *GmailIndexBuildCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if c.Max < 0 {
+		return usage("--max must be >= 0")
+	}
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	// Record the history position before listing so nothing added meanwhile is missed.
+	profile, err := svc.Users.GetProfile("me").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	labelNames, err := fetchLabelIDToName(svc)
+	if err != nil {
+		return err
+	}
+	nameToID, err := fetchLabelNameToID(svc)
+	if err != nil {
+		return err
+	}
+
+	idx := &gmailIndex{
+		Account:    account,
+		Bodies:     !c.NoBodies,
+		HistoryID:  profile.HistoryId,
+		LabelNames: labelNames,
+	}
+	for _, name := range c.Label {
+		id, ok := nameToID[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return usagef("unknown label %q", name)
+		}
+		idx.LabelIDs = append(idx.LabelIDs, id)
+	}
+
+	var ids []string
+	seen := map[string]bool{}
+	queries := []string{""}
+	if len(idx.LabelIDs) > 0 {
+		queries = queries[:0]
+		for _, id := range idx.LabelIDs {
+			queries = append(queries, "label:"+gmailLabelQueryName(labelNames[id], id))
+		}
+	}
+	for _, q := range queries {
+		found, searchErr := searchMessageIDs(ctx, svc, q)
+		if searchErr != nil {
+			return searchErr
+		}
+		if c.Max > 0 && len(found) > c.Max {
+			found = found[:c.Max]
+		}
+		for _, id := range found {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	u.Err().Printf("indexing %d messages", len(ids))
+	msgs, _, err := fetchIndexMessages(ctx, svc, ids, idx.Bodies)
+	if err != nil {
+		return err
+	}
+	idx.UpdatedAt = time.Now().UTC()
+
+	db, path, err := openGmailIndex(account, true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	err = db.Update(func(tx *bolt.Tx) error {
+		if delErr := tx.DeleteBucket(gmailIndexMessagesBucket); delErr != nil && !errors.Is(delErr, bolterrors.ErrBucketNotFound) {
+			return delErr
+		}
+		b, createErr := tx.CreateBucket(gmailIndexMessagesBucket)
+		if createErr != nil {
+			return createErr
+		}
+		for _, m := range msgs {
+			if putErr := putGmailIndexedMessage(b, m); putErr != nil {
+				return putErr
+			}
+		}
+		return writeGmailIndexState(tx, idx)
+	})
+	if err != nil {
+		return fmt.Errorf("write index: %w", err)
+	}
+	return writeGmailIndexStatus(ctx, u, idx, len(msgs), path)
+}
+
+type GmailIndexUpdateCmd struct{}
+
+func (c *GmailIndexUpdateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	idx, _, path, err := loadGmailIndexState(account)
+	if err != nil {
+		return err
+	}
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	changed := map[string]bool{}
+	deleted := map[string]bool{}
+	nextHistoryID := idx.HistoryID
+	pageToken := ""
+	for {
+		call := svc.Users.History.List("me").StartHistoryId(idx.HistoryID).
+			HistoryTypes("messageAdded", "messageDeleted", "labelAdded", "labelRemoved").
+			MaxResults(500).
+			Context(ctx)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, histErr := call.Do()
+		if histErr != nil {
+			if isStaleHistoryError(histErr) {
+				return fmt.Errorf("local index is too old for incremental update; run 'gog gmail index build': %w", histErr)
+			}
+			return histErr
+		}
+		for _, h := range resp.History {
+			for _, a := range h.MessagesAdded {
+				if a != nil && a.Message != nil {
+					changed[a.Message.Id] = true
+				}
+			}
+			for _, a := range h.LabelsAdded {
+				if a != nil && a.Message != nil {
+					changed[a.Message.Id] = true
+				}
+			}
+			for _, r := range h.LabelsRemoved {
+				if r != nil && r.Message != nil {
+					changed[r.Message.Id] = true
+				}
+			}
+			for _, d := range h.MessagesDeleted {
+				if d != nil && d.Message != nil {
+					deleted[d.Message.Id] = true
+				}
+			}
+		}
+		if resp.HistoryId > nextHistoryID {
+			nextHistoryID = resp.HistoryId
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	ids := make([]string, 0, len(changed))
+	for id := range changed {
+		if !deleted[id] {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	msgs, missing, err := fetchIndexMessages(ctx, svc, ids, idx.Bodies)
+	if err != nil {
+		return err
+	}
+
+	if labelNames, labelErr := fetchLabelIDToName(svc); labelErr == nil {
+		idx.LabelNames = labelNames
+	}
+	idx.HistoryID = nextHistoryID
+	idx.UpdatedAt = time.Now().UTC()
+
+	db, _, err := openGmailIndex(account, true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	added, removed, count := 0, 0, 0
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, bucketErr := tx.CreateBucketIfNotExists(gmailIndexMessagesBucket)
+		if bucketErr != nil {
+			return bucketErr
+		}
+		for _, id := range append(slices.Collect(maps.Keys(deleted)), missing...) {
+			if b.Get([]byte(id)) == nil {
+				continue
+			}
+			if delErr := b.Delete([]byte(id)); delErr != nil {
+				return delErr
+			}
+			removed++
+		}
+		for _, m := range msgs {
+			existed := b.Get([]byte(m.ID)) != nil
+			switch {
+			case idx.covers(m.LabelIDs):
+				if putErr := putGmailIndexedMessage(b, m); putErr != nil {
+					return putErr
+				}
+				if !existed {
+					added++
+				}
+			case existed:
+				if delErr := b.Delete([]byte(m.ID)); delErr != nil {
+					return delErr
+				}
+				removed++
+			}
+		}
+		return writeGmailIndexState(tx, idx)
+	})
+	if err != nil {
+		return fmt.Errorf("write index: %w", err)
+	}
+	if err := db.View(func(tx *bolt.Tx) error {
+		count = gmailIndexMessageCount(tx)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"path":      path,
+			"messages":  count,
+			"added":     added,
+			"removed":   removed,
+			"updated":   len(msgs),
+			"historyId": formatHistoryID(idx.HistoryID),
+		})
+	}
+	u.Out().Printf("messages\t%d", count)
+	u.Out().Printf("added\t%d", added)
+	u.Out().Printf("removed\t%d", removed)
+	u.Out().Printf("history_id\t%s", formatHistoryID(idx.HistoryID))
+	return nil
+}
+
+type GmailIndexStatusCmd struct{}
+
+func (c *GmailIndexStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	idx, count, path, err := loadGmailIndexState(account)
+	if err != nil {
+		return err
+	}
+	return writeGmailIndexStatus(ctx, u, idx, count, path)
+}
+
+type GmailIndexClearCmd struct{}
+
+func (c *GmailIndexClearCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	path, err := gmailIndexPath(account)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete index: %w", err)
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"deleted": true, "path": path})
+	}
+	u.Out().Printf("deleted\t%s", path)
+	return nil
+}
+
+func writeGmailIndexStatus(ctx context.Context, u *ui.UI, idx *gmailIndex, count int, path string) error {
+	labels := make([]string, 0, len(idx.LabelIDs))
+	for _, id := range idx.LabelIDs {
+		labels = append(labels, gmailLabelQueryName(idx.LabelNames[id], id))
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"path":      path,
+			"account":   idx.Account,
+			"labels":    labels,
+			"bodies":    idx.Bodies,
+			"messages":  count,
+			"historyId": formatHistoryID(idx.HistoryID),
+			"updatedAt": idx.UpdatedAt,
+		})
+	}
+	u.Out().Printf("path\t%s", path)
+	u.Out().Printf("messages\t%d", count)
+	if len(labels) > 0 {
+		u.Out().Printf("labels\t%s", strings.Join(labels, ","))
+	} else {
+		u.Out().Printf("labels\t(all mail)")
+	}
+	u.Out().Printf("bodies\t%t", idx.Bodies)
+	u.Out().Printf("history_id\t%s", formatHistoryID(idx.HistoryID))
+	u.Out().Printf("updated\t%s", idx.UpdatedAt.Local().Format(time.RFC3339))
+	return nil
+}
+
+func gmailLabelQueryName(name, id string) string {
+	if name == "" {
+		name = id
+	}
+	return strings.ReplaceAll(name, " ", "-")
+}
+
+// fetchIndexMessages fetches messages for the index. IDs that no longer exist
+// are returned separately so callers can drop them.
+func fetchIndexMessages(ctx context.Context, svc *gmail.Service, ids []string, withBody bool) ([]*gmailIndexedMessage, []string, error) {
+	const maxConcurrency = 10
+	sem := make(chan struct{}, maxConcurrency)
+	out := make([]*gmailIndexedMessage, len(ids))
+	errs := make([]error, len(ids))
+	gone := make([]bool, len(ids))
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Add(1)
+		go func(idx int, messageID string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[idx] = ctx.Err()
+				return
+			}
+			call := svc.Users.Messages.Get("me", messageID)
+			if withBody {
+				call = call.Format(gmailFormatFull)
+			} else {
+				call = call.Format(gmailFormatMetadata).MetadataHeaders("From", "To", "Cc", "Subject")
+			}
+			msg, err := call.Context(ctx).Do()
+			if err != nil {
+				if isNotFoundAPIError(err) {
+					gone[idx] = true
+					return
+				}
+				errs[idx] = fmt.Errorf("message %s: %w", messageID, err)
+				return
+			}
+			m := &gmailIndexedMessage{
+				ID:            messageID,
+				ThreadID:      msg.ThreadId,
+				Date:          msg.InternalDate,
+				From:          headerValue(msg.Payload, "From"),
+				To:            headerValue(msg.Payload, "To"),
+				Cc:            headerValue(msg.Payload, "Cc"),
+				Subject:       headerValue(msg.Payload, "Subject"),
+				Snippet:       msg.Snippet,
+				LabelIDs:      msg.LabelIds,
+				HasAttachment: len(collectAttachments(msg.Payload)) > 0,
+			}
+			if withBody {
+				m.Body = plainBodyText(msg.Payload)
+			}
+			out[idx] = m
+		}(i, id)
+	}
+	wg.Wait()
+
+	msgs := make([]*gmailIndexedMessage, 0, len(ids))
+	var missing []string
+	for i := range ids {
+		if errs[i] != nil {
+			return nil, nil, errs[i]
+		}
+		if gone[i] {
+			missing = append(missing, ids[i])
+			continue
+		}
+		msgs = append(msgs, out[i])
+	}
+	return msgs, missing, nil
+}
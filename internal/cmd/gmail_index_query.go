@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// localQueryTerm is one clause of a search query evaluated against the local index.
+type localQueryTerm struct {
+	op     string // "" for free text
+	value  string
+	negate bool
+}
+
+var localQueryAge = regexp.MustCompile(`^(\d+)([dmy])$`)
+
+// parseLocalQuery splits a Gmail-style query into terms. Only the operators the
+// local index can answer are accepted; anything else is an error rather than a
+// silently different result.
+func parseLocalQuery(query string) ([]localQueryTerm, error) {
+	var terms []localQueryTerm
+	for _, tok := range tokenizeLocalQuery(query) {
+		term := localQueryTerm{}
+		if strings.HasPrefix(tok, "-") && len(tok) > 1 {
+			term.negate = true
+			tok = tok[1:]
+		}
+		if op, value, ok := strings.Cut(tok, ":"); ok && !strings.HasPrefix(tok, `"`) {
+			op = strings.ToLower(op)
+			switch op {
+			case "from", "to", "cc", "subject", "label", "in":
+			case "is":
+				switch strings.ToLower(value) {
+				case "unread", "read", "starred", "important":
+				default:
+					return nil, usagef("unsupported offline query %q", tok)
+				}
+			case "has":
+				if !strings.EqualFold(value, "attachment") {
+					return nil, usagef("unsupported offline query %q", tok)
+				}
+			case "after", "before":
+				if _, err := parseLocalQueryDate(value); err != nil {
+					return nil, usagef("invalid date in %q (use YYYY/MM/DD)", tok)
+				}
+			case "newer_than", "older_than":
+				if !localQueryAge.MatchString(strings.ToLower(value)) {
+					return nil, usagef("invalid age in %q (use e.g. 7d, 3m, 1y)", tok)
+				}
+			default:
+				return nil, usagef("unsupported offline query operator %q", op+":")
+			}
+			term.op = op
+			term.value = strings.ToLower(strings.Trim(value, `"`))
+		} else {
+			term.value = strings.ToLower(strings.Trim(tok, `"`))
+		}
+		if term.value == "" {
+			continue
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+// tokenizeLocalQuery splits on whitespace, keeping quoted phrases together.
+func tokenizeLocalQuery(query string) []string {
+	var tokens []string
+	var cur strings.Builder
+	inQuote := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			cur.WriteRune(r)
+		case (r == ' ' || r == '\t' || r == '\n') && !inQuote:
+			if cur.Len() > 0 {
+				tokens = append(tokens, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		tokens = append(tokens, cur.String())
+	}
+	return tokens
+}
+
+func parseLocalQueryDate(value string) (time.Time, error) {
+	value = strings.ReplaceAll(value, "-", "/")
+	return time.ParseInLocation("2006/1/2", value, time.Local)
+}
+
+func localQueryCutoff(age string, now time.Time) time.Time {
+	m := localQueryAge.FindStringSubmatch(age)
+	n, _ := strconv.Atoi(m[1])
+	switch m[2] {
+	case "m":
+		return now.AddDate(0, -n, 0)
+	case "y":
+		return now.AddDate(-n, 0, 0)
+	default:
+		return now.AddDate(0, 0, -n)
+	}
+}
+
+func (idx *gmailIndex) matches(m *gmailIndexedMessage, terms []localQueryTerm, now time.Time) bool {
+	for _, t := range terms {
+		if idx.matchTerm(m, t, now) == t.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (idx *gmailIndex) matchTerm(m *gmailIndexedMessage, t localQueryTerm, now time.Time) bool {
+	has := func(field string) bool { return strings.Contains(strings.ToLower(field), t.value) }
+	date := time.UnixMilli(m.Date)
+	switch t.op {
+	case "from":
+		return has(m.From)
+	case "to":
+		return has(m.To) || has(m.Cc)
+	case "cc":
+		return has(m.Cc)
+	case "subject":
+		return has(m.Subject)
+	case "label", "in":
+		return idx.hasLabel(m, t.value)
+	case "is":
+		switch t.value {
+		case "unread":
+			return slices.Contains(m.LabelIDs, "UNREAD")
+		case "read":
+			return !slices.Contains(m.LabelIDs, "UNREAD")
+		default:
+			return slices.Contains(m.LabelIDs, strings.ToUpper(t.value))
+		}
+	case "has":
+		return m.HasAttachment
+	case "after":
+		d, _ := parseLocalQueryDate(t.value)
+		return !date.Before(d)
+	case "before":
+		d, _ := parseLocalQueryDate(t.value)
+		return date.Before(d)
+	case "newer_than":
+		return date.After(localQueryCutoff(t.value, now))
+	case "older_than":
+		return date.Before(localQueryCutoff(t.value, now))
+	default:
+		return has(m.Subject) || has(m.From) || has(m.To) || has(m.Cc) || has(m.Snippet) || has(m.Body)
+	}
+}
+
+func (idx *gmailIndex) hasLabel(m *gmailIndexedMessage, value string) bool {
+	if value == "anywhere" {
+		return true
+	}
+	for _, id := range m.LabelIDs {
+		if strings.EqualFold(id, value) {
+			return true
+		}
+		name := strings.ToLower(idx.LabelNames[id])
+		if name == value || strings.ReplaceAll(name, " ", "-") == value {
+			return true
+		}
+	}
+	return false
+}
+
+// searchThreads evaluates query against the index and returns one item per
+// thread, newest first, in the same shape as online search results.
+func (idx *gmailIndex) searchThreads(query string, oldest bool, loc *time.Location, now time.Time) ([]threadItem, error) {
+	terms, err := parseLocalQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	type threadHit struct {
+		pick  *gmailIndexedMessage
+		count int
+	}
+	threads := map[string]*threadHit{}
+	for _, m := range idx.Messages {
+		if !idx.matches(m, terms, now) {
+			continue
+		}
+		key := m.ThreadID
+		if key == "" {
+			key = m.ID
+		}
+		hit := threads[key]
+		if hit == nil {
+			threads[key] = &threadHit{pick: m, count: 1}
+			continue
+		}
+		hit.count++
+		if (oldest && m.Date < hit.pick.Date) || (!oldest && m.Date > hit.pick.Date) {
+			hit.pick = m
+		}
+	}
+
+	keys := make([]string, 0, len(threads))
+	for k := range threads {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := threads[keys[i]].pick, threads[keys[j]].pick
+		if a.Date != b.Date {
+			return a.Date > b.Date
+		}
+		return keys[i] < keys[j]
+	})
+
+	items := make([]threadItem, 0, len(keys))
+	for _, k := range keys {
+		hit := threads[k]
+		item := threadItem{
+			ID:      k,
+			From:    sanitizeTab(hit.pick.From),
+			Subject: sanitizeTab(hit.pick.Subject),
+			Snippet: hit.pick.Snippet,
+		}
+		if hit.pick.Date > 0 {
+			item.Date = time.UnixMilli(hit.pick.Date).In(loc).Format("2006-01-02 15:04")
+		}
+		if hit.count > 1 {
+			item.MessageCount = hit.count
+		}
+		for _, id := range hit.pick.LabelIDs {
+			if name := idx.LabelNames[id]; name != "" {
+				item.Labels = append(item.Labels, name)
+			} else {
+				item.Labels = append(item.Labels, id)
+			}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func formatLocalIndexAge(updated time.Time, now time.Time) string {
+	if updated.IsZero() {
+		return "never"
+	}
+	return fmt.Sprintf("%s ago", now.Sub(updated).Round(time.Minute))
+}
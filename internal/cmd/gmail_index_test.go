@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestParseLocalQuery(t *testing.T) {
+	terms, err := parseLocalQuery(`from:ada "quarterly plan" -label:spam has:attachment newer_than:7d`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	want := []localQueryTerm{
+		{op: "from", value: "ada"},
+		{value: "quarterly plan"},
+		{op: "label", value: "spam", negate: true},
+		{op: "has", value: "attachment"},
+		{op: "newer_than", value: "7d"},
+	}
+	if len(terms) != len(want) {
+		t.Fatalf("unexpected terms: %#v", terms)
+	}
+	for i := range want {
+		if terms[i] != want[i] {
+			t.Fatalf("term %d = %#v, want %#v", i, terms[i], want[i])
+		}
+	}
+
+	for _, bad := range []string{"larger:10M", "is:muted", "after:yesterday", "newer_than:week"} {
+		if _, err := parseLocalQuery(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestGmailIndexSearchThreads(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	idx := &gmailIndex{
+		LabelNames: map[string]string{"INBOX": "INBOX", "Label_1": "Work Stuff"},
+		Messages: map[string]*gmailIndexedMessage{
+			"m1": {ID: "m1", ThreadID: "t1", Date: now.Add(-48 * time.Hour).UnixMilli(), From: "Ada <ada@example.com>", Subject: "Plans", Body: "quarterly plan attached", LabelIDs: []string{"INBOX", "Label_1"}, HasAttachment: true},
+			"m2": {ID: "m2", ThreadID: "t1", Date: now.Add(-24 * time.Hour).UnixMilli(), From: "Bob <bob@example.com>", Subject: "Re: Plans", LabelIDs: []string{"INBOX", "UNREAD"}},
+			"m3": {ID: "m3", ThreadID: "t3", Date: now.Add(-30 * 24 * time.Hour).UnixMilli(), From: "Ada <ada@example.com>", Subject: "Old", LabelIDs: []string{"INBOX"}},
+		},
+	}
+
+	cases := []struct {
+		query string
+		want  []string
+	}{
+		{"from:ada", []string{"t1", "t3"}},
+		{`"quarterly plan"`, []string{"t1"}},
+		{"label:work-stuff has:attachment", []string{"t1"}},
+		{"is:unread", []string{"t1"}},
+		{"from:ada -newer_than:7d", []string{"t3"}},
+		{"after:2024/03/01", []string{"t1"}},
+	}
+	for _, tc := range cases {
+		items, err := idx.searchThreads(tc.query, false, time.UTC, now)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.query, err)
+		}
+		var got []string
+		for _, it := range items {
+			got = append(got, it.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("%q: got %v, want %v", tc.query, got, tc.want)
+		}
+	}
+
+	items, err := idx.searchThreads("plans", false, time.UTC, now)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(items) != 1 || items[0].MessageCount != 2 || items[0].From != "Bob <bob@example.com>" {
+		t.Fatalf("expected newest message of a 2-message thread, got %#v", items)
+	}
+}
+
+func TestGmailIndexBuildUpdateAndOfflineSearch(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	messages := map[string]map[string]any{
+		"m1": {"id": "m1", "threadId": "t1", "internalDate": "1700000000000", "labelIds": []string{"INBOX"}, "snippet": "hello there",
+			"payload": map[string]any{"mimeType": "text/plain", "headers": []map[string]any{
+				{"name": "From", "value": "Ada <ada@example.com>"},
+				{"name": "Subject", "value": "Hello"},
+			}}},
+		"m2": {"id": "m2", "threadId": "t2", "internalDate": "1700000100000", "labelIds": []string{"INBOX"}, "snippet": "second",
+			"payload": map[string]any{"mimeType": "text/plain", "headers": []map[string]any{
+				{"name": "From", "value": "Bob <bob@example.com>"},
+				{"name": "Subject", "value": "Second"},
+			}}},
+	}
+	listed := []string{"m1"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/profile"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"emailAddress": "a@b.com", "historyId": "100"})
+		case strings.HasSuffix(r.URL.Path, "/labels"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": []map[string]any{{"id": "INBOX", "name": "INBOX", "type": "system"}}})
+		case strings.HasSuffix(r.URL.Path, "/history"):
+			if r.URL.Query().Get("startHistoryId") != "100" {
+				t.Errorf("unexpected startHistoryId %q", r.URL.Query().Get("startHistoryId"))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"historyId": "120",
+				"history": []map[string]any{
+					{"id": "110", "messagesAdded": []map[string]any{{"message": map[string]any{"id": "m2"}}}},
+					{"id": "115", "messagesDeleted": []map[string]any{{"message": map[string]any{"id": "m1"}}}},
+				},
+			})
+		case strings.HasSuffix(r.URL.Path, "/messages"):
+			var out []map[string]any
+			for _, id := range listed {
+				out = append(out, map[string]any{"id": id})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": out})
+		case strings.Contains(r.URL.Path, "/messages/"):
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			msg, ok := messages[id]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(msg)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	jsonCtx := outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailIndexCmd{}, []string{"build"}, jsonCtx, flags); err != nil {
+			t.Fatalf("build: %v", err)
+		}
+	})
+	idx, _, err := loadGmailIndex("a@b.com")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if idx.HistoryID != 100 || len(idx.Messages) != 1 || idx.Messages["m1"].Subject != "Hello" {
+		t.Fatalf("unexpected index after build: %#v", idx)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailIndexCmd{}, []string{"update"}, jsonCtx, flags); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+	})
+	idx, _, err = loadGmailIndex("a@b.com")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if idx.HistoryID != 120 || len(idx.Messages) != 1 || idx.Messages["m2"] == nil {
+		t.Fatalf("unexpected index after update: %#v", idx.Messages)
+	}
+
+	statusOut := captureStdout(t, func() {
+		if err := runKong(t, &GmailIndexCmd{}, []string{"status"}, jsonCtx, flags); err != nil {
+			t.Fatalf("status: %v", err)
+		}
+	})
+	var status struct {
+		Path     string `json:"path"`
+		Messages int    `json:"messages"`
+	}
+	if err := json.Unmarshal([]byte(statusOut), &status); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, statusOut)
+	}
+	if status.Messages != 1 || !strings.HasSuffix(status.Path, ".db") {
+		t.Fatalf("unexpected status: %#v", status)
+	}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &GmailSearchCmd{}, []string{"--offline", "from:bob"}, jsonCtx, flags); err != nil {
+			t.Fatalf("offline search: %v", err)
+		}
+	})
+	var parsed struct {
+		Threads []threadItem `json:"threads"`
+		Offline bool         `json:"offline"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if !parsed.Offline || len(parsed.Threads) != 1 || parsed.Threads[0].ID != "t2" {
+		t.Fatalf("unexpected offline results: %#v", parsed)
+	}
+}
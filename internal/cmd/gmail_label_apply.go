@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailLabelApplyCmd applies label changes to messages selected by ID or query.
+type GmailLabelApplyCmd struct {
+	Query  []string `arg:"" optional:"" name:"query" help:"Gmail search query selecting messages"`
+	IDs    []string `name:"id" sep:"," help:"Message IDs to modify instead of a query (comma-separated or repeated)"`
+	Add    string   `name:"add" help:"Labels to add (comma-separated, name or ID)"`
+	Remove string   `name:"remove" help:"Labels to remove (comma-separated, name or ID)"`
+}
+
+func (c *GmailLabelApplyCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	addLabels := splitCSV(c.Add)
+	removeLabels := splitCSV(c.Remove)
+	if len(addLabels) == 0 && len(removeLabels) == 0 {
+		return usage("must specify --add and/or --remove")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	ids, query, err := resolveMessageTargets(ctx, svc, c.Query, c.IDs)
+	if err != nil {
+		return err
+	}
+
+	idMap, err := fetchLabelNameToID(svc)
+	if err != nil {
+		return err
+	}
+	addIDs := resolveLabelIDs(addLabels, idMap)
+	removeIDs := resolveLabelIDs(removeLabels, idMap)
+
+	if len(ids) > 0 {
+		if err = batchModifyMessages(ctx, svc, ids, addIDs, removeIDs); err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"query":         query,
+			"count":         len(ids),
+			"addedLabels":   addIDs,
+			"removedLabels": removeIDs,
+		})
+	}
+	if len(ids) == 0 {
+		u.Err().Println("No matching messages")
+		return nil
+	}
+	u.Out().Printf("Modified %d messages", len(ids))
+	return nil
+}
@@ -15,7 +15,9 @@ import (
 type GmailLabelsCmd struct {
 	List   GmailLabelsListCmd   `cmd:"" name:"list" help:"List labels"`
 	Get    GmailLabelsGetCmd    `cmd:"" name:"get" help:"Get label details (including counts)"`
-	Create GmailLabelsCreateCmd `cmd:"" name:"create" help:"Create a new label"`
+	Create GmailLabelsCreateCmd `cmd:"" name:"create" help:"Create a new label (nested names like Clients/Acme create parents)"`
+	Rename GmailLabelsRenameCmd `cmd:"" name:"rename" help:"Rename a label"`
+	Delete GmailLabelsDeleteCmd `cmd:"" name:"delete" help:"Delete a label"`
 	Modify GmailLabelsModifyCmd `cmd:"" name:"modify" help:"Modify labels on threads"`
 }
 
@@ -91,6 +93,10 @@ func (c *GmailLabelsCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
+	if err = ensureParentLabels(ctx, svc, name); err != nil {
+		return err
+	}
+
 	label, err := createLabel(ctx, svc, name)
 	if err != nil {
 		return mapLabelCreateError(err, name)
@@ -111,6 +117,159 @@ func createLabel(ctx context.Context, svc *gmail.Service, name string) (*gmail.L
 	}).Context(ctx).Do()
 }
 
+// ensureParentLabels creates missing ancestors of a nested label name
+// ("Clients/Acme/2024" needs "Clients" and "Clients/Acme").
+func ensureParentLabels(ctx context.Context, svc *gmail.Service, name string) error {
+	parents := parentLabelNames(name)
+	if len(parents) == 0 {
+		return nil
+	}
+	idMap, err := fetchLabelNameToID(svc)
+	if err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if _, ok := idMap[strings.ToLower(parent)]; ok {
+			continue
+		}
+		if _, err := createLabel(ctx, svc, parent); err != nil && !isDuplicateLabelError(err) {
+			return fmt.Errorf("create parent label %q: %w", parent, err)
+		}
+	}
+	return nil
+}
+
+// resolveOrCreateLabelID looks up a label by name or ID in nameToID (as returned
+// by fetchLabelNameToID), creating it (and its parents) when missing.
+func resolveOrCreateLabelID(ctx context.Context, svc *gmail.Service, nameToID map[string]string, name string) (string, error) {
+	if id, ok := nameToID[strings.ToLower(name)]; ok {
+		return id, nil
+	}
+	if err := ensureParentLabels(ctx, svc, name); err != nil {
+		return "", err
+	}
+	label, err := createLabel(ctx, svc, name)
+	if err != nil {
+		return "", mapLabelCreateError(err, name)
+	}
+	nameToID[strings.ToLower(name)] = label.Id
+	return label.Id, nil
+}
+
+func parentLabelNames(name string) []string {
+	parts := strings.Split(name, "/")
+	if len(parts) < 2 {
+		return nil
+	}
+	parents := make([]string, 0, len(parts)-1)
+	for i := 1; i < len(parts); i++ {
+		parent := strings.Join(parts[:i], "/")
+		if strings.TrimSpace(parent) == "" {
+			continue
+		}
+		parents = append(parents, parent)
+	}
+	return parents
+}
+
+type GmailLabelsRenameCmd struct {
+	Label   string `arg:"" name:"labelIdOrName" help:"Label ID or name"`
+	NewName string `arg:"" name:"newName" help:"New label name"`
+}
+
+func (c *GmailLabelsRenameCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	raw := strings.TrimSpace(c.Label)
+	newName := strings.TrimSpace(c.NewName)
+	if raw == "" {
+		return usage("empty label")
+	}
+	if newName == "" {
+		return usage("new label name is required")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	idMap, err := fetchLabelNameToID(svc)
+	if err != nil {
+		return err
+	}
+	id, ok := idMap[strings.ToLower(raw)]
+	if !ok {
+		return usagef("label not found: %s", raw)
+	}
+	if existing, taken := idMap[strings.ToLower(newName)]; taken && existing != id {
+		return usagef("label already exists: %s", newName)
+	}
+	if err = ensureParentLabels(ctx, svc, newName); err != nil {
+		return err
+	}
+
+	label, err := svc.Users.Labels.Patch("me", id, &gmail.Label{Name: newName}).Context(ctx).Do()
+	if err != nil {
+		return mapLabelCreateError(err, newName)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"label": label})
+	}
+	u.Out().Printf("Renamed label %s -> %s (id: %s)", raw, label.Name, label.Id)
+	return nil
+}
+
+type GmailLabelsDeleteCmd struct {
+	Label string `arg:"" name:"labelIdOrName" help:"Label ID or name"`
+}
+
+func (c *GmailLabelsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	raw := strings.TrimSpace(c.Label)
+	if raw == "" {
+		return usage("empty label")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	idMap, err := fetchLabelNameToID(svc)
+	if err != nil {
+		return err
+	}
+	id, ok := idMap[strings.ToLower(raw)]
+	if !ok {
+		return usagef("label not found: %s", raw)
+	}
+
+	if err = confirmDestructive(ctx, flags, fmt.Sprintf("delete label %s", raw)); err != nil {
+		return err
+	}
+
+	if err = svc.Users.Labels.Delete("me", id).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"deleted": true, "id": id})
+	}
+	u.Out().Printf("Deleted label %s (id: %s)", raw, id)
+	return nil
+}
+
 type GmailLabelsListCmd struct{}
 
 func (c *GmailLabelsListCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -561,3 +561,131 @@ func TestFetchLabelIDToName(t *testing.T) {
 		t.Fatalf("unexpected label2: %q", m["Label_2"])
 	}
 }
+
+func TestGmailLabelsCreateCmd_NestedCreatesParents(t *testing.T) {
+	var created []string
+	srv := newLabelsServer(t, []map[string]any{}, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Name string `json:"name"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		created = append(created, body.Name)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "Label_" + body.Name, "name": body.Name, "type": "user"})
+	})
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	if err := runKong(t, &GmailLabelsCreateCmd{}, []string{"Clients/Acme"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if len(created) != 2 || created[0] != "Clients" || created[1] != "Clients/Acme" {
+		t.Fatalf("unexpected created labels: %#v", created)
+	}
+}
+
+func TestGmailLabelsRenameAndDeleteCmd(t *testing.T) {
+	var patchedName, deletedID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/labels") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": []map[string]any{
+				{"id": "Label_1", "name": "Old", "type": "user"},
+			}})
+		case strings.HasSuffix(r.URL.Path, "/users/me/labels/Label_1") && r.Method == http.MethodPatch:
+			var body struct {
+				Name string `json:"name"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			patchedName = body.Name
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "Label_1", "name": body.Name})
+		case strings.HasSuffix(r.URL.Path, "/users/me/labels/Label_1") && r.Method == http.MethodDelete:
+			deletedID = "Label_1"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	var buf strings.Builder
+	u, uiErr := ui.New(ui.Options{Stdout: &buf, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com", Force: true}
+
+	if err := runKong(t, &GmailLabelsRenameCmd{}, []string{"old", "New"}, ctx, flags); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if patchedName != "New" {
+		t.Fatalf("unexpected patched name: %q", patchedName)
+	}
+	if err := runKong(t, &GmailLabelsDeleteCmd{}, []string{"Old"}, ctx, flags); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if deletedID != "Label_1" {
+		t.Fatalf("expected delete of Label_1")
+	}
+	if err := runKong(t, &GmailLabelsDeleteCmd{}, []string{"Missing"}, ctx, flags); err == nil {
+		t.Fatalf("expected error for missing label")
+	}
+}
+
+func TestGmailLabelApplyCmd_Query(t *testing.T) {
+	var modify struct {
+		IDs    []string `json:"ids"`
+		Add    []string `json:"addLabelIds"`
+		Remove []string `json:"removeLabelIds"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/labels"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": []map[string]any{
+				{"id": "INBOX", "name": "INBOX", "type": "system"},
+				{"id": "Label_7", "name": "Clients/Acme", "type": "user"},
+			}})
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages") && r.Method == http.MethodGet:
+			if r.URL.Query().Get("q") != "from:acme.com" {
+				http.Error(w, "bad query", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": []map[string]any{{"id": "m1"}, {"id": "m2"}}})
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages/batchModify"):
+			_ = json.NewDecoder(r.Body).Decode(&modify)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	out := captureStdout(t, func() {
+		u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+		if uiErr != nil {
+			t.Fatalf("ui.New: %v", uiErr)
+		}
+		ctx := ui.WithUI(context.Background(), u)
+		ctx = outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+		if err := runKong(t, &GmailLabelApplyCmd{}, []string{"from:acme.com", "--add", "Clients/Acme", "--remove", "INBOX"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("execute: %v", err)
+		}
+	})
+	if len(modify.IDs) != 2 || modify.Add[0] != "Label_7" || modify.Remove[0] != "INBOX" {
+		t.Fatalf("unexpected batchModify: %#v", modify)
+	}
+	if !strings.Contains(out, `"count": 2`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
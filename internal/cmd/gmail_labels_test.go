@@ -21,3 +21,41 @@ func TestFetchLabelIDToNameBehavior(t *testing.T) {
 	// the helper exists and returns a map. (Compile-time coverage.)
 	_ = fetchLabelIDToName
 }
+
+func TestParentLabelNames(t *testing.T) {
+	if got := parentLabelNames("Flat"); got != nil {
+		t.Fatalf("expected no parents, got %#v", got)
+	}
+	got := parentLabelNames("Clients/Acme/2024")
+	if len(got) != 2 || got[0] != "Clients" || got[1] != "Clients/Acme" {
+		t.Fatalf("unexpected parents: %#v", got)
+	}
+}
+
+func TestSplitTargets(t *testing.T) {
+	query, ids, err := splitTargets([]string{"18c2f0a1b2c3d4e5", "18c2f0a1b2c3d4e6"}, nil)
+	if err != nil || query != "18c2f0a1b2c3d4e5 18c2f0a1b2c3d4e6" || len(ids) != 0 {
+		t.Fatalf("expected ID-shaped tokens to stay a query, got %q %v %v", query, ids, err)
+	}
+	query, ids, err = splitTargets(nil, []string{"m1", " ", "m2"})
+	if err != nil || query != "" || len(ids) != 2 {
+		t.Fatalf("unexpected --id split: %q %v %v", query, ids, err)
+	}
+	if _, _, err = splitTargets([]string{"from:a@b.com"}, []string{"m1"}); err == nil {
+		t.Fatalf("expected error for query plus --id")
+	}
+	if _, _, err = splitTargets(nil, nil); err == nil {
+		t.Fatalf("expected error for no targets")
+	}
+}
+
+func TestChunkIDs(t *testing.T) {
+	ids := []string{"a", "b", "c", "d", "e"}
+	chunks := chunkIDs(ids, 2)
+	if len(chunks) != 3 || len(chunks[2]) != 1 || chunks[2][0] != "e" {
+		t.Fatalf("unexpected chunks: %#v", chunks)
+	}
+	if got := chunkIDs(nil, 2); len(got) != 0 {
+		t.Fatalf("expected no chunks, got %#v", got)
+	}
+}
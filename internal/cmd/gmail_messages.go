@@ -22,6 +22,7 @@ type GmailMessagesSearchCmd struct {
 	Query       []string `arg:"" name:"query" help:"Search query"`
 	Max         int64    `name:"max" aliases:"limit" help:"Max results" default:"10"`
 	Page        string   `name:"page" help:"Page token"`
+	All         bool     `name:"all" help:"Fetch all pages (uses --max as page size)"`
 	Timezone    string   `name:"timezone" short:"z" help:"Output timezone (IANA name, e.g. America/New_York, UTC). Default: local"`
 	Local       bool     `name:"local" help:"Use local timezone (default behavior, useful to override --timezone)"`
 	IncludeBody bool     `name:"include-body" help:"Include decoded message body (JSON is full; text output is truncated)"`
@@ -43,13 +44,7 @@ func (c *GmailMessagesSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 		return err
 	}
 
-	resp, err := svc.Users.Messages.List("me").
-		Q(query).
-		MaxResults(c.Max).
-		PageToken(c.Page).
-		Fields("messages(id,threadId),nextPageToken").
-		Context(ctx).
-		Do()
+	messages, nextPageToken, err := listMessagePages(ctx, svc, query, c.Max, c.Page, c.All)
 	if err != nil {
 		return err
 	}
@@ -64,7 +59,7 @@ func (c *GmailMessagesSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 		return err
 	}
 
-	items, err := fetchMessageDetails(ctx, svc, resp.Messages, idToName, loc, c.IncludeBody)
+	items, err := fetchMessageDetails(ctx, svc, messages, idToName, loc, c.IncludeBody)
 	if err != nil {
 		return err
 	}
@@ -72,7 +67,7 @@ func (c *GmailMessagesSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 	if outfmt.IsJSON(ctx) {
 		return outfmt.WriteJSON(os.Stdout, map[string]any{
 			"messages":      items,
-			"nextPageToken": resp.NextPageToken,
+			"nextPageToken": nextPageToken,
 		})
 	}
 
@@ -100,10 +95,33 @@ func (c *GmailMessagesSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", it.ID, it.ThreadID, it.Date, it.From, it.Subject, strings.Join(it.Labels, ","))
 		}
 	}
-	printNextPageHint(u, resp.NextPageToken)
+	printNextPageHint(u, nextPageToken)
 	return nil
 }
 
+// listMessagePages lists message IDs for query. With all set, it follows
+// nextPageToken until the result set is exhausted and returns an empty token.
+func listMessagePages(ctx context.Context, svc *gmail.Service, query string, pageSize int64, pageToken string, all bool) ([]*gmail.Message, string, error) {
+	var messages []*gmail.Message
+	for {
+		resp, err := svc.Users.Messages.List("me").
+			Q(query).
+			MaxResults(pageSize).
+			PageToken(pageToken).
+			Fields("messages(id,threadId),nextPageToken").
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, "", err
+		}
+		messages = append(messages, resp.Messages...)
+		if !all || resp.NextPageToken == "" {
+			return messages, resp.NextPageToken, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
 type messageItem struct {
 	ID       string   `json:"id"`
 	ThreadID string   `json:"threadId,omitempty"`
@@ -111,6 +129,8 @@ type messageItem struct {
 	From     string   `json:"from,omitempty"`
 	Subject  string   `json:"subject,omitempty"`
 	Labels   []string `json:"labels,omitempty"`
+	LabelIDs []string `json:"labelIds,omitempty"`
+	Snippet  string   `json:"snippet,omitempty"`
 	Body     string   `json:"body,omitempty"`
 }
 
@@ -154,7 +174,7 @@ func fetchMessageDetails(ctx context.Context, svc *gmail.Service, messages []*gm
 			} else {
 				call = call.Format("metadata").
 					MetadataHeaders("From", "Subject", "Date").
-					Fields("id,threadId,labelIds,payload(headers)")
+					Fields("id,threadId,labelIds,snippet,payload(headers)")
 			}
 			msg, err := call.Context(ctx).Do()
 			if err != nil {
@@ -165,6 +185,8 @@ func fetchMessageDetails(ctx context.Context, svc *gmail.Service, messages []*gm
 			item := messageItem{
 				ID:       messageID,
 				ThreadID: msg.ThreadId,
+				LabelIDs: msg.LabelIds,
+				Snippet:  msg.Snippet,
 			}
 
 			item.From = sanitizeTab(headerValue(msg.Payload, "From"))
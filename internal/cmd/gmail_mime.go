@@ -20,6 +20,8 @@ type mailAttachment struct {
 	Filename string
 	MIMEType string
 	Data     []byte
+	// ContentID marks an inline part referenced from the HTML body as cid:<ContentID>.
+	ContentID string
 }
 
 type rfc822Config struct {
@@ -120,7 +122,30 @@ func buildRFC822(opts mailOptions, cfg *rfc822Config) ([]byte, error) {
 	hasPlain := strings.TrimSpace(plainBody) != ""
 	hasHTML := strings.TrimSpace(htmlBody) != ""
 
-	if len(opts.Attachments) == 0 {
+	var inline, attached []mailAttachment
+	for _, a := range opts.Attachments {
+		loaded, err := loadAttachment(a)
+		if err != nil {
+			return nil, err
+		}
+		if loaded.ContentID != "" {
+			inline = append(inline, loaded)
+		} else {
+			attached = append(attached, loaded)
+		}
+	}
+	if len(inline) > 0 && !hasHTML {
+		return nil, errors.New("inline attachments require an HTML body")
+	}
+
+	if len(inline) > 0 && len(attached) == 0 {
+		if err := writeInlineBody(&b, plainBody, htmlBody, inline); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+
+	if len(attached) == 0 {
 		switch {
 		case hasPlain && hasHTML:
 			altBoundary, err := randomBoundary()
@@ -160,6 +185,10 @@ func buildRFC822(opts mailOptions, cfg *rfc822Config) ([]byte, error) {
 	// Body part
 	b.WriteString(fmt.Sprintf("--%s\r\n", mixedBoundary))
 	switch {
+	case len(inline) > 0:
+		if err := writeInlineBody(&b, plainBody, htmlBody, inline); err != nil {
+			return nil, err
+		}
 	case hasPlain && hasHTML:
 		altBoundary, err := randomBoundary()
 		if err != nil {
@@ -180,24 +209,7 @@ func buildRFC822(opts mailOptions, cfg *rfc822Config) ([]byte, error) {
 	}
 
 	// Attachments
-	for _, a := range opts.Attachments {
-		if a.Filename == "" {
-			a.Filename = filepath.Base(a.Path)
-		}
-		if a.MIMEType == "" {
-			a.MIMEType = mime.TypeByExtension(strings.ToLower(filepath.Ext(a.Filename)))
-			if a.MIMEType == "" {
-				a.MIMEType = "application/octet-stream"
-			}
-		}
-		if len(a.Data) == 0 {
-			data, err := os.ReadFile(a.Path)
-			if err != nil {
-				return nil, err
-			}
-			a.Data = data
-		}
-
+	for _, a := range attached {
 		b.WriteString(fmt.Sprintf("\r\n--%s\r\n", mixedBoundary))
 		b.WriteString(fmt.Sprintf("Content-Type: %s\r\n", a.MIMEType))
 		b.WriteString("Content-Transfer-Encoding: base64\r\n")
@@ -210,6 +222,67 @@ func buildRFC822(opts mailOptions, cfg *rfc822Config) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// loadAttachment fills in the filename, MIME type, and data of a.
+func loadAttachment(a mailAttachment) (mailAttachment, error) {
+	if a.Filename == "" {
+		a.Filename = filepath.Base(a.Path)
+	}
+	if a.MIMEType == "" {
+		a.MIMEType = mime.TypeByExtension(strings.ToLower(filepath.Ext(a.Filename)))
+		if a.MIMEType == "" {
+			a.MIMEType = "application/octet-stream"
+		}
+	}
+	if len(a.Data) == 0 {
+		data, err := os.ReadFile(a.Path)
+		if err != nil {
+			return a, err
+		}
+		a.Data = data
+	}
+	return a, nil
+}
+
+// writeInlineBody writes an HTML body with its inline parts as multipart/related,
+// wrapped in multipart/alternative when there is also a plain-text body.
+func writeInlineBody(b *bytes.Buffer, plainBody, htmlBody string, inline []mailAttachment) error {
+	if strings.TrimSpace(plainBody) == "" {
+		return writeRelatedPart(b, htmlBody, inline)
+	}
+	altBoundary, err := randomBoundary()
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(b, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", altBoundary)
+	writeTextPart(b, altBoundary, "text/plain; charset=\"utf-8\"", plainBody)
+	_, _ = fmt.Fprintf(b, "--%s\r\n", altBoundary)
+	if err := writeRelatedPart(b, htmlBody, inline); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(b, "--%s--\r\n", altBoundary)
+	return nil
+}
+
+func writeRelatedPart(b *bytes.Buffer, htmlBody string, inline []mailAttachment) error {
+	relBoundary, err := randomBoundary()
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(b, "Content-Type: multipart/related; boundary=%q; type=\"text/html\"\r\n\r\n", relBoundary)
+	writeTextPart(b, relBoundary, "text/html; charset=\"utf-8\"", htmlBody)
+	for _, a := range inline {
+		_, _ = fmt.Fprintf(b, "--%s\r\n", relBoundary)
+		_, _ = fmt.Fprintf(b, "Content-Type: %s\r\n", a.MIMEType)
+		b.WriteString("Content-Transfer-Encoding: base64\r\n")
+		_, _ = fmt.Fprintf(b, "Content-ID: <%s>\r\n", a.ContentID)
+		_, _ = fmt.Fprintf(b, "Content-Disposition: inline; %s\r\n\r\n", contentDispositionFilename(a.Filename))
+		b.WriteString(wrapBase64(a.Data))
+		b.WriteString("\r\n")
+	}
+	_, _ = fmt.Fprintf(b, "--%s--\r\n", relBoundary)
+	return nil
+}
+
 func writeHeader(b *bytes.Buffer, name, value string) {
 	b.WriteString(name)
 	b.WriteString(": ")
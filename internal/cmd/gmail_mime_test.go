@@ -1,6 +1,10 @@
 package cmd
 
 import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
 	"regexp"
 	"strings"
 	"testing"
@@ -124,6 +128,106 @@ func TestBuildRFC822AlternativeWithAttachment(t *testing.T) {
 	}
 }
 
+func TestBuildRFC822InlineImages(t *testing.T) {
+	raw, err := buildRFC822(mailOptions{
+		From:     "a@b.com",
+		To:       []string{"c@d.com"},
+		Subject:  "Hi",
+		Body:     "Plain",
+		BodyHTML: `<p><img src="cid:logo"></p>`,
+		Attachments: []mailAttachment{
+			{Filename: "logo.png", MIMEType: "image/png", Data: []byte("PNG"), ContentID: "logo"},
+			{Filename: "x.txt", MIMEType: "text/plain", Data: []byte("abc")},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// Expect mixed{alternative{plain, related{html, png}}, x.txt}.
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	mixed := readMultipartTypes(t, msg.Header.Get("Content-Type"), msg.Body)
+	if len(mixed) != 2 || mixed[0].mediaType != "multipart/alternative" || mixed[1].header.Get("Content-Disposition") != `attachment; filename="x.txt"` {
+		t.Fatalf("unexpected mixed parts: %#v", mixed)
+	}
+	alt := readMultipartTypes(t, mixed[0].header.Get("Content-Type"), strings.NewReader(mixed[0].body))
+	if len(alt) != 2 || alt[0].mediaType != "text/plain" || alt[1].mediaType != "multipart/related" {
+		t.Fatalf("unexpected alternative parts: %#v", alt)
+	}
+	related := readMultipartTypes(t, alt[1].header.Get("Content-Type"), strings.NewReader(alt[1].body))
+	if len(related) != 2 || related[0].mediaType != "text/html" || related[1].mediaType != "image/png" {
+		t.Fatalf("unexpected related parts: %#v", related)
+	}
+	if got := related[1].header.Get("Content-Id"); got != "<logo>" {
+		t.Fatalf("unexpected Content-ID: %q", got)
+	}
+	if got := related[1].header.Get("Content-Disposition"); got != `inline; filename="logo.png"` {
+		t.Fatalf("unexpected Content-Disposition: %q", got)
+	}
+}
+
+func TestBuildRFC822InlineImagesHTMLOnly(t *testing.T) {
+	raw, err := buildRFC822(mailOptions{
+		From:     "a@b.com",
+		To:       []string{"c@d.com"},
+		Subject:  "Hi",
+		BodyHTML: `<img src="cid:logo">`,
+		Attachments: []mailAttachment{
+			{Filename: "logo.png", MIMEType: "image/png", Data: []byte("PNG"), ContentID: "logo"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if !strings.Contains(string(raw), "Content-Type: multipart/related; boundary=") {
+		t.Fatalf("expected top-level multipart/related: %q", raw)
+	}
+	if strings.Contains(string(raw), "multipart/mixed") || strings.Contains(string(raw), "multipart/alternative") {
+		t.Fatalf("unexpected extra nesting: %q", raw)
+	}
+
+	if _, err := buildRFC822(mailOptions{
+		From:        "a@b.com",
+		To:          []string{"c@d.com"},
+		Subject:     "Hi",
+		Body:        "Plain only",
+		Attachments: []mailAttachment{{Filename: "logo.png", Data: []byte("PNG"), ContentID: "logo"}},
+	}, nil); err == nil {
+		t.Fatalf("expected error for inline image without HTML body")
+	}
+}
+
+type testMIMEPart struct {
+	mediaType string
+	header    mail.Header
+	body      string
+}
+
+func readMultipartTypes(t *testing.T, contentType string, r io.Reader) []testMIMEPart {
+	t.Helper()
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("content type %q: %v", contentType, err)
+	}
+	mr := multipart.NewReader(r, params["boundary"])
+	var parts []testMIMEPart
+	for {
+		p, err := mr.NextRawPart()
+		if err == io.EOF {
+			return parts
+		}
+		if err != nil {
+			t.Fatalf("next part: %v", err)
+		}
+		body, _ := io.ReadAll(p)
+		mediaType, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		parts = append(parts, testMIMEPart{mediaType: mediaType, header: mail.Header(p.Header), body: string(body)})
+	}
+}
+
 func TestBuildRFC822UTF8Subject(t *testing.T) {
 	raw, err := buildRFC822(mailOptions{
 		From:    "a@b.com",
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestGmailMuteAndUnmute(t *testing.T) {
+	var mu sync.Mutex
+	labels := []map[string]any{{"id": "INBOX", "name": "INBOX", "type": "system"}}
+	modified := map[string]gmail.ModifyThreadRequest{}
+	var listQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/labels") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": labels})
+		case strings.HasSuffix(r.URL.Path, "/labels") && r.Method == http.MethodPost:
+			labels = append(labels, map[string]any{"id": "Label_9", "name": "Muted", "type": "user"})
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "Label_9", "name": "Muted"})
+		case strings.HasSuffix(r.URL.Path, "/threads") && r.Method == http.MethodGet:
+			listQuery = r.URL.Query().Get("q")
+			_ = json.NewEncoder(w).Encode(map[string]any{"threads": []map[string]any{{"id": "t1"}, {"id": "t2"}}})
+		case strings.HasSuffix(r.URL.Path, "/modify"):
+			parts := strings.Split(r.URL.Path, "/")
+			var req gmail.ModifyThreadRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			modified[parts[len(parts)-2]] = req
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": parts[len(parts)-2]})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com"}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailMuteCmd{}, []string{"from:ci@example.com"}, ctx, flags); err != nil {
+			t.Fatalf("mute: %v", err)
+		}
+	})
+	if listQuery != "from:ci@example.com" || len(modified) != 2 {
+		t.Fatalf("unexpected mute: query=%q modified=%v", listQuery, modified)
+	}
+	if req := modified["t2"]; !slices.Equal(req.AddLabelIds, []string{"Label_9"}) || !slices.Equal(req.RemoveLabelIds, []string{"INBOX"}) {
+		t.Fatalf("unexpected mute request: %#v", req)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailMuteCmd{}, []string{"--sweep"}, ctx, flags); err != nil {
+			t.Fatalf("sweep: %v", err)
+		}
+	})
+	if listQuery != "in:inbox label:Muted" {
+		t.Fatalf("unexpected sweep query: %q", listQuery)
+	}
+
+	clear(modified)
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailUnmuteCmd{}, []string{"--id", "18c2f0a1b2c3d4e5", "--inbox"}, ctx, flags); err != nil {
+			t.Fatalf("unmute: %v", err)
+		}
+	})
+	if req := modified["18c2f0a1b2c3d4e5"]; len(modified) != 1 || !slices.Equal(req.RemoveLabelIds, []string{"Label_9"}) || !slices.Equal(req.AddLabelIds, []string{"INBOX"}) {
+		t.Fatalf("unexpected unmute: %#v", modified)
+	}
+}
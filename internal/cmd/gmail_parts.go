@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"os"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailPartsCmd prints a message's MIME tree and can extract a single part.
+type GmailPartsCmd struct {
+	MessageID string `arg:"" name:"messageId" help:"Message ID"`
+	Part      string `name:"part" help:"Extract the part with this ID (e.g. 0.1) instead of listing the tree"`
+	Out       string `name:"out" aliases:"output" help:"Write the extracted part to this file (default: stdout)"`
+}
+
+type mimePartView struct {
+	PartID       string `json:"partId"`
+	Depth        int    `json:"depth"`
+	MimeType     string `json:"mimeType"`
+	Size         int64  `json:"size"`
+	Filename     string `json:"filename,omitempty"`
+	Encoding     string `json:"encoding,omitempty"`
+	Charset      string `json:"charset,omitempty"`
+	Disposition  string `json:"disposition,omitempty"`
+	ContentID    string `json:"contentId,omitempty"`
+	AttachmentID string `json:"attachmentId,omitempty"`
+}
+
+func (c *GmailPartsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	messageID := strings.TrimSpace(c.MessageID)
+	if messageID == "" {
+		return usage("empty messageId")
+	}
+	partID := strings.TrimSpace(c.Part)
+	if strings.TrimSpace(c.Out) != "" && partID == "" {
+		return usage("--out requires --part")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	msg, err := svc.Users.Messages.Get("me", messageID).Format(gmailFormatFull).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if partID != "" {
+		return c.extract(ctx, u, svc, messageID, msg.Payload, partID)
+	}
+
+	parts := flattenMimeParts(msg.Payload, 0, nil)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"id": msg.Id, "parts": parts})
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "PART\tTYPE\tSIZE\tENCODING\tFILENAME")
+	for _, p := range parts {
+		id := p.PartID
+		if id == "" {
+			id = "-"
+		}
+		encoding := p.Encoding
+		if encoding == "" {
+			encoding = "-"
+		}
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\n", strings.Repeat("  ", p.Depth), id, p.MimeType, formatBytes(p.Size), encoding, sanitizeTab(p.Filename))
+	}
+	return nil
+}
+
+func (c *GmailPartsCmd) extract(ctx context.Context, u *ui.UI, svc *gmail.Service, messageID string, root *gmail.MessagePart, partID string) error {
+	part := findMimePart(root, partID)
+	if part == nil {
+		return fmt.Errorf("part %q not found in message %s", partID, messageID)
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case part.Body != nil && part.Body.AttachmentId != "":
+		data, err = fetchAttachmentBytes(ctx, svc, messageID, part.Body.AttachmentId)
+	case part.Body != nil && part.Body.Data != "":
+		data, err = decodeBase64URLBytes(part.Body.Data)
+	default:
+		return fmt.Errorf("part %q has no body (type %s)", partID, part.MimeType)
+	}
+	if err != nil {
+		return fmt.Errorf("decode part %q: %w", partID, err)
+	}
+
+	outPath := strings.TrimSpace(c.Out)
+	if outPath == "" || outPath == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	outPath, err = config.ExpandPath(outPath)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(outPath, data, 0o600); err != nil {
+		return fmt.Errorf("write %s: %w", outPath, err)
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"partId": partID, "path": outPath, "bytes": len(data)})
+	}
+	u.Out().Printf("part\t%s", partID)
+	u.Out().Printf("path\t%s", outPath)
+	u.Out().Printf("bytes\t%d", len(data))
+	return nil
+}
+
+func flattenMimeParts(p *gmail.MessagePart, depth int, out []mimePartView) []mimePartView {
+	if p == nil {
+		return out
+	}
+	view := mimePartView{
+		PartID:   p.PartId,
+		Depth:    depth,
+		MimeType: p.MimeType,
+		Filename: p.Filename,
+		Encoding: strings.TrimSpace(headerValue(p, "Content-Transfer-Encoding")),
+	}
+	if p.Body != nil {
+		view.Size = p.Body.Size
+		view.AttachmentID = p.Body.AttachmentId
+	}
+	if _, params, err := mime.ParseMediaType(headerValue(p, "Content-Type")); err == nil {
+		view.Charset = params["charset"]
+	}
+	if disposition, _, err := mime.ParseMediaType(headerValue(p, "Content-Disposition")); err == nil {
+		view.Disposition = disposition
+	}
+	view.ContentID = strings.Trim(headerValue(p, "Content-ID"), "<>")
+	out = append(out, view)
+	for _, child := range p.Parts {
+		out = flattenMimeParts(child, depth+1, out)
+	}
+	return out
+}
+
+func findMimePart(p *gmail.MessagePart, partID string) *gmail.MessagePart {
+	if p == nil {
+		return nil
+	}
+	if p.PartId == partID {
+		return p
+	}
+	for _, child := range p.Parts {
+		if found := findMimePart(child, partID); found != nil {
+			return found
+		}
+	}
+	return nil
+}
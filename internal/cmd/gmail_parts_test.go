@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestGmailPartsCmd_TreeAndExtract(t *testing.T) {
+	enc := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/messages/m1/attachments/att1"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": enc("%PDF-1.4")})
+		case strings.HasSuffix(r.URL.Path, "/messages/m1"):
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id": "m1",
+				"payload": map[string]any{
+					"partId":   "",
+					"mimeType": "multipart/mixed",
+					"parts": []map[string]any{
+						{
+							"partId":   "0",
+							"mimeType": "text/plain",
+							"headers": []map[string]any{
+								{"name": "Content-Type", "value": `text/plain; charset="UTF-8"`},
+								{"name": "Content-Transfer-Encoding", "value": "quoted-printable"},
+							},
+							"body": map[string]any{"data": enc("hello"), "size": 5},
+						},
+						{
+							"partId":   "1",
+							"mimeType": "application/pdf",
+							"filename": "a.pdf",
+							"headers": []map[string]any{
+								{"name": "Content-Disposition", "value": `attachment; filename="a.pdf"`},
+								{"name": "Content-Transfer-Encoding", "value": "base64"},
+							},
+							"body": map[string]any{"attachmentId": "att1", "size": 8},
+						},
+					},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &GmailPartsCmd{}, []string{"m1"}, outfmt.WithMode(ctx, outfmt.Mode{JSON: true}), flags); err != nil {
+			t.Fatalf("parts: %v", err)
+		}
+	})
+	var parsed struct {
+		Parts []mimePartView `json:"parts"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Parts) != 3 {
+		t.Fatalf("expected 3 parts, got %#v", parsed.Parts)
+	}
+	text, pdf := parsed.Parts[1], parsed.Parts[2]
+	if text.Depth != 1 || text.Charset != "UTF-8" || text.Encoding != "quoted-printable" {
+		t.Fatalf("unexpected text part: %#v", text)
+	}
+	if pdf.Disposition != "attachment" || pdf.Filename != "a.pdf" || pdf.AttachmentID != "att1" {
+		t.Fatalf("unexpected pdf part: %#v", pdf)
+	}
+
+	out = captureStdout(t, func() {
+		if err := runKong(t, &GmailPartsCmd{}, []string{"m1", "--part", "1"}, ctx, flags); err != nil {
+			t.Fatalf("extract: %v", err)
+		}
+	})
+	if out != "%PDF-1.4" {
+		t.Fatalf("unexpected extracted part: %q", out)
+	}
+
+	if err := runKong(t, &GmailPartsCmd{}, []string{"m1", "--part", "9"}, ctx, flags); err == nil {
+		t.Fatalf("expected missing part error")
+	}
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+	"google.golang.org/api/people/v1"
+
+	"github.com/steipete/gogcli/internal/input"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// Overridable in tests.
+var (
+	recipientPromptLine   = input.PromptLine
+	recipientCanPromptTTY = func() bool { return term.IsTerminal(int(os.Stdin.Fd())) }
+)
+
+const recipientMaxCandidates = 10
+
+// recipientResolver expands partial recipients ("peter") into addresses using
+// the user's contacts and other contacts.
+type recipientResolver struct {
+	ctx     context.Context
+	flags   *RootFlags
+	account string
+	cache   map[string]string
+}
+
+func newRecipientResolver(ctx context.Context, flags *RootFlags, account string) *recipientResolver {
+	return &recipientResolver{ctx: ctx, flags: flags, account: account, cache: map[string]string{}}
+}
+
+// resolveAll returns recipients with every entry lacking an "@" replaced by a
+// contact address. Full addresses pass through unchanged.
+func (r *recipientResolver) resolveAll(recipients []string) ([]string, error) {
+	out := make([]string, 0, len(recipients))
+	for _, rcpt := range recipients {
+		rcpt = strings.TrimSpace(rcpt)
+		if rcpt == "" || strings.Contains(rcpt, "@") {
+			out = append(out, rcpt)
+			continue
+		}
+		resolved, err := r.resolve(rcpt)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resolved)
+	}
+	return out, nil
+}
+
+func (r *recipientResolver) resolve(query string) (string, error) {
+	key := strings.ToLower(query)
+	if cached, ok := r.cache[key]; ok {
+		return cached, nil
+	}
+	candidates, err := r.search(query)
+	if err != nil {
+		return "", fmt.Errorf("resolve recipient %q: %w", query, err)
+	}
+
+	var picked string
+	switch len(candidates) {
+	case 0:
+		return "", usagef("no contact matches %q; use a full email address", query)
+	case 1:
+		picked = candidates[0]
+	default:
+		picked, err = r.choose(query, candidates)
+		if err != nil {
+			return "", err
+		}
+	}
+	if u := ui.FromContext(r.ctx); u != nil {
+		u.Err().Printf("%s -> %s", query, picked)
+	}
+	r.cache[key] = picked
+	return picked, nil
+}
+
+func (r *recipientResolver) choose(query string, candidates []string) (string, error) {
+	if r.flags.NoInput || !recipientCanPromptTTY() {
+		return "", usagef("%q matches several contacts (%s); use a full email address or run interactively", query, strings.Join(candidates, "; "))
+	}
+	if u := ui.FromContext(r.ctx); u != nil {
+		u.Err().Printf("%q matches several contacts:", query)
+		for i, c := range candidates {
+			u.Err().Printf("  %d) %s", i+1, c)
+		}
+	}
+	line, err := recipientPromptLine(r.ctx, fmt.Sprintf("Choose 1-%d: ", len(candidates)))
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return "", &ExitError{Code: 1, Err: errors.New("cancelled")}
+		}
+		return "", fmt.Errorf("read choice: %w", err)
+	}
+	n, convErr := strconv.Atoi(strings.TrimSpace(line))
+	if convErr != nil || n < 1 || n > len(candidates) {
+		return "", usagef("invalid choice %q", strings.TrimSpace(line))
+	}
+	return candidates[n-1], nil
+}
+
+// search returns "Name <email>" candidates from contacts, then other contacts,
+// without duplicate addresses.
+func (r *recipientResolver) search(query string) ([]string, error) {
+	svc, err := newPeopleContactsService(r.ctx, r.account)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := svc.People.SearchContacts().
+		Query(query).
+		PageSize(recipientMaxCandidates).
+		ReadMask("names,emailAddresses").
+		Context(r.ctx).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+	var persons []*people.Person
+	for _, res := range resp.Results {
+		persons = append(persons, res.Person)
+	}
+
+	// Other contacts (people you've emailed) are best-effort.
+	if otherSvc, otherErr := newPeopleOtherContactsService(r.ctx, r.account); otherErr == nil {
+		if otherResp, searchErr := otherSvc.OtherContacts.Search().
+			Query(query).
+			PageSize(recipientMaxCandidates).
+			ReadMask("names,emailAddresses").
+			Context(r.ctx).
+			Do(); searchErr == nil {
+			for _, res := range otherResp.Results {
+				persons = append(persons, res.Person)
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	var candidates []string
+	for _, p := range persons {
+		if p == nil {
+			continue
+		}
+		name := primaryName(p)
+		for _, e := range p.EmailAddresses {
+			if e == nil || strings.TrimSpace(e.Value) == "" {
+				continue
+			}
+			addr := strings.TrimSpace(e.Value)
+			if seen[strings.ToLower(addr)] {
+				continue
+			}
+			seen[strings.ToLower(addr)] = true
+			if name != "" {
+				candidates = append(candidates, (&mail.Address{Name: name, Address: addr}).String())
+			} else {
+				candidates = append(candidates, addr)
+			}
+		}
+	}
+	if len(candidates) > recipientMaxCandidates {
+		candidates = candidates[:recipientMaxCandidates]
+	}
+	return candidates, nil
+}
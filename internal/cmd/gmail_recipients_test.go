@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestRecipientResolver(t *testing.T) {
+	svc, closeSrv := newPeopleService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		q := r.URL.Query().Get("query")
+		switch {
+		case strings.Contains(r.URL.Path, "people:searchContacts") && q == "ada":
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": []map[string]any{
+				{"person": map[string]any{"names": []map[string]any{{"displayName": "Ada Lovelace"}}, "emailAddresses": []map[string]any{{"value": "ada@example.com"}}}},
+			}})
+		case strings.Contains(r.URL.Path, "people:searchContacts") && q == "peter":
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": []map[string]any{
+				{"person": map[string]any{"names": []map[string]any{{"displayName": "Peter Pan"}}, "emailAddresses": []map[string]any{{"value": "pan@example.com"}}}},
+			}})
+		case strings.Contains(r.URL.Path, "otherContacts:search") && q == "peter":
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": []map[string]any{
+				{"person": map[string]any{"emailAddresses": []map[string]any{{"value": "peter@work.example"}, {"value": "PAN@example.com"}}}},
+			}})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": []map[string]any{}})
+		}
+	}))
+	defer closeSrv()
+	stubPeopleServices(t, svc)
+
+	origPrompt, origTTY := recipientPromptLine, recipientCanPromptTTY
+	t.Cleanup(func() { recipientPromptLine, recipientCanPromptTTY = origPrompt, origTTY })
+	recipientCanPromptTTY = func() bool { return true }
+	prompts := 0
+	recipientPromptLine = func(context.Context, string) (string, error) {
+		prompts++
+		return "2", nil
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	r := newRecipientResolver(ctx, &RootFlags{}, "me@example.com")
+	got, err := r.resolveAll([]string{"ada", "bob@example.com", "peter", "Peter"})
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	want := []string{`"Ada Lovelace" <ada@example.com>`, "bob@example.com", "peter@work.example", "peter@work.example"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if prompts != 1 {
+		t.Fatalf("expected one prompt (cached second lookup), got %d", prompts)
+	}
+
+	if _, err := newRecipientResolver(ctx, &RootFlags{}, "me@example.com").resolveAll([]string{"nobody"}); err == nil {
+		t.Fatalf("expected error for unknown contact")
+	}
+	if _, err := newRecipientResolver(ctx, &RootFlags{NoInput: true}, "me@example.com").resolveAll([]string{"peter"}); err == nil || !strings.Contains(err.Error(), "several contacts") {
+		t.Fatalf("expected ambiguity error without input, got %v", err)
+	}
+}
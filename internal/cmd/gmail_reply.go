@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type GmailReplyCmd struct {
+	MessageID string   `arg:"" name:"messageId" help:"Message ID to reply to"`
+	All       bool     `name:"all" aliases:"reply-all" help:"Reply to the sender and all original recipients"`
+	Cc        string   `name:"cc" help:"Additional CC recipients (comma-separated)"`
+	Bcc       string   `name:"bcc" help:"BCC recipients (comma-separated)"`
+	Body      string   `name:"body" help:"Reply body (plain text)"`
+	BodyFile  string   `name:"body-file" help:"Reply body file path (plain text; '-' for stdin)"`
+	BodyHTML  string   `name:"body-html" help:"Reply body (HTML; optional)"`
+	Markdown  bool     `name:"markdown" help:"Treat --body/--body-file as Markdown and send it as styled HTML (plus plain text)"`
+	NoQuote   bool     `name:"no-quote" help:"Do not quote the original message"`
+	Attach    []string `name:"attach" help:"Attachment file path (repeatable)"`
+	From      string   `name:"from" help:"Send from this email address (must be a verified send-as alias)"`
+}
+
+func (c *GmailReplyCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	messageID := strings.TrimSpace(c.MessageID)
+	if messageID == "" {
+		return usage("empty messageId")
+	}
+	body, err := resolveBodyInput(c.Body, c.BodyFile)
+	if err != nil {
+		return err
+	}
+	body, bodyHTML, err := renderMarkdownBody(body, c.BodyHTML, c.Markdown)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(body) == "" && strings.TrimSpace(bodyHTML) == "" {
+		return usage("required: --body, --body-file, or --body-html")
+	}
+	atts, err := expandAttachments(c.Attach)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	fromAddr, sendingEmail, err := resolveFromAddress(ctx, svc, account, c.From)
+	if err != nil {
+		return err
+	}
+
+	orig, err := svc.Users.Messages.Get("me", messageID).Format(gmailFormatFull).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	info := replyInfoFromMessage(orig)
+
+	var to, cc []string
+	if c.All {
+		to, cc = buildReplyAllRecipients(info, sendingEmail)
+	} else {
+		replyAddress := info.ReplyToAddr
+		if replyAddress == "" {
+			replyAddress = info.FromAddr
+		}
+		to = parseEmailAddresses(replyAddress)
+	}
+	cc = deduplicateAddresses(append(cc, splitCSV(c.Cc)...))
+	if len(to) == 0 {
+		if len(cc) == 0 {
+			return usage("no recipients: original message has no usable From/Reply-To address")
+		}
+		to, cc = cc, nil
+	}
+
+	if !c.NoQuote {
+		body, bodyHTML = quoteOriginal(orig, body, bodyHTML)
+	}
+
+	results, err := sendGmailBatches(ctx, svc, sendMessageOptions{
+		FromAddr:    fromAddr,
+		Subject:     prefixSubject("Re:", headerValue(orig.Payload, "Subject")),
+		Body:        body,
+		BodyHTML:    bodyHTML,
+		ReplyInfo:   info,
+		Attachments: atts,
+	}, []sendBatch{{To: to, Cc: cc, Bcc: splitCSV(c.Bcc)}})
+	if err != nil {
+		return err
+	}
+	return writeSendResults(ctx, u, fromAddr, results)
+}
+
+type GmailForwardCmd struct {
+	MessageID     string   `arg:"" name:"messageId" help:"Message ID to forward"`
+	To            string   `name:"to" required:"" help:"Recipients (comma-separated)"`
+	Cc            string   `name:"cc" help:"CC recipients (comma-separated)"`
+	Bcc           string   `name:"bcc" help:"BCC recipients (comma-separated)"`
+	Body          string   `name:"body" help:"Note to add above the forwarded message (plain text)"`
+	BodyFile      string   `name:"body-file" help:"Note file path (plain text; '-' for stdin)"`
+	Markdown      bool     `name:"markdown" help:"Treat --body/--body-file as Markdown"`
+	NoAttachments bool     `name:"no-attachments" help:"Do not include the original attachments"`
+	Attach        []string `name:"attach" help:"Additional attachment file path (repeatable)"`
+	From          string   `name:"from" help:"Send from this email address (must be a verified send-as alias)"`
+}
+
+func (c *GmailForwardCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	messageID := strings.TrimSpace(c.MessageID)
+	if messageID == "" {
+		return usage("empty messageId")
+	}
+	to := splitCSV(c.To)
+	if len(to) == 0 {
+		return usage("required: --to")
+	}
+	note, err := resolveBodyInput(c.Body, c.BodyFile)
+	if err != nil {
+		return err
+	}
+	note, noteHTML, err := renderMarkdownBody(note, "", c.Markdown)
+	if err != nil {
+		return err
+	}
+	atts, err := expandAttachments(c.Attach)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	fromAddr, _, err := resolveFromAddress(ctx, svc, account, c.From)
+	if err != nil {
+		return err
+	}
+
+	orig, err := svc.Users.Messages.Get("me", messageID).Format(gmailFormatFull).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if !c.NoAttachments {
+		for _, a := range collectAttachments(orig.Payload) {
+			data, fetchErr := fetchAttachmentBytes(ctx, svc, messageID, a.AttachmentID)
+			if fetchErr != nil {
+				return fmt.Errorf("fetch attachment %s: %w", a.Filename, fetchErr)
+			}
+			atts = append(atts, mailAttachment{Filename: a.Filename, MIMEType: a.MimeType, Data: data})
+		}
+	}
+
+	body, bodyHTML := forwardedBody(orig, note, noteHTML)
+	info := replyInfoFromMessage(orig)
+	results, err := sendGmailBatches(ctx, svc, sendMessageOptions{
+		FromAddr:    fromAddr,
+		Subject:     prefixSubject("Fwd:", headerValue(orig.Payload, "Subject")),
+		Body:        body,
+		BodyHTML:    bodyHTML,
+		ReplyInfo:   info,
+		Attachments: atts,
+	}, []sendBatch{{To: to, Cc: splitCSV(c.Cc), Bcc: splitCSV(c.Bcc)}})
+	if err != nil {
+		return err
+	}
+	return writeSendResults(ctx, u, fromAddr, results)
+}
+
+func fetchAttachmentBytes(ctx context.Context, svc *gmail.Service, messageID, attachmentID string) ([]byte, error) {
+	body, err := svc.Users.Messages.Attachments.Get("me", messageID, attachmentID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return decodeBase64URLBytes(body.Data)
+}
+
+// plainBodyText returns the message body as plain text, stripping tags from
+// HTML-only messages.
+func plainBodyText(p *gmail.MessagePart) string {
+	body, isHTML := bestBodyForDisplay(p)
+	if isHTML {
+		body = stripHTMLTags(body)
+	}
+	return strings.ReplaceAll(body, "\r\n", "\n")
+}
+
+// prefixSubject adds prefix ("Re:" / "Fwd:") unless the subject already has it.
+func prefixSubject(prefix, subject string) string {
+	subject = strings.TrimSpace(subject)
+	if strings.HasPrefix(strings.ToLower(subject), strings.ToLower(prefix)) {
+		return subject
+	}
+	if subject == "" {
+		return prefix
+	}
+	return prefix + " " + subject
+}
+
+// quoteOriginal appends the original message below the reply, "> "-quoted in
+// the plain text part and as a gmail_quote blockquote in the HTML part.
+func quoteOriginal(orig *gmail.Message, body, bodyHTML string) (string, string) {
+	attribution := fmt.Sprintf("On %s, %s wrote:", headerValue(orig.Payload, "Date"), headerValue(orig.Payload, "From"))
+	origText := strings.TrimRight(plainBodyText(orig.Payload), "\n")
+
+	if strings.TrimSpace(body) != "" || strings.TrimSpace(bodyHTML) == "" {
+		var quoted strings.Builder
+		for _, line := range strings.Split(origText, "\n") {
+			if line == "" || strings.HasPrefix(line, ">") {
+				quoted.WriteString(">" + line + "\n")
+			} else {
+				quoted.WriteString("> " + line + "\n")
+			}
+		}
+		body = strings.TrimRight(body, "\n") + "\n\n" + attribution + "\n" + quoted.String()
+	}
+
+	if strings.TrimSpace(bodyHTML) != "" {
+		origHTML := findPartBody(orig.Payload, "text/html")
+		if origHTML == "" {
+			origHTML = strings.ReplaceAll(html.EscapeString(origText), "\n", "<br>")
+		}
+		bodyHTML += `<div class="gmail_quote"><div>` + html.EscapeString(attribution) + `</div>` +
+			`<blockquote class="gmail_quote" style="margin:0 0 0 .8ex;border-left:1px solid #ccc;padding-left:1ex">` +
+			origHTML + `</blockquote></div>`
+	}
+	return body, bodyHTML
+}
+
+// forwardedBody builds the Gmail-style "Forwarded message" block below an optional note.
+func forwardedBody(orig *gmail.Message, note, noteHTML string) (string, string) {
+	headers := []struct{ name, value string }{
+		{"From", headerValue(orig.Payload, "From")},
+		{"Date", headerValue(orig.Payload, "Date")},
+		{"Subject", headerValue(orig.Payload, "Subject")},
+		{"To", headerValue(orig.Payload, "To")},
+		{"Cc", headerValue(orig.Payload, "Cc")},
+	}
+
+	var text strings.Builder
+	if strings.TrimSpace(note) != "" {
+		text.WriteString(strings.TrimRight(note, "\n") + "\n\n")
+	}
+	text.WriteString("---------- Forwarded message ---------\n")
+	for _, h := range headers {
+		if h.value != "" {
+			text.WriteString(h.name + ": " + h.value + "\n")
+		}
+	}
+	text.WriteString("\n")
+	text.WriteString(plainBodyText(orig.Payload))
+
+	origHTML := findPartBody(orig.Payload, "text/html")
+	if origHTML == "" && noteHTML == "" {
+		return text.String(), ""
+	}
+	if origHTML == "" {
+		origHTML = strings.ReplaceAll(html.EscapeString(plainBodyText(orig.Payload)), "\n", "<br>")
+	}
+	var h strings.Builder
+	h.WriteString(noteHTML)
+	h.WriteString(`<div class="gmail_quote">---------- Forwarded message ---------<br>`)
+	for _, hdr := range headers {
+		if hdr.value != "" {
+			h.WriteString(hdr.name + ": " + html.EscapeString(hdr.value) + "<br>")
+		}
+	}
+	h.WriteString("<br>" + origHTML + "</div>")
+	return text.String(), h.String()
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestPrefixSubject(t *testing.T) {
+	cases := map[string]string{
+		"Hello":     "Re: Hello",
+		"re: Hello": "re: Hello",
+		"":          "Re:",
+	}
+	for in, want := range cases {
+		if got := prefixSubject("Re:", in); got != want {
+			t.Fatalf("prefixSubject(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func newReplyTestServer(t *testing.T, sent *[]string, threadIDs *[]string) *httptest.Server {
+	t.Helper()
+	enc := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/settings/sendAs/"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"sendAsEmail": "me@example.com", "displayName": "Me"})
+		case strings.HasSuffix(r.URL.Path, "/messages/send"):
+			var msg gmail.Message
+			_ = json.NewDecoder(r.Body).Decode(&msg)
+			raw, _ := base64.RawURLEncoding.DecodeString(msg.Raw)
+			*sent = append(*sent, string(raw))
+			*threadIDs = append(*threadIDs, msg.ThreadId)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "s1", "threadId": msg.ThreadId})
+		case strings.Contains(r.URL.Path, "/messages/m1/attachments/att1"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": enc("PDFDATA"), "size": 7})
+		case strings.HasSuffix(r.URL.Path, "/messages/m1"):
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":       "m1",
+				"threadId": "t1",
+				"payload": map[string]any{
+					"mimeType": "multipart/mixed",
+					"headers": []map[string]any{
+						{"name": "From", "value": "Ada <ada@example.com>"},
+						{"name": "To", "value": "me@example.com, bob@example.com"},
+						{"name": "Cc", "value": "carol@example.com"},
+						{"name": "Subject", "value": "Plans"},
+						{"name": "Date", "value": "Mon, 1 Jan 2024 10:00:00 +0000"},
+						{"name": "Message-ID", "value": "<orig@example.com>"},
+					},
+					"parts": []map[string]any{
+						{"mimeType": "text/plain", "body": map[string]any{"data": enc("Line one\nLine two")}},
+						{"mimeType": "application/pdf", "filename": "plan.pdf", "body": map[string]any{"attachmentId": "att1", "size": 7}},
+					},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestGmailReplyCmd_ReplyAllQuotesAndThreads(t *testing.T) {
+	var sent, threadIDs []string
+	srv := newReplyTestServer(t, &sent, &threadIDs)
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailReplyCmd{}, []string{"m1", "--all", "--body", "Sounds good"}, ctx, &RootFlags{Account: "me@example.com"}); err != nil {
+			t.Fatalf("reply: %v", err)
+		}
+	})
+
+	if len(sent) != 1 || threadIDs[0] != "t1" {
+		t.Fatalf("expected one threaded message, got %d (threads %v)", len(sent), threadIDs)
+	}
+	raw := sent[0]
+	for _, want := range []string{
+		"To: ada@example.com, bob@example.com\r\n",
+		"Cc: carol@example.com\r\n",
+		"Subject: Re: Plans\r\n",
+		"In-Reply-To: <orig@example.com>\r\n",
+		"References: <orig@example.com>\r\n",
+		"On Mon, 1 Jan 2024 10:00:00 +0000, Ada <ada@example.com> wrote:",
+		"> Line one\r\n> Line two",
+	} {
+		if !strings.Contains(raw, want) {
+			t.Fatalf("missing %q in:\n%s", want, raw)
+		}
+	}
+}
+
+func TestGmailForwardCmd_IncludesAttachments(t *testing.T) {
+	var sent, threadIDs []string
+	srv := newReplyTestServer(t, &sent, &threadIDs)
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailForwardCmd{}, []string{"m1", "--to", "dan@example.com", "--body", "FYI"}, ctx, &RootFlags{Account: "me@example.com"}); err != nil {
+			t.Fatalf("forward: %v", err)
+		}
+	})
+
+	if len(sent) != 1 {
+		t.Fatalf("expected one message, got %d", len(sent))
+	}
+	raw := sent[0]
+	for _, want := range []string{
+		"To: dan@example.com\r\n",
+		"Subject: Fwd: Plans\r\n",
+		"FYI",
+		"---------- Forwarded message ---------",
+		"From: Ada <ada@example.com>",
+		`filename="plan.pdf"`,
+		base64.StdEncoding.EncodeToString([]byte("PDFDATA")),
+	} {
+		if !strings.Contains(raw, want) {
+			t.Fatalf("missing %q in:\n%s", want, raw)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/mail"
 	"os"
 	"strings"
+	"time"
 
 	"google.golang.org/api/gmail/v1"
 
@@ -24,14 +25,19 @@ type GmailSendCmd struct {
 	Body             string   `name:"body" help:"Body (plain text; required unless --body-html is set)"`
 	BodyFile         string   `name:"body-file" help:"Body file path (plain text; '-' for stdin)"`
 	BodyHTML         string   `name:"body-html" help:"Body (HTML; optional)"`
+	Markdown         bool     `name:"markdown" help:"Treat --body/--body-file as Markdown and send it as styled HTML (plus plain text); raw HTML in the Markdown is omitted"`
 	ReplyToMessageID string   `name:"reply-to-message-id" aliases:"in-reply-to" help:"Reply to Gmail message ID (sets In-Reply-To/References and thread)"`
 	ThreadID         string   `name:"thread-id" help:"Reply within a Gmail thread (uses latest message for headers)"`
 	ReplyAll         bool     `name:"reply-all" help:"Auto-populate recipients from original message (requires --reply-to-message-id or --thread-id)"`
 	ReplyTo          string   `name:"reply-to" help:"Reply-To header address"`
+	NoResolve        bool     `name:"no-resolve" help:"Do not resolve partial recipients (e.g. --to peter) via contacts; require full addresses"`
 	Attach           []string `name:"attach" help:"Attachment file path (repeatable)"`
+	AttachInline     []string `name:"attach-inline" help:"Inline image for the HTML body as cid:<id>=<path>, referenced via <img src=\"cid:<id>\"> (repeatable)"`
 	From             string   `name:"from" help:"Send from this email address (must be a verified send-as alias)"`
 	Track            bool     `name:"track" help:"Enable open tracking (requires tracking setup)"`
 	TrackSplit       bool     `name:"track-split" help:"Send tracked messages separately per recipient"`
+	TrackLinks       bool     `name:"track-links" help:"Route links in the HTML body through the tracking worker to log clicks (requires --track)"`
+	Campaign         string   `name:"campaign" help:"Campaign name for 'gog gmail track report --group-by campaign' (requires --track)"`
 }
 
 type sendBatch struct {
@@ -57,6 +63,9 @@ type sendMessageOptions struct {
 	ReplyInfo   *replyInfo
 	Attachments []mailAttachment
 	Track       bool
+	TrackLinks  bool
+	Campaign    string
+	GroupID     string // shared by the per-recipient copies of one tracked send
 	TrackingCfg *tracking.Config
 }
 
@@ -74,6 +83,10 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
+	body, bodyHTML, err := renderMarkdownBody(body, c.BodyHTML, c.Markdown)
+	if err != nil {
+		return err
+	}
 
 	if replyToMessageID != "" && threadID != "" {
 		return usage("use only one of --reply-to-message-id or --thread-id")
@@ -91,45 +104,27 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if strings.TrimSpace(c.Subject) == "" {
 		return usage("required: --subject")
 	}
-	if strings.TrimSpace(body) == "" && strings.TrimSpace(c.BodyHTML) == "" {
+	if strings.TrimSpace(body) == "" && strings.TrimSpace(bodyHTML) == "" {
 		return usage("required: --body, --body-file, or --body-html")
 	}
 	if c.TrackSplit && !c.Track {
 		return usage("--track-split requires --track")
 	}
+	if c.TrackLinks && !c.Track {
+		return usage("--track-links requires --track")
+	}
+	if strings.TrimSpace(c.Campaign) != "" && !c.Track {
+		return usage("--campaign requires --track")
+	}
 
 	svc, err := newGmailService(ctx, account)
 	if err != nil {
 		return err
 	}
 
-	// Determine the From address
-	fromAddr := account
-	sendingEmail := account // The email we're sending from (without display name)
-	if strings.TrimSpace(c.From) != "" {
-		// Validate that this is a configured send-as alias
-		var sa *gmail.SendAs
-		sa, err = svc.Users.Settings.SendAs.Get("me", c.From).Context(ctx).Do()
-		if err != nil {
-			return fmt.Errorf("invalid --from address %q: %w", c.From, err)
-		}
-		if sa.VerificationStatus != gmailVerificationAccepted {
-			return fmt.Errorf("--from address %q is not verified (status: %s)", c.From, sa.VerificationStatus)
-		}
-		sendingEmail = c.From
-		fromAddr = c.From
-		// Include display name if set
-		if sa.DisplayName != "" {
-			fromAddr = sa.DisplayName + " <" + c.From + ">"
-		}
-	} else {
-		// No --from specified: look up the primary account's send-as settings
-		// to get the display name
-		sa, saErr := svc.Users.Settings.SendAs.Get("me", account).Context(ctx).Do()
-		if saErr == nil && sa.DisplayName != "" {
-			fromAddr = sa.DisplayName + " <" + account + ">"
-		}
-		// If lookup fails, we just use the plain email address (no error)
+	fromAddr, sendingEmail, err := resolveFromAddress(ctx, svc, account, c.From)
+	if err != nil {
+		return err
 	}
 
 	// Fetch reply info (includes recipient headers for reply-all)
@@ -160,21 +155,43 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 	bccRecipients := splitCSV(c.Bcc)
 
-	atts := make([]mailAttachment, 0, len(c.Attach))
-	for _, p := range c.Attach {
-		expanded, expandErr := config.ExpandPath(p)
-		if expandErr != nil {
-			return expandErr
+	if !c.NoResolve {
+		resolver := newRecipientResolver(ctx, flags, account)
+		if toRecipients, err = resolver.resolveAll(toRecipients); err != nil {
+			return err
+		}
+		if ccRecipients, err = resolver.resolveAll(ccRecipients); err != nil {
+			return err
+		}
+		if bccRecipients, err = resolver.resolveAll(bccRecipients); err != nil {
+			return err
 		}
-		atts = append(atts, mailAttachment{Path: expanded})
 	}
 
+	atts, err := expandAttachments(c.Attach)
+	if err != nil {
+		return err
+	}
+	inline, err := parseInlineAttachments(c.AttachInline)
+	if err != nil {
+		return err
+	}
+	if len(inline) > 0 && strings.TrimSpace(bodyHTML) == "" {
+		return usage("--attach-inline requires an HTML body (--body-html or --markdown)")
+	}
+	atts = append(atts, inline...)
+
 	var trackingCfg *tracking.Config
+	trackingGroup := ""
 	if c.Track {
 		trackingCfg, err = c.resolveTrackingConfig(account, toRecipients, ccRecipients, bccRecipients)
 		if err != nil {
 			return err
 		}
+		trackingGroup, err = newTrackingGroupID()
+		if err != nil {
+			return err
+		}
 	}
 
 	batches := buildSendBatches(toRecipients, ccRecipients, bccRecipients, c.Track, c.TrackSplit)
@@ -183,10 +200,13 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 		ReplyTo:     c.ReplyTo,
 		Subject:     c.Subject,
 		Body:        body,
-		BodyHTML:    c.BodyHTML,
+		BodyHTML:    bodyHTML,
 		ReplyInfo:   replyInfo,
 		Attachments: atts,
 		Track:       c.Track,
+		TrackLinks:  c.TrackLinks,
+		Campaign:    strings.TrimSpace(c.Campaign),
+		GroupID:     trackingGroup,
 		TrackingCfg: trackingCfg,
 	}, batches)
 	if err != nil {
@@ -196,14 +216,82 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	return writeSendResults(ctx, u, fromAddr, results)
 }
 
+func expandAttachments(paths []string) ([]mailAttachment, error) {
+	atts := make([]mailAttachment, 0, len(paths))
+	for _, p := range paths {
+		expanded, err := config.ExpandPath(p)
+		if err != nil {
+			return nil, err
+		}
+		atts = append(atts, mailAttachment{Path: expanded})
+	}
+	return atts, nil
+}
+
+// parseInlineAttachments parses --attach-inline values of the form
+// cid:<id>=<path> (the "cid:" prefix is optional).
+func parseInlineAttachments(specs []string) ([]mailAttachment, error) {
+	atts := make([]mailAttachment, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		cid, path, ok := strings.Cut(strings.TrimPrefix(strings.TrimSpace(spec), "cid:"), "=")
+		cid = strings.TrimSpace(cid)
+		path = strings.TrimSpace(path)
+		if !ok || cid == "" || path == "" {
+			return nil, usagef("invalid --attach-inline %q (expected cid:<id>=<path>)", spec)
+		}
+		if strings.ContainsAny(cid, " \t<>\"") {
+			return nil, usagef("invalid content ID %q in --attach-inline", cid)
+		}
+		if seen[cid] {
+			return nil, usagef("duplicate content ID %q in --attach-inline", cid)
+		}
+		seen[cid] = true
+		expanded, err := config.ExpandPath(path)
+		if err != nil {
+			return nil, err
+		}
+		atts = append(atts, mailAttachment{Path: expanded, ContentID: cid})
+	}
+	return atts, nil
+}
+
+// resolveFromAddress returns the From header value (including the send-as
+// display name when known) and the bare address mail is sent from.
+func resolveFromAddress(ctx context.Context, svc *gmail.Service, account, from string) (string, string, error) {
+	from = strings.TrimSpace(from)
+	if from == "" {
+		// No --from specified: look up the primary account's send-as settings
+		// to get the display name. If lookup fails, use the plain address.
+		sa, err := svc.Users.Settings.SendAs.Get("me", account).Context(ctx).Do()
+		if err == nil && sa.DisplayName != "" {
+			return sa.DisplayName + " <" + account + ">", account, nil
+		}
+		return account, account, nil
+	}
+
+	// Validate that this is a configured send-as alias
+	sa, err := svc.Users.Settings.SendAs.Get("me", from).Context(ctx).Do()
+	if err != nil {
+		return "", "", fmt.Errorf("invalid --from address %q: %w", from, err)
+	}
+	if sa.VerificationStatus != gmailVerificationAccepted {
+		return "", "", fmt.Errorf("--from address %q is not verified (status: %s)", from, sa.VerificationStatus)
+	}
+	if sa.DisplayName != "" {
+		return sa.DisplayName + " <" + from + ">", from, nil
+	}
+	return from, from, nil
+}
+
 func (c *GmailSendCmd) resolveTrackingConfig(account string, toRecipients, ccRecipients, bccRecipients []string) (*tracking.Config, error) {
 	totalRecipients := len(toRecipients) + len(ccRecipients) + len(bccRecipients)
 	if totalRecipients != 1 && !c.TrackSplit {
 		return nil, usage("--track requires exactly 1 recipient (no cc/bcc); use --track-split for per-recipient sends")
 	}
 
-	if strings.TrimSpace(c.BodyHTML) == "" {
-		return nil, fmt.Errorf("--track requires --body-html (pixel must be in HTML)")
+	if strings.TrimSpace(c.BodyHTML) == "" && !c.Markdown {
+		return nil, fmt.Errorf("--track requires --body-html or --markdown (pixel must be in HTML)")
 	}
 
 	trackingCfg, err := tracking.LoadConfig(account)
@@ -258,12 +346,21 @@ func sendGmailBatches(ctx context.Context, svc *gmail.Service, opts sendMessageO
 			if recipient == "" {
 				recipient = strings.TrimSpace(firstRecipient(batch.To, batch.Cc, batch.Bcc))
 			}
-			pixelURL, blob, pixelErr := tracking.GeneratePixelURL(opts.TrackingCfg, recipient, opts.Subject)
+			sentAt := time.Now()
+			pixelURL, blob, pixelErr := tracking.GeneratePixelURLAt(opts.TrackingCfg, recipient, opts.Subject, sentAt)
 			if pixelErr != nil {
 				return nil, fmt.Errorf("generate tracking pixel: %w", pixelErr)
 			}
 			trackingID = blob
 
+			if opts.TrackLinks {
+				rewritten, _, linkErr := tracking.RewriteLinks(opts.TrackingCfg, htmlBody, recipient, opts.Subject, sentAt)
+				if linkErr != nil {
+					return nil, fmt.Errorf("rewrite tracked links: %w", linkErr)
+				}
+				htmlBody = rewritten
+			}
+
 			// Inject pixel into HTML body (prefer before </body> / </html>)
 			pixelHTML := tracking.GeneratePixelHTML(pixelURL)
 			htmlBody = injectTrackingPixelHTML(htmlBody, pixelHTML)
@@ -298,6 +395,19 @@ func sendGmailBatches(ctx context.Context, svc *gmail.Service, opts sendMessageO
 			return nil, err
 		}
 
+		if trackingID != "" && strings.TrimSpace(opts.TrackingCfg.AdminKey) != "" {
+			if regErr := registerTrackedSend(ctx, opts.TrackingCfg, trackedSend{
+				TrackingID: trackingID,
+				MessageID:  sent.Id,
+				GroupID:    opts.GroupID,
+				Campaign:   opts.Campaign,
+			}); regErr != nil {
+				if u := ui.FromContext(ctx); u != nil {
+					u.Err().Printf("warning: tracked send not registered for reports: %v", regErr)
+				}
+			}
+		}
+
 		resultRecipient := strings.TrimSpace(batch.TrackingRecipient)
 		if resultRecipient == "" {
 			resultRecipient = strings.TrimSpace(firstRecipient(batch.To, batch.Cc, batch.Bcc))
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -81,6 +82,107 @@ func TestSendGmailBatches_WithTracking(t *testing.T) {
 	}
 }
 
+func TestSendGmailBatches_TrackLinks(t *testing.T) {
+	var raw string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg gmail.Message
+		_ = json.NewDecoder(r.Body).Decode(&msg)
+		decoded, _ := base64.RawURLEncoding.DecodeString(msg.Raw)
+		raw = string(decoded)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "m1", "threadId": "t1"})
+	}))
+	defer srv.Close()
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	cfg := &tracking.Config{
+		Enabled:     true,
+		WorkerURL:   "https://tracker.example.com",
+		TrackingKey: mustTrackingKey(t),
+	}
+	_, err = sendGmailBatches(context.Background(), svc, sendMessageOptions{
+		FromAddr:    "me@example.com",
+		Subject:     "Hello",
+		BodyHTML:    `<html><body><a href="https://example.com/docs">Docs</a></body></html>`,
+		Track:       true,
+		TrackLinks:  true,
+		TrackingCfg: cfg,
+	}, buildSendBatches([]string{"a@example.com"}, nil, nil, true, false))
+	if err != nil {
+		t.Fatalf("sendGmailBatches: %v", err)
+	}
+	if strings.Contains(raw, "https://example.com/docs") || !strings.Contains(raw, `href="https://tracker.example.com/l/`) {
+		t.Fatalf("link not rewritten: %s", raw)
+	}
+	if !strings.Contains(raw, "https://tracker.example.com/p/") {
+		t.Fatalf("pixel missing: %s", raw)
+	}
+}
+
+func TestSendGmailBatches_RegistersTrackedSend(t *testing.T) {
+	var registered map[string]string
+	var gotAuth string
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/sends" {
+			http.NotFound(w, r)
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&registered)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer worker.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "m1", "threadId": "t1"})
+	}))
+	defer srv.Close()
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	cfg := &tracking.Config{
+		Enabled:     true,
+		WorkerURL:   worker.URL,
+		TrackingKey: mustTrackingKey(t),
+		AdminKey:    "admin",
+	}
+	results, err := sendGmailBatches(context.Background(), svc, sendMessageOptions{
+		FromAddr:    "me@example.com",
+		Subject:     "Launch",
+		BodyHTML:    "<p>Hi</p>",
+		Track:       true,
+		Campaign:    "q3-launch",
+		GroupID:     "g1",
+		TrackingCfg: cfg,
+	}, buildSendBatches([]string{"a@example.com"}, nil, nil, true, false))
+	if err != nil {
+		t.Fatalf("sendGmailBatches: %v", err)
+	}
+	if gotAuth != "Bearer admin" || registered["tracking_id"] != results[0].TrackingID ||
+		registered["message_id"] != "m1" || registered["group_id"] != "g1" || registered["campaign"] != "q3-launch" {
+		t.Fatalf("unexpected registration: auth=%q body=%v", gotAuth, registered)
+	}
+	if _, ok := registered["subject"]; ok {
+		t.Fatalf("plaintext subject sent to the tracker: %v", registered)
+	}
+}
+
 func TestReplyHeaders_Message(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := strings.TrimPrefix(r.URL.Path, "/gmail/v1")
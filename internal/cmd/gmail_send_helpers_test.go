@@ -148,3 +148,24 @@ func TestFilterOutSelfAndDeduplicate(t *testing.T) {
 		t.Fatalf("unexpected deduped list: %v", deduped)
 	}
 }
+
+func TestParseInlineAttachments(t *testing.T) {
+	atts, err := parseInlineAttachments([]string{"cid:logo=/tmp/logo.png", "banner=/tmp/banner.gif"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(atts) != 2 || atts[0].ContentID != "logo" || atts[0].Path != "/tmp/logo.png" || atts[1].ContentID != "banner" {
+		t.Fatalf("unexpected attachments: %#v", atts)
+	}
+
+	for _, bad := range [][]string{
+		{"cid:logo"},
+		{"cid:=logo.png"},
+		{"cid:lo go=logo.png"},
+		{"cid:logo=a.png", "cid:logo=b.png"},
+	} {
+		if _, err := parseInlineAttachments(bad); err == nil {
+			t.Fatalf("expected error for %v", bad)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type GmailSignatureCmd struct {
+	List GmailSignatureListCmd `cmd:"" name:"list" help:"List send-as aliases with their signatures"`
+	Get  GmailSignatureGetCmd  `cmd:"" name:"get" help:"Print the signature of a send-as alias"`
+	Set  GmailSignatureSetCmd  `cmd:"" name:"set" help:"Set the signature of a send-as alias"`
+}
+
+type GmailSignatureListCmd struct{}
+
+func (c *GmailSignatureListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	resp, err := svc.Users.Settings.SendAs.List("me").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		type item struct {
+			SendAsEmail string `json:"sendAsEmail"`
+			IsDefault   bool   `json:"isDefault"`
+			Signature   string `json:"signature"`
+		}
+		items := make([]item, 0, len(resp.SendAs))
+		for _, sa := range resp.SendAs {
+			items = append(items, item{SendAsEmail: sa.SendAsEmail, IsDefault: sa.IsDefault, Signature: sa.Signature})
+		}
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"signatures": items})
+	}
+
+	if len(resp.SendAs) == 0 {
+		u.Err().Println("No send-as aliases")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "EMAIL\tDEFAULT\tSIGNATURE")
+	for _, sa := range resp.SendAs {
+		isDefault := ""
+		if sa.IsDefault {
+			isDefault = sendAsYes
+		}
+		preview := "-"
+		if sa.Signature != "" {
+			preview = truncateRunes(sanitizeMessageBody(sa.Signature), 60)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", sa.SendAsEmail, isDefault, preview)
+	}
+	return nil
+}
+
+type GmailSignatureGetCmd struct {
+	SendAs string `name:"send-as" help:"Send-as email (default: the default alias)"`
+}
+
+func (c *GmailSignatureGetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	sa, err := resolveSignatureSendAs(ctx, svc, c.SendAs)
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"sendAsEmail": sa.SendAsEmail,
+			"signature":   sa.Signature,
+		})
+	}
+	if sa.Signature == "" {
+		u.Err().Printf("No signature for %s", sa.SendAsEmail)
+		return nil
+	}
+	u.Out().Println(sa.Signature)
+	return nil
+}
+
+type GmailSignatureSetCmd struct {
+	SendAs   string `name:"send-as" help:"Send-as email (default: the default alias)"`
+	All      bool   `name:"all" help:"Apply to every send-as alias"`
+	HTML     string `name:"html" help:"Signature HTML"`
+	HTMLFile string `name:"html-file" help:"Signature HTML file ('-' for stdin)"`
+	Clear    bool   `name:"clear" help:"Remove the signature"`
+}
+
+func (c *GmailSignatureSetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	if c.All && strings.TrimSpace(c.SendAs) != "" {
+		return usage("--all and --send-as are mutually exclusive")
+	}
+	if strings.TrimSpace(c.HTML) != "" && strings.TrimSpace(c.HTMLFile) != "" {
+		return usage("use only one of --html or --html-file")
+	}
+	signature, err := resolveBodyInput(c.HTML, c.HTMLFile)
+	if err != nil {
+		return err
+	}
+	signature = strings.TrimSpace(signature)
+	if c.Clear && signature != "" {
+		return usage("--clear cannot be combined with --html/--html-file")
+	}
+	if !c.Clear && signature == "" {
+		return usage("provide --html, --html-file, or --clear")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var targets []string
+	if c.All {
+		resp, listErr := svc.Users.Settings.SendAs.List("me").Context(ctx).Do()
+		if listErr != nil {
+			return listErr
+		}
+		for _, sa := range resp.SendAs {
+			targets = append(targets, sa.SendAsEmail)
+		}
+	} else {
+		sa, resolveErr := resolveSignatureSendAs(ctx, svc, c.SendAs)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		targets = []string{sa.SendAsEmail}
+	}
+
+	updated := make([]string, 0, len(targets))
+	for _, email := range targets {
+		// ForceSendFields so an empty signature (--clear) is actually sent.
+		patch := &gmail.SendAs{Signature: signature, ForceSendFields: []string{"Signature"}}
+		if _, err = svc.Users.Settings.SendAs.Patch("me", email, patch).Context(ctx).Do(); err != nil {
+			return fmt.Errorf("%s: %w", email, err)
+		}
+		updated = append(updated, email)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"updated": updated})
+	}
+	for _, email := range updated {
+		u.Out().Printf("Updated signature: %s", email)
+	}
+	return nil
+}
+
+// resolveSignatureSendAs returns the named alias, or the default alias when email is empty.
+func resolveSignatureSendAs(ctx context.Context, svc *gmail.Service, email string) (*gmail.SendAs, error) {
+	email = strings.TrimSpace(email)
+	if email != "" {
+		return svc.Users.Settings.SendAs.Get("me", email).Context(ctx).Do()
+	}
+	resp, err := svc.Users.Settings.SendAs.List("me").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	var primary *gmail.SendAs
+	for _, sa := range resp.SendAs {
+		if sa.IsDefault {
+			return sa, nil
+		}
+		if sa.IsPrimary && primary == nil {
+			primary = sa
+		}
+	}
+	if primary != nil {
+		return primary, nil
+	}
+	return nil, usage("no default send-as alias found; pass --send-as")
+}
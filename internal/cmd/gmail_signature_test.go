@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func newSignatureServer(t *testing.T, patched map[string]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/settings/sendAs") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"sendAs": []map[string]any{
+				{"sendAsEmail": "me@example.com", "isPrimary": true, "isDefault": true, "signature": "<b>Me</b>"},
+				{"sendAsEmail": "alias@example.com", "signature": ""},
+			}})
+		case strings.Contains(r.URL.Path, "/settings/sendAs/") && r.Method == http.MethodGet:
+			email := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			_ = json.NewEncoder(w).Encode(map[string]any{"sendAsEmail": email})
+		case strings.Contains(r.URL.Path, "/settings/sendAs/") && r.Method == http.MethodPatch:
+			email := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			sig, ok := body["signature"].(string)
+			if !ok {
+				http.Error(w, "signature missing", http.StatusBadRequest)
+				return
+			}
+			patched[email] = sig
+			_ = json.NewEncoder(w).Encode(map[string]any{"sendAsEmail": email, "signature": sig})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestGmailSignatureGetCmd_DefaultAlias(t *testing.T) {
+	srv := newSignatureServer(t, map[string]string{})
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	var buf strings.Builder
+	u, uiErr := ui.New(ui.Options{Stdout: &buf, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	if err := runKong(t, &GmailSignatureGetCmd{}, nil, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "<b>Me</b>" {
+		t.Fatalf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestGmailSignatureSetCmd_FileAndAll(t *testing.T) {
+	patched := map[string]string{}
+	srv := newSignatureServer(t, patched)
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	path := filepath.Join(t.TempDir(), "sig.html")
+	if err := os.WriteFile(path, []byte("<p>Team</p>\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com"}
+
+	if err := runKong(t, &GmailSignatureSetCmd{}, []string{"--all", "--html-file", path}, ctx, flags); err != nil {
+		t.Fatalf("set --all: %v", err)
+	}
+	if patched["me@example.com"] != "<p>Team</p>" || patched["alias@example.com"] != "<p>Team</p>" {
+		t.Fatalf("unexpected patches: %#v", patched)
+	}
+
+	if err := runKong(t, &GmailSignatureSetCmd{}, []string{"--send-as", "alias@example.com", "--clear"}, ctx, flags); err != nil {
+		t.Fatalf("set --clear: %v", err)
+	}
+	if sig, ok := patched["alias@example.com"]; !ok || sig != "" {
+		t.Fatalf("expected cleared signature, got %#v", patched)
+	}
+
+	if err := runKong(t, &GmailSignatureSetCmd{}, nil, ctx, flags); err == nil {
+		t.Fatalf("expected usage error without signature")
+	}
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailReportSpamCmd moves messages to spam, which also trains Gmail's filters.
+type GmailReportSpamCmd struct {
+	Query []string `arg:"" optional:"" name:"query" help:"Gmail search query selecting messages"`
+	IDs   []string `name:"id" sep:"," help:"Message IDs to report instead of a query (comma-separated or repeated)"`
+}
+
+func (c *GmailReportSpamCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return runGmailSpam(ctx, flags, c.Query, c.IDs, true)
+}
+
+// GmailNotSpamCmd moves messages out of spam and back to the inbox. Queries
+// are limited to the spam folder.
+type GmailNotSpamCmd struct {
+	Query []string `arg:"" optional:"" name:"query" help:"Gmail search query (searched within spam)"`
+	IDs   []string `name:"id" sep:"," help:"Message IDs to restore instead of a query (comma-separated or repeated)"`
+}
+
+func (c *GmailNotSpamCmd) Run(ctx context.Context, flags *RootFlags) error {
+	query := c.Query
+	if q := strings.Join(query, " "); strings.TrimSpace(q) != "" && !strings.Contains(strings.ToLower(q), "in:spam") {
+		query = append([]string{"in:spam"}, query...)
+	}
+	return runGmailSpam(ctx, flags, query, c.IDs, false)
+}
+
+func runGmailSpam(ctx context.Context, flags *RootFlags, query []string, ids []string, spam bool) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	ids, searched, err := resolveMessageTargets(ctx, svc, query, ids)
+	if err != nil {
+		return err
+	}
+
+	addIDs, removeIDs := []string{"SPAM"}, []string{"INBOX"}
+	if !spam {
+		addIDs, removeIDs = removeIDs, addIDs
+	}
+	if len(ids) > 0 {
+		if err := batchModifyMessages(ctx, svc, ids, addIDs, removeIDs); err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"query": searched,
+			"count": len(ids),
+			"spam":  spam,
+		})
+	}
+	if len(ids) == 0 {
+		u.Err().Println("No matching messages")
+		return nil
+	}
+	if spam {
+		u.Out().Printf("Reported %d messages as spam", len(ids))
+	} else {
+		u.Out().Printf("Moved %d messages out of spam", len(ids))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestGmailReportSpamAndNotSpam(t *testing.T) {
+	var modifies []gmail.BatchModifyMessagesRequest
+	var listQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/messages/batchModify"):
+			var req gmail.BatchModifyMessagesRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			modifies = append(modifies, req)
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasSuffix(r.URL.Path, "/messages"):
+			listQuery = r.URL.Query().Get("q")
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": []map[string]any{{"id": "m9"}}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com"}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailReportSpamCmd{}, []string{"--id", "m1,m2"}, ctx, flags); err != nil {
+			t.Fatalf("report-spam: %v", err)
+		}
+	})
+	if len(modifies) != 1 || !slices.Equal(modifies[0].Ids, []string{"m1", "m2"}) ||
+		!slices.Equal(modifies[0].AddLabelIds, []string{"SPAM"}) || !slices.Equal(modifies[0].RemoveLabelIds, []string{"INBOX"}) {
+		t.Fatalf("unexpected report-spam request: %#v", modifies)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailNotSpamCmd{}, []string{"from:boss@example.com"}, ctx, flags); err != nil {
+			t.Fatalf("not-spam: %v", err)
+		}
+	})
+	if listQuery != "in:spam from:boss@example.com" {
+		t.Fatalf("unexpected not-spam query: %q", listQuery)
+	}
+	if len(modifies) != 2 || !slices.Equal(modifies[1].AddLabelIds, []string{"INBOX"}) || !slices.Equal(modifies[1].RemoveLabelIds, []string{"SPAM"}) {
+		t.Fatalf("unexpected not-spam request: %#v", modifies[1:])
+	}
+}
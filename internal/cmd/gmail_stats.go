@@ -0,0 +1,363 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const (
+	statsGroupSender = "sender"
+	statsGroupDomain = "domain"
+	statsGroupLabel  = "label"
+)
+
+var gmailRelativeAgePattern = regexp.MustCompile(`^\d+[dmy]$`)
+
+// GmailStatsCmd summarizes message counts and sizes to help decide what to clean up.
+type GmailStatsCmd struct {
+	Since   string `name:"since" help:"Only messages newer than this (Gmail age: 90d, 6m, 1y)" default:"90d"`
+	Query   string `name:"query" short:"q" help:"Additional Gmail search query"`
+	GroupBy string `name:"group-by" help:"Group by: sender|domain|label" default:"sender" enum:"sender,domain,label"`
+	Top     int    `name:"top" help:"Show the top N groups by message count (0 = all)" default:"25"`
+	CSV     bool   `name:"csv" help:"Write CSV instead of a table"`
+	Refresh bool   `name:"refresh" help:"Ignore cached message metadata and refetch"`
+}
+
+type gmailStatsRow struct {
+	Key      string `json:"key"`
+	Messages int    `json:"messages"`
+	Bytes    int64  `json:"bytes"`
+}
+
+// gmailStatsEntry is the cached metadata needed for stats. From and size never
+// change for a message; labels can, which is what --refresh is for.
+// InternalDate (ms since epoch) decides when the entry is evicted.
+type gmailStatsEntry struct {
+	From         string   `json:"from"`
+	LabelIDs     []string `json:"labelIds,omitempty"`
+	Size         int64    `json:"size"`
+	InternalDate int64    `json:"internalDate,omitempty"`
+}
+
+type gmailStatsCache struct {
+	Messages map[string]gmailStatsEntry `json:"messages"`
+}
+
+func (c *GmailStatsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	since := strings.TrimSpace(c.Since)
+	if since != "" && !gmailRelativeAgePattern.MatchString(since) {
+		return usagef("invalid --since %q (expected e.g. 90d, 6m, 1y)", since)
+	}
+	if c.Top < 0 {
+		return usage("--top must be >= 0")
+	}
+
+	query := strings.TrimSpace(c.Query)
+	if since != "" {
+		query = strings.TrimSpace("newer_than:" + since + " " + query)
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	ids, err := searchMessageIDs(ctx, svc, query)
+	if err != nil {
+		return err
+	}
+
+	cachePath, err := gmailStatsCachePath(account)
+	if err != nil {
+		return err
+	}
+	cache := gmailStatsCache{Messages: map[string]gmailStatsEntry{}}
+	if !c.Refresh {
+		cache = loadGmailStatsCache(cachePath)
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if _, ok := cache.Messages[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		u.Err().Printf("fetching metadata for %d of %d messages", len(missing), len(ids))
+		fetched, fetchErr := fetchGmailStatsEntries(ctx, svc, missing)
+		if fetchErr != nil {
+			return fetchErr
+		}
+		for id, entry := range fetched {
+			cache.Messages[id] = entry
+		}
+	}
+	evicted := pruneGmailStatsCache(&cache, ids, gmailStatsCutoff(since, time.Now()))
+	if len(missing) > 0 || evicted > 0 {
+		if saveErr := saveGmailStatsCache(cachePath, cache); saveErr != nil {
+			u.Err().Printf("warning: %v", saveErr)
+		}
+	}
+
+	var idToName map[string]string
+	if c.GroupBy == statsGroupLabel {
+		idToName, err = fetchLabelIDToName(svc)
+		if err != nil {
+			return err
+		}
+	}
+
+	entries := make([]gmailStatsEntry, 0, len(ids))
+	for _, id := range ids {
+		entries = append(entries, cache.Messages[id])
+	}
+	rows, total := aggregateGmailStats(entries, c.GroupBy, idToName)
+	if c.Top > 0 && len(rows) > c.Top {
+		rows = rows[:c.Top]
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"query":    query,
+			"groupBy":  c.GroupBy,
+			"messages": len(ids),
+			"bytes":    total,
+			"groups":   rows,
+		})
+	}
+
+	if c.CSV {
+		w := csv.NewWriter(os.Stdout)
+		_ = w.Write([]string{c.GroupBy, "messages", "bytes"})
+		for _, r := range rows {
+			_ = w.Write([]string{r.Key, strconv.Itoa(r.Messages), strconv.FormatInt(r.Bytes, 10)})
+		}
+		w.Flush()
+		return w.Error()
+	}
+
+	if len(rows) == 0 {
+		u.Err().Println("No messages")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintf(w, "%s\tMESSAGES\tSIZE\n", strings.ToUpper(c.GroupBy))
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%d\t%s\n", sanitizeTab(r.Key), r.Messages, formatBytes(r.Bytes))
+	}
+	fmt.Fprintf(w, "TOTAL\t%d\t%s\n", len(ids), formatBytes(total))
+	return nil
+}
+
+// aggregateGmailStats groups entries and returns rows sorted by message count
+// (then size), plus the total size of all entries.
+func aggregateGmailStats(entries []gmailStatsEntry, groupBy string, idToName map[string]string) ([]gmailStatsRow, int64) {
+	groups := map[string]*gmailStatsRow{}
+	add := func(key string, size int64) {
+		if key == "" {
+			key = "(unknown)"
+		}
+		row, ok := groups[key]
+		if !ok {
+			row = &gmailStatsRow{Key: key}
+			groups[key] = row
+		}
+		row.Messages++
+		row.Bytes += size
+	}
+
+	var total int64
+	for _, e := range entries {
+		total += e.Size
+		switch groupBy {
+		case statsGroupLabel:
+			if len(e.LabelIDs) == 0 {
+				add("(none)", e.Size)
+			}
+			for _, id := range e.LabelIDs {
+				name := id
+				if n, ok := idToName[id]; ok {
+					name = n
+				}
+				add(name, e.Size)
+			}
+		case statsGroupDomain:
+			addr := ""
+			if parsed := parseEmailAddresses(e.From); len(parsed) > 0 {
+				addr = parsed[0]
+			}
+			if at := strings.LastIndex(addr, "@"); at != -1 {
+				addr = addr[at+1:]
+			}
+			add(addr, e.Size)
+		default:
+			addr := ""
+			if parsed := parseEmailAddresses(e.From); len(parsed) > 0 {
+				addr = parsed[0]
+			}
+			add(addr, e.Size)
+		}
+	}
+
+	rows := make([]gmailStatsRow, 0, len(groups))
+	for _, r := range groups {
+		rows = append(rows, *r)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Messages != rows[j].Messages {
+			return rows[i].Messages > rows[j].Messages
+		}
+		if rows[i].Bytes != rows[j].Bytes {
+			return rows[i].Bytes > rows[j].Bytes
+		}
+		return rows[i].Key < rows[j].Key
+	})
+	return rows, total
+}
+
+// gmailStatsCutoff is the start of the --since window (Gmail's newer_than),
+// or the zero time when there is no window.
+func gmailStatsCutoff(since string, now time.Time) time.Time {
+	if since == "" {
+		return time.Time{}
+	}
+	n, err := strconv.Atoi(since[:len(since)-1])
+	if err != nil {
+		return time.Time{}
+	}
+	switch since[len(since)-1] {
+	case 'y':
+		return now.AddDate(-n, 0, 0)
+	case 'm':
+		return now.AddDate(0, -n, 0)
+	default:
+		return now.AddDate(0, 0, -n)
+	}
+}
+
+// pruneGmailStatsCache drops entries for messages outside the queried window:
+// anything not in this result that is older than cutoff (or undated), which
+// also covers deleted messages. It returns how many entries were dropped.
+func pruneGmailStatsCache(cache *gmailStatsCache, ids []string, cutoff time.Time) int {
+	keep := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		keep[id] = struct{}{}
+	}
+	evicted := 0
+	for id, entry := range cache.Messages {
+		if _, ok := keep[id]; ok {
+			continue
+		}
+		if !cutoff.IsZero() && entry.InternalDate > 0 && time.UnixMilli(entry.InternalDate).After(cutoff) {
+			continue
+		}
+		delete(cache.Messages, id)
+		evicted++
+	}
+	return evicted
+}
+
+func fetchGmailStatsEntries(ctx context.Context, svc *gmail.Service, ids []string) (map[string]gmailStatsEntry, error) {
+	const maxConcurrency = 10
+	sem := make(chan struct{}, maxConcurrency)
+	entries := make([]gmailStatsEntry, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Add(1)
+		go func(idx int, messageID string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[idx] = ctx.Err()
+				return
+			}
+			msg, err := svc.Users.Messages.Get("me", messageID).
+				Format(gmailFormatMetadata).
+				MetadataHeaders("From").
+				Fields("id,labelIds,sizeEstimate,internalDate,payload(headers)").
+				Context(ctx).
+				Do()
+			if err != nil {
+				errs[idx] = fmt.Errorf("message %s: %w", messageID, err)
+				return
+			}
+			entries[idx] = gmailStatsEntry{
+				From:         headerValue(msg.Payload, "From"),
+				LabelIDs:     msg.LabelIds,
+				Size:         msg.SizeEstimate,
+				InternalDate: msg.InternalDate,
+			}
+		}(i, id)
+	}
+	wg.Wait()
+
+	out := make(map[string]gmailStatsEntry, len(ids))
+	for i, id := range ids {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		out[id] = entries[i]
+	}
+	return out, nil
+}
+
+func gmailStatsCachePath(account string) (string, error) {
+	dir, err := config.EnsureGmailStatsDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, sanitizeAccountForPath(account)+".json"), nil
+}
+
+func loadGmailStatsCache(path string) gmailStatsCache {
+	cache := gmailStatsCache{Messages: map[string]gmailStatsEntry{}}
+	data, err := os.ReadFile(path) //nolint:gosec // path is derived from the config dir
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil || cache.Messages == nil {
+		return gmailStatsCache{Messages: map[string]gmailStatsEntry{}}
+	}
+	return cache
+}
+
+func saveGmailStatsCache(path string, cache gmailStatsCache) error {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("encode stats cache: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write stats cache: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Join(fmt.Errorf("write stats cache: %w", err), os.Remove(tmp))
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestAggregateGmailStats(t *testing.T) {
+	entries := []gmailStatsEntry{
+		{From: "Ada <ada@example.com>", LabelIDs: []string{"INBOX", "Label_1"}, Size: 100},
+		{From: "bob@example.com", LabelIDs: []string{"Label_1"}, Size: 50},
+		{From: "news@other.org", Size: 1000},
+	}
+
+	rows, total := aggregateGmailStats(entries, statsGroupDomain, nil)
+	if total != 1150 || len(rows) != 2 || rows[0].Key != "example.com" || rows[0].Messages != 2 || rows[0].Bytes != 150 {
+		t.Fatalf("unexpected domain rows: %#v total=%d", rows, total)
+	}
+
+	rows, _ = aggregateGmailStats(entries, statsGroupSender, nil)
+	if len(rows) != 3 || rows[0].Key != "news@other.org" {
+		t.Fatalf("unexpected sender rows: %#v", rows)
+	}
+
+	rows, _ = aggregateGmailStats(entries, statsGroupLabel, map[string]string{"Label_1": "Work"})
+	if rows[0].Key != "Work" || rows[0].Messages != 2 {
+		t.Fatalf("unexpected label rows: %#v", rows)
+	}
+}
+
+func TestPruneGmailStatsCache(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	cutoff := gmailStatsCutoff("1m", now)
+	if want := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC); !cutoff.Equal(want) {
+		t.Fatalf("cutoff = %v, want %v", cutoff, want)
+	}
+
+	cache := gmailStatsCache{Messages: map[string]gmailStatsEntry{
+		"listed":  {InternalDate: now.AddDate(-1, 0, 0).UnixMilli()},
+		"recent":  {InternalDate: now.AddDate(0, 0, -1).UnixMilli()},
+		"old":     {InternalDate: now.AddDate(0, -2, 0).UnixMilli()},
+		"undated": {},
+	}}
+	if n := pruneGmailStatsCache(&cache, []string{"listed"}, cutoff); n != 2 {
+		t.Fatalf("evicted %d entries, want 2", n)
+	}
+	if _, ok := cache.Messages["old"]; ok || len(cache.Messages) != 2 {
+		t.Fatalf("unexpected cache after prune: %#v", cache.Messages)
+	}
+
+	if n := pruneGmailStatsCache(&cache, []string{"listed"}, time.Time{}); n != 1 || len(cache.Messages) != 1 {
+		t.Fatalf("expected only listed entries without a window, got %#v", cache.Messages)
+	}
+}
+
+func TestGmailStatsCmd_CachesMetadata(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	gets := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/me/messages") && r.Method == http.MethodGet:
+			if r.URL.Query().Get("q") != "newer_than:30d" {
+				http.Error(w, "unexpected query "+r.URL.Query().Get("q"), http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"messages": []map[string]any{{"id": "m1"}, {"id": "m2"}}})
+		case strings.Contains(r.URL.Path, "/users/me/messages/"):
+			gets++
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":           id,
+				"sizeEstimate": 2048,
+				"payload":      map[string]any{"headers": []map[string]any{{"name": "From", "value": "a@example.com"}}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com"}
+
+	run := func() string {
+		return captureStdout(t, func() {
+			if err := runKong(t, &GmailStatsCmd{}, []string{"--since", "30d", "--csv"}, ctx, flags); err != nil {
+				t.Fatalf("stats: %v", err)
+			}
+		})
+	}
+	out := run()
+	if out != "sender,messages,bytes\na@example.com,2,4096\n" {
+		t.Fatalf("unexpected csv: %q", out)
+	}
+	_ = run()
+	if gets != 2 {
+		t.Fatalf("expected cached metadata on second run, got %d fetches", gets)
+	}
+
+	if err := runKong(t, &GmailStatsCmd{}, []string{"--since", "soon"}, ctx, flags); err == nil {
+		t.Fatalf("expected invalid --since error")
+	}
+}
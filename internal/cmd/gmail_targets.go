@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+// gmailBatchModifyLimit is the maximum number of IDs accepted by
+// users.messages.batchModify / batchDelete per request.
+const gmailBatchModifyLimit = 1000
+
+// resolveMessageTargets returns the messages to act on: the explicit --id
+// values when given, otherwise every match (all pages) of the positional
+// query, whose tokens are joined like gmail search.
+func resolveMessageTargets(ctx context.Context, svc *gmail.Service, queryArgs []string, ids []string) ([]string, string, error) {
+	query, ids, err := splitTargets(queryArgs, ids)
+	if err != nil || query == "" {
+		return ids, "", err
+	}
+	ids, err = searchMessageIDs(ctx, svc, query)
+	return ids, query, err
+}
+
+// resolveThreadTargets is resolveMessageTargets for thread IDs.
+func resolveThreadTargets(ctx context.Context, svc *gmail.Service, queryArgs []string, ids []string) ([]string, string, error) {
+	query, ids, err := splitTargets(queryArgs, ids)
+	if err != nil || query == "" {
+		return ids, "", err
+	}
+	ids, err = searchThreadIDs(ctx, svc, query)
+	return ids, query, err
+}
+
+// splitTargets validates that exactly one of a query or --id values was given.
+// Positional arguments are never guessed to be IDs, however they look.
+func splitTargets(queryArgs []string, ids []string) (string, []string, error) {
+	query := strings.TrimSpace(strings.Join(queryArgs, " "))
+	cleaned := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			cleaned = append(cleaned, id)
+		}
+	}
+	switch {
+	case query != "" && len(cleaned) > 0:
+		return "", nil, usage("use either a query or --id, not both")
+	case query == "" && len(cleaned) == 0:
+		return "", nil, usage("missing query or --id")
+	}
+	return query, cleaned, nil
+}
+
+func searchThreadIDs(ctx context.Context, svc *gmail.Service, query string) ([]string, error) {
+	threads, _, err := listThreadPages(ctx, svc, query, 500, "", true)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(threads))
+	for _, t := range threads {
+		if t != nil && t.Id != "" {
+			ids = append(ids, t.Id)
+		}
+	}
+	return ids, nil
+}
+
+// modifyThreads applies label changes to each thread (there is no batch
+// endpoint for threads), with bounded parallelism.
+func modifyThreads(ctx context.Context, svc *gmail.Service, ids []string, addIDs []string, removeIDs []string) error {
+	const maxConcurrency = 10
+	sem := make(chan struct{}, maxConcurrency)
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(idx int, threadID string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[idx] = ctx.Err()
+				return
+			}
+			_, err := svc.Users.Threads.Modify("me", threadID, &gmail.ModifyThreadRequest{
+				AddLabelIds:    addIDs,
+				RemoveLabelIds: removeIDs,
+			}).Context(ctx).Do()
+			if err != nil {
+				errs[idx] = fmt.Errorf("thread %s: %w", threadID, err)
+			}
+		}(i, id)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func searchMessageIDs(ctx context.Context, svc *gmail.Service, query string) ([]string, error) {
+	messages, _, err := listMessagePages(ctx, svc, query, 500, "", true)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if m != nil && m.Id != "" {
+			ids = append(ids, m.Id)
+		}
+	}
+	return ids, nil
+}
+
+// chunkIDs splits ids into slices of at most size elements.
+func chunkIDs(ids []string, size int) [][]string {
+	if size <= 0 || size > gmailBatchModifyLimit {
+		size = gmailBatchModifyLimit
+	}
+	chunks := make([][]string, 0, (len(ids)+size-1)/size)
+	for start := 0; start < len(ids); start += size {
+		end := start + size
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunks = append(chunks, ids[start:end])
+	}
+	return chunks
+}
+
+// batchModifyMessages applies label changes in batchModify-sized chunks.
+func batchModifyMessages(ctx context.Context, svc *gmail.Service, ids []string, addIDs []string, removeIDs []string) error {
+	for _, chunk := range chunkIDs(ids, gmailBatchModifyLimit) {
+		err := svc.Users.Messages.BatchModify("me", &gmail.BatchModifyMessagesRequest{
+			Ids:            chunk,
+			AddLabelIds:    addIDs,
+			RemoveLabelIds: removeIDs,
+		}).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
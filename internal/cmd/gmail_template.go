@@ -0,0 +1,598 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// gmailTemplateSleep is swapped out in tests to skip the rate-limit delay.
+var gmailTemplateSleep = time.Sleep
+
+var (
+	gmailTemplateNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	gmailTemplateVarPattern  = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+)
+
+type GmailTemplateCmd struct {
+	Save   GmailTemplateSaveCmd   `cmd:"" name:"save" help:"Save (or overwrite) a local message template"`
+	List   GmailTemplateListCmd   `cmd:"" name:"list" aliases:"ls" help:"List saved templates"`
+	Show   GmailTemplateShowCmd   `cmd:"" name:"show" aliases:"get" help:"Show a saved template"`
+	Delete GmailTemplateDeleteCmd `cmd:"" name:"delete" aliases:"rm" help:"Delete a saved template"`
+	Send   GmailTemplateSendCmd   `cmd:"" name:"send" help:"Send a template, substituting {{variables}} per recipient"`
+}
+
+type gmailTemplate struct {
+	Name      string    `json:"name"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body,omitempty"`
+	BodyHTML  string    `json:"bodyHtml,omitempty"`
+	Markdown  bool      `json:"markdown,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func validateGmailTemplateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", usage("empty template name")
+	}
+	if !gmailTemplateNamePattern.MatchString(name) {
+		return "", usagef("invalid template name %q (use letters, digits, '.', '-', '_')", name)
+	}
+	return name, nil
+}
+
+func gmailTemplatePath(name string) (string, error) {
+	dir, err := config.EnsureGmailTemplatesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+func loadGmailTemplate(name string) (*gmailTemplate, error) {
+	name, err := validateGmailTemplateName(name)
+	if err != nil {
+		return nil, err
+	}
+	path, err := gmailTemplatePath(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path is built from a validated template name
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("template %q not found", name)
+		}
+		return nil, fmt.Errorf("read template: %w", err)
+	}
+	var tpl gmailTemplate
+	if err := json.Unmarshal(data, &tpl); err != nil {
+		return nil, fmt.Errorf("parse template %q: %w", name, err)
+	}
+	tpl.Name = name
+	return &tpl, nil
+}
+
+func saveGmailTemplate(tpl *gmailTemplate) (string, error) {
+	path, err := gmailTemplatePath(tpl.Name)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(tpl, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encode template: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return "", fmt.Errorf("write template: %w", err)
+	}
+	return path, nil
+}
+
+func listGmailTemplates() ([]gmailTemplate, error) {
+	dir, err := config.EnsureGmailTemplatesDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read templates dir: %w", err)
+	}
+	out := make([]gmailTemplate, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		tpl, err := loadGmailTemplate(strings.TrimSuffix(e.Name(), ".json"))
+		if err != nil {
+			continue
+		}
+		out = append(out, *tpl)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// templateVariables returns the distinct {{variable}} names used by the template.
+func templateVariables(tpl *gmailTemplate) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, text := range []string{tpl.Subject, tpl.Body, tpl.BodyHTML} {
+		for _, m := range gmailTemplateVarPattern.FindAllStringSubmatch(text, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				out = append(out, m[1])
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// renderTemplateText replaces {{key}} placeholders and reports keys without a
+// value. escape (when set) is applied to every substituted value, so CSV rows
+// cannot inject markup into HTML or Markdown bodies.
+func renderTemplateText(text string, vars map[string]string, escape func(string) string) (string, []string) {
+	var missing []string
+	out := gmailTemplateVarPattern.ReplaceAllStringFunc(text, func(match string) string {
+		key := gmailTemplateVarPattern.FindStringSubmatch(match)[1]
+		if v, ok := vars[key]; ok {
+			if escape != nil {
+				return escape(v)
+			}
+			return v
+		}
+		missing = append(missing, key)
+		return match
+	})
+	return out, missing
+}
+
+const markdownPunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// escapeMarkdown backslash-escapes ASCII punctuation so a value renders as
+// literal text (CommonMark allows escaping any ASCII punctuation).
+func escapeMarkdown(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(markdownPunctuation, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func parseTemplateVars(pairs []string) (map[string]string, error) {
+	vars := map[string]string{}
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, usagef("invalid --vars %q (expected key=value)", pair)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+type templateRecipient struct {
+	To   []string
+	Vars map[string]string
+}
+
+// readTemplateRecipientsCSV reads one recipient per row. The header row names the
+// variables; an "email" (or "to") column supplies the recipient address.
+func readTemplateRecipientsCSV(r io.Reader, base map[string]string) ([]templateRecipient, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read --vars-csv: %w", err)
+	}
+	if len(rows) < 2 {
+		return nil, usage("--vars-csv needs a header row and at least one recipient row")
+	}
+
+	header := rows[0]
+	emailCol := -1
+	for i, h := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		switch strings.ToLower(header[i]) {
+		case "email", "to":
+			if emailCol == -1 {
+				emailCol = i
+			}
+		}
+	}
+	if emailCol == -1 {
+		return nil, usage("--vars-csv header must include an email (or to) column")
+	}
+
+	out := make([]templateRecipient, 0, len(rows)-1)
+	for n, row := range rows[1:] {
+		if emailCol >= len(row) || strings.TrimSpace(row[emailCol]) == "" {
+			return nil, usagef("--vars-csv row %d: missing email", n+2)
+		}
+		vars := make(map[string]string, len(base)+len(header))
+		for k, v := range base {
+			vars[k] = v
+		}
+		for i, h := range header {
+			if h == "" || i >= len(row) {
+				continue
+			}
+			vars[h] = strings.TrimSpace(row[i])
+		}
+		out = append(out, templateRecipient{To: splitCSV(row[emailCol]), Vars: vars})
+	}
+	return out, nil
+}
+
+type GmailTemplateSaveCmd struct {
+	Name     string `arg:"" name:"name" help:"Template name"`
+	Subject  string `name:"subject" help:"Subject (may contain {{variables}}; required)"`
+	Body     string `name:"body" help:"Body (plain text; may contain {{variables}})"`
+	BodyFile string `name:"body-file" help:"Body file path (plain text; '-' for stdin)"`
+	BodyHTML string `name:"body-html" help:"Body (HTML; may contain {{variables}})"`
+	Markdown bool   `name:"markdown" help:"Render the body as Markdown when sending"`
+}
+
+func (c *GmailTemplateSaveCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+	name, err := validateGmailTemplateName(c.Name)
+	if err != nil {
+		return err
+	}
+	body, err := resolveBodyInput(c.Body, c.BodyFile)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(c.Subject) == "" {
+		return usage("required: --subject")
+	}
+	if strings.TrimSpace(body) == "" && strings.TrimSpace(c.BodyHTML) == "" {
+		return usage("required: --body, --body-file, or --body-html")
+	}
+	if c.Markdown && strings.TrimSpace(c.BodyHTML) != "" {
+		return usage("--markdown cannot be combined with --body-html")
+	}
+
+	tpl := &gmailTemplate{
+		Name:      name,
+		Subject:   c.Subject,
+		Body:      body,
+		BodyHTML:  c.BodyHTML,
+		Markdown:  c.Markdown,
+		UpdatedAt: time.Now().UTC(),
+	}
+	path, err := saveGmailTemplate(tpl)
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"template":  tpl,
+			"variables": templateVariables(tpl),
+			"path":      path,
+		})
+	}
+	u.Out().Printf("name\t%s", tpl.Name)
+	u.Out().Printf("path\t%s", path)
+	if vars := templateVariables(tpl); len(vars) > 0 {
+		u.Out().Printf("variables\t%s", strings.Join(vars, ","))
+	}
+	return nil
+}
+
+type GmailTemplateListCmd struct{}
+
+func (c *GmailTemplateListCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+	templates, err := listGmailTemplates()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"templates": templates})
+	}
+	if len(templates) == 0 {
+		u.Err().Println("No templates")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "NAME\tSUBJECT\tVARIABLES\tUPDATED")
+	for i := range templates {
+		tpl := &templates[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			tpl.Name,
+			sanitizeTab(tpl.Subject),
+			strings.Join(templateVariables(tpl), ","),
+			tpl.UpdatedAt.Local().Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+type GmailTemplateShowCmd struct {
+	Name string `arg:"" name:"name" help:"Template name"`
+}
+
+func (c *GmailTemplateShowCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+	tpl, err := loadGmailTemplate(c.Name)
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"template":  tpl,
+			"variables": templateVariables(tpl),
+		})
+	}
+	u.Out().Printf("name\t%s", tpl.Name)
+	u.Out().Printf("subject\t%s", tpl.Subject)
+	if vars := templateVariables(tpl); len(vars) > 0 {
+		u.Out().Printf("variables\t%s", strings.Join(vars, ","))
+	}
+	if tpl.Markdown {
+		u.Out().Printf("markdown\ttrue")
+	}
+	if tpl.Body != "" {
+		u.Out().Println("")
+		u.Out().Println(tpl.Body)
+	}
+	if tpl.BodyHTML != "" {
+		u.Out().Println("")
+		u.Out().Println(tpl.BodyHTML)
+	}
+	return nil
+}
+
+type GmailTemplateDeleteCmd struct {
+	Name string `arg:"" name:"name" help:"Template name"`
+}
+
+func (c *GmailTemplateDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	name, err := validateGmailTemplateName(c.Name)
+	if err != nil {
+		return err
+	}
+	path, err := gmailTemplatePath(name)
+	if err != nil {
+		return err
+	}
+	if _, statErr := os.Stat(path); statErr != nil {
+		return fmt.Errorf("template %q not found", name)
+	}
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete template %s", name)); confirmErr != nil {
+		return confirmErr
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("delete template: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"deleted": true, "name": name})
+	}
+	u.Out().Printf("deleted\t%s", name)
+	return nil
+}
+
+type GmailTemplateSendCmd struct {
+	Name    string        `arg:"" name:"name" help:"Template name"`
+	To      string        `name:"to" help:"Recipients (comma-separated; one message to all of them)"`
+	Cc      string        `name:"cc" help:"CC recipients (comma-separated; added to every message)"`
+	Bcc     string        `name:"bcc" help:"BCC recipients (comma-separated; added to every message)"`
+	Vars    []string      `name:"vars" sep:"none" help:"Template variable as key=value (repeatable)"`
+	VarsCSV string        `name:"vars-csv" help:"CSV file with a header row and one recipient per row (needs an email column); sends one message per row"`
+	From    string        `name:"from" help:"Send from this email address (must be a verified send-as alias)"`
+	Delay   time.Duration `name:"delay" default:"1s" help:"Pause between messages when sending to several recipients"`
+	Draft   bool          `name:"draft" help:"Create drafts instead of sending"`
+	DryRun  bool          `name:"dry-run" help:"Render messages and print them without sending"`
+}
+
+type templateSendItem struct {
+	To        string `json:"to"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body,omitempty"`
+	MessageID string `json:"messageId,omitempty"`
+	ThreadID  string `json:"threadId,omitempty"`
+	DraftID   string `json:"draftId,omitempty"`
+}
+
+func (c *GmailTemplateSendCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	tpl, err := loadGmailTemplate(c.Name)
+	if err != nil {
+		return err
+	}
+	baseVars, err := parseTemplateVars(c.Vars)
+	if err != nil {
+		return err
+	}
+	if c.Delay < 0 {
+		return usage("--delay must be >= 0")
+	}
+
+	var recipients []templateRecipient
+	switch {
+	case strings.TrimSpace(c.VarsCSV) != "" && strings.TrimSpace(c.To) != "":
+		return usage("use only one of --to or --vars-csv")
+	case strings.TrimSpace(c.VarsCSV) != "":
+		path, expandErr := config.ExpandPath(c.VarsCSV)
+		if expandErr != nil {
+			return expandErr
+		}
+		f, openErr := os.Open(path) //nolint:gosec // user-provided path
+		if openErr != nil {
+			return fmt.Errorf("open --vars-csv: %w", openErr)
+		}
+		recipients, err = readTemplateRecipientsCSV(f, baseVars)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+	case strings.TrimSpace(c.To) != "":
+		recipients = []templateRecipient{{To: splitCSV(c.To), Vars: baseVars}}
+	default:
+		return usage("required: --to or --vars-csv")
+	}
+
+	// Render everything up front so a missing variable aborts before anything is sent.
+	type rendered struct {
+		to       []string
+		subject  string
+		body     string
+		bodyHTML string
+	}
+	messages := make([]rendered, 0, len(recipients))
+	for i, r := range recipients {
+		vars := r.Vars
+		if _, ok := vars["email"]; !ok && len(r.To) > 0 {
+			vars["email"] = r.To[0]
+		}
+		var missing []string
+		subject, m := renderTemplateText(tpl.Subject, vars, nil)
+		missing = append(missing, m...)
+		body, m := renderTemplateText(tpl.Body, vars, nil)
+		missing = append(missing, m...)
+		bodyHTML, m := renderTemplateText(tpl.BodyHTML, vars, html.EscapeString)
+		missing = append(missing, m...)
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			return usagef("recipient %d (%s): missing template variables: %s", i+1, strings.Join(r.To, ","), strings.Join(slices.Compact(missing), ","))
+		}
+		if tpl.Markdown {
+			// The text part keeps raw values; the HTML part is rendered from
+			// Markdown with escaped values.
+			markdownBody, _ := renderTemplateText(tpl.Body, vars, escapeMarkdown)
+			_, bodyHTML, err = renderMarkdownBody(markdownBody, bodyHTML, true)
+			if err != nil {
+				return err
+			}
+		}
+		messages = append(messages, rendered{to: r.To, subject: subject, body: body, bodyHTML: bodyHTML})
+	}
+
+	if c.DryRun {
+		items := make([]templateSendItem, 0, len(messages))
+		for _, m := range messages {
+			items = append(items, templateSendItem{To: strings.Join(m.to, ", "), Subject: m.subject, Body: m.body})
+		}
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, map[string]any{"dryRun": true, "messages": items})
+		}
+		for i, it := range items {
+			if i > 0 {
+				u.Out().Println("")
+			}
+			u.Out().Printf("to\t%s", it.To)
+			u.Out().Printf("subject\t%s", it.Subject)
+			if it.Body != "" {
+				u.Out().Println(it.Body)
+			}
+		}
+		return nil
+	}
+
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	fromAddr, _, err := resolveFromAddress(ctx, svc, account, c.From)
+	if err != nil {
+		return err
+	}
+
+	cc := splitCSV(c.Cc)
+	bcc := splitCSV(c.Bcc)
+	items := make([]templateSendItem, 0, len(messages))
+	for i, m := range messages {
+		if i > 0 && c.Delay > 0 {
+			gmailTemplateSleep(c.Delay)
+		}
+		raw, buildErr := buildRFC822(mailOptions{
+			From:     fromAddr,
+			To:       m.to,
+			Cc:       cc,
+			Bcc:      bcc,
+			Subject:  m.subject,
+			Body:     m.body,
+			BodyHTML: m.bodyHTML,
+		}, nil)
+		if buildErr != nil {
+			return buildErr
+		}
+		msg := &gmail.Message{Raw: base64.RawURLEncoding.EncodeToString(raw)}
+		item := templateSendItem{To: strings.Join(m.to, ", "), Subject: m.subject}
+		if c.Draft {
+			draft, draftErr := svc.Users.Drafts.Create("me", &gmail.Draft{Message: msg}).Context(ctx).Do()
+			if draftErr != nil {
+				return fmt.Errorf("create draft for %s: %w", item.To, draftErr)
+			}
+			item.DraftID = draft.Id
+			if draft.Message != nil {
+				item.MessageID = draft.Message.Id
+				item.ThreadID = draft.Message.ThreadId
+			}
+		} else {
+			sent, sendErr := svc.Users.Messages.Send("me", msg).Context(ctx).Do()
+			if sendErr != nil {
+				return fmt.Errorf("send to %s: %w", item.To, sendErr)
+			}
+			item.MessageID = sent.Id
+			item.ThreadID = sent.ThreadId
+		}
+		items = append(items, item)
+		if len(messages) > 1 {
+			u.Err().Printf("%d/%d\t%s", i+1, len(messages), item.To)
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"from": fromAddr, "messages": items})
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	if c.Draft {
+		fmt.Fprintln(w, "TO\tDRAFT_ID\tMESSAGE_ID")
+		for _, it := range items {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", it.To, it.DraftID, it.MessageID)
+		}
+		return nil
+	}
+	fmt.Fprintln(w, "TO\tMESSAGE_ID\tTHREAD_ID")
+	for _, it := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", it.To, it.MessageID, it.ThreadID)
+	}
+	return nil
+}
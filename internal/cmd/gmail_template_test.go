@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestRenderTemplateText(t *testing.T) {
+	out, missing := renderTemplateText("Hi {{name}}, re {{ topic }} {{name}}", map[string]string{"name": "Ada"}, nil)
+	if out != "Hi Ada, re {{ topic }} Ada" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if len(missing) != 1 || missing[0] != "topic" {
+		t.Fatalf("unexpected missing: %#v", missing)
+	}
+}
+
+func TestReadTemplateRecipientsCSV(t *testing.T) {
+	in := "Email,name\nada@example.com,Ada\nbob@example.com,\n"
+	got, err := readTemplateRecipientsCSV(strings.NewReader(in), map[string]string{"team": "Ops", "name": "friend"})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 recipients, got %d", len(got))
+	}
+	if got[0].To[0] != "ada@example.com" || got[0].Vars["name"] != "Ada" || got[0].Vars["team"] != "Ops" {
+		t.Fatalf("unexpected first recipient: %#v", got[0])
+	}
+	if got[1].Vars["name"] != "" {
+		t.Fatalf("expected CSV column to override --vars, got %#v", got[1].Vars)
+	}
+
+	if _, err := readTemplateRecipientsCSV(strings.NewReader("name\nAda\n"), nil); err == nil {
+		t.Fatalf("expected error for missing email column")
+	}
+}
+
+func TestGmailTemplateSaveAndMailMerge(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	origSleep := gmailTemplateSleep
+	var sleeps []time.Duration
+	gmailTemplateSleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	t.Cleanup(func() { gmailTemplateSleep = origSleep })
+
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.Contains(r.URL.Path, "/gmail/v1/users/me/messages/send") {
+			http.NotFound(w, r)
+			return
+		}
+		var msg gmail.Message
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+		raw, err := base64.RawURLEncoding.DecodeString(msg.Raw)
+		if err != nil {
+			t.Errorf("decode raw: %v", err)
+		}
+		sent = append(sent, string(raw))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "s" + string(rune('0'+len(sent))), "threadId": "t1"})
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "me@example.com"}
+
+	if err := runKong(t, &GmailTemplateSaveCmd{}, []string{"welcome", "--subject", "Welcome {{name}}", "--body", "Hi {{name}}, see you in {{city}}."}, ctx, flags); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	csvPath := filepath.Join(home, "recipients.csv")
+	if err := os.WriteFile(csvPath, []byte("email,name\nada@example.com,Ada\nbob@example.com,Bob\n"), 0o600); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+
+	// A missing variable aborts before anything is sent.
+	if err := runKong(t, &GmailTemplateSendCmd{}, []string{"welcome", "--vars-csv", csvPath}, ctx, flags); err == nil || !strings.Contains(err.Error(), "city") {
+		t.Fatalf("expected missing variable error, got %v", err)
+	}
+	if len(sent) != 0 {
+		t.Fatalf("expected nothing sent, got %d", len(sent))
+	}
+
+	jsonCtx := outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+	out := captureStdout(t, func() {
+		args := []string{"welcome", "--vars-csv", csvPath, "--vars", "city=Vienna, Austria", "--delay", "2s"}
+		if err := runKong(t, &GmailTemplateSendCmd{}, args, jsonCtx, flags); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	})
+
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(sent))
+	}
+	if !strings.Contains(sent[0], "To: ada@example.com") || !strings.Contains(sent[0], "Hi Ada, see you in Vienna, Austria.") {
+		t.Fatalf("unexpected first message:\n%s", sent[0])
+	}
+	if !strings.Contains(sent[1], "Subject: Welcome Bob") {
+		t.Fatalf("unexpected second message:\n%s", sent[1])
+	}
+	if len(sleeps) != 1 || sleeps[0] != 2*time.Second {
+		t.Fatalf("unexpected sleeps: %#v", sleeps)
+	}
+
+	var parsed struct {
+		Messages []templateSendItem `json:"messages"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Messages) != 2 || parsed.Messages[1].To != "bob@example.com" {
+		t.Fatalf("unexpected output: %#v", parsed.Messages)
+	}
+}
+
+func TestGmailTemplateSend_EscapesValuesInMarkup(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.Contains(r.URL.Path, "/gmail/v1/users/me/messages/send") {
+			http.NotFound(w, r)
+			return
+		}
+		var msg gmail.Message
+		_ = json.NewDecoder(r.Body).Decode(&msg)
+		raw, _ := base64.RawURLEncoding.DecodeString(msg.Raw)
+		sent = append(sent, string(raw))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "s1", "threadId": "t1"})
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "me@example.com"}
+
+	if err := runKong(t, &GmailTemplateSaveCmd{}, []string{"html", "--subject", "Hi", "--body-html", "<p>Hi {{name}}</p>"}, ctx, flags); err != nil {
+		t.Fatalf("save html: %v", err)
+	}
+	if err := runKong(t, &GmailTemplateSaveCmd{}, []string{"md", "--subject", "Hi", "--body", "Hi **{{name}}**", "--markdown"}, ctx, flags); err != nil {
+		t.Fatalf("save markdown: %v", err)
+	}
+
+	name := `<img src=x>[click](https://evil.example)`
+	_ = captureStdout(t, func() {
+		for _, tpl := range []string{"html", "md"} {
+			if err := runKong(t, &GmailTemplateSendCmd{}, []string{tpl, "--to", "ada@example.com", "--vars", "name=" + name}, ctx, flags); err != nil {
+				t.Fatalf("send %s: %v", tpl, err)
+			}
+		}
+	})
+
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(sent))
+	}
+	for _, raw := range sent {
+		_, htmlPart, _ := strings.Cut(raw, "text/html")
+		if strings.Contains(htmlPart, "<img") || strings.Contains(htmlPart, `href="https://evil.example"`) {
+			t.Fatalf("value injected markup:\n%s", raw)
+		}
+	}
+	if !strings.Contains(sent[0], "&lt;img src=3Dx&gt;") && !strings.Contains(sent[0], "&lt;img src=x&gt;") {
+		t.Fatalf("expected escaped HTML value:\n%s", sent[0])
+	}
+	// The plain-text part of a Markdown template keeps the raw value.
+	if !strings.Contains(sent[1], "Hi **"+name+"**") {
+		t.Fatalf("expected raw value in text part:\n%s", sent[1])
+	}
+}
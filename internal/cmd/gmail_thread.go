@@ -53,10 +53,11 @@ type GmailThreadCmd struct {
 }
 
 type GmailThreadGetCmd struct {
-	ThreadID  string        `arg:"" name:"threadId" help:"Thread ID"`
-	Download  bool          `name:"download" help:"Download attachments"`
-	Full      bool          `name:"full" help:"Show full message bodies"`
-	OutputDir OutputDirFlag `embed:""`
+	ThreadID    string        `arg:"" name:"threadId" help:"Thread ID"`
+	Download    bool          `name:"download" help:"Download attachments"`
+	Full        bool          `name:"full" help:"Show full message bodies"`
+	StripQuotes bool          `name:"strip-quotes" help:"Strip quoted reply text from message bodies"`
+	OutputDir   OutputDirFlag `embed:""`
 }
 
 func (c *GmailThreadGetCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -110,6 +111,7 @@ func (c *GmailThreadGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		}
 		return outfmt.WriteJSON(os.Stdout, map[string]any{
 			"thread":     thread,
+			"messages":   threadMessageViews(thread, c.StripQuotes),
 			"downloaded": downloadedFiles,
 		})
 	}
@@ -140,6 +142,9 @@ func (c *GmailThreadGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 				// Strip HTML tags for cleaner text output
 				cleanBody = stripHTMLTags(body)
 			}
+			if c.StripQuotes {
+				cleanBody = stripQuotedText(cleanBody)
+			}
 			// Limit body preview to avoid overwhelming output
 			// Use runes to avoid breaking multi-byte UTF-8 characters
 			runes := []rune(cleanBody)
@@ -341,18 +346,21 @@ func (c *GmailURLCmd) Run(ctx context.Context, flags *RootFlags) error {
 		for _, id := range c.ThreadIDs {
 			urls = append(urls, map[string]string{
 				"id":  id,
-				"url": fmt.Sprintf("https://mail.google.com/mail/?authuser=%s#all/%s", url.QueryEscape(account), id),
+				"url": gmailThreadURL(account, id),
 			})
 		}
 		return outfmt.WriteJSON(os.Stdout, map[string]any{"urls": urls})
 	}
 	for _, id := range c.ThreadIDs {
-		threadURL := fmt.Sprintf("https://mail.google.com/mail/?authuser=%s#all/%s", url.QueryEscape(account), id)
-		u.Out().Printf("%s\t%s", id, threadURL)
+		u.Out().Printf("%s\t%s", id, gmailThreadURL(account, id))
 	}
 	return nil
 }
 
+func gmailThreadURL(account, threadID string) string {
+	return fmt.Sprintf("https://mail.google.com/mail/?authuser=%s#all/%s", url.QueryEscape(account), threadID)
+}
+
 func bestBodyText(p *gmail.MessagePart) string {
 	if p == nil {
 		return ""
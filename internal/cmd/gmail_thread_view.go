@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+// threadMessageView is the structured per-message shape emitted by `gmail thread get --json`.
+type threadMessageView struct {
+	ID       string   `json:"id"`
+	ThreadID string   `json:"threadId,omitempty"`
+	Date     string   `json:"date,omitempty"`
+	From     string   `json:"from,omitempty"`
+	To       string   `json:"to,omitempty"`
+	Cc       string   `json:"cc,omitempty"`
+	Subject  string   `json:"subject,omitempty"`
+	LabelIDs []string `json:"labelIds,omitempty"`
+	Body     string   `json:"body,omitempty"`
+}
+
+func threadMessageViews(thread *gmail.Thread, stripQuotes bool) []threadMessageView {
+	if thread == nil {
+		return nil
+	}
+	views := make([]threadMessageView, 0, len(thread.Messages))
+	for _, msg := range thread.Messages {
+		if msg == nil {
+			continue
+		}
+		body, isHTML := bestBodyForDisplay(msg.Payload)
+		if isHTML {
+			body = stripHTMLTags(body)
+		}
+		if stripQuotes {
+			body = stripQuotedText(body)
+		}
+		views = append(views, threadMessageView{
+			ID:       msg.Id,
+			ThreadID: msg.ThreadId,
+			Date:     headerValue(msg.Payload, "Date"),
+			From:     headerValue(msg.Payload, "From"),
+			To:       headerValue(msg.Payload, "To"),
+			Cc:       headerValue(msg.Payload, "Cc"),
+			Subject:  headerValue(msg.Payload, "Subject"),
+			LabelIDs: msg.LabelIds,
+			Body:     body,
+		})
+	}
+	return views
+}
+
+var (
+	// "On Mon, Jan 2, 2006 at 3:04 PM Someone <a@b.com> wrote:"
+	quoteAttributionPattern = regexp.MustCompile(`(?i)^on\s.+\swrote:\s*$`)
+	// Outlook-style separators.
+	quoteSeparatorPattern = regexp.MustCompile(`(?i)^-{2,}\s*(original message|forwarded message)\s*-{2,}$`)
+)
+
+// stripQuotedText removes quoted reply content: ">"-prefixed lines and
+// everything after a reply attribution or original-message separator.
+func stripQuotedText(body string) string {
+	if body == "" {
+		return ""
+	}
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if quoteAttributionPattern.MatchString(trimmed) || quoteSeparatorPattern.MatchString(trimmed) {
+			break
+		}
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+func TestStripQuotedText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "empty", in: "", want: ""},
+		{name: "no quotes", in: "Hello\nWorld", want: "Hello\nWorld"},
+		{name: "prefixed lines", in: "Reply\n> quoted\n>> deeper\nTail", want: "Reply\nTail"},
+		{name: "attribution", in: "Thanks!\n\nOn Mon, Jan 2, 2006 at 3:04 PM Ann <a@b.com> wrote:\nold text", want: "Thanks!"},
+		{name: "outlook separator", in: "See below\r\n-----Original Message-----\r\nFrom: x", want: "See below"},
+		{name: "forwarded separator", in: "FYI\n---------- Forwarded message ---------\nbody", want: "FYI"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripQuotedText(tt.in); got != tt.want {
+				t.Fatalf("stripQuotedText(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThreadMessageViews(t *testing.T) {
+	body := base64.RawURLEncoding.EncodeToString([]byte("Sounds good\n\nOn Tue, Bob wrote:\n> earlier"))
+	thread := &gmail.Thread{
+		Id: "t1",
+		Messages: []*gmail.Message{
+			nil,
+			{
+				Id:       "m1",
+				ThreadId: "t1",
+				LabelIds: []string{"INBOX"},
+				Payload: &gmail.MessagePart{
+					MimeType: "text/plain",
+					Headers: []*gmail.MessagePartHeader{
+						{Name: "From", Value: "a@b.com"},
+						{Name: "To", Value: "c@d.com"},
+						{Name: "Subject", Value: "Re: plan"},
+					},
+					Body: &gmail.MessagePartBody{Data: body},
+				},
+			},
+		},
+	}
+
+	views := threadMessageViews(thread, true)
+	if len(views) != 1 {
+		t.Fatalf("expected 1 view, got %d", len(views))
+	}
+	v := views[0]
+	if v.ID != "m1" || v.From != "a@b.com" || v.To != "c@d.com" || v.Subject != "Re: plan" {
+		t.Fatalf("unexpected view: %#v", v)
+	}
+	if v.Body != "Sounds good" {
+		t.Fatalf("expected stripped body, got %q", v.Body)
+	}
+	if full := threadMessageViews(thread, false); full[0].Body == "Sounds good" {
+		t.Fatalf("expected quoted text to remain without strip, got %q", full[0].Body)
+	}
+	if threadMessageViews(nil, false) != nil {
+		t.Fatalf("expected nil for nil thread")
+	}
+}
@@ -2,7 +2,16 @@ package cmd
 
 // GmailTrackCmd groups tracking-related subcommands
 type GmailTrackCmd struct {
-	Setup  GmailTrackSetupCmd  `cmd:"" help:"Set up email tracking (deploy Cloudflare Worker)"`
-	Opens  GmailTrackOpensCmd  `cmd:"" help:"Query email opens"`
-	Status GmailTrackStatusCmd `cmd:"" help:"Show tracking configuration status"`
+	Setup     GmailTrackSetupCmd     `cmd:"" help:"Set up email tracking (deploy Cloudflare Worker)"`
+	Opens     GmailTrackOpensCmd     `cmd:"" help:"Query email opens"`
+	Clicks    GmailTrackClicksCmd    `cmd:"" help:"Show link clicks for a message sent with --track-links"`
+	Report    GmailTrackReportCmd    `cmd:"" help:"Aggregate open/click rates across tracked sends"`
+	Campaigns GmailTrackCampaignsCmd `cmd:"" help:"Open/click performance per campaign (sends tagged with --campaign)"`
+	Export    GmailTrackExportCmd    `cmd:"" help:"Export raw open/click events as CSV or JSONL"`
+	Status    GmailTrackStatusCmd    `cmd:"" help:"Show tracking configuration status"`
+	Webhook   GmailTrackWebhookCmd   `cmd:"" help:"Forward open events to a webhook"`
+	Purge     GmailTrackPurgeCmd     `cmd:"" help:"Delete tracking data older than a cutoff"`
+	Retention GmailTrackRetentionCmd `cmd:"" help:"Show or set automatic retention for tracking data"`
+	Doctor    GmailTrackDoctorCmd    `cmd:"" help:"Check tracker reachability, pixel serving, admin key and schema version"`
+	Migrate   GmailTrackMigrateCmd   `cmd:"" help:"Upgrade the deployed tracker and schema to the version bundled with gog"`
 }
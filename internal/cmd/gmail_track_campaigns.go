@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/tracking"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailTrackCampaignsCmd shows performance of sends tagged with --campaign.
+type GmailTrackCampaignsCmd struct {
+	List   GmailTrackCampaignsListCmd   `cmd:"" default:"withargs" help:"List campaigns with open/click rates"`
+	Status GmailTrackCampaignsStatusCmd `cmd:"" help:"Show aggregate and per-send performance of one campaign"`
+}
+
+type trackCampaignRow struct {
+	Campaign  string `json:"campaign"`
+	FirstSent string `json:"first_sent"`
+	LastSent  string `json:"last_sent"`
+	trackReportRow
+}
+
+type trackCampaignSend struct {
+	Recipient   string `json:"recipient"`
+	MessageID   string `json:"message_id"`
+	TrackingID  string `json:"tracking_id"`
+	SentAt      string `json:"sent_at"`
+	SubjectHash string `json:"subject_hash"`
+	Opens       int    `json:"opens"`
+	HumanOpens  int    `json:"human_opens"`
+	Clicks      int    `json:"clicks"`
+	HumanClicks int    `json:"human_clicks"`
+}
+
+var errTrackerNotFound = errors.New("not found")
+
+type GmailTrackCampaignsListCmd struct {
+	Since      string `name:"since" help:"Only sends newer than this (e.g. 90d, 24h, 2024-01-01)" default:"90d"`
+	FilterBots bool   `name:"filter-bots" help:"Count only opens/clicks not flagged as bots, prefetchers or privacy proxies"`
+}
+
+func (c *GmailTrackCampaignsListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	cfg, err := loadTrackingAdminConfig(flags)
+	if err != nil {
+		return err
+	}
+	since, err := parseTrackingSince(c.Since)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Campaigns []trackCampaignRow `json:"campaigns"`
+	}
+	if err := getTrackerJSON(ctx, cfg, "/campaigns", url.Values{"since": {since}}, &result); err != nil {
+		return err
+	}
+	rows := result.Campaigns
+	for i := range rows {
+		rows[i].computeRates()
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"since":      since,
+			"filterBots": c.FilterBots,
+			"campaigns":  rows,
+		})
+	}
+	if len(rows) == 0 {
+		u.Err().Println("No campaigns (tag tracked sends with --campaign)")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "CAMPAIGN\tSENT\tOPENED\tOPEN_RATE\tCLICKED\tCLICK_RATE\tFIRST_SENT\tLAST_SENT")
+	for _, r := range rows {
+		opened, openRate, clicked, clickRate := r.counts(c.FilterBots)
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%d\t%s\t%s\t%s\n", sanitizeTab(r.Campaign), r.Sent, opened, formatTrackingRate(openRate), clicked, formatTrackingRate(clickRate), formatDateTime(r.FirstSent), formatDateTime(r.LastSent))
+	}
+	return nil
+}
+
+type GmailTrackCampaignsStatusCmd struct {
+	Name       string `arg:"" name:"name" help:"Campaign name (as passed to --campaign)"`
+	FilterBots bool   `name:"filter-bots" help:"Count only opens/clicks not flagged as bots, prefetchers or privacy proxies"`
+}
+
+func (c *GmailTrackCampaignsStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	cfg, err := loadTrackingAdminConfig(flags)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		return usage("empty campaign name")
+	}
+
+	var result struct {
+		trackCampaignRow
+		Sends []trackCampaignSend `json:"sends"`
+	}
+	err = getTrackerJSON(ctx, cfg, "/campaigns/"+url.PathEscape(name), nil, &result)
+	if errors.Is(err, errTrackerNotFound) {
+		return fmt.Errorf("campaign %q not found; list campaigns with 'gog gmail track campaigns list'", name)
+	}
+	if err != nil {
+		return err
+	}
+	summary := result.trackCampaignRow
+	summary.computeRates()
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"campaign":   summary,
+			"filterBots": c.FilterBots,
+			"sends":      result.Sends,
+		})
+	}
+
+	opened, openRate, clicked, clickRate := summary.counts(c.FilterBots)
+	u.Out().Printf("campaign\t%s", summary.Campaign)
+	u.Out().Printf("sent\t%d", summary.Sent)
+	u.Out().Printf("opened\t%d (%s)", opened, formatTrackingRate(openRate))
+	u.Out().Printf("clicked\t%d (%s)", clicked, formatTrackingRate(clickRate))
+	if c.FilterBots {
+		u.Out().Printf("opened_raw\t%d", summary.Opened)
+		u.Out().Printf("clicked_raw\t%d", summary.Clicked)
+	}
+	u.Out().Printf("first_sent\t%s", summary.FirstSent)
+	u.Out().Printf("last_sent\t%s", summary.LastSent)
+	u.Out().Println("")
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	if c.FilterBots {
+		fmt.Fprintln(w, "RECIPIENT\tMESSAGE_ID\tSENT_AT\tSUBJECT_HASH\tOPENS\tCLICKS\tOPENS_RAW\tCLICKS_RAW")
+	} else {
+		fmt.Fprintln(w, "RECIPIENT\tMESSAGE_ID\tSENT_AT\tSUBJECT_HASH\tOPENS\tHUMAN_OPENS\tCLICKS")
+	}
+	for _, s := range result.Sends {
+		prefix := fmt.Sprintf("%s\t%s\t%s\t%s", sanitizeTab(s.Recipient), orDash(s.MessageID), formatDateTime(s.SentAt), orDash(s.SubjectHash))
+		if c.FilterBots {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", prefix, s.HumanOpens, s.HumanClicks, s.Opens, s.Clicks)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", prefix, s.Opens, s.HumanOpens, s.Clicks)
+	}
+	return nil
+}
+
+// loadTrackingAdminConfig loads the tracking config and requires the admin
+// key needed for the aggregate endpoints.
+func loadTrackingAdminConfig(flags *RootFlags) (*tracking.Config, error) {
+	_, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.IsConfigured() {
+		return nil, fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+	if strings.TrimSpace(cfg.AdminKey) == "" {
+		return nil, fmt.Errorf("tracking admin key not configured; run 'gog gmail track setup' again")
+	}
+	return cfg, nil
+}
+
+// getTrackerJSON GETs an admin endpoint and decodes the JSON response. A 404
+// is reported as errTrackerNotFound.
+func getTrackerJSON(ctx context.Context, cfg *tracking.Config, path string, query url.Values, out any) error {
+	reqURL, err := url.Parse(cfg.WorkerURL + path)
+	if err != nil {
+		return fmt.Errorf("parse worker url: %w", err)
+	}
+	reqURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.AdminKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("query tracker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return fmt.Errorf("unauthorized: admin key may be incorrect")
+	case http.StatusNotFound:
+		return errTrackerNotFound
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tracker returned %d: %s", resp.StatusCode, body)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
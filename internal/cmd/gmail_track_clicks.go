@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/tracking"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailTrackClicksCmd reports link clicks for a message sent with --track-links.
+type GmailTrackClicksCmd struct {
+	MessageID string `arg:"" name:"messageId" help:"Gmail message ID of the tracked send"`
+}
+
+type trackClicksResult struct {
+	TrackingID  string            `json:"tracking_id"`
+	Recipient   string            `json:"recipient"`
+	SentAt      string            `json:"sent_at"`
+	Clicks      []trackClickEvent `json:"clicks"`
+	TotalClicks int               `json:"total_clicks"`
+	HumanClicks int               `json:"human_clicks"`
+}
+
+type trackClickEvent struct {
+	URL      string `json:"url"`
+	At       string `json:"at"`
+	IsBot    bool   `json:"is_bot"`
+	BotType  string `json:"bot_type,omitempty"`
+	Location *struct {
+		City    string `json:"city"`
+		Region  string `json:"region"`
+		Country string `json:"country"`
+	} `json:"location"`
+}
+
+func (c *GmailTrackClicksCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+
+	messageID := strings.TrimSpace(c.MessageID)
+	if messageID == "" {
+		return usage("empty messageId")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	msg, err := svc.Users.Messages.Get("me", messageID).Format("full").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	trackingID := tracking.FindTrackingID(cfg, findPartBody(msg.Payload, "text/html"))
+	if trackingID == "" {
+		return fmt.Errorf("message %s has no tracking pixel (was it sent with --track?)", messageID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/c/%s", cfg.WorkerURL, trackingID), nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("query tracker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tracker returned %d: %s", resp.StatusCode, body)
+	}
+
+	var result trackClicksResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"messageId":    messageID,
+			"tracking_id":  result.TrackingID,
+			"recipient":    result.Recipient,
+			"sent_at":      result.SentAt,
+			"clicks":       result.Clicks,
+			"total_clicks": result.TotalClicks,
+			"human_clicks": result.HumanClicks,
+		})
+	}
+
+	u.Out().Printf("message_id\t%s", messageID)
+	u.Out().Printf("recipient\t%s", result.Recipient)
+	u.Out().Printf("sent_at\t%s", result.SentAt)
+	u.Out().Printf("clicks_total\t%d", result.TotalClicks)
+	u.Out().Printf("clicks_human\t%d", result.HumanClicks)
+	if len(result.Clicks) == 0 {
+		return nil
+	}
+
+	u.Out().Println("")
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "CLICKED_AT\tURL\tBOT\tLOCATION")
+	for _, click := range result.Clicks {
+		loc := trackingUnknown
+		if click.Location != nil && click.Location.City != "" {
+			loc = fmt.Sprintf("%s, %s", click.Location.City, click.Location.Region)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\n", click.At, sanitizeTab(click.URL), click.IsBot, loc)
+	}
+	return nil
+}
@@ -1,12 +1,17 @@
 package cmd
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/steipete/gogcli/internal/tracking"
 )
@@ -231,6 +236,9 @@ func TestGmailTrackOpens_JSON(t *testing.T) {
 	if parsed, err := parseTrackingSince("24h"); err != nil || parsed == "" {
 		t.Fatalf("unexpected parseTrackingSince duration result: %q err=%v", parsed, err)
 	}
+	if parsed, err := parseTrackingSince("30d"); err != nil || parsed == "" {
+		t.Fatalf("unexpected parseTrackingSince days result: %q err=%v", parsed, err)
+	}
 	if parsed, err := parseTrackingSince("2025-01-01"); err != nil || parsed == "" {
 		t.Fatalf("unexpected parseTrackingSince date result: %q err=%v", parsed, err)
 	}
@@ -285,3 +293,618 @@ func TestGmailTrackOpens_NotConfigured(t *testing.T) {
 		t.Fatalf("expected error for unconfigured tracking")
 	}
 }
+
+func TestGmailTrackWebhookSetAndTest(t *testing.T) {
+	setupTrackingEnv(t)
+
+	var gotSig, gotTS string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get(tracking.WebhookSignatureHeader)
+		gotTS = r.Header.Get(tracking.WebhookTimestampHeader)
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "--no-input", "gmail", "track", "setup", "--worker-url", "https://example.com"}); err != nil {
+				t.Fatalf("setup: %v", err)
+			}
+		})
+	})
+
+	var errOut string
+	out := captureStdout(t, func() {
+		errOut = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "webhook", "set", "--url", srv.URL, "--format", "slack", "--secret", "s3cret"}); err != nil {
+				t.Fatalf("webhook set: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "webhook_format\tslack") {
+		t.Fatalf("unexpected set output: %q", out)
+	}
+	if strings.Contains(out+errOut, "s3cret") {
+		t.Fatalf("secret printed without --show-secret: %q %q", out, errOut)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "webhook", "set", "--url", srv.URL, "--format", "slack", "--show-secret"}); err != nil {
+				t.Fatalf("webhook set: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "webhook_secret\ts3cret") {
+		t.Fatalf("expected secret with --show-secret: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "gmail", "track", "webhook", "set", "--url", srv.URL, "--format", "slack"}); err != nil {
+				t.Fatalf("webhook set: %v", err)
+			}
+		})
+	})
+	var setPayload map[string]any
+	if err := json.Unmarshal([]byte(out), &setPayload); err != nil || setPayload["webhook_secret"] != "s3cret" {
+		t.Fatalf("unexpected json output: %q (%v)", out, err)
+	}
+
+	cfg, err := tracking.LoadConfig("a@b.com")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.WebhookURL != srv.URL || cfg.WebhookSecret != "s3cret" {
+		t.Fatalf("unexpected config: %#v", cfg)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "webhook", "test"}); err != nil {
+				t.Fatalf("webhook test: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "status\t200") {
+		t.Fatalf("unexpected test output: %q", out)
+	}
+	ts, _ := strconv.ParseInt(gotTS, 10, 64)
+	if gotSig != "sha256="+tracking.SignWebhookPayload("s3cret", ts, gotBody) {
+		t.Fatalf("signature mismatch: %q", gotSig)
+	}
+	if !strings.Contains(string(gotBody), `"text":"[test] Email opened by`) {
+		t.Fatalf("unexpected body: %s", gotBody)
+	}
+}
+
+func TestGmailTrackClicks(t *testing.T) {
+	setupTrackingEnv(t)
+
+	var gotPath string
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"tracking_id":  "tid",
+			"recipient":    "ada@example.com",
+			"sent_at":      "2025-01-01T00:00:00Z",
+			"total_clicks": 1,
+			"human_clicks": 1,
+			"clicks": []map[string]any{{
+				"url":      "https://example.com/pricing",
+				"at":       "2025-01-01T01:00:00Z",
+				"is_bot":   false,
+				"location": map[string]any{"city": "London", "region": "England", "country": "GB"},
+			}},
+		})
+	}))
+	defer worker.Close()
+
+	key, err := tracking.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	cfg := &tracking.Config{Enabled: true, WorkerURL: worker.URL, TrackingKey: key, AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+	pixelURL, blob, err := tracking.GeneratePixelURL(cfg, "ada@example.com", "Hi")
+	if err != nil {
+		t.Fatalf("GeneratePixelURL: %v", err)
+	}
+	html := "<p>Hi</p>" + tracking.GeneratePixelHTML(pixelURL)
+
+	gmailSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/messages/m1") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id": "m1",
+			"payload": map[string]any{
+				"mimeType": "multipart/alternative",
+				"parts": []map[string]any{
+					{"mimeType": "text/plain", "body": map[string]any{"data": base64.RawURLEncoding.EncodeToString([]byte("Hi"))}},
+					{"mimeType": "text/html", "body": map[string]any{"data": base64.RawURLEncoding.EncodeToString([]byte(html))}},
+				},
+			},
+		})
+	}))
+	defer gmailSrv.Close()
+	stubGmailService(t, gmailSrv)
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "clicks", "m1"}); err != nil {
+				t.Fatalf("clicks: %v", err)
+			}
+		})
+	})
+	if gotPath != "/c/"+blob {
+		t.Fatalf("unexpected worker path: %q", gotPath)
+	}
+	if !strings.Contains(out, "clicks_total\t1") || !strings.Contains(out, "https://example.com/pricing") || !strings.Contains(out, "London, England") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestGmailTrackReport(t *testing.T) {
+	setupTrackingEnv(t)
+
+	var gotQuery url.Values
+	var gotAuth string
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/report" {
+			http.NotFound(w, r)
+			return
+		}
+		gotQuery = r.URL.Query()
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"rows": []map[string]any{
+				{"key": "launch", "sent": 4, "opened": 3, "clicked": 1, "opened_human": 2, "clicked_human": 1},
+				{"key": "(none)", "sent": 1, "opened": 0, "clicked": 0, "opened_human": 0, "clicked_human": 0},
+			},
+		})
+	}))
+	defer worker.Close()
+
+	cfg := &tracking.Config{Enabled: true, WorkerURL: worker.URL, TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "report", "--group-by", "campaign"}); err != nil {
+				t.Fatalf("report: %v", err)
+			}
+		})
+	})
+	if gotAuth != "Bearer admin" || gotQuery.Get("group_by") != "campaign" || gotQuery.Get("since") == "" {
+		t.Fatalf("unexpected request: auth=%q query=%v", gotAuth, gotQuery)
+	}
+	if !strings.Contains(out, "CAMPAIGN") || !strings.Contains(out, "75.0%") || !strings.Contains(out, "TOTAL") {
+		t.Fatalf("unexpected table output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "report", "--csv"}); err != nil {
+				t.Fatalf("report csv: %v", err)
+			}
+		})
+	})
+	if !strings.HasPrefix(out, "recipient,sent,opened,open_rate,clicked,click_rate\n") || !strings.Contains(out, "launch,4,3,0.7500,1,0.2500") {
+		t.Fatalf("unexpected csv output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "--json", "gmail", "track", "report"}); err != nil {
+				t.Fatalf("report json: %v", err)
+			}
+		})
+	})
+	var parsed struct {
+		Sent          int     `json:"sent"`
+		OpenRate      float64 `json:"open_rate"`
+		HumanOpenRate float64 `json:"human_open_rate"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil || parsed.Sent != 5 || parsed.OpenRate != 0.6 || parsed.HumanOpenRate != 0.4 {
+		t.Fatalf("unexpected json output: %q err=%v", out, err)
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "report", "--csv", "--filter-bots"}); err != nil {
+				t.Fatalf("report filtered: %v", err)
+			}
+		})
+	})
+	if !strings.HasPrefix(out, "recipient,sent,opened,open_rate,clicked,click_rate,opened_raw,clicked_raw\n") || !strings.Contains(out, "launch,4,2,0.5000,1,0.2500,3,1") {
+		t.Fatalf("unexpected filtered output: %q", out)
+	}
+}
+
+func TestGmailTrackSetup_HTTPProvider(t *testing.T) {
+	setupTrackingEnv(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "--no-input", "gmail", "track", "setup", "--provider", "http", "--worker-url", srv.URL, "--deploy"}); err != nil {
+				t.Fatalf("setup: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "provider\thttp") || strings.Contains(out, "database_name") {
+		t.Fatalf("unexpected setup output: %q", out)
+	}
+
+	cfg, err := tracking.LoadConfig("a@b.com")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.Provider != tracking.ProviderHTTP || !cfg.IsConfigured() {
+		t.Fatalf("unexpected config: %#v", cfg)
+	}
+
+	err = Execute([]string{"--account", "a@b.com", "gmail", "track", "webhook", "set", "--url", "https://hooks.example.com", "--deploy"})
+	if err == nil || !strings.Contains(err.Error(), "cloudflare") {
+		t.Fatalf("expected cloudflare-only deploy error, got %v", err)
+	}
+}
+
+func TestGmailTrackExport(t *testing.T) {
+	setupTrackingEnv(t)
+
+	var gotQuery url.Values
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/events" || r.Header.Get("Authorization") != "Bearer admin" {
+			http.NotFound(w, r)
+			return
+		}
+		gotQuery = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"events": []map[string]any{
+				{"type": "open", "at": "2025-01-01T01:00:00Z", "tracking_id": "tid", "message_id": "m1", "campaign": "launch", "recipient": "ada@example.com", "subject_hash": "abc123", "url": nil, "ip": "1.2.3.4", "user_agent": "Mail", "city": "London", "country": "GB", "is_bot": false},
+				{"type": "click", "at": "2025-01-01T02:00:00Z", "tracking_id": "tid", "message_id": "m1", "recipient": "ada@example.com", "subject_hash": "abc123", "url": "https://example.com/x", "is_bot": true, "bot_type": "security_scanner"},
+			},
+		})
+	}))
+	defer worker.Close()
+
+	cfg := &tracking.Config{Enabled: true, WorkerURL: worker.URL, TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "export", "--since", "7d"}); err != nil {
+			t.Fatalf("export: %v", err)
+		}
+	})
+	if gotQuery.Get("since") == "" || gotQuery.Get("offset") != "0" {
+		t.Fatalf("unexpected query: %v", gotQuery)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"message_id":"m1"`) || !strings.Contains(lines[1], `"url":"https://example.com/x"`) {
+		t.Fatalf("unexpected jsonl output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "export", "--format", "csv"}); err != nil {
+			t.Fatalf("export csv: %v", err)
+		}
+	})
+	if gotQuery.Has("since") {
+		t.Fatalf("expected no since filter, got %v", gotQuery)
+	}
+	if !strings.HasPrefix(out, "type,at,message_id,") || !strings.Contains(out, "open,2025-01-01T01:00:00Z,m1,tid,launch,ada@example.com,abc123,,1.2.3.4,Mail,GB,,London,false,") {
+		t.Fatalf("unexpected csv output: %q", out)
+	}
+}
+
+func TestGmailTrackStatus_MessageGroup(t *testing.T) {
+	setupTrackingEnv(t)
+
+	var gotPath string
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		if r.Header.Get("Authorization") != "Bearer admin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"group_id": "g1",
+			"recipients": []map[string]any{
+				{"recipient": "ada@example.com", "message_id": "m1", "opens": 2, "human_opens": 1, "first_open": "2025-01-01T00:00:01Z", "first_human_open": "2025-01-01T01:00:00Z", "clicks": 1, "human_clicks": 0},
+				{"recipient": "bob@example.com", "message_id": "m2", "opens": 0, "human_opens": 0, "first_open": nil, "first_human_open": nil, "clicks": 0, "human_clicks": 0},
+			},
+		})
+	}))
+	defer worker.Close()
+
+	cfg := &tracking.Config{Enabled: true, WorkerURL: worker.URL, TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+	pixelURL, blob, err := tracking.GeneratePixelURL(cfg, "bob@example.com", "Hi")
+	if err != nil {
+		t.Fatalf("GeneratePixelURL: %v", err)
+	}
+	html := "<p>Hi</p>" + tracking.GeneratePixelHTML(pixelURL)
+
+	gmailSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":      "m2",
+			"payload": map[string]any{"mimeType": "text/html", "body": map[string]any{"data": base64.RawURLEncoding.EncodeToString([]byte(html))}},
+		})
+	}))
+	defer gmailSrv.Close()
+	stubGmailService(t, gmailSrv)
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "status", "m2"}); err != nil {
+			t.Fatalf("status: %v", err)
+		}
+	})
+	if gotPath != "/group/"+blob {
+		t.Fatalf("unexpected worker path: %q", gotPath)
+	}
+	if !strings.Contains(out, "RECIPIENT") || !strings.Contains(out, "ada@example.com") || !strings.Contains(out, "bob@example.com") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[1], "2025-01-01T00:00:01Z") || !strings.Contains(lines[2], "-") {
+		t.Fatalf("unexpected rows: %q", lines)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "status", "m2", "--filter-bots"}); err != nil {
+			t.Fatalf("status filtered: %v", err)
+		}
+	})
+	lines = strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[0], "OPENS_RAW") || !strings.Contains(lines[1], "2025-01-01T01:00:00Z") {
+		t.Fatalf("unexpected filtered rows: %q", lines)
+	}
+	if fields := strings.Fields(lines[1]); len(fields) != 7 || fields[2] != "1" || fields[4] != "0" || fields[5] != "2" || fields[6] != "1" {
+		t.Fatalf("unexpected filtered row: %q", lines[1])
+	}
+}
+
+func TestGmailTrackPurge(t *testing.T) {
+	setupTrackingEnv(t)
+
+	var gotMethod, gotBefore string
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/purge" || r.Header.Get("Authorization") != "Bearer admin" {
+			http.NotFound(w, r)
+			return
+		}
+		gotMethod = r.Method
+		gotBefore = r.URL.Query().Get("before")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"before": gotBefore, "opens": 12, "clicks": 3, "sends": 5})
+	}))
+	defer worker.Close()
+
+	cfg := &tracking.Config{Enabled: true, WorkerURL: worker.URL, TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	if err := Execute([]string{"--account", "a@b.com", "--no-input", "gmail", "track", "purge", "--older-than", "180d"}); err == nil {
+		t.Fatalf("expected refusal without --force")
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "--force", "gmail", "track", "purge", "--older-than", "180d"}); err != nil {
+			t.Fatalf("purge: %v", err)
+		}
+	})
+	before, err := time.Parse(time.RFC3339, gotBefore)
+	if gotMethod != http.MethodPost || err != nil || time.Since(before) < 179*24*time.Hour {
+		t.Fatalf("unexpected request: method=%q before=%q", gotMethod, gotBefore)
+	}
+	if !strings.Contains(out, "opens\t12") || !strings.Contains(out, "sends\t5") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestGmailTrackRetention(t *testing.T) {
+	setupTrackingEnv(t)
+
+	cfg := &tracking.Config{Enabled: true, WorkerURL: "https://tracker.example.com", TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	var stderr string
+	out := captureStdout(t, func() {
+		stderr = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "retention", "180d"}); err != nil {
+				t.Fatalf("retention: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "retention_days\t180") || !strings.Contains(stderr, "wrangler secret put RETENTION_DAYS") {
+		t.Fatalf("unexpected output: out=%q err=%q", out, stderr)
+	}
+
+	saved, err := tracking.LoadConfig("a@b.com")
+	if err != nil || saved.RetentionDays != 180 {
+		t.Fatalf("retention not saved: %#v err=%v", saved, err)
+	}
+
+	if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "retention", "soon"}); err == nil {
+		t.Fatalf("expected error for invalid retention")
+	}
+}
+
+func TestGmailTrackCampaigns(t *testing.T) {
+	setupTrackingEnv(t)
+
+	var gotPaths []string
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer admin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		gotPaths = append(gotPaths, r.URL.EscapedPath())
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/campaigns":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"campaigns": []map[string]any{
+					{"campaign": "q3 launch", "sent": 4, "opened": 2, "clicked": 1, "opened_human": 1, "clicked_human": 1, "first_sent": "2025-01-01T10:00:00Z", "last_sent": "2025-01-03T10:00:00Z"},
+				},
+			})
+		case "/campaigns/q3 launch":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"campaign": "q3 launch", "sent": 2, "opened": 2, "clicked": 1, "opened_human": 1, "clicked_human": 0,
+				"first_sent": "2025-01-01T10:00:00Z", "last_sent": "2025-01-03T10:00:00Z",
+				"sends": []map[string]any{
+					{"recipient": "ada@example.com", "message_id": "m1", "sent_at": "2025-01-03T10:00:00Z", "subject_hash": "abc123", "opens": 3, "human_opens": 1, "clicks": 1, "human_clicks": 0},
+					{"recipient": "bob@example.com", "message_id": "m2", "sent_at": "2025-01-01T10:00:00Z", "subject_hash": "abc123", "opens": 1, "human_opens": 0, "clicks": 0, "human_clicks": 0},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer worker.Close()
+
+	cfg := &tracking.Config{Enabled: true, WorkerURL: worker.URL, TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "campaigns", "list"}); err != nil {
+			t.Fatalf("campaigns list: %v", err)
+		}
+	})
+	if !strings.Contains(out, "q3 launch") || !strings.Contains(out, "50.0%") || !strings.Contains(out, "2025-01-03 10:00") {
+		t.Fatalf("unexpected list output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "campaigns", "status", "q3 launch", "--filter-bots"}); err != nil {
+			t.Fatalf("campaigns status: %v", err)
+		}
+	})
+	if gotPaths[1] != "/campaigns/q3%20launch" {
+		t.Fatalf("unexpected path: %q", gotPaths[1])
+	}
+	if !strings.Contains(out, "opened\t1 (50.0%)") || !strings.Contains(out, "opened_raw\t2") || !strings.Contains(out, "OPENS_RAW") || !strings.Contains(out, "ada@example.com") || !strings.Contains(out, "abc123") {
+		t.Fatalf("unexpected status output: %q", out)
+	}
+
+	if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "campaigns", "status", "missing"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestGmailTrackDoctor(t *testing.T) {
+	setupTrackingEnv(t)
+
+	schemaVersion := 1
+	worker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			_, _ = w.Write([]byte("ok"))
+		case "/p/doctor.gif":
+			w.Header().Set("Content-Type", "image/gif")
+			_, _ = w.Write([]byte("GIF89a"))
+		case "/version":
+			if r.Header.Get("Authorization") != "Bearer admin" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"schema_version": schemaVersion, "worker_schema_version": tracking.SchemaVersion})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer worker.Close()
+
+	cfg := &tracking.Config{Enabled: true, WorkerURL: worker.URL, TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "gmail", "track", "doctor"}); err != nil {
+			t.Fatalf("doctor: %v", err)
+		}
+	})
+	for _, check := range []string{"reachable", "pixel", "admin_key", "worker", "schema"} {
+		if !strings.Contains(out, check) {
+			t.Fatalf("missing check %q: %q", check, out)
+		}
+	}
+	if strings.Contains(out, "fail") {
+		t.Fatalf("unexpected failure: %q", out)
+	}
+
+	schemaVersion = 0
+	var err error
+	out = captureStdout(t, func() {
+		err = Execute([]string{"--account", "a@b.com", "gmail", "track", "doctor"})
+	})
+	if err == nil || !strings.Contains(out, "gog gmail track migrate") {
+		t.Fatalf("expected schema failure, err=%v out=%q", err, out)
+	}
+
+	cfg.AdminKey = "wrong"
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+	out = captureStdout(t, func() {
+		err = Execute([]string{"--account", "a@b.com", "gmail", "track", "doctor"})
+	})
+	if err == nil || !strings.Contains(out, "rejected by the tracker") {
+		t.Fatalf("expected admin key failure, err=%v out=%q", err, out)
+	}
+}
+
+func TestGmailTrackMigrate_HTTPProviderUnsupported(t *testing.T) {
+	setupTrackingEnv(t)
+
+	cfg := &tracking.Config{Enabled: true, Provider: tracking.ProviderHTTP, WorkerURL: "https://tracker.example.com", TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+	err := Execute([]string{"--account", "a@b.com", "gmail", "track", "migrate"})
+	if err == nil || !strings.Contains(err.Error(), "not supported for the http provider") {
+		t.Fatalf("expected unsupported error, got %v", err)
+	}
+}
+
+func TestGmailTrackWebhookSet_RejectsNonCloudflareProvider(t *testing.T) {
+	setupTrackingEnv(t)
+
+	cfg := &tracking.Config{Enabled: true, Provider: tracking.ProviderLambda, WorkerURL: "https://tracker.example.com", TrackingKey: mustTrackingKey(t), AdminKey: "admin"}
+	if err := tracking.SaveConfig("a@b.com", cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+	err := Execute([]string{"--account", "a@b.com", "gmail", "track", "webhook", "set", "--url", "https://example.com/hook"})
+	if err == nil || ExitCode(err) != 2 || !strings.Contains(err.Error(), "only supported for the cloudflare provider") {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/tracking"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailTrackDoctorCmd checks that the tracking backend is reachable, serves
+// pixels, accepts the admin key and runs the schema bundled with the CLI.
+type GmailTrackDoctorCmd struct{}
+
+type trackDoctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"` // ok, warn, fail
+	Detail string `json:"detail,omitempty"`
+}
+
+func (c *GmailTrackDoctorCmd) Run(ctx context.Context, flags *RootFlags) error {
+	_, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	checks := runTrackDoctorChecks(ctx, cfg)
+	failed := 0
+	for _, check := range checks {
+		if check.Status == "fail" {
+			failed++
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{"ok": failed == 0, "checks": checks}); err != nil {
+			return err
+		}
+	} else {
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "CHECK\tSTATUS\tDETAIL")
+		for _, check := range checks {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", check.Name, check.Status, sanitizeTab(check.Detail))
+		}
+		flush()
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d tracking check(s) failed", failed)
+	}
+	return nil
+}
+
+func runTrackDoctorChecks(ctx context.Context, cfg *tracking.Config) []trackDoctorCheck {
+	if !cfg.IsConfigured() {
+		return []trackDoctorCheck{{Name: "config", Status: "fail", Detail: "tracking not configured; run 'gog gmail track setup'"}}
+	}
+	provider := cfg.Provider
+	if provider == "" {
+		provider = tracking.ProviderCloudflare
+	}
+	checks := []trackDoctorCheck{{Name: "config", Status: "ok", Detail: fmt.Sprintf("%s %s", provider, cfg.WorkerURL)}}
+
+	if err := tracking.CheckHealth(ctx, cfg.WorkerURL); err != nil {
+		// Nothing else can pass when the tracker is unreachable.
+		return append(checks, trackDoctorCheck{Name: "reachable", Status: "fail", Detail: err.Error()})
+	}
+	checks = append(checks, trackDoctorCheck{Name: "reachable", Status: "ok", Detail: "/health"})
+
+	if err := tracking.CheckPixel(ctx, cfg.WorkerURL); err != nil {
+		checks = append(checks, trackDoctorCheck{Name: "pixel", Status: "fail", Detail: err.Error()})
+	} else {
+		checks = append(checks, trackDoctorCheck{Name: "pixel", Status: "ok", Detail: "serves image/gif"})
+	}
+
+	if strings.TrimSpace(cfg.AdminKey) == "" {
+		return append(checks, trackDoctorCheck{Name: "admin_key", Status: "fail", Detail: "not configured; run 'gog gmail track setup' again"})
+	}
+	info, err := tracking.FetchVersion(ctx, cfg.WorkerURL, cfg.AdminKey)
+	switch {
+	case errors.Is(err, tracking.ErrUnauthorized):
+		return append(checks, trackDoctorCheck{Name: "admin_key", Status: "fail", Detail: "rejected by the tracker"})
+	case errors.Is(err, tracking.ErrVersionUnsupported):
+		return append(checks,
+			trackDoctorCheck{Name: "admin_key", Status: "warn", Detail: "not verified (tracker has no /version)"},
+			trackDoctorCheck{Name: "schema", Status: "fail", Detail: "tracker predates schema versioning; run 'gog gmail track migrate'"},
+		)
+	case err != nil:
+		return append(checks, trackDoctorCheck{Name: "admin_key", Status: "fail", Detail: err.Error()})
+	}
+	checks = append(checks, trackDoctorCheck{Name: "admin_key", Status: "ok"})
+
+	return append(checks,
+		trackVersionCheck("worker", info.WorkerSchemaVersion),
+		trackVersionCheck("schema", info.SchemaVersion),
+	)
+}
+
+func trackVersionCheck(name string, version int) trackDoctorCheck {
+	detail := fmt.Sprintf("v%d (CLI bundles v%d)", version, tracking.SchemaVersion)
+	switch {
+	case version < tracking.SchemaVersion:
+		return trackDoctorCheck{Name: name, Status: "fail", Detail: detail + "; run 'gog gmail track migrate'"}
+	case version > tracking.SchemaVersion:
+		return trackDoctorCheck{Name: name, Status: "warn", Detail: detail + "; update gog"}
+	default:
+		return trackDoctorCheck{Name: name, Status: "ok", Detail: detail}
+	}
+}
+
+// GmailTrackMigrateCmd upgrades a deployed backend to the worker code and
+// schema bundled with the CLI.
+type GmailTrackMigrateCmd struct {
+	WorkerDir string `name:"worker-dir" help:"Backend template directory (default: internal/tracking/worker or internal/tracking/lambda)"`
+}
+
+func (c *GmailTrackMigrateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	_, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+	if strings.TrimSpace(cfg.AdminKey) == "" {
+		return fmt.Errorf("tracking admin key not configured; run 'gog gmail track setup' again")
+	}
+
+	backend, err := tracking.BackendFor(cfg.Provider)
+	if err != nil {
+		return err
+	}
+	if c.WorkerDir == "" {
+		c.WorkerDir = backend.DefaultDir()
+	}
+
+	var applied []string
+	switch backend.Name() {
+	case tracking.ProviderCloudflare:
+		if strings.TrimSpace(cfg.DatabaseID) == "" {
+			return fmt.Errorf("database id unknown; run 'gog gmail track setup --deploy' instead")
+		}
+		// New code first so /migrate exists, then columns, then schema.sql
+		// (its indexes may reference the added columns).
+		u.Err().Printf("migrate\tdeploying worker (%s)", cfg.WorkerName)
+		if err := tracking.UpgradeWorker(ctx, c.WorkerDir, cfg.WorkerName, cfg.DatabaseName, cfg.DatabaseID); err != nil {
+			return err
+		}
+		applied, err = tracking.MigrateColumns(ctx, cfg.WorkerURL, cfg.AdminKey)
+		if err != nil {
+			return fmt.Errorf("migrate columns: %w", err)
+		}
+		u.Err().Printf("migrate\tapplying schema.sql (%s)", cfg.DatabaseName)
+		if err := tracking.ApplyWorkerSchema(ctx, c.WorkerDir, cfg.DatabaseName); err != nil {
+			return err
+		}
+	case tracking.ProviderLambda:
+		if _, err := backend.Deploy(ctx, u.Err(), tracking.DeployOptions{
+			WorkerDir:     c.WorkerDir,
+			WorkerName:    cfg.WorkerName,
+			TrackingKey:   cfg.TrackingKey,
+			AdminKey:      cfg.AdminKey,
+			RetentionDays: cfg.RetentionDays,
+		}); err != nil {
+			return err
+		}
+	default:
+		return usagef("migrate is not supported for the %s provider; upgrade your server to schema v%d", backend.Name(), tracking.SchemaVersion)
+	}
+
+	info, err := tracking.FetchVersion(ctx, cfg.WorkerURL, cfg.AdminKey)
+	if err != nil {
+		return fmt.Errorf("verify migration: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"applied":               applied,
+			"schema_version":        info.SchemaVersion,
+			"worker_schema_version": info.WorkerSchemaVersion,
+		})
+	}
+	for _, column := range applied {
+		u.Out().Printf("added_column\t%s", column)
+	}
+	u.Out().Printf("schema_version\t%d", info.SchemaVersion)
+	u.Out().Printf("worker_schema_version\t%d", info.WorkerSchemaVersion)
+	return nil
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/tracking"
+)
+
+const trackExportPageSize = 1000
+
+// GmailTrackExportCmd dumps raw open/click events for archival or external
+// analytics.
+type GmailTrackExportCmd struct {
+	Format string `name:"format" help:"Output format: csv|jsonl" default:"jsonl" enum:"csv,jsonl"`
+	Since  string `name:"since" help:"Only events after this (e.g. 30d, 24h, 2024-01-01; default: all)"`
+}
+
+type trackEvent struct {
+	Type        string `json:"type"`
+	At          string `json:"at"`
+	TrackingID  string `json:"tracking_id,omitempty"`
+	MessageID   string `json:"message_id,omitempty"`
+	Campaign    string `json:"campaign,omitempty"`
+	Recipient   string `json:"recipient"`
+	SubjectHash string `json:"subject_hash"`
+	URL         string `json:"url,omitempty"`
+	IP          string `json:"ip,omitempty"`
+	UserAgent   string `json:"user_agent,omitempty"`
+	Country     string `json:"country,omitempty"`
+	Region      string `json:"region,omitempty"`
+	City        string `json:"city,omitempty"`
+	IsBot       bool   `json:"is_bot"`
+	BotType     string `json:"bot_type,omitempty"`
+}
+
+func (c *GmailTrackExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	_, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+	if strings.TrimSpace(cfg.AdminKey) == "" {
+		return fmt.Errorf("tracking admin key not configured; run 'gog gmail track setup' again")
+	}
+
+	since := ""
+	if strings.TrimSpace(c.Since) != "" {
+		since, err = parseTrackingSince(c.Since)
+		if err != nil {
+			return err
+		}
+	}
+
+	var csvWriter *csv.Writer
+	if c.Format == "csv" {
+		csvWriter = csv.NewWriter(os.Stdout)
+		_ = csvWriter.Write([]string{"type", "at", "message_id", "tracking_id", "campaign", "recipient", "subject_hash", "url", "ip", "user_agent", "country", "region", "city", "is_bot", "bot_type"})
+	}
+	enc := json.NewEncoder(os.Stdout)
+
+	for offset := 0; ; offset += trackExportPageSize {
+		events, err := fetchTrackEvents(ctx, cfg, since, offset)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if csvWriter != nil {
+				_ = csvWriter.Write([]string{
+					e.Type, e.At, e.MessageID, e.TrackingID, e.Campaign, e.Recipient, e.SubjectHash, e.URL,
+					e.IP, e.UserAgent, e.Country, e.Region, e.City, strconv.FormatBool(e.IsBot), e.BotType,
+				})
+				continue
+			}
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+		if len(events) < trackExportPageSize {
+			break
+		}
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		return csvWriter.Error()
+	}
+	return nil
+}
+
+func fetchTrackEvents(ctx context.Context, cfg *tracking.Config, since string, offset int) ([]trackEvent, error) {
+	reqURL, err := url.Parse(cfg.WorkerURL + "/events")
+	if err != nil {
+		return nil, fmt.Errorf("parse worker url: %w", err)
+	}
+	q := reqURL.Query()
+	if since != "" {
+		q.Set("since", since)
+	}
+	q.Set("limit", strconv.Itoa(trackExportPageSize))
+	q.Set("offset", strconv.Itoa(offset))
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.AdminKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("query tracker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("unauthorized: admin key may be incorrect")
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("tracker returned %d: %s", resp.StatusCode, body)
+	}
+
+	var result struct {
+		Events []trackEvent `json:"events"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return result.Events, nil
+}
@@ -1,7 +1,16 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/steipete/gogcli/internal/tracking"
 )
@@ -19,3 +28,48 @@ func loadTrackingConfigForAccount(flags *RootFlags) (string, *tracking.Config, e
 
 	return account, cfg, nil
 }
+
+type trackedSend struct {
+	TrackingID string `json:"tracking_id"`
+	MessageID  string `json:"message_id,omitempty"`
+	GroupID    string `json:"group_id,omitempty"`
+	Campaign   string `json:"campaign,omitempty"`
+}
+
+// registerTrackedSend records a tracked send with the worker so reports have a
+// denominator. Only possible when the admin key is available.
+func registerTrackedSend(ctx context.Context, cfg *tracking.Config, send trackedSend) error {
+	body, err := json.Marshal(send)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.WorkerURL+"/sends", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+cfg.AdminKey)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("register send: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tracker returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// newTrackingGroupID links the per-recipient copies of one tracked send.
+func newTrackingGroupID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate tracking group: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,7 +22,7 @@ const trackingUnknown = "unknown"
 type GmailTrackOpensCmd struct {
 	TrackingID string `arg:"" optional:"" help:"Tracking ID from send command"`
 	To         string `name:"to" help:"Filter by recipient email"`
-	Since      string `name:"since" help:"Filter by time (e.g., '24h', '2024-01-01')"`
+	Since      string `name:"since" help:"Filter by time (e.g., '24h', '7d', '2024-01-01')"`
 }
 
 func (c *GmailTrackOpensCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -199,6 +200,11 @@ func parseTrackingSince(s string) (string, error) {
 	if d, err := time.ParseDuration(s); err == nil {
 		return time.Now().Add(-d).UTC().Format(time.RFC3339), nil
 	}
+	if strings.HasSuffix(s, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil && days >= 0 {
+			return time.Now().AddDate(0, 0, -days).UTC().Format(time.RFC3339), nil
+		}
+	}
 
 	if t, err := time.Parse("2006-01-02", s); err == nil {
 		return t.UTC().Format(time.RFC3339), nil
@@ -211,5 +217,5 @@ func parseTrackingSince(s string) (string, error) {
 		return t.UTC().Format(time.RFC3339Nano), nil
 	}
 
-	return "", usagef("invalid --since %q (use duration like 24h, date YYYY-MM-DD, or RFC3339)", s)
+	return "", usagef("invalid --since %q (use duration like 24h or 30d, date YYYY-MM-DD, or RFC3339)", s)
 }
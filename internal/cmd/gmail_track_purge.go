@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/tracking"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailTrackPurgeCmd deletes opens, clicks and registered sends older than a
+// cutoff from the tracking backend.
+type GmailTrackPurgeCmd struct {
+	OlderThan string `name:"older-than" required:"" help:"Delete data older than this (e.g. 180d, 720h, 2024-01-01)"`
+}
+
+type trackPurgeResult struct {
+	Before string `json:"before"`
+	Opens  int    `json:"opens"`
+	Clicks int    `json:"clicks"`
+	Sends  int    `json:"sends"`
+}
+
+func (c *GmailTrackPurgeCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	_, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+	if strings.TrimSpace(cfg.AdminKey) == "" {
+		return fmt.Errorf("tracking admin key not configured; run 'gog gmail track setup' again")
+	}
+
+	before, err := parseTrackingSince(c.OlderThan)
+	if err != nil {
+		return err
+	}
+	if err := confirmDestructive(ctx, flags, fmt.Sprintf("delete tracking data from before %s", before)); err != nil {
+		return err
+	}
+
+	reqURL, err := url.Parse(cfg.WorkerURL + "/purge")
+	if err != nil {
+		return fmt.Errorf("parse worker url: %w", err)
+	}
+	q := reqURL.Query()
+	q.Set("before", before)
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.AdminKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("query tracker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("unauthorized: admin key may be incorrect")
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tracker returned %d: %s", resp.StatusCode, body)
+	}
+
+	var result trackPurgeResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, result)
+	}
+	u.Out().Printf("before\t%s", result.Before)
+	u.Out().Printf("opens\t%d", result.Opens)
+	u.Out().Printf("clicks\t%d", result.Clicks)
+	u.Out().Printf("sends\t%d", result.Sends)
+	return nil
+}
+
+// GmailTrackRetentionCmd shows or sets how long the backend keeps tracking
+// data; older data is purged daily by the backend itself.
+type GmailTrackRetentionCmd struct {
+	Days      string `arg:"" optional:"" name:"days" help:"Retention in days (e.g. 180 or 180d); 0 keeps data forever. Omit to show the current setting."`
+	Deploy    bool   `name:"deploy" help:"Push the setting to the worker (cloudflare only; requires wrangler)"`
+	WorkerDir string `name:"worker-dir" help:"Worker directory (default: internal/tracking/worker)"`
+}
+
+func (c *GmailTrackRetentionCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+
+	if strings.TrimSpace(c.Days) == "" {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, map[string]any{"retention_days": cfg.RetentionDays})
+		}
+		u.Out().Printf("retention_days\t%d", cfg.RetentionDays)
+		return nil
+	}
+
+	days, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(c.Days), "d"))
+	if err != nil || days < 0 {
+		return usagef("invalid retention %q (want days, e.g. 180)", c.Days)
+	}
+
+	provider := cfg.Provider
+	if provider == "" {
+		provider = tracking.ProviderCloudflare
+	}
+	if c.Deploy && provider != tracking.ProviderCloudflare {
+		return usagef("--deploy is only supported for the cloudflare provider (configured: %s)", provider)
+	}
+	if c.WorkerDir == "" {
+		c.WorkerDir = filepath.Join("internal", "tracking", "worker")
+	}
+
+	cfg.RetentionDays = days
+	if err := tracking.SaveConfig(account, cfg); err != nil {
+		return fmt.Errorf("save tracking config: %w", err)
+	}
+
+	if c.Deploy {
+		if days == 0 {
+			err = tracking.DeleteWorkerSecrets(ctx, c.WorkerDir, cfg.WorkerName, "RETENTION_DAYS")
+		} else {
+			err = tracking.PutWorkerSecrets(ctx, c.WorkerDir, cfg.WorkerName, map[string]string{"RETENTION_DAYS": strconv.Itoa(days)})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"retention_days": days, "deployed": c.Deploy})
+	}
+	u.Out().Printf("retention_days\t%d", days)
+
+	if !c.Deploy {
+		u.Err().Println("")
+		switch provider {
+		case tracking.ProviderCloudflare:
+			if days == 0 {
+				u.Err().Printf("Next steps: cd %s && wrangler secret delete RETENTION_DAYS --name %s", c.WorkerDir, cfg.WorkerName)
+			} else {
+				u.Err().Printf("Next steps: cd %s && wrangler secret put RETENTION_DAYS --name %s   (%d)", c.WorkerDir, cfg.WorkerName, days)
+			}
+		case tracking.ProviderLambda:
+			u.Err().Println("Next steps: re-run 'gog gmail track setup --provider lambda --deploy' to update the RetentionDays stack parameter")
+		default:
+			u.Err().Printf("Next steps: configure your %s backend with RETENTION_DAYS=%d", provider, days)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailTrackReportCmd aggregates open/click rates across tracked sends. Only
+// sends registered with the worker (tracked sends with the admin key
+// available) are counted. With --filter-bots, opens and clicks the worker
+// flagged as bots/proxies are left out of the headline numbers.
+type GmailTrackReportCmd struct {
+	Since      string `name:"since" help:"Only sends newer than this (e.g. 30d, 24h, 2024-01-01)" default:"30d"`
+	GroupBy    string `name:"group-by" help:"Group by: recipient|subject (subject hash)|campaign" default:"recipient" enum:"recipient,subject,campaign"`
+	CSV        bool   `name:"csv" help:"Write CSV instead of a table"`
+	FilterBots bool   `name:"filter-bots" help:"Count only opens/clicks not flagged as bots, prefetchers or privacy proxies"`
+}
+
+type trackReportRow struct {
+	Key            string  `json:"key,omitempty"`
+	Sent           int     `json:"sent"`
+	Opened         int     `json:"opened"`
+	Clicked        int     `json:"clicked"`
+	OpenedHuman    int     `json:"opened_human"`
+	ClickedHuman   int     `json:"clicked_human"`
+	OpenRate       float64 `json:"open_rate"`
+	ClickRate      float64 `json:"click_rate"`
+	HumanOpenRate  float64 `json:"human_open_rate"`
+	HumanClickRate float64 `json:"human_click_rate"`
+}
+
+func (r *trackReportRow) computeRates() {
+	r.OpenRate = trackingRate(r.Opened, r.Sent)
+	r.ClickRate = trackingRate(r.Clicked, r.Sent)
+	r.HumanOpenRate = trackingRate(r.OpenedHuman, r.Sent)
+	r.HumanClickRate = trackingRate(r.ClickedHuman, r.Sent)
+}
+
+// counts returns the headline opened/clicked numbers and rates.
+func (r trackReportRow) counts(filterBots bool) (opened int, openRate float64, clicked int, clickRate float64) {
+	if filterBots {
+		return r.OpenedHuman, r.HumanOpenRate, r.ClickedHuman, r.HumanClickRate
+	}
+	return r.Opened, r.OpenRate, r.Clicked, r.ClickRate
+}
+
+func (c *GmailTrackReportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	_, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+	if strings.TrimSpace(cfg.AdminKey) == "" {
+		return fmt.Errorf("tracking admin key not configured; run 'gog gmail track setup' again")
+	}
+
+	since, err := parseTrackingSince(c.Since)
+	if err != nil {
+		return err
+	}
+
+	reqURL, err := url.Parse(cfg.WorkerURL + "/report")
+	if err != nil {
+		return fmt.Errorf("parse worker url: %w", err)
+	}
+	q := reqURL.Query()
+	q.Set("since", since)
+	q.Set("group_by", c.GroupBy)
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.AdminKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("query tracker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("unauthorized: admin key may be incorrect")
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tracker returned %d: %s", resp.StatusCode, body)
+	}
+
+	var result struct {
+		Rows []trackReportRow `json:"rows"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	rows := result.Rows
+	total := trackReportRow{Key: "TOTAL"}
+	for i := range rows {
+		rows[i].computeRates()
+		total.Sent += rows[i].Sent
+		total.Opened += rows[i].Opened
+		total.Clicked += rows[i].Clicked
+		total.OpenedHuman += rows[i].OpenedHuman
+		total.ClickedHuman += rows[i].ClickedHuman
+	}
+	total.computeRates()
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"since":            since,
+			"groupBy":          c.GroupBy,
+			"filterBots":       c.FilterBots,
+			"groups":           rows,
+			"sent":             total.Sent,
+			"opened":           total.Opened,
+			"clicked":          total.Clicked,
+			"opened_human":     total.OpenedHuman,
+			"clicked_human":    total.ClickedHuman,
+			"open_rate":        total.OpenRate,
+			"click_rate":       total.ClickRate,
+			"human_open_rate":  total.HumanOpenRate,
+			"human_click_rate": total.HumanClickRate,
+		})
+	}
+
+	if c.CSV {
+		w := csv.NewWriter(os.Stdout)
+		header := []string{c.GroupBy, "sent", "opened", "open_rate", "clicked", "click_rate"}
+		if c.FilterBots {
+			header = append(header, "opened_raw", "clicked_raw")
+		}
+		_ = w.Write(header)
+		for _, r := range rows {
+			opened, openRate, clicked, clickRate := r.counts(c.FilterBots)
+			record := []string{
+				r.Key,
+				strconv.Itoa(r.Sent),
+				strconv.Itoa(opened),
+				strconv.FormatFloat(openRate, 'f', 4, 64),
+				strconv.Itoa(clicked),
+				strconv.FormatFloat(clickRate, 'f', 4, 64),
+			}
+			if c.FilterBots {
+				record = append(record, strconv.Itoa(r.Opened), strconv.Itoa(r.Clicked))
+			}
+			_ = w.Write(record)
+		}
+		w.Flush()
+		return w.Error()
+	}
+
+	if len(rows) == 0 {
+		u.Err().Println("No tracked sends")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	if c.FilterBots {
+		fmt.Fprintf(w, "%s\tSENT\tOPENED\tOPEN_RATE\tCLICKED\tCLICK_RATE\tOPENED_RAW\tCLICKED_RAW\n", strings.ToUpper(c.GroupBy))
+	} else {
+		fmt.Fprintf(w, "%s\tSENT\tOPENED\tOPEN_RATE\tCLICKED\tCLICK_RATE\n", strings.ToUpper(c.GroupBy))
+	}
+	for _, r := range append(rows, total) {
+		opened, openRate, clicked, clickRate := r.counts(c.FilterBots)
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%d\t%s", sanitizeTab(r.Key), r.Sent, opened, formatTrackingRate(openRate), clicked, formatTrackingRate(clickRate))
+		if c.FilterBots {
+			fmt.Fprintf(w, "\t%d\t%d", r.Opened, r.Clicked)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func trackingRate(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
+
+func formatTrackingRate(rate float64) string {
+	return strconv.FormatFloat(rate*100, 'f', 1, 64) + "%"
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/steipete/gogcli/internal/input"
@@ -17,13 +16,14 @@ import (
 )
 
 type GmailTrackSetupCmd struct {
+	Provider     string `name:"provider" help:"Tracking backend: cloudflare (Worker + D1, default), http (self-hosted endpoint) or lambda (AWS SAM template)"`
 	WorkerName   string `name:"worker-name" help:"Cloudflare Worker name (defaults to gog-email-tracker-<account>)"`
 	DatabaseName string `name:"db-name" help:"D1 database name (defaults to worker name)"`
-	WorkerURL    string `name:"worker-url" aliases:"domain" help:"Tracking worker base URL (e.g. https://gog-email-tracker.<acct>.workers.dev)"`
+	WorkerURL    string `name:"worker-url" aliases:"domain" help:"Tracking endpoint base URL (e.g. https://gog-email-tracker.<acct>.workers.dev)"`
 	TrackingKey  string `name:"tracking-key" help:"Tracking key (base64; generates one if omitted)"`
 	AdminKey     string `name:"admin-key" help:"Admin key for /opens (generates one if omitted)"`
-	Deploy       bool   `name:"deploy" help:"Provision D1 + deploy the worker (requires wrangler)"`
-	WorkerDir    string `name:"worker-dir" help:"Worker directory (default: internal/tracking/worker)"`
+	Deploy       bool   `name:"deploy" help:"Deploy the backend (cloudflare: wrangler; lambda: sam; http: health check only)"`
+	WorkerDir    string `name:"worker-dir" help:"Backend template directory (default: internal/tracking/worker or internal/tracking/lambda)"`
 }
 
 func (c *GmailTrackSetupCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -33,6 +33,15 @@ func (c *GmailTrackSetupCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
+	provider := strings.TrimSpace(c.Provider)
+	if provider == "" {
+		provider = cfg.Provider
+	}
+	backend, err := tracking.BackendFor(provider)
+	if err != nil {
+		return usage(err.Error())
+	}
+
 	workerName := strings.TrimSpace(c.WorkerName)
 	if workerName == "" {
 		workerName = strings.TrimSpace(cfg.WorkerName)
@@ -58,7 +67,9 @@ func (c *GmailTrackSetupCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if c.WorkerURL == "" {
 		c.WorkerURL = strings.TrimSpace(cfg.WorkerURL)
 	}
-	if c.WorkerURL == "" && !flags.NoInput {
+	// Lambda deploys learn their URL from the stack outputs.
+	urlFromDeploy := c.Deploy && backend.Name() == tracking.ProviderLambda
+	if c.WorkerURL == "" && !flags.NoInput && !urlFromDeploy {
 		line, readErr := input.PromptLine(ctx, "Tracking worker base URL (e.g. https://...workers.dev): ")
 		if readErr != nil {
 			if errors.Is(readErr, io.EOF) || errors.Is(readErr, os.ErrClosed) {
@@ -71,7 +82,7 @@ func (c *GmailTrackSetupCmd) Run(ctx context.Context, flags *RootFlags) error {
 		c.WorkerURL = strings.TrimSpace(line)
 	}
 	c.WorkerURL = strings.TrimSpace(c.WorkerURL)
-	if c.WorkerURL == "" {
+	if c.WorkerURL == "" && !urlFromDeploy {
 		return usage("required: --worker-url")
 	}
 
@@ -102,6 +113,7 @@ func (c *GmailTrackSetupCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	cfg.Enabled = true
+	cfg.Provider = backend.Name()
 	cfg.WorkerURL = c.WorkerURL
 	cfg.WorkerName = workerName
 	cfg.DatabaseName = c.DatabaseName
@@ -110,21 +122,29 @@ func (c *GmailTrackSetupCmd) Run(ctx context.Context, flags *RootFlags) error {
 	cfg.AdminKey = ""
 
 	if c.WorkerDir == "" {
-		c.WorkerDir = filepath.Join("internal", "tracking", "worker")
+		c.WorkerDir = backend.DefaultDir()
 	}
 
+	deployOpts := tracking.DeployOptions{
+		WorkerDir:     c.WorkerDir,
+		WorkerName:    workerName,
+		URL:           c.WorkerURL,
+		DatabaseName:  c.DatabaseName,
+		TrackingKey:   key,
+		AdminKey:      adminKey,
+		RetentionDays: cfg.RetentionDays,
+	}
 	if c.Deploy {
-		dbID, deployErr := tracking.DeployWorker(ctx, u.Err(), tracking.DeployOptions{
-			WorkerDir:    c.WorkerDir,
-			WorkerName:   workerName,
-			DatabaseName: c.DatabaseName,
-			TrackingKey:  key,
-			AdminKey:     adminKey,
-		})
+		result, deployErr := backend.Deploy(ctx, u.Err(), deployOpts)
 		if deployErr != nil {
 			return deployErr
 		}
-		cfg.DatabaseID = dbID
+		if result.URL != "" {
+			cfg.WorkerURL = result.URL
+		}
+		if result.DatabaseID != "" {
+			cfg.DatabaseID = result.DatabaseID
+		}
 	}
 
 	if err := tracking.SaveConfig(account, cfg); err != nil {
@@ -137,26 +157,26 @@ func (c *GmailTrackSetupCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if path != "" {
 		u.Out().Printf("config_path\t%s", path)
 	}
+	u.Out().Printf("provider\t%s", cfg.Provider)
 	u.Out().Printf("worker_url\t%s", cfg.WorkerURL)
 	u.Out().Printf("worker_name\t%s", cfg.WorkerName)
-	u.Out().Printf("database_name\t%s", cfg.DatabaseName)
+	if cfg.Provider == tracking.ProviderCloudflare {
+		u.Out().Printf("database_name\t%s", cfg.DatabaseName)
+	}
 	if cfg.DatabaseID != "" {
 		u.Out().Printf("database_id\t%s", cfg.DatabaseID)
 	}
 
 	if !c.Deploy {
 		u.Err().Println("")
-		u.Err().Println("Next steps (manual worker deploy):")
-		u.Err().Printf("  - cd %s", c.WorkerDir)
-		u.Err().Println("  - use these values when prompted:")
-		u.Err().Printf("    TRACKING_KEY=%s", key)
-		u.Err().Printf("    ADMIN_KEY=%s", adminKey)
-		u.Err().Printf("  - wrangler d1 create %s", c.DatabaseName)
-		u.Err().Println("  - wrangler d1 execute <db> --file schema.sql --remote")
-		u.Err().Printf("  - set wrangler.toml name=%s + database_id", cfg.WorkerName)
-		u.Err().Println("  - wrangler secret put TRACKING_KEY")
-		u.Err().Println("  - wrangler secret put ADMIN_KEY")
-		u.Err().Println("  - wrangler deploy")
+		u.Err().Printf("Next steps (manual %s deploy):", backend.Name())
+		for _, step := range backend.ManualSteps(deployOpts) {
+			if strings.HasPrefix(step, "  ") {
+				u.Err().Printf("  %s", step)
+				continue
+			}
+			u.Err().Printf("  - %s", step)
+		}
 	}
 
 	return nil
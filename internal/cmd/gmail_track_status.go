@@ -2,13 +2,35 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
 	"strings"
 
+	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/tracking"
 	"github.com/steipete/gogcli/internal/ui"
 )
 
-type GmailTrackStatusCmd struct{}
+type GmailTrackStatusCmd struct {
+	MessageID  string `arg:"" optional:"" name:"messageId" help:"Show per-recipient opens/clicks for this tracked send instead of the configuration"`
+	FilterBots bool   `name:"filter-bots" help:"With messageId: count only opens/clicks not flagged as bots, prefetchers or privacy proxies"`
+}
+
+type trackRecipientStatus struct {
+	Recipient      string `json:"recipient"`
+	MessageID      string `json:"message_id"`
+	TrackingID     string `json:"tracking_id"`
+	SentAt         string `json:"sent_at"`
+	Opens          int    `json:"opens"`
+	HumanOpens     int    `json:"human_opens"`
+	FirstOpen      string `json:"first_open"`
+	FirstHumanOpen string `json:"first_human_open"`
+	Clicks         int    `json:"clicks"`
+	HumanClicks    int    `json:"human_clicks"`
+}
 
 func (c *GmailTrackStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
@@ -17,6 +39,10 @@ func (c *GmailTrackStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
+	if strings.TrimSpace(c.MessageID) != "" {
+		return c.messageStatus(ctx, account, cfg, u)
+	}
+
 	path, _ := tracking.ConfigPath()
 	if path != "" {
 		u.Out().Printf("config_path\t%s", path)
@@ -29,6 +55,11 @@ func (c *GmailTrackStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	u.Out().Printf("configured\ttrue")
+	provider := cfg.Provider
+	if provider == "" {
+		provider = tracking.ProviderCloudflare
+	}
+	u.Out().Printf("provider\t%s", provider)
 	u.Out().Printf("worker_url\t%s", cfg.WorkerURL)
 	if strings.TrimSpace(cfg.WorkerName) != "" {
 		u.Out().Printf("worker_name\t%s", cfg.WorkerName)
@@ -40,6 +71,102 @@ func (c *GmailTrackStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 		u.Out().Printf("database_id\t%s", cfg.DatabaseID)
 	}
 	u.Out().Printf("admin_configured\t%t", strings.TrimSpace(cfg.AdminKey) != "")
+	if strings.TrimSpace(cfg.WebhookURL) != "" {
+		u.Out().Printf("webhook_url\t%s", cfg.WebhookURL)
+		u.Out().Printf("webhook_format\t%s", cfg.WebhookFormat)
+	}
+	if cfg.RetentionDays > 0 {
+		u.Out().Printf("retention_days\t%d", cfg.RetentionDays)
+	}
 
 	return nil
 }
+
+// messageStatus attributes opens to each recipient of a tracked send. Sends
+// to several recipients go out as per-recipient copies sharing a group, so
+// any one of the message IDs finds all of them.
+func (c *GmailTrackStatusCmd) messageStatus(ctx context.Context, account string, cfg *tracking.Config, u *ui.UI) error {
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+	if strings.TrimSpace(cfg.AdminKey) == "" {
+		return fmt.Errorf("tracking admin key not configured; run 'gog gmail track setup' again")
+	}
+
+	messageID := strings.TrimSpace(c.MessageID)
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	msg, err := svc.Users.Messages.Get("me", messageID).Format("full").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	trackingID := tracking.FindTrackingID(cfg, findPartBody(msg.Payload, "text/html"))
+	if trackingID == "" {
+		return fmt.Errorf("message %s has no tracking pixel (was it sent with --track?)", messageID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/group/%s", cfg.WorkerURL, trackingID), nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.AdminKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("query tracker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return fmt.Errorf("unauthorized: admin key may be incorrect")
+	case http.StatusNotFound:
+		return fmt.Errorf("send not registered with the tracker; use 'gog gmail track opens %s'", trackingID)
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tracker returned %d: %s", resp.StatusCode, body)
+	}
+
+	var result struct {
+		GroupID    string                 `json:"group_id"`
+		Recipients []trackRecipientStatus `json:"recipients"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"messageId":  messageID,
+			"filterBots": c.FilterBots,
+			"group_id":   result.GroupID,
+			"recipients": result.Recipients,
+		})
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	if c.FilterBots {
+		fmt.Fprintln(w, "RECIPIENT\tMESSAGE_ID\tOPENS\tFIRST_OPEN\tCLICKS\tOPENS_RAW\tCLICKS_RAW")
+	} else {
+		fmt.Fprintln(w, "RECIPIENT\tMESSAGE_ID\tOPENS\tHUMAN_OPENS\tFIRST_OPEN\tCLICKS")
+	}
+	for _, r := range result.Recipients {
+		if c.FilterBots {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%d\t%d\t%d\n", sanitizeTab(r.Recipient), r.MessageID, r.HumanOpens, orDash(r.FirstHumanOpen), r.HumanClicks, r.Opens, r.Clicks)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%d\n", sanitizeTab(r.Recipient), r.MessageID, r.Opens, r.HumanOpens, orDash(r.FirstOpen), r.Clicks)
+	}
+	return nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
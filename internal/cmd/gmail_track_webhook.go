@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/tracking"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// GmailTrackWebhookCmd configures where the worker forwards open events.
+type GmailTrackWebhookCmd struct {
+	Set  GmailTrackWebhookSetCmd  `cmd:"" help:"Configure the open-event webhook"`
+	Test GmailTrackWebhookTestCmd `cmd:"" help:"Send a signed sample event to the configured webhook"`
+}
+
+type GmailTrackWebhookSetCmd struct {
+	URL         string `name:"url" help:"Webhook URL (Slack incoming webhook or any HTTPS endpoint)"`
+	Format      string `name:"format" help:"Payload format: json|slack" default:"json" enum:"json,slack"`
+	Secret      string `name:"secret" help:"Signing secret (generates one if omitted)"`
+	IncludeBots bool   `name:"include-bots" help:"Also forward opens flagged as bots/prefetchers"`
+	Clear       bool   `name:"clear" help:"Remove the webhook"`
+	Deploy      bool   `name:"deploy" help:"Push the webhook settings to the worker (requires wrangler)"`
+	WorkerDir   string `name:"worker-dir" help:"Worker directory (default: internal/tracking/worker)"`
+	ShowSecret  bool   `name:"show-secret" help:"Print the signing secret (always included with --json)"`
+}
+
+func (c *GmailTrackWebhookSetCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if !cfg.IsConfigured() {
+		return fmt.Errorf("tracking not configured; run 'gog gmail track setup' first")
+	}
+	// Only the Cloudflare worker forwards opens to a webhook.
+	if cfg.Provider != "" && cfg.Provider != tracking.ProviderCloudflare {
+		return usagef("webhooks are only supported for the cloudflare provider (configured: %s)", cfg.Provider)
+	}
+	if c.WorkerDir == "" {
+		c.WorkerDir = filepath.Join("internal", "tracking", "worker")
+	}
+
+	if c.Clear {
+		cfg.WebhookURL = ""
+		cfg.WebhookFormat = ""
+		cfg.WebhookIncludeBots = false
+		cfg.WebhookSecret = ""
+		if cfg.SecretsInKeyring {
+			if err := tracking.SaveWebhookSecret(account, ""); err != nil {
+				return err
+			}
+		}
+		if err := tracking.SaveConfig(account, cfg); err != nil {
+			return fmt.Errorf("save tracking config: %w", err)
+		}
+		if c.Deploy {
+			if err := tracking.DeleteWorkerSecrets(ctx, c.WorkerDir, cfg.WorkerName, "WEBHOOK_URL"); err != nil {
+				return err
+			}
+		} else {
+			u.Err().Println("Remove it from the worker with: wrangler secret delete WEBHOOK_URL")
+		}
+		u.Out().Printf("webhook\tcleared")
+		return nil
+	}
+
+	webhookURL := strings.TrimSpace(c.URL)
+	if webhookURL == "" {
+		return usage("required: --url")
+	}
+	if !strings.HasPrefix(webhookURL, "https://") && !strings.HasPrefix(webhookURL, "http://") {
+		return usage("--url must be an http(s) URL")
+	}
+	format, err := tracking.NormalizeWebhookFormat(c.Format)
+	if err != nil {
+		return usage(err.Error())
+	}
+
+	secret := strings.TrimSpace(c.Secret)
+	if secret == "" {
+		secret = strings.TrimSpace(cfg.WebhookSecret)
+	}
+	if secret == "" {
+		secret, err = generateAdminKey()
+		if err != nil {
+			return fmt.Errorf("generate webhook secret: %w", err)
+		}
+	}
+
+	cfg.WebhookURL = webhookURL
+	cfg.WebhookFormat = format
+	cfg.WebhookIncludeBots = c.IncludeBots
+	cfg.WebhookSecret = secret
+	if cfg.SecretsInKeyring {
+		if err := tracking.SaveWebhookSecret(account, secret); err != nil {
+			return err
+		}
+	}
+	if err := tracking.SaveConfig(account, cfg); err != nil {
+		return fmt.Errorf("save tracking config: %w", err)
+	}
+
+	values := map[string]string{
+		"WEBHOOK_URL":          webhookURL,
+		"WEBHOOK_SECRET":       secret,
+		"WEBHOOK_FORMAT":       format,
+		"WEBHOOK_INCLUDE_BOTS": strconv.FormatBool(c.IncludeBots),
+	}
+	if c.Deploy {
+		if err := tracking.PutWorkerSecrets(ctx, c.WorkerDir, cfg.WorkerName, values); err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"webhook_url":          webhookURL,
+			"webhook_format":       format,
+			"webhook_include_bots": c.IncludeBots,
+			"webhook_secret":       secret,
+			"deployed":             c.Deploy,
+		})
+	}
+
+	u.Out().Printf("webhook_url\t%s", webhookURL)
+	u.Out().Printf("webhook_format\t%s", format)
+	u.Out().Printf("webhook_include_bots\t%t", c.IncludeBots)
+	if c.ShowSecret {
+		u.Out().Printf("webhook_secret\t%s", secret)
+	} else {
+		// Keep the secret out of terminal scrollback and logs unless asked for.
+		values["WEBHOOK_SECRET"] = "<secret; rerun with --show-secret>"
+	}
+
+	if !c.Deploy {
+		u.Err().Println("")
+		u.Err().Println("Next steps (manual worker update):")
+		u.Err().Printf("  - cd %s", c.WorkerDir)
+		for _, name := range []string{"WEBHOOK_URL", "WEBHOOK_SECRET", "WEBHOOK_FORMAT", "WEBHOOK_INCLUDE_BOTS"} {
+			u.Err().Printf("  - wrangler secret put %s   (%s)", name, values[name])
+		}
+	}
+	return nil
+}
+
+type GmailTrackWebhookTestCmd struct {
+	Timeout time.Duration `name:"timeout" help:"Request timeout" default:"10s"`
+}
+
+func (c *GmailTrackWebhookTestCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	_, cfg, err := loadTrackingConfigForAccount(flags)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(cfg.WebhookURL) == "" {
+		return usage("no webhook configured; run 'gog gmail track webhook set --url ...'")
+	}
+
+	status, err := tracking.SendTestWebhook(ctx, &http.Client{Timeout: c.Timeout}, cfg)
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"url":    cfg.WebhookURL,
+			"status": status,
+			"signed": cfg.WebhookSecret != "",
+		})
+	}
+	u.Out().Printf("url\t%s", cfg.WebhookURL)
+	u.Out().Printf("status\t%d", status)
+	u.Out().Printf("signed\t%t", cfg.WebhookSecret != "")
+	return nil
+}
@@ -205,6 +205,7 @@ type GmailWatchServeCmd struct {
 	IncludeBody  bool   `name:"include-body" help:"Include text/plain body in hook payload"`
 	MaxBytes     int    `name:"max-bytes" help:"Max bytes of body to include" default:"20000"`
 	SaveHook     bool   `name:"save-hook" help:"Persist hook settings to watch state"`
+	JSONL        bool   `name:"jsonl" help:"Write each processed push as a JSON line to stdout"`
 }
 
 func (c *GmailWatchServeCmd) Run(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
@@ -323,6 +324,9 @@ func (c *GmailWatchServeCmd) Run(ctx context.Context, kctx *kong.Context, flags
 		logf:       u.Err().Printf,
 		warnf:      u.Err().Printf,
 	}
+	if c.JSONL {
+		server.jsonlOut = os.Stdout
+	}
 
 	addr := net.JoinHostPort(c.Bind, strconv.Itoa(c.Port))
 	u.Err().Printf("watch: listening on %s%s", addr, c.Path)
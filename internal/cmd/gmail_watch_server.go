@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/api/gmail/v1"
@@ -33,6 +34,10 @@ type gmailWatchServer struct {
 	hookClient *http.Client
 	logf       func(string, ...any)
 	warnf      func(string, ...any)
+
+	// jsonlOut, when set, receives one JSON line per processed push.
+	jsonlOut io.Writer
+	jsonlMu  sync.Mutex
 }
 
 func (s *gmailWatchServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -83,6 +88,12 @@ func (s *gmailWatchServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if s.jsonlOut != nil {
+		if err := s.writeJSONL(result); err != nil {
+			s.warnf("watch: jsonl write failed: %v", err)
+		}
+	}
+
 	if s.cfg.HookURL == "" {
 		if s.cfg.AllowNoHook {
 			_ = json.NewEncoder(w).Encode(result)
@@ -100,6 +111,18 @@ func (s *gmailWatchServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func (s *gmailWatchServer) writeJSONL(payload *gmailHookPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	s.jsonlMu.Lock()
+	defer s.jsonlMu.Unlock()
+	_, err = s.jsonlOut.Write(data)
+	return err
+}
+
 func (s *gmailWatchServer) authorize(r *http.Request) bool {
 	if s.cfg.VerifyOIDC {
 		bearer := bearerToken(r)
@@ -122,8 +145,14 @@ func (s *gmailWatchServer) authorize(r *http.Request) bool {
 }
 
 func (s *gmailWatchServer) oidcAudience(r *http.Request) string {
-	if s.cfg.OIDCAudience != "" {
-		return s.cfg.OIDCAudience
+	return pushOIDCAudience(r, s.cfg.OIDCAudience)
+}
+
+// pushOIDCAudience returns the configured audience, or the URL the push was
+// delivered to (honoring X-Forwarded-* from proxies).
+func pushOIDCAudience(r *http.Request, configured string) string {
+	if configured != "" {
+		return configured
 	}
 	scheme := "http"
 	if r.TLS != nil {
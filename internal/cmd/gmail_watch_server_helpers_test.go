@@ -194,3 +194,28 @@ func TestAuthorizeVariants(t *testing.T) {
 		t.Fatalf("expected oidc authorization failure without token")
 	}
 }
+
+func TestGmailWatchServer_WriteJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	s := &gmailWatchServer{jsonlOut: &buf}
+	payloads := []*gmailHookPayload{
+		{Source: "gmail", Account: "a@b.com", HistoryID: "1"},
+		{Source: "gmail", Account: "a@b.com", HistoryID: "2"},
+	}
+	for _, p := range payloads {
+		if err := s.writeJSONL(p); err != nil {
+			t.Fatalf("writeJSONL: %v", err)
+		}
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", buf.String())
+	}
+	var got gmailHookPayload
+	if err := json.Unmarshal([]byte(lines[1]), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.HistoryID != "2" {
+		t.Fatalf("unexpected payload: %#v", got)
+	}
+}
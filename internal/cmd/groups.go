@@ -26,6 +26,8 @@ const (
 type GroupsCmd struct {
 	List    GroupsListCmd    `cmd:"" name:"list" help:"List groups you belong to"`
 	Members GroupsMembersCmd `cmd:"" name:"members" help:"List members of a group"`
+	Add     GroupsAddCmd     `cmd:"" name:"add" help:"Add members to a group (owner/manager or admin)"`
+	Remove  GroupsRemoveCmd  `cmd:"" name:"remove" aliases:"rm" help:"Remove members from a group (owner/manager or admin)"`
 }
 
 type GroupsListCmd struct {
@@ -111,7 +113,7 @@ func wrapCloudIdentityError(err error, account string) error {
 	}
 	if strings.Contains(errStr, "insufficientPermissions") ||
 		strings.Contains(errStr, "insufficient authentication scopes") {
-		return errfmt.NewUserFacingError("Insufficient permissions for Cloud Identity API; re-authenticate with the cloud-identity.groups.readonly scope: gog auth add <account> --services groups", err)
+		return errfmt.NewUserFacingError("Insufficient permissions for Cloud Identity API; re-authenticate with the cloud-identity.groups scope (or cloud-identity.groups.readonly for listing only): gog auth add <account> --services groups", err)
 	}
 	if isConsumerAccount(account) && (strings.Contains(errStr, "invalid argument") || strings.Contains(errStr, "badRequest")) {
 		return errfmt.NewUserFacingError("Cloud Identity groups require a Google Workspace/Cloud Identity account; consumer accounts (gmail.com/googlemail.com) are not supported.", err)
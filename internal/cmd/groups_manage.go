@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"google.golang.org/api/cloudidentity/v1"
+	gapi "google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/googleapi"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var newCloudIdentityWriteService = googleapi.NewCloudIdentityGroupsWriter
+
+type GroupsAddCmd struct {
+	GroupEmail string   `arg:"" name:"groupEmail" help:"Group email (e.g., engineering@company.com)"`
+	Emails     []string `arg:"" name:"email" optional:"" help:"Member emails (users or groups)"`
+	Role       string   `name:"role" help:"member|manager|owner" default:"member"`
+	CSV        string   `name:"csv" help:"CSV of members: email[,role] per row (header optional; - for stdin)"`
+}
+
+type groupMemberChange struct {
+	Email  string `json:"email"`
+	Role   string `json:"role,omitempty"`
+	Status string `json:"status"` // added, exists, removed, missing, failed
+	Error  string `json:"error,omitempty"`
+}
+
+func (c *GroupsAddCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	groupEmail := strings.TrimSpace(c.GroupEmail)
+	if groupEmail == "" {
+		return usage("group email required")
+	}
+	role, err := parseGroupRole(c.Role)
+	if err != nil {
+		return err
+	}
+
+	changes := make([]groupMemberChange, 0, len(c.Emails))
+	for _, email := range parseCommaArgs(c.Emails) {
+		changes = append(changes, groupMemberChange{Email: email, Role: role})
+	}
+	if strings.TrimSpace(c.CSV) != "" {
+		data, readErr := readInputFile(strings.TrimSpace(c.CSV))
+		if readErr != nil {
+			return readErr
+		}
+		rows, parseErr := parseGroupMembersCSV(data, role)
+		if parseErr != nil {
+			return parseErr
+		}
+		changes = append(changes, rows...)
+	}
+	if len(changes) == 0 {
+		return usage("no members given (pass emails or --csv)")
+	}
+
+	svc, err := newCloudIdentityWriteService(ctx, account)
+	if err != nil {
+		return wrapCloudIdentityError(err, account)
+	}
+	groupName, err := lookupGroupByEmail(ctx, svc, groupEmail)
+	if err != nil {
+		return fmt.Errorf("failed to find group %q: %w", groupEmail, wrapCloudIdentityError(err, account))
+	}
+
+	for i := range changes {
+		ch := &changes[i]
+		_, createErr := svc.Groups.Memberships.Create(groupName, &cloudidentity.Membership{
+			PreferredMemberKey: &cloudidentity.EntityKey{Id: ch.Email},
+			Roles:              groupMembershipRoles(ch.Role),
+		}).Context(ctx).Do()
+		switch {
+		case createErr == nil:
+			ch.Status = "added"
+		case isGoogleAPIStatus(createErr, http.StatusConflict):
+			ch.Status = "exists"
+		default:
+			ch.Status = "failed"
+			ch.Error = wrapCloudIdentityError(createErr, account).Error()
+		}
+	}
+	return writeGroupMemberChanges(ctx, groupEmail, changes)
+}
+
+type GroupsRemoveCmd struct {
+	GroupEmail string   `arg:"" name:"groupEmail" help:"Group email (e.g., engineering@company.com)"`
+	Emails     []string `arg:"" name:"email" help:"Member emails to remove"`
+}
+
+func (c *GroupsRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	groupEmail := strings.TrimSpace(c.GroupEmail)
+	if groupEmail == "" {
+		return usage("group email required")
+	}
+	emails := parseCommaArgs(c.Emails)
+	if len(emails) == 0 {
+		return usage("no members given")
+	}
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("remove %d member(s) from %s", len(emails), groupEmail)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newCloudIdentityWriteService(ctx, account)
+	if err != nil {
+		return wrapCloudIdentityError(err, account)
+	}
+	groupName, err := lookupGroupByEmail(ctx, svc, groupEmail)
+	if err != nil {
+		return fmt.Errorf("failed to find group %q: %w", groupEmail, wrapCloudIdentityError(err, account))
+	}
+
+	changes := make([]groupMemberChange, 0, len(emails))
+	for _, email := range emails {
+		ch := groupMemberChange{Email: email}
+		lookup, lookupErr := svc.Groups.Memberships.Lookup(groupName).MemberKeyId(email).Context(ctx).Do()
+		if lookupErr == nil {
+			_, lookupErr = svc.Groups.Memberships.Delete(lookup.Name).Context(ctx).Do()
+		}
+		switch {
+		case lookupErr == nil:
+			ch.Status = "removed"
+		case isGoogleAPIStatus(lookupErr, http.StatusNotFound):
+			ch.Status = "missing"
+		default:
+			ch.Status = "failed"
+			ch.Error = wrapCloudIdentityError(lookupErr, account).Error()
+		}
+		changes = append(changes, ch)
+	}
+	return writeGroupMemberChanges(ctx, groupEmail, changes)
+}
+
+// writeGroupMemberChanges prints per-member results and fails if any member
+// failed, after reporting all of them.
+func writeGroupMemberChanges(ctx context.Context, groupEmail string, changes []groupMemberChange) error {
+	failed := 0
+	for _, ch := range changes {
+		if ch.Status == "failed" {
+			failed++
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{"group": groupEmail, "members": changes}); err != nil {
+			return err
+		}
+	} else {
+		u := ui.FromContext(ctx)
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "EMAIL\tROLE\tSTATUS\tERROR")
+		for _, ch := range changes {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", sanitizeTab(ch.Email), ch.Role, ch.Status, sanitizeTab(ch.Error))
+		}
+		flush()
+		if failed > 0 {
+			u.Err().Printf("%d of %d member(s) failed", failed, len(changes))
+		}
+	}
+	if failed > 0 {
+		return &ExitError{Code: 1, Err: fmt.Errorf("%d of %d member(s) failed", failed, len(changes))}
+	}
+	return nil
+}
+
+func parseGroupRole(value string) (string, error) {
+	switch role := strings.ToUpper(strings.TrimSpace(value)); role {
+	case "", groupRoleMember:
+		return groupRoleMember, nil
+	case groupRoleManager, groupRoleOwner:
+		return role, nil
+	default:
+		return "", usagef("invalid role %q (expected member|manager|owner)", value)
+	}
+}
+
+// groupMembershipRoles always includes MEMBER; Cloud Identity models
+// managers and owners as members with an extra role.
+func groupMembershipRoles(role string) []*cloudidentity.MembershipRole {
+	roles := []*cloudidentity.MembershipRole{{Name: groupRoleMember}}
+	if role != groupRoleMember {
+		roles = append(roles, &cloudidentity.MembershipRole{Name: role})
+	}
+	return roles
+}
+
+// parseGroupMembersCSV reads "email[,role]" rows. A first row without an
+// email address is treated as a header.
+func parseGroupMembersCSV(data []byte, defaultRole string) ([]groupMemberChange, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var out []groupMemberChange
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse CSV: %w", err)
+		}
+		if len(record) == 0 || strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		email := strings.TrimSpace(record[0])
+		if !strings.Contains(email, "@") {
+			if line == 1 {
+				continue
+			}
+			return nil, usagef("CSV line %d: %q is not an email address", line, email)
+		}
+		role := defaultRole
+		if len(record) > 1 && strings.TrimSpace(record[1]) != "" {
+			if role, err = parseGroupRole(record[1]); err != nil {
+				return nil, usagef("CSV line %d: %v", line, err)
+			}
+		}
+		out = append(out, groupMemberChange{Email: email, Role: role})
+	}
+	return out, nil
+}
+
+func isGoogleAPIStatus(err error, code int) bool {
+	var apiErr *gapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/cloudidentity/v1"
+	"google.golang.org/api/option"
+)
+
+func newTestCloudIdentityWriteService(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	svc, err := cloudidentity.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	orig := newCloudIdentityWriteService
+	t.Cleanup(func() { newCloudIdentityWriteService = orig })
+	newCloudIdentityWriteService = func(context.Context, string) (*cloudidentity.Service, error) { return svc, nil }
+}
+
+func TestGroupsAdd_CSVAndConflicts(t *testing.T) {
+	var mu sync.Mutex
+	created := map[string][]string{}
+	newTestCloudIdentityWriteService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "groups:lookup"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "groups/g1"})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "groups/g1/memberships"):
+			var m cloudidentity.Membership
+			_ = json.NewDecoder(r.Body).Decode(&m)
+			email := m.PreferredMemberKey.Id
+			if email == "dup@example.com" {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = io.WriteString(w, `{"error":{"code":409,"message":"exists"}}`)
+				return
+			}
+			if email == "bad@example.com" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = io.WriteString(w, `{"error":{"code":400,"message":"nope"}}`)
+				return
+			}
+			var roles []string
+			for _, role := range m.Roles {
+				roles = append(roles, role.Name)
+			}
+			mu.Lock()
+			created[email] = roles
+			mu.Unlock()
+			_ = json.NewEncoder(w).Encode(map[string]any{"done": true})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	csvPath := filepath.Join(t.TempDir(), "members.csv")
+	csvData := "email,role\nb@example.com,owner\ndup@example.com\nbad@example.com,\n"
+	if err := os.WriteFile(csvPath, []byte(csvData), 0o600); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+
+	var execErr error
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			execErr = Execute([]string{"--json", "--account", "a@b.com", "groups", "add", "team@example.com", "a@example.com", "--role", "manager", "--csv", csvPath})
+		})
+	})
+	if execErr == nil {
+		t.Fatalf("expected error for failed member")
+	}
+
+	var parsed struct {
+		Group   string              `json:"group"`
+		Members []groupMemberChange `json:"members"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	statuses := map[string]string{}
+	for _, m := range parsed.Members {
+		statuses[m.Email] = m.Status
+	}
+	want := map[string]string{"a@example.com": "added", "b@example.com": "added", "dup@example.com": "exists", "bad@example.com": "failed"}
+	for email, status := range want {
+		if statuses[email] != status {
+			t.Fatalf("%s: status %q, want %q (%v)", email, statuses[email], status, statuses)
+		}
+	}
+	if got := strings.Join(created["a@example.com"], ","); got != "MEMBER,MANAGER" {
+		t.Fatalf("a roles: %q", got)
+	}
+	if got := strings.Join(created["b@example.com"], ","); got != "MEMBER,OWNER" {
+		t.Fatalf("b roles: %q", got)
+	}
+}
+
+func TestGroupsRemove(t *testing.T) {
+	var deleted []string
+	newTestCloudIdentityWriteService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "groups:lookup"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "groups/g1"})
+		case strings.HasSuffix(r.URL.Path, "memberships:lookup"):
+			if r.URL.Query().Get("memberKey.id") != "a@example.com" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = io.WriteString(w, `{"error":{"code":404,"message":"not found"}}`)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "groups/g1/memberships/m1"})
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/v1/"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"done": true})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--force", "--account", "a@b.com", "groups", "remove", "team@example.com", "a@example.com,gone@example.com"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if len(deleted) != 1 || deleted[0] != "groups/g1/memberships/m1" {
+		t.Fatalf("deleted: %v", deleted)
+	}
+	if !strings.Contains(out, `"status": "removed"`) || !strings.Contains(out, `"status": "missing"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestGroupsRemove_RequiresForceNonInteractive(t *testing.T) {
+	err := Execute([]string{"--no-input", "--account", "a@b.com", "groups", "remove", "team@example.com", "a@example.com"})
+	if err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("expected --force error, got %v", err)
+	}
+}
+
+func TestParseGroupMembersCSV(t *testing.T) {
+	rows, err := parseGroupMembersCSV([]byte("a@example.com\nb@example.com, Owner\n\n"), groupRoleMember)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(rows) != 2 || rows[0].Role != groupRoleMember || rows[1].Role != groupRoleOwner {
+		t.Fatalf("rows: %+v", rows)
+	}
+	if _, err := parseGroupMembersCSV([]byte("a@example.com\nnot-an-email\n"), groupRoleMember); err == nil {
+		t.Fatalf("expected error for non-email row")
+	}
+	if _, err := parseGroupMembersCSV([]byte("a@example.com,admin\n"), groupRoleMember); err == nil {
+		t.Fatalf("expected error for bad role")
+	}
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+// readInputFile reads a user-supplied file argument; "-" reads stdin.
+func readInputFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, usage("empty file path")
+	}
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	path, err := config.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path) //nolint:gosec // user-provided path
+}
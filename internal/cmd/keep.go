@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -16,16 +17,21 @@ import (
 	"github.com/steipete/gogcli/internal/ui"
 )
 
-var newKeepServiceWithSA = googleapi.NewKeepWithServiceAccount
+var (
+	newKeepServiceWithSA      = googleapi.NewKeepWithServiceAccount
+	newKeepWriteServiceWithSA = googleapi.NewKeepWriterWithServiceAccount
+)
 
 type KeepCmd struct {
-	ServiceAccount string `name:"service-account" help:"Path to service account JSON file"`
-	Impersonate    string `name:"impersonate" help:"Email to impersonate (required with service-account)"`
-
-	List       KeepListCmd       `cmd:"" default:"withargs" help:"List notes"`
-	Get        KeepGetCmd        `cmd:"" name:"get" help:"Get a note"`
-	Search     KeepSearchCmd     `cmd:"" name:"search" help:"Search notes by text (client-side)"`
-	Attachment KeepAttachmentCmd `cmd:"" name:"attachment" help:"Download an attachment"`
+	ServiceAccount string `name:"service-account" help:"Path to service account JSON file (use with the global --impersonate)"`
+
+	List        KeepListCmd        `cmd:"" default:"withargs" help:"List notes"`
+	Get         KeepGetCmd         `cmd:"" name:"get" help:"Get a note"`
+	Search      KeepSearchCmd      `cmd:"" name:"search" help:"Search notes by text (client-side)"`
+	Attachment  KeepAttachmentCmd  `cmd:"" name:"attachment" help:"Download an attachment"`
+	Attachments KeepAttachmentsCmd `cmd:"" name:"attachments" help:"Download all attachments of a note"`
+	Create      KeepCreateCmd      `cmd:"" name:"create" help:"Create a text or checklist note"`
+	Export      KeepExportCmd      `cmd:"" name:"export" help:"Export all notes as Markdown or JSON files"`
 }
 
 type KeepListCmd struct {
@@ -101,11 +107,29 @@ func noteContains(n *keepapi.Note, query string) bool {
 			return true
 		}
 	}
+	if n.Body != nil && n.Body.List != nil {
+		return listItemsContain(n.Body.List.ListItems, query)
+	}
+	return false
+}
+
+func listItemsContain(items []*keepapi.ListItem, query string) bool {
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if item.Text != nil && strings.Contains(strings.ToLower(item.Text.Text), query) {
+			return true
+		}
+		if listItemsContain(item.ChildListItems, query) {
+			return true
+		}
+	}
 	return false
 }
 
 type KeepSearchCmd struct {
-	Query string `arg:"" name:"query" help:"Text to search for in title and body"`
+	Query string `arg:"" name:"query" help:"Text to search for in title, body and checklist items"`
 	Max   int64  `name:"max" help:"Max results to fetch before filtering" default:"500"`
 }
 
@@ -205,6 +229,12 @@ func (c *KeepGetCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd) e
 		u.Out().Println("")
 		u.Out().Println(note.Body.Text.Text)
 	}
+	if note.Body != nil && note.Body.List != nil {
+		u.Out().Println("")
+		for _, line := range keepListLines(note.Body.List.ListItems, "", "") {
+			u.Out().Println(line)
+		}
+	}
 	if len(note.Attachments) > 0 {
 		u.Out().Println("")
 		u.Out().Printf("attachments\t%d", len(note.Attachments))
@@ -234,33 +264,15 @@ func (c *KeepAttachmentCmd) Run(ctx context.Context, flags *RootFlags, keep *Kee
 		return fmt.Errorf("invalid attachment name format, expected: notes/<noteId>/attachments/<attachmentId>")
 	}
 
-	resp, err := svc.Media.Download(name).MimeType(c.MimeType).Download()
-	if err != nil {
-		return fmt.Errorf("download attachment: %w", err)
-	}
-	defer resp.Body.Close()
-
 	outPath := c.Out
 	if outPath == "" {
 		parts := strings.Split(name, "/")
 		outPath = parts[len(parts)-1]
 	}
 
-	if dir := filepath.Dir(outPath); dir != "." {
-		if mkdirErr := os.MkdirAll(dir, 0o700); mkdirErr != nil && !os.IsExist(mkdirErr) {
-			return fmt.Errorf("create output directory: %w", mkdirErr)
-		}
-	}
-
-	f, err := os.Create(outPath) //nolint:gosec // user-provided output path
-	if err != nil {
-		return fmt.Errorf("create output file: %w", err)
-	}
-	defer f.Close()
-
-	written, err := io.Copy(f, resp.Body)
+	written, err := downloadKeepAttachment(svc, name, c.MimeType, outPath)
 	if err != nil {
-		return fmt.Errorf("write attachment: %w", err)
+		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
@@ -276,12 +288,102 @@ func (c *KeepAttachmentCmd) Run(ctx context.Context, flags *RootFlags, keep *Kee
 	return nil
 }
 
+type KeepAttachmentsCmd struct {
+	NoteID string `arg:"" name:"noteId" help:"Note ID or name (e.g. notes/abc123)"`
+	Out    string `name:"out" help:"Output directory" default:"."`
+}
+
+func (c *KeepAttachmentsCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd) error {
+	u := ui.FromContext(ctx)
+
+	outDir, err := config.ExpandPath(strings.TrimSpace(c.Out))
+	if err != nil {
+		return err
+	}
+	svc, err := getKeepService(ctx, flags, keep)
+	if err != nil {
+		return err
+	}
+
+	name := c.NoteID
+	if !strings.HasPrefix(name, "notes/") {
+		name = "notes/" + name
+	}
+	note, err := svc.Notes.Get(name).Do()
+	if err != nil {
+		return err
+	}
+
+	type item struct {
+		Name  string `json:"name"`
+		Path  string `json:"path"`
+		Bytes int64  `json:"bytes"`
+	}
+	items := make([]item, 0, len(note.Attachments))
+	for _, a := range note.Attachments {
+		mimeType := keepAttachmentMimeType(a)
+		outPath := filepath.Join(outDir, path.Base(a.Name)+keepAttachmentExt(mimeType))
+		written, err := downloadKeepAttachment(svc, a.Name, mimeType, outPath)
+		if err != nil {
+			return err
+		}
+		items = append(items, item{Name: a.Name, Path: outPath, Bytes: written})
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"note": note.Name, "attachments": items})
+	}
+	if len(items) == 0 {
+		u.Err().Println("No attachments")
+		return nil
+	}
+	for _, it := range items {
+		u.Out().Printf("%s\t%d", it.Path, it.Bytes)
+	}
+	return nil
+}
+
+func downloadKeepAttachment(svc *keepapi.Service, name, mimeType, outPath string) (int64, error) {
+	resp, err := svc.Media.Download(name).MimeType(mimeType).Download()
+	if err != nil {
+		return 0, fmt.Errorf("download attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if dir := filepath.Dir(outPath); dir != "." {
+		if mkdirErr := os.MkdirAll(dir, 0o700); mkdirErr != nil && !os.IsExist(mkdirErr) {
+			return 0, fmt.Errorf("create output directory: %w", mkdirErr)
+		}
+	}
+
+	f, err := os.Create(outPath) //nolint:gosec // user-provided output path
+	if err != nil {
+		return 0, fmt.Errorf("create output file: %w", err)
+	}
+	defer f.Close()
+
+	written, err := io.Copy(f, resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("write attachment: %w", err)
+	}
+	return written, nil
+}
+
 func getKeepService(ctx context.Context, flags *RootFlags, keepCmd *KeepCmd) (*keepapi.Service, error) {
+	return keepServiceWith(ctx, flags, keepCmd, newKeepServiceWithSA)
+}
+
+// getKeepWriteService is getKeepService with the read-write keep scope.
+func getKeepWriteService(ctx context.Context, flags *RootFlags, keepCmd *KeepCmd) (*keepapi.Service, error) {
+	return keepServiceWith(ctx, flags, keepCmd, newKeepWriteServiceWithSA)
+}
+
+func keepServiceWith(ctx context.Context, flags *RootFlags, keepCmd *KeepCmd, build func(context.Context, string, string) (*keepapi.Service, error)) (*keepapi.Service, error) {
 	if keepCmd.ServiceAccount != "" {
-		if keepCmd.Impersonate == "" {
+		if flags == nil || strings.TrimSpace(flags.Impersonate) == "" {
 			return nil, fmt.Errorf("--impersonate is required when using --service-account")
 		}
-		return newKeepServiceWithSA(ctx, keepCmd.ServiceAccount, keepCmd.Impersonate)
+		return build(ctx, keepCmd.ServiceAccount, normalizeEmail(flags.Impersonate))
 	}
 
 	account, err := requireAccount(flags)
@@ -289,29 +391,96 @@ func getKeepService(ctx context.Context, flags *RootFlags, keepCmd *KeepCmd) (*k
 		return nil, err
 	}
 
-	genericSAPath, err := config.ServiceAccountPath(account)
+	// With --impersonate the delegating account's key signs for the subject.
+	saPath, ok, err := googleapi.StoredServiceAccountKeyPath(ctx, account)
 	if err != nil {
 		return nil, err
 	}
-	if _, statErr := os.Stat(genericSAPath); statErr == nil {
-		return newKeepServiceWithSA(ctx, genericSAPath, account)
+	if ok {
+		return build(ctx, saPath, account)
 	}
 
-	saPath, err := config.KeepServiceAccountPath(account)
+	return nil, usage("Keep is Workspace-only and requires a service account. Configure it with: gog auth service-account set <email> --key <service-account.json> (or legacy: gog auth keep <email> --key <service-account.json>)")
+}
+
+type KeepCreateCmd struct {
+	Title     string   `name:"title" help:"Note title"`
+	Text      string   `name:"text" help:"Note text"`
+	ListItems []string `name:"list-items" help:"Checklist items (comma-separated or repeatable; prefix with [x] to check)"`
+}
+
+func (c *KeepCreateCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd) error {
+	u := ui.FromContext(ctx)
+
+	title := strings.TrimSpace(c.Title)
+	text := strings.TrimSpace(c.Text)
+	if text != "" && len(c.ListItems) > 0 {
+		return usage("use either --text or --list-items")
+	}
+	note := &keepapi.Note{Title: title}
+	switch {
+	case len(c.ListItems) > 0:
+		list := &keepapi.ListContent{}
+		for _, raw := range c.ListItems {
+			item := strings.TrimSpace(raw)
+			checked := false
+			if rest, ok := strings.CutPrefix(item, "[x]"); ok {
+				item, checked = strings.TrimSpace(rest), true
+			} else if rest, ok := strings.CutPrefix(item, "[ ]"); ok {
+				item = strings.TrimSpace(rest)
+			}
+			if item == "" {
+				continue
+			}
+			list.ListItems = append(list.ListItems, &keepapi.ListItem{Text: &keepapi.TextContent{Text: item}, Checked: checked})
+		}
+		if len(list.ListItems) == 0 {
+			return usage("empty --list-items")
+		}
+		note.Body = &keepapi.Section{List: list}
+	case text != "":
+		note.Body = &keepapi.Section{Text: &keepapi.TextContent{Text: text}}
+	case title == "":
+		return usage("required: --title, --text or --list-items")
+	}
+
+	svc, err := getKeepWriteService(ctx, flags, keep)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	created, err := svc.Notes.Create(note).Do()
+	if err != nil {
+		return err
 	}
 
-	if _, statErr := os.Stat(saPath); statErr == nil {
-		return newKeepServiceWithSA(ctx, saPath, account)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"note": created})
 	}
+	u.Out().Printf("name\t%s", created.Name)
+	if created.Title != "" {
+		u.Out().Printf("title\t%s", created.Title)
+	}
+	return nil
+}
 
-	legacyPath, legacyErr := config.KeepServiceAccountLegacyPath(account)
-	if legacyErr == nil {
-		if _, statErr := os.Stat(legacyPath); statErr == nil {
-			return newKeepServiceWithSA(ctx, legacyPath, account)
+// keepListLines renders checklist items as "[ ] item" lines (after the
+// given bullet), indenting nested items.
+func keepListLines(items []*keepapi.ListItem, indent, bullet string) []string {
+	var lines []string
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		box := "[ ]"
+		if item.Checked {
+			box = "[x]"
 		}
+		text := ""
+		if item.Text != nil {
+			text = item.Text.Text
+		}
+		lines = append(lines, indent+bullet+box+" "+text)
+		lines = append(lines, keepListLines(item.ChildListItems, indent+"  ", bullet)...)
 	}
-
-	return nil, usage("Keep is Workspace-only and requires a service account. Configure it with: gog auth service-account set <email> --key <service-account.json> (or legacy: gog auth keep <email> --key <service-account.json>)")
+	return lines
 }
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	keepapi "google.golang.org/api/keep/v1"
+	"gopkg.in/yaml.v3"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type KeepExportCmd struct {
+	Out           string `name:"out" help:"Output directory" required:""`
+	Format        string `name:"format" help:"md|json" default:"md"`
+	Filter        string `name:"filter" help:"Filter expression (as for keep list)"`
+	NoAttachments bool   `name:"no-attachments" help:"Skip downloading attachments"`
+}
+
+// keepFrontMatter is the YAML header of exported Markdown notes. The Keep API
+// doesn't expose labels, colors or pins, so only these fields are available.
+type keepFrontMatter struct {
+	Title       string   `yaml:"title,omitempty"`
+	ID          string   `yaml:"id"`
+	Created     string   `yaml:"created,omitempty"`
+	Updated     string   `yaml:"updated,omitempty"`
+	Trashed     bool     `yaml:"trashed,omitempty"`
+	Attachments []string `yaml:"attachments,omitempty"`
+}
+
+func (c *KeepExportCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd) error {
+	u := ui.FromContext(ctx)
+
+	format := strings.ToLower(strings.TrimSpace(c.Format))
+	if format != "md" && format != "json" {
+		return usagef("invalid --format %q (expected md|json)", c.Format)
+	}
+	outDir, err := config.ExpandPath(strings.TrimSpace(c.Out))
+	if err != nil {
+		return err
+	}
+
+	svc, err := getKeepService(ctx, flags, keep)
+	if err != nil {
+		return err
+	}
+	notes, err := listAllKeepNotes(svc, c.Filter)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(outDir, 0o700); err != nil {
+		return fmt.Errorf("create output directory: %w", err)
+	}
+
+	files := make([]string, 0, len(notes))
+	attachments := 0
+	for _, note := range notes {
+		id := strings.TrimPrefix(note.Name, "notes/")
+		var saved []string
+		if !c.NoAttachments {
+			for _, a := range note.Attachments {
+				rel := keepAttachmentPath(id, a)
+				if _, err := downloadKeepAttachment(svc, a.Name, keepAttachmentMimeType(a), filepath.Join(outDir, filepath.FromSlash(rel))); err != nil {
+					return fmt.Errorf("%s: %w", note.Name, err)
+				}
+				saved = append(saved, rel)
+			}
+		}
+		attachments += len(saved)
+
+		var data []byte
+		if format == "json" {
+			data, err = json.MarshalIndent(note, "", "  ")
+			if err == nil {
+				data = append(data, '\n')
+			}
+		} else {
+			data, err = keepNoteMarkdown(note, saved)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", note.Name, err)
+		}
+		file := keepNoteFilename(note) + "." + format
+		if err := os.WriteFile(filepath.Join(outDir, file), data, 0o600); err != nil {
+			return fmt.Errorf("write %s: %w", file, err)
+		}
+		files = append(files, file)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"out":         outDir,
+			"format":      format,
+			"notes":       len(notes),
+			"attachments": attachments,
+			"files":       files,
+		})
+	}
+	u.Out().Printf("out\t%s", outDir)
+	u.Out().Printf("notes\t%d", len(notes))
+	u.Out().Printf("attachments\t%d", attachments)
+	return nil
+}
+
+func listAllKeepNotes(svc *keepapi.Service, filter string) ([]*keepapi.Note, error) {
+	var notes []*keepapi.Note
+	for page := ""; ; {
+		call := svc.Notes.List().PageSize(100).PageToken(page)
+		if filter != "" {
+			call = call.Filter(filter)
+		}
+		resp, err := call.Do()
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, resp.Notes...)
+		if resp.NextPageToken == "" {
+			return notes, nil
+		}
+		page = resp.NextPageToken
+	}
+}
+
+// keepNoteMarkdown renders a note as YAML front matter plus its text or a
+// Markdown task list; attachments are linked by their exported paths.
+func keepNoteMarkdown(note *keepapi.Note, attachments []string) ([]byte, error) {
+	front, err := yaml.Marshal(keepFrontMatter{
+		Title:       note.Title,
+		ID:          strings.TrimPrefix(note.Name, "notes/"),
+		Created:     note.CreateTime,
+		Updated:     note.UpdateTime,
+		Trashed:     note.Trashed,
+		Attachments: attachments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	b.Write(front)
+	b.WriteString("---\n\n")
+	if note.Title != "" {
+		fmt.Fprintf(&b, "# %s\n\n", note.Title)
+	}
+	if note.Body != nil && note.Body.Text != nil && note.Body.Text.Text != "" {
+		b.WriteString(strings.TrimRight(note.Body.Text.Text, "\n"))
+		b.WriteString("\n")
+	}
+	if note.Body != nil && note.Body.List != nil {
+		for _, line := range keepListLines(note.Body.List.ListItems, "", "- ") {
+			b.WriteString(line + "\n")
+		}
+	}
+	if len(attachments) > 0 {
+		b.WriteString("\n")
+		for _, rel := range attachments {
+			if strings.HasPrefix(mime.TypeByExtension(path.Ext(rel)), "image/") {
+				fmt.Fprintf(&b, "![](%s)\n", rel)
+			} else {
+				fmt.Fprintf(&b, "[%s](%s)\n", path.Base(rel), rel)
+			}
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// keepNoteFilename is a readable, stable file name: the title slug plus the
+// note ID so renamed or same-titled notes don't collide.
+func keepNoteFilename(note *keepapi.Note) string {
+	id := strings.TrimPrefix(note.Name, "notes/")
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(note.Title) {
+		if slug.Len() >= 60 {
+			break
+		}
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			slug.WriteRune(r)
+			dash = false
+		case !dash && slug.Len() > 0:
+			slug.WriteByte('-')
+			dash = true
+		}
+	}
+	s := strings.Trim(slug.String(), "-")
+	if s == "" {
+		return id
+	}
+	return s + "-" + id
+}
+
+func keepAttachmentMimeType(a *keepapi.Attachment) string {
+	if len(a.MimeType) > 0 && a.MimeType[0] != "" {
+		return a.MimeType[0]
+	}
+	return "application/octet-stream"
+}
+
+// keepAttachmentPath is the slash-separated path, relative to the export
+// directory, that an attachment is saved under.
+func keepAttachmentPath(noteID string, a *keepapi.Attachment) string {
+	return path.Join("attachments", noteID, path.Base(a.Name)+keepAttachmentExt(keepAttachmentMimeType(a)))
+}
+
+func keepAttachmentExt(mimeType string) string {
+	switch mimeType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "audio/3gpp":
+		return ".3gp"
+	case "audio/mp4":
+		return ".m4a"
+	}
+	if exts, err := mime.ExtensionsByType(mimeType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -14,6 +15,7 @@ import (
 	keepapi "google.golang.org/api/keep/v1"
 	"google.golang.org/api/option"
 
+	"github.com/steipete/gogcli/internal/authclient"
 	"github.com/steipete/gogcli/internal/config"
 )
 
@@ -592,7 +594,7 @@ func TestGetKeepService_ServiceAccountOverride_CallsBuilder(t *testing.T) {
 		return &keepapi.Service{}, nil
 	}
 
-	_, err := getKeepService(context.Background(), nil, &KeepCmd{ServiceAccount: "sa.json", Impersonate: "a@b.com"})
+	_, err := getKeepService(context.Background(), &RootFlags{Impersonate: "a@b.com"}, &KeepCmd{ServiceAccount: "sa.json"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -635,3 +637,209 @@ func TestGetKeepService_UsesLegacyPath(t *testing.T) {
 		t.Fatalf("unexpected path: %q", gotPath)
 	}
 }
+
+func TestKeepCreate_ListItems(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	account := "a@b.com"
+	_ = writeKeepSA(t, account)
+
+	var created keepapi.Note
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/notes" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&created)
+		_, _ = io.WriteString(w, `{"name":"notes/new1","title":"Groceries"}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	origRead, origWrite := newKeepServiceWithSA, newKeepWriteServiceWithSA
+	t.Cleanup(func() { newKeepServiceWithSA, newKeepWriteServiceWithSA = origRead, origWrite })
+	newKeepServiceWithSA = func(context.Context, string, string) (*keepapi.Service, error) {
+		t.Fatalf("create must use the read-write service")
+		return nil, errors.New("unexpected")
+	}
+	newKeepWriteServiceWithSA = func(ctx context.Context, _, _ string) (*keepapi.Service, error) {
+		return keepapi.NewService(ctx,
+			option.WithEndpoint(srv.URL+"/"),
+			option.WithHTTPClient(srv.Client()),
+			option.WithoutAuthentication(),
+		)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"keep", "create", "--title", "Groceries", "--list-items", "milk,[x] eggs", "--list-items", "bread", "--account", account}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !strings.Contains(out, "name\tnotes/new1") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if created.Title != "Groceries" || created.Body == nil || created.Body.List == nil || len(created.Body.List.ListItems) != 3 {
+		t.Fatalf("unexpected note: %#v", created)
+	}
+	items := created.Body.List.ListItems
+	if items[1].Text.Text != "eggs" || !items[1].Checked || items[0].Checked || items[2].Text.Text != "bread" {
+		t.Fatalf("unexpected items: %#v %#v %#v", items[0], items[1], items[2])
+	}
+
+	if err := Execute([]string{"keep", "create", "--text", "x", "--list-items", "y", "--account", account}); err == nil {
+		t.Fatalf("expected error for --text with --list-items")
+	}
+}
+
+func TestKeepListLines(t *testing.T) {
+	lines := keepListLines([]*keepapi.ListItem{
+		{Text: &keepapi.TextContent{Text: "a"}, Checked: true, ChildListItems: []*keepapi.ListItem{{Text: &keepapi.TextContent{Text: "b"}}}},
+	}, "", "")
+	if strings.Join(lines, "\n") != "[x] a\n  [ ] b" {
+		t.Fatalf("unexpected lines: %q", lines)
+	}
+}
+
+func TestKeepExport_Markdown(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	account := "a@b.com"
+	_ = writeKeepSA(t, account)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/notes" && r.URL.Query().Get("pageToken") == "":
+			_, _ = io.WriteString(w, `{"notes":[{"name":"notes/n1","title":"Trip: Rome!","createTime":"2026-01-01T00:00:00Z","body":{"text":{"text":"Pack light"}},"attachments":[{"name":"notes/n1/attachments/a1","mimeType":["image/png"]}]}],"nextPageToken":"p2"}`)
+		case r.URL.Path == "/v1/notes":
+			_, _ = io.WriteString(w, `{"notes":[{"name":"notes/n2","body":{"list":{"listItems":[{"text":{"text":"milk"},"checked":true},{"text":{"text":"eggs"}}]}}}]}`)
+		case r.URL.Path == "/v1/notes/n1/attachments/a1":
+			_, _ = io.WriteString(w, "PNGDATA")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	orig := newKeepServiceWithSA
+	t.Cleanup(func() { newKeepServiceWithSA = orig })
+	newKeepServiceWithSA = func(ctx context.Context, _, _ string) (*keepapi.Service, error) {
+		return keepapi.NewService(ctx,
+			option.WithEndpoint(srv.URL+"/"),
+			option.WithHTTPClient(srv.Client()),
+			option.WithoutAuthentication(),
+		)
+	}
+
+	outDir := filepath.Join(t.TempDir(), "export")
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"keep", "export", "--out", outDir, "--account", account}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+
+	rome, err := os.ReadFile(filepath.Join(outDir, "trip-rome-n1.md"))
+	if err != nil {
+		t.Fatalf("read note: %v", err)
+	}
+	for _, want := range []string{"---\ntitle: 'Trip: Rome!'\nid: n1\n", "attachments:\n    - attachments/n1/a1.png\n", "# Trip: Rome!\n\nPack light\n", "![](attachments/n1/a1.png)"} {
+		if !strings.Contains(string(rome), want) {
+			t.Fatalf("missing %q in:\n%s", want, rome)
+		}
+	}
+	if data, err := os.ReadFile(filepath.Join(outDir, "attachments", "n1", "a1.png")); err != nil || string(data) != "PNGDATA" {
+		t.Fatalf("attachment: %q %v", data, err)
+	}
+	list, err := os.ReadFile(filepath.Join(outDir, "n2.md"))
+	if err != nil {
+		t.Fatalf("read list note: %v", err)
+	}
+	if !strings.Contains(string(list), "- [x] milk\n- [ ] eggs\n") {
+		t.Fatalf("unexpected list note:\n%s", list)
+	}
+}
+
+func TestKeepAttachments_DownloadsAll(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	account := "a@b.com"
+	_ = writeKeepSA(t, account)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/notes/n1":
+			_, _ = io.WriteString(w, `{"name":"notes/n1","attachments":[{"name":"notes/n1/attachments/a1","mimeType":["image/jpeg"]},{"name":"notes/n1/attachments/a2","mimeType":["audio/3gpp"]}]}`)
+		case "/v1/notes/n1/attachments/a1", "/v1/notes/n1/attachments/a2":
+			_, _ = io.WriteString(w, "data-"+filepath.Base(r.URL.Path))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	orig := newKeepServiceWithSA
+	t.Cleanup(func() { newKeepServiceWithSA = orig })
+	newKeepServiceWithSA = func(ctx context.Context, _, _ string) (*keepapi.Service, error) {
+		return keepapi.NewService(ctx,
+			option.WithEndpoint(srv.URL+"/"),
+			option.WithHTTPClient(srv.Client()),
+			option.WithoutAuthentication(),
+		)
+	}
+
+	outDir := filepath.Join(t.TempDir(), "att")
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"keep", "attachments", "n1", "--out", outDir, "--json", "--account", account}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"notes/n1/attachments/a2"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	for file, want := range map[string]string{"a1.jpg": "data-a1", "a2.3gp": "data-a2"} {
+		if data, err := os.ReadFile(filepath.Join(outDir, file)); err != nil || string(data) != want {
+			t.Fatalf("%s: %q %v", file, data, err)
+		}
+	}
+}
+
+func TestNoteContains_ListItems(t *testing.T) {
+	n := &keepapi.Note{Body: &keepapi.Section{List: &keepapi.ListContent{ListItems: []*keepapi.ListItem{
+		{Text: &keepapi.TextContent{Text: "Milk"}, ChildListItems: []*keepapi.ListItem{{Text: &keepapi.TextContent{Text: "Oat"}}}},
+	}}}}
+	if !noteContains(n, "oat") || noteContains(n, "bread") {
+		t.Fatalf("unexpected list item matching")
+	}
+}
+
+func TestGetKeepService_ImpersonateUsesDelegatingKey(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv("GOG_ACCOUNT", "")
+
+	saPath := writeKeepSA(t, "admin@b.com")
+
+	orig := newKeepServiceWithSA
+	t.Cleanup(func() { newKeepServiceWithSA = orig })
+
+	var gotPath, gotImpersonate string
+	newKeepServiceWithSA = func(_ context.Context, path, impersonate string) (*keepapi.Service, error) {
+		gotPath = path
+		gotImpersonate = impersonate
+		return &keepapi.Service{}, nil
+	}
+
+	flags := &RootFlags{Account: "admin@b.com", Impersonate: "User@B.com"}
+	ctx := authclient.WithImpersonation(context.Background(), "user@b.com", "admin@b.com")
+	if _, err := getKeepService(ctx, flags, &KeepCmd{}); err != nil {
+		t.Fatalf("getKeepService: %v", err)
+	}
+	if gotPath != saPath || gotImpersonate != "user@b.com" {
+		t.Fatalf("unexpected args: path=%q impersonate=%q", gotPath, gotImpersonate)
+	}
+}
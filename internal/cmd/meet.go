@@ -0,0 +1,383 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/meet/v2"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleapi"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var (
+	newMeetService      = googleapi.NewMeet
+	newMeetDriveService = googleapi.NewMeetDrive
+)
+
+const meetArtifactReady = "FILE_GENERATED"
+
+type MeetCmd struct {
+	Conferences MeetConferencesCmd `cmd:"" name:"conferences" help:"Past conferences (conference records)"`
+	Artifacts   MeetArtifactsCmd   `cmd:"" name:"artifacts" help:"List or download recordings and transcripts of a conference"`
+}
+
+type MeetConferencesCmd struct {
+	List MeetConferencesListCmd `cmd:"" name:"list" aliases:"ls" help:"List conferences you took part in"`
+}
+
+type MeetConferencesListCmd struct {
+	Space  string `name:"space" help:"Only this meeting space (meeting code, meet.google.com URL or spaces/ID)"`
+	Since  string `name:"since" help:"Only conferences started since (duration like 24h/7d, date or RFC3339)"`
+	Filter string `name:"filter" help:"Extra conference record filter (e.g. 'end_time IS NULL' for ongoing)"`
+	Max    int64  `name:"max" aliases:"limit" help:"Max results" default:"25"`
+	Page   string `name:"page" help:"Page token"`
+}
+
+func (c *MeetConferencesListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	filter, err := meetConferenceFilter(c.Space, c.Since, c.Filter)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newMeetService(ctx, account)
+	if err != nil {
+		return err
+	}
+	call := svc.ConferenceRecords.List().PageSize(c.Max).PageToken(c.Page).Context(ctx)
+	if filter != "" {
+		call = call.Filter(filter)
+	}
+	resp, err := call.Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"conferences":   resp.ConferenceRecords,
+			"nextPageToken": resp.NextPageToken,
+		})
+	}
+	if len(resp.ConferenceRecords) == 0 {
+		u.Err().Println("No conferences")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ID\tSPACE\tSTART\tEND")
+	for _, rec := range resp.ConferenceRecords {
+		if rec == nil {
+			continue
+		}
+		end := formatDateTime(rec.EndTime)
+		if rec.EndTime == "" {
+			end = "(ongoing)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			strings.TrimPrefix(rec.Name, "conferenceRecords/"),
+			strings.TrimPrefix(rec.Space, "spaces/"),
+			formatDateTime(rec.StartTime),
+			end,
+		)
+	}
+	printNextPageHint(u, resp.NextPageToken)
+	return nil
+}
+
+// meetConferenceFilter builds a conferenceRecords.list filter from --space,
+// --since and a raw --filter, joined with AND.
+func meetConferenceFilter(space, since, extra string) (string, error) {
+	var parts []string
+	if space = strings.TrimSpace(space); space != "" {
+		if strings.HasPrefix(space, "spaces/") {
+			parts = append(parts, fmt.Sprintf("space.name = %q", space))
+		} else {
+			code := strings.TrimSuffix(space, "/")
+			if i := strings.LastIndex(code, "/"); i >= 0 {
+				code = code[i+1:]
+			}
+			if q := strings.IndexByte(code, '?'); q >= 0 {
+				code = code[:q]
+			}
+			if code == "" {
+				return "", usagef("invalid --space %q", space)
+			}
+			parts = append(parts, fmt.Sprintf("space.meeting_code = %q", code))
+		}
+	}
+	if since = strings.TrimSpace(since); since != "" {
+		ts, err := parseTrackingSince(since)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("start_time >= %q", ts))
+	}
+	if extra = strings.TrimSpace(extra); extra != "" {
+		parts = append(parts, extra)
+	}
+	return strings.Join(parts, " AND "), nil
+}
+
+type MeetArtifactsCmd struct {
+	ConferenceID string `arg:"" name:"conferenceId" help:"Conference record ID (from 'gog meet conferences list')"`
+	Out          string `name:"out" help:"Download recordings and transcripts into this directory"`
+	Format       string `name:"format" help:"Transcript download format: txt|pdf|docx" default:"txt" enum:"txt,pdf,docx"`
+	Entries      bool   `name:"entries" help:"Also fetch transcript entries (speaker, time, text)"`
+}
+
+type meetTranscriptEntry struct {
+	Transcript string `json:"transcript"`
+	Speaker    string `json:"speaker,omitempty"`
+	StartTime  string `json:"startTime,omitempty"`
+	EndTime    string `json:"endTime,omitempty"`
+	Language   string `json:"languageCode,omitempty"`
+	Text       string `json:"text"`
+}
+
+type meetDownload struct {
+	Artifact string `json:"artifact"`
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+}
+
+func (c *MeetArtifactsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	conference := meetConferenceName(c.ConferenceID)
+	if conference == "" {
+		return usage("empty conferenceId")
+	}
+
+	svc, err := newMeetService(ctx, account)
+	if err != nil {
+		return err
+	}
+	var recordings []*meet.Recording
+	if err := svc.ConferenceRecords.Recordings.List(conference).Pages(ctx, func(resp *meet.ListRecordingsResponse) error {
+		recordings = append(recordings, resp.Recordings...)
+		return nil
+	}); err != nil {
+		return err
+	}
+	var transcripts []*meet.Transcript
+	if err := svc.ConferenceRecords.Transcripts.List(conference).Pages(ctx, func(resp *meet.ListTranscriptsResponse) error {
+		transcripts = append(transcripts, resp.Transcripts...)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var entries []meetTranscriptEntry
+	if c.Entries && len(transcripts) > 0 {
+		entries, err = fetchMeetTranscriptEntries(ctx, svc, conference, transcripts)
+		if err != nil {
+			return err
+		}
+	}
+
+	var downloads []meetDownload
+	if out := strings.TrimSpace(c.Out); out != "" {
+		downloads, err = downloadMeetArtifacts(ctx, account, out, c.Format, recordings, transcripts)
+		if err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		payload := map[string]any{
+			"conference":  conference,
+			"recordings":  recordings,
+			"transcripts": transcripts,
+		}
+		if c.Entries {
+			payload["entries"] = entries
+		}
+		if downloads != nil {
+			payload["downloads"] = downloads
+		}
+		return outfmt.WriteJSON(os.Stdout, payload)
+	}
+
+	if len(recordings) == 0 && len(transcripts) == 0 {
+		u.Err().Println("No recordings or transcripts")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	fmt.Fprintln(w, "TYPE\tSTATE\tSTART\tFILE\tURL")
+	for _, r := range recordings {
+		file, uri := "", ""
+		if r.DriveDestination != nil {
+			file, uri = r.DriveDestination.File, r.DriveDestination.ExportUri
+		}
+		fmt.Fprintf(w, "recording\t%s\t%s\t%s\t%s\n", r.State, formatDateTime(r.StartTime), file, uri)
+	}
+	for _, t := range transcripts {
+		file, uri := "", ""
+		if t.DocsDestination != nil {
+			file, uri = t.DocsDestination.Document, t.DocsDestination.ExportUri
+		}
+		fmt.Fprintf(w, "transcript\t%s\t%s\t%s\t%s\n", t.State, formatDateTime(t.StartTime), file, uri)
+	}
+	flush()
+
+	for _, e := range entries {
+		u.Out().Printf("[%s] %s: %s", meetClock(e.StartTime), e.Speaker, e.Text)
+	}
+	for _, d := range downloads {
+		u.Out().Printf("path\t%s", d.Path)
+	}
+	return nil
+}
+
+func meetConferenceName(id string) string {
+	id = strings.TrimSpace(id)
+	if id == "" || strings.HasPrefix(id, "conferenceRecords/") {
+		return id
+	}
+	return "conferenceRecords/" + id
+}
+
+// fetchMeetTranscriptEntries returns all entries of the given transcripts with
+// participant resource names resolved to display names.
+func fetchMeetTranscriptEntries(ctx context.Context, svc *meet.Service, conference string, transcripts []*meet.Transcript) ([]meetTranscriptEntry, error) {
+	speakers := map[string]string{}
+	if err := svc.ConferenceRecords.Participants.List(conference).Pages(ctx, func(resp *meet.ListParticipantsResponse) error {
+		for _, p := range resp.Participants {
+			if p != nil {
+				speakers[p.Name] = meetParticipantName(p)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var out []meetTranscriptEntry
+	for _, t := range transcripts {
+		if t == nil {
+			continue
+		}
+		if err := svc.ConferenceRecords.Transcripts.Entries.List(t.Name).PageSize(100).Pages(ctx, func(resp *meet.ListTranscriptEntriesResponse) error {
+			for _, e := range resp.TranscriptEntries {
+				if e == nil {
+					continue
+				}
+				speaker := speakers[e.Participant]
+				if speaker == "" {
+					speaker = e.Participant
+				}
+				out = append(out, meetTranscriptEntry{
+					Transcript: t.Name,
+					Speaker:    speaker,
+					StartTime:  e.StartTime,
+					EndTime:    e.EndTime,
+					Language:   e.LanguageCode,
+					Text:       e.Text,
+				})
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func meetParticipantName(p *meet.Participant) string {
+	switch {
+	case p.SignedinUser != nil:
+		return p.SignedinUser.DisplayName
+	case p.AnonymousUser != nil:
+		return p.AnonymousUser.DisplayName
+	case p.PhoneUser != nil:
+		return p.PhoneUser.DisplayName
+	default:
+		return ""
+	}
+}
+
+// downloadMeetArtifacts saves generated recordings (as-is) and transcripts
+// (exported from Google Docs in format) into dir. Artifacts still being
+// processed are skipped.
+func downloadMeetArtifacts(ctx context.Context, account, dir, format string, recordings []*meet.Recording, transcripts []*meet.Transcript) ([]meetDownload, error) {
+	dir, err := config.ExpandPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	type artifact struct{ name, fileID, format string }
+	var pending []artifact
+	for _, r := range recordings {
+		if r != nil && r.State == meetArtifactReady && r.DriveDestination != nil && r.DriveDestination.File != "" {
+			pending = append(pending, artifact{r.Name, r.DriveDestination.File, ""})
+		}
+	}
+	for _, t := range transcripts {
+		if t != nil && t.State == meetArtifactReady && t.DocsDestination != nil && t.DocsDestination.Document != "" {
+			pending = append(pending, artifact{t.Name, t.DocsDestination.Document, format})
+		}
+	}
+	if len(pending) == 0 {
+		return []meetDownload{}, nil
+	}
+
+	drv, err := newMeetDriveService(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]meetDownload, 0, len(pending))
+	for _, a := range pending {
+		path, size, err := downloadMeetArtifact(ctx, drv, a.fileID, dir, a.format)
+		if err != nil {
+			return out, fmt.Errorf("%s: %w", a.name, err)
+		}
+		out = append(out, meetDownload{Artifact: a.name, Path: path, Size: size})
+	}
+	return out, nil
+}
+
+func downloadMeetArtifact(ctx context.Context, svc *drive.Service, fileID, dir, format string) (string, int64, error) {
+	meta, err := svc.Files.Get(fileID).
+		SupportsAllDrives(true).
+		Fields("id, name, mimeType").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", 0, err
+	}
+	if meta.Name == "" {
+		return "", 0, errors.New("file has no name")
+	}
+	destPath, err := resolveDriveDownloadDestPath(meta, dir)
+	if err != nil {
+		return "", 0, err
+	}
+	return downloadDriveFile(ctx, svc, meta, destPath, format)
+}
+
+func meetClock(ts string) string {
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return ts
+	}
+	return t.Local().Format("15:04:05")
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/meet/v2"
+	"google.golang.org/api/option"
+)
+
+func newTestMeetServices(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	meetSvc, err := meet.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("meet.NewService: %v", err)
+	}
+	driveSvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("drive.NewService: %v", err)
+	}
+	origMeet, origDrive := newMeetService, newMeetDriveService
+	t.Cleanup(func() {
+		newMeetService = origMeet
+		newMeetDriveService = origDrive
+	})
+	newMeetService = func(context.Context, string) (*meet.Service, error) { return meetSvc, nil }
+	newMeetDriveService = func(context.Context, string) (*drive.Service, error) { return driveSvc, nil }
+}
+
+func TestMeetConferenceFilter(t *testing.T) {
+	got, err := meetConferenceFilter("https://meet.google.com/abc-mnop-xyz?authuser=0", "2026-01-02", "end_time IS NULL")
+	if err != nil {
+		t.Fatalf("filter: %v", err)
+	}
+	if !strings.HasPrefix(got, `space.meeting_code = "abc-mnop-xyz" AND start_time >= "2026-01-02T`) || !strings.HasSuffix(got, " AND end_time IS NULL") {
+		t.Fatalf("unexpected filter: %s", got)
+	}
+	got, err = meetConferenceFilter("spaces/S1", "", "")
+	if err != nil || got != `space.name = "spaces/S1"` {
+		t.Fatalf("unexpected filter: %q (%v)", got, err)
+	}
+}
+
+func TestMeetConferencesList(t *testing.T) {
+	var filter string
+	newTestMeetServices(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/conferenceRecords" {
+			http.NotFound(w, r)
+			return
+		}
+		filter = r.URL.Query().Get("filter")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"conferenceRecords": []any{
+			map[string]any{"name": "conferenceRecords/c1", "space": "spaces/s1", "startTime": "2026-01-02T10:00:00Z", "endTime": "2026-01-02T11:00:00Z"},
+			map[string]any{"name": "conferenceRecords/c2", "space": "spaces/s1", "startTime": "2026-01-03T10:00:00Z"},
+		}})
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "meet", "conferences", "list", "--space", "abc-mnop-xyz"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if filter != `space.meeting_code = "abc-mnop-xyz"` {
+		t.Fatalf("unexpected filter: %q", filter)
+	}
+	if !strings.Contains(out, "c1") || !strings.Contains(out, "(ongoing)") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestMeetArtifacts_EntriesAndDownload(t *testing.T) {
+	newTestMeetServices(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/conferenceRecords/c1/recordings":
+			_ = json.NewEncoder(w).Encode(map[string]any{"recordings": []any{
+				map[string]any{"name": "conferenceRecords/c1/recordings/r1", "state": "FILE_GENERATED", "driveDestination": map[string]any{"file": "vid1"}},
+				map[string]any{"name": "conferenceRecords/c1/recordings/r2", "state": "STARTED"},
+			}})
+		case "/v2/conferenceRecords/c1/transcripts":
+			_ = json.NewEncoder(w).Encode(map[string]any{"transcripts": []any{
+				map[string]any{"name": "conferenceRecords/c1/transcripts/t1", "state": "FILE_GENERATED", "docsDestination": map[string]any{"document": "doc1"}},
+			}})
+		case "/v2/conferenceRecords/c1/participants":
+			_ = json.NewEncoder(w).Encode(map[string]any{"participants": []any{
+				map[string]any{"name": "conferenceRecords/c1/participants/p1", "signedinUser": map[string]any{"displayName": "Ada"}},
+			}})
+		case "/v2/conferenceRecords/c1/transcripts/t1/entries":
+			_ = json.NewEncoder(w).Encode(map[string]any{"transcriptEntries": []any{
+				map[string]any{"participant": "conferenceRecords/c1/participants/p1", "text": "Hello all", "startTime": "2026-01-02T10:00:05Z"},
+			}})
+		case "/files/vid1":
+			if r.URL.Query().Get("alt") == "media" {
+				_, _ = io.WriteString(w, "VIDEO")
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "vid1", "name": "Standup.mp4", "mimeType": "video/mp4"})
+		case "/files/doc1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "doc1", "name": "Standup - Transcript", "mimeType": "application/vnd.google-apps.document"})
+		case "/files/doc1/export":
+			if r.URL.Query().Get("mimeType") != "text/plain" {
+				http.Error(w, "bad mime", http.StatusBadRequest)
+				return
+			}
+			_, _ = io.WriteString(w, "Ada: Hello all")
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	dir := t.TempDir()
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "meet", "artifacts", "c1", "--entries", "--out", dir}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	var parsed struct {
+		Entries   []meetTranscriptEntry `json:"entries"`
+		Downloads []meetDownload        `json:"downloads"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if len(parsed.Entries) != 1 || parsed.Entries[0].Speaker != "Ada" || parsed.Entries[0].Text != "Hello all" {
+		t.Fatalf("unexpected entries: %+v", parsed.Entries)
+	}
+	if len(parsed.Downloads) != 2 {
+		t.Fatalf("expected 2 downloads (processing recording skipped), got %+v", parsed.Downloads)
+	}
+	video, err := os.ReadFile(filepath.Join(dir, "vid1_Standup.mp4"))
+	if err != nil || string(video) != "VIDEO" {
+		t.Fatalf("video: %q %v", video, err)
+	}
+	transcript, err := os.ReadFile(filepath.Join(dir, "doc1_Standup - Transcript.txt"))
+	if err != nil || string(transcript) != "Ada: Hello all" {
+		t.Fatalf("transcript: %q %v", transcript, err)
+	}
+}
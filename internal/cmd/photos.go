@@ -0,0 +1,540 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/googleapi"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var newPhotosService = googleapi.NewPhotos
+
+// Photos Library API maximum page size for media items.
+const maxPhotosPageSize = 100
+
+type PhotosCmd struct {
+	List     PhotosListCmd     `cmd:"" name:"list" aliases:"ls" help:"List media items uploaded by gog"`
+	Upload   PhotosUploadCmd   `cmd:"" name:"upload" help:"Upload files or directories, optionally into an album"`
+	Download PhotosDownloadCmd `cmd:"" name:"download" help:"Download media items uploaded by gog into a directory"`
+	Albums   PhotosAlbumsCmd   `cmd:"" name:"albums" help:"List or create albums"`
+}
+
+// photosFilter selects media items by album and/or creation time.
+type photosFilter struct {
+	Album string `name:"album" help:"Only items in this album ID"`
+	Since string `name:"since" help:"Only items created since (duration like 7d, date or RFC3339)"`
+}
+
+type PhotosListCmd struct {
+	photosFilter
+	Max  int64  `name:"max" aliases:"limit" help:"Max results per page (max 100)" default:"50"`
+	Page string `name:"page" help:"Page token"`
+	All  bool   `name:"all" help:"Fetch all pages"`
+}
+
+func (c *PhotosListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if c.Max <= 0 {
+		return usage("--max must be positive")
+	}
+	svc, err := newPhotosService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var items []*googleapi.PhotosMediaItem
+	pageToken := c.Page
+	for {
+		page, next, listErr := c.photosFilter.page(ctx, svc, min(c.Max, maxPhotosPageSize), pageToken)
+		if listErr != nil {
+			return listErr
+		}
+		items = append(items, page...)
+		pageToken = next
+		if !c.All || pageToken == "" {
+			break
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"mediaItems":    items,
+			"nextPageToken": pageToken,
+		})
+	}
+	if len(items) == 0 {
+		u.Err().Println("No media items (only items uploaded by gog are visible to the API)")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	fmt.Fprintln(w, "ID\tFILENAME\tTYPE\tCREATED")
+	for _, item := range items {
+		created := ""
+		if item.MediaMetadata != nil {
+			created = formatDateTime(item.MediaMetadata.CreationTime)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.ID, sanitizeTab(item.Filename), item.MimeType, created)
+	}
+	flush()
+	printNextPageHint(u, pageToken)
+	return nil
+}
+
+// page fetches one page of matching media items. The API can't combine an
+// album with a date filter, and date filters are whole days, so --since is
+// also applied client-side on the creation time.
+func (f photosFilter) page(ctx context.Context, svc *googleapi.Photos, pageSize int64, pageToken string) ([]*googleapi.PhotosMediaItem, string, error) {
+	album := strings.TrimSpace(f.Album)
+	var since time.Time
+	if s := strings.TrimSpace(f.Since); s != "" {
+		ts, err := parseTrackingSince(s)
+		if err != nil {
+			return nil, "", err
+		}
+		if since, err = time.Parse(time.RFC3339, ts); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var (
+		page *googleapi.PhotosMediaItemsPage
+		err  error
+	)
+	switch {
+	case album != "":
+		page, err = svc.SearchMediaItems(ctx, googleapi.PhotosSearch{AlbumID: album, PageSize: pageSize, PageToken: pageToken})
+	case !since.IsZero():
+		today := time.Now()
+		page, err = svc.SearchMediaItems(ctx, googleapi.PhotosSearch{
+			Start:     &googleapi.PhotosDate{Year: since.Year(), Month: int(since.Month()), Day: since.Day()},
+			End:       &googleapi.PhotosDate{Year: today.Year(), Month: int(today.Month()), Day: today.Day()},
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+	default:
+		page, err = svc.ListMediaItems(ctx, pageSize, pageToken)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if since.IsZero() {
+		return page.MediaItems, page.NextPageToken, nil
+	}
+	items := make([]*googleapi.PhotosMediaItem, 0, len(page.MediaItems))
+	for _, item := range page.MediaItems {
+		if item.MediaMetadata == nil {
+			continue
+		}
+		created, parseErr := time.Parse(time.RFC3339, item.MediaMetadata.CreationTime)
+		if parseErr == nil && !created.Before(since) {
+			items = append(items, item)
+		}
+	}
+	return items, page.NextPageToken, nil
+}
+
+type PhotosDownloadCmd struct {
+	photosFilter
+	Out       string `name:"out" help:"Output directory" default:"."`
+	Max       int    `name:"max" help:"Stop after N items (0 = all)" default:"0"`
+	Overwrite bool   `name:"overwrite" help:"Overwrite existing files instead of skipping them"`
+}
+
+type photosDownloadResult struct {
+	ID     string `json:"id"`
+	Path   string `json:"path"`
+	Size   int64  `json:"size,omitempty"`
+	Status string `json:"status"` // downloaded, skipped
+}
+
+func (c *PhotosDownloadCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	dir, err := config.ExpandPath(strings.TrimSpace(c.Out))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	svc, err := newPhotosService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	results := []photosDownloadResult{}
+	pageToken := ""
+	for {
+		items, next, listErr := c.photosFilter.page(ctx, svc, maxPhotosPageSize, pageToken)
+		if listErr != nil {
+			return listErr
+		}
+		for _, item := range items {
+			if c.Max > 0 && len(results) >= c.Max {
+				break
+			}
+			res, dlErr := downloadPhotosItem(ctx, svc, item, dir, c.Overwrite)
+			if dlErr != nil {
+				return fmt.Errorf("%s: %w", item.Filename, dlErr)
+			}
+			results = append(results, res)
+			if !outfmt.IsJSON(ctx) {
+				u.Out().Printf("%s\t%s", res.Status, res.Path)
+			}
+		}
+		pageToken = next
+		if pageToken == "" || (c.Max > 0 && len(results) >= c.Max) {
+			break
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"downloads": results})
+	}
+	if len(results) == 0 {
+		u.Err().Println("No media items (only items uploaded by gog are visible to the API)")
+	}
+	return nil
+}
+
+func downloadPhotosItem(ctx context.Context, svc *googleapi.Photos, item *googleapi.PhotosMediaItem, dir string, overwrite bool) (photosDownloadResult, error) {
+	name := filepath.Base(item.Filename)
+	if name == "" || name == "." || name == ".." || name == string(filepath.Separator) {
+		name = item.ID
+	}
+	path := filepath.Join(dir, name)
+	res := photosDownloadResult{ID: item.ID, Path: path}
+	if !overwrite {
+		if _, err := os.Stat(path); err == nil {
+			res.Status = "skipped"
+			return res, nil
+		}
+	}
+
+	resp, err := svc.Download(ctx, item)
+	if err != nil {
+		return res, err
+	}
+	defer resp.Body.Close()
+	f, err := os.Create(path) //nolint:gosec // output directory chosen by the user
+	if err != nil {
+		return res, err
+	}
+	n, copyErr := io.Copy(f, resp.Body)
+	if closeErr := f.Close(); copyErr == nil {
+		copyErr = closeErr
+	}
+	if copyErr != nil {
+		return res, copyErr
+	}
+	res.Size = n
+	res.Status = "downloaded"
+	return res, nil
+}
+
+type PhotosUploadCmd struct {
+	Paths       []string `arg:"" name:"path" help:"Files or directories to upload"`
+	Album       string   `name:"album" help:"Add uploads to this album ID (must have been created by gog)"`
+	NewAlbum    string   `name:"new-album" help:"Create an album with this title and add the uploads to it"`
+	Recursive   bool     `name:"recursive" short:"r" help:"Include subdirectories"`
+	Description string   `name:"description" help:"Description for every uploaded item"`
+}
+
+type photosUploadResult struct {
+	File   string `json:"file"`
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status"` // uploaded, failed
+	Error  string `json:"error,omitempty"`
+}
+
+func (c *PhotosUploadCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	albumID := strings.TrimSpace(c.Album)
+	newAlbum := strings.TrimSpace(c.NewAlbum)
+	if albumID != "" && newAlbum != "" {
+		return usage("use either --album or --new-album")
+	}
+	files, err := collectPhotosUploadFiles(c.Paths, c.Recursive)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return usage("no photos or videos found")
+	}
+
+	svc, err := newPhotosService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if newAlbum != "" {
+		album, createErr := svc.CreateAlbum(ctx, newAlbum)
+		if createErr != nil {
+			return createErr
+		}
+		albumID = album.ID
+		if !outfmt.IsJSON(ctx) {
+			u.Err().Printf("Created album %s (%s)", newAlbum, albumID)
+		}
+	}
+
+	results := make([]photosUploadResult, 0, len(files))
+	// Upload bytes first, then register them in batches of the API maximum.
+	var batch []googleapi.PhotosNewMediaItem
+	batchFiles := map[string]int{}
+	flushBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		created, createErr := svc.BatchCreate(ctx, albumID, batch)
+		if createErr != nil {
+			return createErr
+		}
+		for _, r := range created {
+			i, ok := batchFiles[r.UploadToken]
+			if !ok {
+				continue
+			}
+			if r.MediaItem != nil && (r.Status == nil || r.Status.Code == 0) {
+				results[i].Status = "uploaded"
+				results[i].ID = r.MediaItem.ID
+			} else if r.Status != nil {
+				results[i].Error = r.Status.Message
+			}
+		}
+		batch = batch[:0]
+		clear(batchFiles)
+		return nil
+	}
+	for _, file := range files {
+		results = append(results, photosUploadResult{File: file, Status: "failed"})
+		i := len(results) - 1
+		token, uploadErr := uploadPhotosFile(ctx, svc, file)
+		if uploadErr != nil {
+			results[i].Error = uploadErr.Error()
+			continue
+		}
+		batchFiles[token] = i
+		batch = append(batch, googleapi.PhotosNewMediaItem{
+			UploadToken: token,
+			FileName:    filepath.Base(file),
+			Description: c.Description,
+		})
+		if len(batch) == googleapi.PhotosBatchCreateLimit {
+			if err := flushBatch(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flushBatch(); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Status != "uploaded" {
+			failed++
+		}
+	}
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{"albumId": albumID, "uploads": results}); err != nil {
+			return err
+		}
+	} else {
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "FILE\tSTATUS\tID\tERROR")
+		for _, r := range results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", sanitizeTab(r.File), r.Status, r.ID, sanitizeTab(r.Error))
+		}
+		flush()
+	}
+	if failed > 0 {
+		return &ExitError{Code: 1, Err: fmt.Errorf("%d of %d upload(s) failed", failed, len(results))}
+	}
+	return nil
+}
+
+func uploadPhotosFile(ctx context.Context, svc *googleapi.Photos, path string) (string, error) {
+	f, err := os.Open(path) //nolint:gosec // user-provided path
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return svc.Upload(ctx, f, filepath.Base(path), photosMimeType(path))
+}
+
+// collectPhotosUploadFiles expands directories to the photos and videos they
+// contain; files named explicitly are always included.
+func collectPhotosUploadFiles(paths []string, recursive bool) ([]string, error) {
+	var out []string
+	for _, p := range paths {
+		p, err := config.ExpandPath(strings.TrimSpace(p))
+		if err != nil {
+			return nil, err
+		}
+		st, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !st.IsDir() {
+			out = append(out, p)
+			continue
+		}
+		var found []string
+		walkErr := filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != p && (!recursive || strings.HasPrefix(d.Name(), ".")) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasPrefix(d.Name(), ".") && photosMimeType(path) != "" {
+				found = append(found, path)
+			}
+			return nil
+		})
+		if walkErr != nil {
+			return nil, walkErr
+		}
+		sort.Strings(found)
+		out = append(out, found...)
+	}
+	return out, nil
+}
+
+// photosMimeTypes lists the formats Google Photos accepts by extension.
+var photosMimeTypes = map[string]string{
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".gif":  "image/gif",
+	".webp": "image/webp",
+	".heic": "image/heic",
+	".heif": "image/heif",
+	".avif": "image/avif",
+	".bmp":  "image/bmp",
+	".tif":  "image/tiff",
+	".tiff": "image/tiff",
+	".ico":  "image/x-icon",
+	".dng":  "image/x-adobe-dng",
+	".cr2":  "image/x-canon-cr2",
+	".nef":  "image/x-nikon-nef",
+	".arw":  "image/x-sony-arw",
+	".mp4":  "video/mp4",
+	".m4v":  "video/x-m4v",
+	".mov":  "video/quicktime",
+	".3gp":  "video/3gpp",
+	".avi":  "video/x-msvideo",
+	".mkv":  "video/x-matroska",
+	".webm": "video/webm",
+	".wmv":  "video/x-ms-wmv",
+	".mpg":  "video/mpeg",
+	".mpeg": "video/mpeg",
+	".mts":  "video/mp2t",
+	".m2ts": "video/mp2t",
+}
+
+func photosMimeType(path string) string {
+	return photosMimeTypes[strings.ToLower(filepath.Ext(path))]
+}
+
+type PhotosAlbumsCmd struct {
+	List   PhotosAlbumsListCmd   `cmd:"" name:"list" aliases:"ls" default:"withargs" help:"List albums created by gog"`
+	Create PhotosAlbumsCreateCmd `cmd:"" name:"create" help:"Create an album"`
+}
+
+type PhotosAlbumsListCmd struct {
+	Max  int64  `name:"max" aliases:"limit" help:"Max results (max 50)" default:"50"`
+	Page string `name:"page" help:"Page token"`
+}
+
+func (c *PhotosAlbumsListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	svc, err := newPhotosService(ctx, account)
+	if err != nil {
+		return err
+	}
+	page, err := svc.ListAlbums(ctx, c.Max, c.Page)
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"albums":        page.Albums,
+			"nextPageToken": page.NextPageToken,
+		})
+	}
+	if len(page.Albums) == 0 {
+		u.Err().Println("No albums")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	fmt.Fprintln(w, "ID\tTITLE\tITEMS")
+	for _, a := range page.Albums {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", a.ID, sanitizeTab(a.Title), a.MediaItemsCount)
+	}
+	flush()
+	printNextPageHint(u, page.NextPageToken)
+	return nil
+}
+
+type PhotosAlbumsCreateCmd struct {
+	Title string `arg:"" name:"title" help:"Album title"`
+}
+
+func (c *PhotosAlbumsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	title := strings.TrimSpace(c.Title)
+	if title == "" {
+		return errors.New("empty album title")
+	}
+	svc, err := newPhotosService(ctx, account)
+	if err != nil {
+		return err
+	}
+	album, err := svc.CreateAlbum(ctx, title)
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"album": album})
+	}
+	u.Out().Printf("id\t%s", album.ID)
+	u.Out().Printf("title\t%s", album.Title)
+	if album.ProductURL != "" {
+		u.Out().Printf("url\t%s", album.ProductURL)
+	}
+	return nil
+}
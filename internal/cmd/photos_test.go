@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/googleapi"
+)
+
+func newTestPhotosService(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	svc := googleapi.NewPhotosWithClient(srv.Client(), srv.URL+"/v1/")
+	orig := newPhotosService
+	t.Cleanup(func() { newPhotosService = orig })
+	newPhotosService = func(context.Context, string) (*googleapi.Photos, error) { return svc, nil }
+	return srv
+}
+
+func TestPhotosUploadDirBatchesIntoNewAlbum(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 52; i++ {
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("img%02d.jpg", i)), []byte("x"), 0o600); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("skip"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	var (
+		mu         sync.Mutex
+		uploads    int
+		batchSizes []int
+		albumIDs   []string
+	)
+	newTestPhotosService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/albums":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "A1", "title": "Trip"})
+		case "/v1/uploads":
+			if r.Header.Get("X-Goog-Upload-Content-Type") != "image/jpeg" {
+				t.Errorf("unexpected upload content type: %q", r.Header.Get("X-Goog-Upload-Content-Type"))
+			}
+			uploads++
+			_, _ = io.WriteString(w, "tok-"+r.Header.Get("X-Goog-Upload-File-Name"))
+		case "/v1/mediaItems:batchCreate":
+			var body struct {
+				AlbumID       string `json:"albumId"`
+				NewMediaItems []struct {
+					SimpleMediaItem struct {
+						UploadToken string `json:"uploadToken"`
+					} `json:"simpleMediaItem"`
+				} `json:"newMediaItems"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			batchSizes = append(batchSizes, len(body.NewMediaItems))
+			albumIDs = append(albumIDs, body.AlbumID)
+			results := []any{}
+			for _, it := range body.NewMediaItems {
+				tok := it.SimpleMediaItem.UploadToken
+				if tok == "tok-img51.jpg" {
+					results = append(results, map[string]any{"uploadToken": tok, "status": map[string]any{"code": 3, "message": "bad image"}})
+					continue
+				}
+				results = append(results, map[string]any{"uploadToken": tok, "mediaItem": map[string]any{"id": "M-" + tok}})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"newMediaItemResults": results})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	var execErr error
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			execErr = Execute([]string{"--json", "--account", "a@b.com", "photos", "upload", dir, "--new-album", "Trip"})
+		})
+	})
+	if execErr == nil {
+		t.Fatalf("expected error for failed item")
+	}
+	if uploads != 52 {
+		t.Fatalf("expected 52 uploads, got %d", uploads)
+	}
+	if len(batchSizes) != 2 || batchSizes[0] != 50 || batchSizes[1] != 2 {
+		t.Fatalf("unexpected batches: %v", batchSizes)
+	}
+	if albumIDs[0] != "A1" || albumIDs[1] != "A1" {
+		t.Fatalf("unexpected album ids: %v", albumIDs)
+	}
+	var parsed struct {
+		AlbumID string               `json:"albumId"`
+		Uploads []photosUploadResult `json:"uploads"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if parsed.AlbumID != "A1" || len(parsed.Uploads) != 52 {
+		t.Fatalf("unexpected output: %s", out)
+	}
+	if parsed.Uploads[0].Status != "uploaded" || parsed.Uploads[0].ID != "M-tok-img00.jpg" {
+		t.Fatalf("unexpected first result: %+v", parsed.Uploads[0])
+	}
+	if last := parsed.Uploads[51]; last.Status != "failed" || last.Error != "bad image" {
+		t.Fatalf("unexpected last result: %+v", last)
+	}
+}
+
+func TestPhotosDownloadSince(t *testing.T) {
+	var search map[string]any
+	var srv *httptest.Server
+	srv = newTestPhotosService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/mediaItems:search":
+			_ = json.NewDecoder(r.Body).Decode(&search)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"mediaItems": []any{
+				map[string]any{"id": "old", "filename": "old.jpg", "baseUrl": srv.URL + "/media/old", "mediaMetadata": map[string]any{"creationTime": "2026-01-01T08:00:00Z", "photo": map[string]any{}}},
+				map[string]any{"id": "new", "filename": "new.jpg", "baseUrl": srv.URL + "/media/new", "mediaMetadata": map[string]any{"creationTime": "2026-01-01T12:00:00Z", "photo": map[string]any{}}},
+				map[string]any{"id": "vid", "filename": "clip.mp4", "baseUrl": srv.URL + "/media/vid", "mediaMetadata": map[string]any{"creationTime": "2026-01-02T12:00:00Z", "video": map[string]any{}}},
+			}})
+		case "/media/new=d":
+			_, _ = io.WriteString(w, "photo-bytes")
+		case "/media/vid=dv":
+			_, _ = io.WriteString(w, "video-bytes")
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	dir := t.TempDir()
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "photos", "download", "--since", "2026-01-01T10:00:00Z", "--out", dir}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	filters, _ := search["filters"].(map[string]any)
+	if filters == nil || !strings.Contains(fmt.Sprint(filters["dateFilter"]), "day:1 month:1 year:2026") {
+		t.Fatalf("unexpected search: %v", search)
+	}
+	if n := decodeJSONArrayLen(t, out, "downloads"); n != 2 {
+		t.Fatalf("expected 2 downloads, got %d: %s", n, out)
+	}
+	if b, err := os.ReadFile(filepath.Join(dir, "clip.mp4")); err != nil || string(b) != "video-bytes" {
+		t.Fatalf("unexpected video: %q (%v)", b, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "old.jpg")); !os.IsNotExist(err) {
+		t.Fatalf("old item should not be downloaded")
+	}
+
+	// A second run skips files that already exist.
+	out = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "photos", "download", "--since", "2026-01-01T10:00:00Z", "--out", dir}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"status": "skipped"`) || strings.Contains(out, `"status": "downloaded"`) {
+		t.Fatalf("expected skipped downloads: %s", out)
+	}
+}
+
+func TestPhotosListAlbum(t *testing.T) {
+	var search map[string]any
+	newTestPhotosService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/mediaItems:search" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&search)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"mediaItems":    []any{map[string]any{"id": "m1", "filename": "a.jpg", "mimeType": "image/jpeg"}},
+			"nextPageToken": "next",
+		})
+	})
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "photos", "list", "--album", "A1", "--max", "500"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if search["albumId"] != "A1" || search["pageSize"] != float64(100) || search["filters"] != nil {
+		t.Fatalf("unexpected search: %v", search)
+	}
+	if !strings.Contains(out, "m1") || !strings.Contains(out, "a.jpg") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
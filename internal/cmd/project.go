@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+)
+
+var loadProject = config.LoadProject
+
+// loadDefaults layers the settings that sit below flags and env vars: an
+// explicitly selected profile (--profile/GOG_PROFILE) beats .gogcli.toml,
+// which beats the profile made current with `gog auth profile use`.
+func loadDefaults(profile string) (config.Project, error) {
+	project, _, err := loadProject()
+	if err != nil {
+		// A broken file in some parent directory must not take down every
+		// command (help, version, auth included); run without it.
+		_, _ = fmt.Fprintf(os.Stderr, "warning: ignoring %v\n", err)
+		project = config.Project{}
+	}
+
+	name, explicit, err := activeProfileName(profile)
+	if err != nil || name == "" {
+		return project, err
+	}
+	p, err := config.GetProfile(name)
+	if err != nil {
+		return config.Project{}, err
+	}
+
+	layers := []config.Project{project, p.Project()}
+	if explicit {
+		layers = []config.Project{p.Project(), project}
+	}
+	merged := layers[0]
+	for _, l := range layers[1:] {
+		merged.Account = firstNonEmpty(merged.Account, l.Account)
+		merged.Client = firstNonEmpty(merged.Client, l.Client)
+		merged.Output = firstNonEmpty(merged.Output, l.Output)
+		merged.Calendar = firstNonEmpty(merged.Calendar, l.Calendar)
+		merged.DriveFolder = firstNonEmpty(merged.DriveFolder, l.DriveFolder)
+	}
+	merged.Path = project.Path
+	return merged, nil
+}
+
+// activeProfileName returns the selected profile (--profile, else
+// GOG_PROFILE) or the current one from config.json; explicit reports the former.
+func activeProfileName(profile string) (string, bool, error) {
+	if name := config.NormalizeProfileName(firstNonEmpty(profile, os.Getenv("GOG_PROFILE"))); name != "" {
+		return name, true, nil
+	}
+	_, current, err := config.ListProfiles()
+	if err != nil {
+		return "", false, err
+	}
+	return current, false, nil
+}
+
+// profileFromArgs finds --profile on the raw command line; the profile feeds
+// parser defaults, so it is needed before parsing.
+func profileFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if v, ok := strings.CutPrefix(arg, "--profile="); ok {
+			return v
+		}
+		if arg == "--profile" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// applyProjectOutput lets .gogcli.toml pick the output mode unless GOG_JSON or
+// GOG_PLAIN already did.
+func applyProjectOutput(mode outfmt.Mode, p config.Project) outfmt.Mode {
+	if mode.JSON || mode.Plain {
+		return mode
+	}
+	switch p.Output {
+	case "json":
+		mode.JSON = true
+	case "plain":
+		mode.Plain = true
+	}
+	return mode
+}
+
+// projectResolver fills unset --calendar flags on calendar commands and
+// --parent on drive ls/upload/mkdir from .gogcli.toml.
+func projectResolver(p config.Project) kong.Resolver {
+	return kong.ResolverFunc(func(_ *kong.Context, parent *kong.Path, flag *kong.Flag) (any, error) {
+		node := parent.Node()
+		if node == nil {
+			return nil, nil
+		}
+		path := strings.Fields(node.FullPath())
+		if len(path) < 3 {
+			return nil, nil
+		}
+		switch {
+		case path[1] == "calendar" && p.Calendar != "":
+			// Only conflicts defaults --calendars; elsewhere it widens a search.
+			if flag.Name == "calendar" || (flag.Name == "calendars" && path[2] == "conflicts") {
+				return p.Calendar, nil
+			}
+		case path[1] == "drive" && flag.Name == "parent" && p.DriveFolder != "":
+			switch path[2] {
+			case "ls", "upload", "mkdir":
+				return p.DriveFolder, nil
+			}
+		}
+		return nil, nil
+	})
+}
+
+// defaultCalendarID is the calendar used when a command's calendar argument
+// is omitted: the profile or .gogcli.toml calendar, else primary.
+func defaultCalendarID(flags *RootFlags) string {
+	profile := ""
+	if flags != nil {
+		profile = flags.Profile
+	}
+	if p, err := loadDefaults(profile); err == nil && p.Calendar != "" {
+		return p.Calendar
+	}
+	return "primary"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
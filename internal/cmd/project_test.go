@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func chdirProject(t *testing.T, content string) {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, ".gogcli.toml"), []byte(content), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	nested := filepath.Join(root, "src", "pkg")
+	if err := os.MkdirAll(nested, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	t.Chdir(nested)
+}
+
+func TestProjectConfig_PinsAccountOutputAndCalendar(t *testing.T) {
+	t.Setenv("GOG_ACCOUNT", "")
+	t.Setenv("GOG_JSON", "")
+	t.Setenv("GOG_PLAIN", "")
+	chdirProject(t, "account = \"proj@b.com\"\noutput = \"json\"\n\n[calendar]\ndefault = \"team1\"\n")
+
+	var gotAccount, gotPath string
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+			{"id": "e1", "summary": "S", "start": map[string]any{"dateTime": "2025-12-17T10:00:00Z"}, "end": map[string]any{"dateTime": "2025-12-17T11:00:00Z"}},
+		}})
+	})))
+	t.Cleanup(srv.Close)
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+	newCalendarService = func(_ context.Context, account string) (*calendar.Service, error) {
+		gotAccount = account
+		return svc, nil
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"calendar", "events", "--from", "2025-12-17T00:00:00Z", "--to", "2025-12-18T00:00:00Z"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	if gotAccount != "proj@b.com" {
+		t.Fatalf("unexpected account: %q", gotAccount)
+	}
+	if !strings.Contains(gotPath, "/calendars/team1/events") {
+		t.Fatalf("unexpected path: %q", gotPath)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(out), "{") {
+		t.Fatalf("expected JSON output, got %q", out)
+	}
+
+	// Flags still win over the project file.
+	account, err := requireAccount(&RootFlags{Account: "flag@b.com"})
+	if err != nil || account != "flag@b.com" {
+		t.Fatalf("expected flag account, got %q (%v)", account, err)
+	}
+}
+
+func TestProjectConfig_ResolvesFlagDefaults(t *testing.T) {
+	chdirProject(t, "[calendar]\ndefault = \"team1\"\n[drive]\nfolder = \"f1\"\n")
+
+	parser, cli, err := newParser("test")
+	if err != nil {
+		t.Fatalf("newParser: %v", err)
+	}
+	if _, err := parser.Parse([]string{"drive", "ls"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if cli.Drive.Ls.Parent != "f1" {
+		t.Fatalf("unexpected parent: %q", cli.Drive.Ls.Parent)
+	}
+
+	parser, cli, err = newParser("test")
+	if err != nil {
+		t.Fatalf("newParser: %v", err)
+	}
+	if _, err := parser.Parse([]string{"calendar", "search", "standup"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if cli.Calendar.Search.CalendarID != "team1" || cli.Calendar.Search.Calendars != "" {
+		t.Fatalf("unexpected search calendars: %q %q", cli.Calendar.Search.CalendarID, cli.Calendar.Search.Calendars)
+	}
+
+	parser, cli, err = newParser("test")
+	if err != nil {
+		t.Fatalf("newParser: %v", err)
+	}
+	if _, err := parser.Parse([]string{"calendar", "search", "standup", "--calendar", "other"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if cli.Calendar.Search.CalendarID != "other" {
+		t.Fatalf("expected flag to win, got %q", cli.Calendar.Search.CalendarID)
+	}
+}
+
+func TestProjectConfig_InvalidFileWarnsAndIsIgnored(t *testing.T) {
+	setupProfileHome(t)
+	chdirProject(t, "output = json\n")
+
+	var err error
+	errOut := captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			err = Execute([]string{"version"})
+		})
+	})
+	if err != nil {
+		t.Fatalf("bad project file broke the command: %v", err)
+	}
+	if !strings.Contains(errOut, "warning: ignoring") || !strings.Contains(errOut, ".gogcli.toml") {
+		t.Fatalf("expected warning on stderr, got %q", errOut)
+	}
+
+	var out string
+	_ = captureStderr(t, func() {
+		out = captureStdout(t, func() {
+			if err := Execute([]string{"auth", "status"}); err != nil {
+				t.Fatalf("auth status: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "project_config_error\t") {
+		t.Fatalf("expected project error in auth status, got %q", out)
+	}
+}
+
+func TestProjectConfig_UnknownKeysIgnored(t *testing.T) {
+	setupProfileHome(t)
+	t.Setenv("GOG_ACCOUNT", "")
+	chdirProject(t, "acount = \"typo@b.com\"\naccount = \"a@b.com\"\n")
+
+	var out string
+	errOut := captureStderr(t, func() {
+		out = captureStdout(t, func() {
+			if err := Execute([]string{"auth", "status"}); err != nil {
+				t.Fatalf("auth status: %v", err)
+			}
+		})
+	})
+	if strings.Contains(errOut, "warning") {
+		t.Fatalf("unexpected warning: %q", errOut)
+	}
+	if !strings.Contains(out, "project_config_ignored_keys\tacount") {
+		t.Fatalf("expected ignored keys in auth status, got %q", out)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/alecthomas/kong"
 
@@ -24,15 +25,18 @@ const (
 )
 
 type RootFlags struct {
-	Color          string `help:"Color output: auto|always|never" default:"${color}"`
-	Account        string `help:"Account email for API commands (gmail/calendar/chat/classroom/drive/docs/slides/contacts/tasks/people/sheets)"`
-	Client         string `help:"OAuth client name (selects stored credentials + token bucket)" default:"${client}"`
-	EnableCommands string `help:"Comma-separated list of enabled top-level commands (restricts CLI)" default:"${enabled_commands}"`
-	JSON           bool   `help:"Output JSON to stdout (best for scripting)" default:"${json}"`
-	Plain          bool   `help:"Output stable, parseable text to stdout (TSV; no colors)" default:"${plain}"`
-	Force          bool   `help:"Skip confirmations for destructive commands"`
-	NoInput        bool   `help:"Never prompt; fail instead (useful for CI)"`
-	Verbose        bool   `help:"Enable verbose logging"`
+	Color            string `help:"Color output: auto|always|never" default:"${color}"`
+	Account          string `help:"Account email for API commands (gmail/calendar/chat/classroom/drive/docs/slides/forms/contacts/tasks/people/sheets)"`
+	Client           string `help:"OAuth client name (selects stored credentials + token bucket)" default:"${client}"`
+	Profile          string `help:"Named profile (account + defaults; see gog auth profile)" default:"${profile}"`
+	Impersonate      string `help:"Act as this Workspace user via the stored delegated service account key (key of --account, or the only one stored)"`
+	AsServiceAccount bool   `name:"as-service-account" help:"Authenticate via the stored service account key (domain-wide delegation) instead of OAuth; fail if none is configured" default:"${as_service_account}"`
+	EnableCommands   string `help:"Comma-separated list of enabled top-level commands (restricts CLI)" default:"${enabled_commands}"`
+	JSON             bool   `help:"Output JSON to stdout (best for scripting)" default:"${json}"`
+	Plain            bool   `help:"Output stable, parseable text to stdout (TSV; no colors)" default:"${plain}"`
+	Force            bool   `help:"Skip confirmations for destructive commands"`
+	NoInput          bool   `help:"Never prompt; fail instead (useful for CI)"`
+	Verbose          bool   `help:"Enable verbose logging"`
 }
 
 type CLI struct {
@@ -42,14 +46,18 @@ type CLI struct {
 
 	Auth       AuthCmd               `cmd:"" help:"Auth and credentials"`
 	Groups     GroupsCmd             `cmd:"" help:"Google Groups"`
+	Admin      AdminCmd              `cmd:"" help:"Google Workspace admin (Directory API)"`
 	Drive      DriveCmd              `cmd:"" help:"Google Drive"`
 	Docs       DocsCmd               `cmd:"" help:"Google Docs (export via Drive)"`
 	Slides     SlidesCmd             `cmd:"" help:"Google Slides"`
+	Forms      FormsCmd              `cmd:"" help:"Google Forms"`
 	Calendar   CalendarCmd           `cmd:"" help:"Google Calendar"`
 	Classroom  ClassroomCmd          `cmd:"" help:"Google Classroom"`
 	Time       TimeCmd               `cmd:"" help:"Local time utilities"`
 	Gmail      GmailCmd              `cmd:"" aliases:"mail,email" help:"Gmail"`
 	Chat       ChatCmd               `cmd:"" help:"Google Chat"`
+	Meet       MeetCmd               `cmd:"" help:"Google Meet (conference records, recordings, transcripts)"`
+	Photos     PhotosCmd             `cmd:"" help:"Google Photos (upload, albums, download)"`
 	Contacts   ContactsCmd           `cmd:"" help:"Google Contacts"`
 	Tasks      TasksCmd              `cmd:"" help:"Google Tasks"`
 	People     PeopleCmd             `cmd:"" help:"Google People"`
@@ -64,8 +72,9 @@ type CLI struct {
 type exitPanic struct{ code int }
 
 func Execute(args []string) (err error) {
-	parser, cli, err := newParser(helpDescription())
+	parser, cli, err := newParserWithProfile(helpDescription(), profileFromArgs(args))
 	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
 		return err
 	}
 
@@ -111,6 +120,7 @@ func Execute(args []string) (err error) {
 	ctx := context.Background()
 	ctx = outfmt.WithMode(ctx, mode)
 	ctx = authclient.WithClient(ctx, cli.Client)
+	ctx = authclient.WithServiceAccountOnly(ctx, cli.AsServiceAccount)
 
 	uiColor := cli.Color
 	if outfmt.IsJSON(ctx) || outfmt.IsPlain(ctx) {
@@ -127,6 +137,18 @@ func Execute(args []string) (err error) {
 	}
 	ctx = ui.WithUI(ctx, u)
 
+	if subject := strings.TrimSpace(cli.Impersonate); subject != "" {
+		keyAccount, impErr := explicitAccount(&cli.RootFlags)
+		if impErr == nil && !strings.Contains(subject, "@") {
+			impErr = newUsageError(fmt.Errorf("--impersonate needs a user email, got %q", subject))
+		}
+		if impErr != nil {
+			u.Err().Error(errfmt.Format(impErr))
+			return impErr
+		}
+		ctx = authclient.WithImpersonation(ctx, normalizeEmail(subject), keyAccount)
+	}
+
 	kctx.BindTo(ctx, (*context.Context)(nil))
 	kctx.Bind(&cli.RootFlags)
 
@@ -169,16 +191,29 @@ func boolString(v bool) string {
 }
 
 func newParser(description string) (*kong.Kong, *CLI, error) {
-	envMode := outfmt.FromEnv()
+	return newParserWithProfile(description, "")
+}
+
+// newParserWithProfile builds the parser with profile/.gogcli.toml defaults;
+// profile is the --profile value (GOG_PROFILE applies when empty).
+func newParserWithProfile(description string, profileArg string) (*kong.Kong, *CLI, error) {
+	profile := strings.TrimSpace(firstNonEmpty(profileArg, os.Getenv("GOG_PROFILE")))
+	project, err := loadDefaults(profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	envMode := applyProjectOutput(outfmt.FromEnv(), project)
 	vars := kong.Vars{
-		"auth_services":    googleauth.UserServiceCSV(),
-		"color":            envOr("GOG_COLOR", "auto"),
-		"calendar_weekday": envOr("GOG_CALENDAR_WEEKDAY", "false"),
-		"client":           envOr("GOG_CLIENT", ""),
-		"enabled_commands": envOr("GOG_ENABLE_COMMANDS", ""),
-		"json":             boolString(envMode.JSON),
-		"plain":            boolString(envMode.Plain),
-		"version":          VersionString(),
+		"as_service_account": envOr("GOG_AS_SERVICE_ACCOUNT", "false"),
+		"auth_services":      googleauth.UserServiceCSV(),
+		"color":              envOr("GOG_COLOR", "auto"),
+		"calendar_weekday":   envOr("GOG_CALENDAR_WEEKDAY", "false"),
+		"client":             envOr("GOG_CLIENT", project.Client),
+		"enabled_commands":   envOr("GOG_ENABLE_COMMANDS", ""),
+		"json":               boolString(envMode.JSON),
+		"plain":              boolString(envMode.Plain),
+		"profile":            profile,
+		"version":            VersionString(),
 	}
 
 	cli := &CLI{}
@@ -189,6 +224,7 @@ func newParser(description string) (*kong.Kong, *CLI, error) {
 		kong.ConfigureHelp(helpOptions()),
 		kong.Help(helpPrinter),
 		kong.Vars(vars),
+		kong.Resolvers(projectResolver(project)),
 		kong.Writers(os.Stdout, os.Stderr),
 		kong.Exit(func(code int) { panic(exitPanic{code: code}) }),
 	)
@@ -199,7 +235,7 @@ func newParser(description string) (*kong.Kong, *CLI, error) {
 }
 
 func baseDescription() string {
-	return "Google CLI for Gmail/Calendar/Chat/Classroom/Drive/Contacts/Tasks/Sheets/Docs/Slides/People"
+	return "Google CLI for Gmail/Calendar/Chat/Classroom/Drive/Contacts/Tasks/Sheets/Docs/Slides/Forms/People"
 }
 
 func helpDescription() string {
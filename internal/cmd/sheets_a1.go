@@ -101,6 +101,11 @@ func unquoteSheetName(name string) (string, error) {
 	return name, nil
 }
 
+// quoteSheetName is the inverse of unquoteSheetName, for building A1 ranges.
+func quoteSheetName(name string) string {
+	return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+}
+
 func parseA1Cell(ref string) (int, int, error) {
 	matches := a1CellRe.FindStringSubmatch(ref)
 	if matches == nil {
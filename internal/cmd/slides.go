@@ -3,35 +3,56 @@ package cmd
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
 	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/googleapi"
 
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/ui"
 )
 
+var newSlidesService = googleapi.NewSlides
+
 type SlidesCmd struct {
-	Export SlidesExportCmd `cmd:"" name:"export" help:"Export a Google Slides deck (pdf|pptx)"`
-	Info   SlidesInfoCmd   `cmd:"" name:"info" help:"Get Google Slides presentation metadata"`
-	Create SlidesCreateCmd `cmd:"" name:"create" help:"Create a Google Slides presentation"`
-	Copy   SlidesCopyCmd   `cmd:"" name:"copy" help:"Copy a Google Slides presentation"`
+	Export        SlidesExportCmd        `cmd:"" name:"export" help:"Export a Google Slides deck (pdf|pptx)"`
+	Info          SlidesInfoCmd          `cmd:"" name:"info" help:"Get Google Slides presentation metadata"`
+	List          SlidesListCmd          `cmd:"" name:"list" aliases:"ls" help:"List slides (object IDs, titles, optional thumbnail URLs)"`
+	Cat           SlidesCatCmd           `cmd:"" name:"cat" help:"Print slide text (titles, bodies, speaker notes)"`
+	Diff          SlidesDiffCmd          `cmd:"" name:"diff" help:"Compare slide text of two presentations"`
+	Create        SlidesCreateCmd        `cmd:"" name:"create" help:"Create a Google Slides presentation"`
+	Copy          SlidesCopyCmd          `cmd:"" name:"copy" help:"Copy a Google Slides presentation"`
+	AddSlide      SlidesAddSlideCmd      `cmd:"" name:"add-slide" help:"Append or insert a slide with a title and body"`
+	InsertImage   SlidesInsertImageCmd   `cmd:"" name:"insert-image" help:"Insert an image (local file or URL) on a slide"`
+	Reorder       SlidesReorderCmd       `cmd:"" name:"reorder" aliases:"move" help:"Move slides to a new position"`
+	Delete        SlidesDeleteCmd        `cmd:"" name:"delete" aliases:"rm" help:"Delete slides"`
+	MergeTemplate SlidesMergeTemplateCmd `cmd:"" name:"merge-template" help:"Copy a template deck and fill {{placeholders}} with text and images"`
+	BatchUpdate   SlidesBatchUpdateCmd   `cmd:"" name:"batch-update" help:"Send raw Slides API batchUpdate requests from a JSON file"`
 }
 
 type SlidesExportCmd struct {
 	PresentationID string         `arg:"" name:"presentationId" help:"Presentation ID"`
 	Output         OutputPathFlag `embed:""`
-	Format         string         `name:"format" help:"Export format: pdf|pptx" default:"pptx"`
+	Format         string         `name:"format" help:"Export format: pdf|pptx (whole deck, default pptx) or png|jpeg (with --slide, default png)"`
+	Slide          int            `name:"slide" help:"Export a single slide (1-based) as an image"`
+	Size           string         `name:"size" help:"Slide image width with --slide: small (200px), medium (800px), large (1600px)" default:"large"`
 }
 
 func (c *SlidesExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	format := strings.ToLower(strings.TrimSpace(c.Format))
+	if c.Slide != 0 || format == "png" || format == "jpeg" || format == "jpg" {
+		return exportSlideImage(ctx, flags, c.PresentationID, c.Slide, format, c.Size, c.Output.Path)
+	}
 	return exportViaDrive(ctx, flags, exportViaDriveOptions{
 		ArgName:       "presentationId",
 		ExpectedMime:  "application/vnd.google-apps.presentation",
 		KindLabel:     "Google Slides presentation",
 		DefaultFormat: "pptx",
-	}, c.PresentationID, c.Output.Path, c.Format)
+	}, c.PresentationID, c.Output.Path, format)
 }
 
 type SlidesInfoCmd struct {
@@ -47,8 +68,9 @@ func (c *SlidesInfoCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type SlidesCreateCmd struct {
-	Title  string `arg:"" name:"title" help:"Presentation title"`
-	Parent string `name:"parent" help:"Destination folder ID"`
+	Title    string `arg:"" name:"title" help:"Presentation title"`
+	Parent   string `name:"parent" help:"Destination folder ID"`
+	Markdown string `name:"markdown" help:"Build the slides from a Markdown file (--- or # headings start a slide; - for stdin)"`
 }
 
 func (c *SlidesCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -63,6 +85,18 @@ func (c *SlidesCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("empty title")
 	}
 
+	var deck []markdownSlide
+	if path := strings.TrimSpace(c.Markdown); path != "" {
+		data, readErr := readInputFile(path)
+		if readErr != nil {
+			return readErr
+		}
+		deck = parseSlidesMarkdown(string(data))
+		if len(deck) == 0 {
+			return usage("no slides found in --markdown input")
+		}
+	}
+
 	svc, err := newDriveService(ctx, account)
 	if err != nil {
 		return err
@@ -89,19 +123,86 @@ func (c *SlidesCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return errors.New("create failed")
 	}
 
+	if len(deck) > 0 {
+		if err := buildSlidesFromMarkdown(ctx, account, created.Id, deck); err != nil {
+			return fmt.Errorf("presentation %s created, but adding slides failed: %w", created.Id, err)
+		}
+	}
+
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{strFile: created})
+		out := map[string]any{strFile: created}
+		if len(deck) > 0 {
+			out["slides"] = len(deck)
+		}
+		return outfmt.WriteJSON(os.Stdout, out)
 	}
 
 	u.Out().Printf("id\t%s", created.Id)
 	u.Out().Printf("name\t%s", created.Name)
 	u.Out().Printf("mime\t%s", created.MimeType)
+	if len(deck) > 0 {
+		u.Out().Printf("slides\t%d", len(deck))
+	}
 	if created.WebViewLink != "" {
 		u.Out().Printf("link\t%s", created.WebViewLink)
 	}
 	return nil
 }
 
+// buildSlidesFromMarkdown replaces the blank starter slide of a new
+// presentation with the parsed deck, then fills in speaker notes.
+func buildSlidesFromMarkdown(ctx context.Context, account, presentationID string, deck []markdownSlide) error {
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := svc.Presentations.Get(presentationID).Fields("slides.objectId").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	reqs := markdownSlideRequests(deck, "gog_md")
+	for _, s := range pres.Slides {
+		reqs = append(reqs, &slides.Request{DeleteObject: &slides.DeleteObjectRequest{ObjectId: s.ObjectId}})
+	}
+	if _, err := svc.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{Requests: reqs}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	hasNotes := false
+	for _, s := range deck {
+		hasNotes = hasNotes || len(s.Notes) > 0
+	}
+	if !hasNotes {
+		return nil
+	}
+	pres, err = svc.Presentations.Get(presentationID).
+		Fields("slides(objectId,slideProperties.notesPage.notesProperties.speakerNotesObjectId)").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	var notesReqs []*slides.Request
+	for i, s := range pres.Slides {
+		if i >= len(deck) || len(deck[i].Notes) == 0 {
+			continue
+		}
+		if s.SlideProperties == nil || s.SlideProperties.NotesPage == nil || s.SlideProperties.NotesPage.NotesProperties == nil {
+			continue
+		}
+		notesReqs = append(notesReqs, &slides.Request{InsertText: &slides.InsertTextRequest{
+			ObjectId: s.SlideProperties.NotesPage.NotesProperties.SpeakerNotesObjectId,
+			Text:     strings.Join(deck[i].Notes, "\n"),
+		}})
+	}
+	if len(notesReqs) == 0 {
+		return nil
+	}
+	_, err = svc.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{Requests: notesReqs}).Context(ctx).Do()
+	return err
+}
+
 type SlidesCopyCmd struct {
 	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
 	Title          string `arg:"" name:"title" help:"New title"`
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// SlidesBatchUpdateCmd sends raw Slides API requests for features without a
+// dedicated command.
+type SlidesBatchUpdateCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	RequestsFile   string `name:"requests-file" help:"JSON file with a requests array or {\"requests\": [...]} ('-' for stdin)" required:""`
+	DryRun         bool   `name:"dry-run" help:"Validate and print the requests; do not send them"`
+}
+
+func (c *SlidesBatchUpdateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.PresentationID)
+	if id == "" {
+		return usage("empty presentationId")
+	}
+	data, err := readInputFile(strings.TrimSpace(c.RequestsFile))
+	if err != nil {
+		return err
+	}
+	reqs, err := parseSlidesRequests(data)
+	if err != nil {
+		return err
+	}
+	kinds := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		kinds = append(kinds, slidesRequestKind(req))
+	}
+
+	if c.DryRun {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSON(os.Stdout, map[string]any{
+				"presentationId": id,
+				"dryRun":         true,
+				"requests":       reqs,
+			})
+		}
+		for i, kind := range kinds {
+			u.Out().Printf("%d\t%s", i+1, kind)
+		}
+		u.Out().Printf("Dry run: %d requests would be sent to %s", len(reqs), id)
+		return nil
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	resp, err := svc.Presentations.BatchUpdate(id, &slides.BatchUpdatePresentationRequest{Requests: reqs}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"presentationId": resp.PresentationId,
+			"replies":        resp.Replies,
+		})
+	}
+	u.Out().Printf("presentationId\t%s", resp.PresentationId)
+	u.Out().Printf("requests\t%d", len(reqs))
+	u.Out().Printf("replies\t%d", len(resp.Replies))
+	return nil
+}
+
+// parseSlidesRequests accepts either a bare requests array or a full
+// batchUpdate body. Unknown fields are rejected so typos fail before the
+// request reaches the API.
+func parseSlidesRequests(data []byte) ([]*slides.Request, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, usage("empty --requests-file")
+	}
+	var reqs []*slides.Request
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if data[0] == '[' {
+		if err := dec.Decode(&reqs); err != nil {
+			return nil, fmt.Errorf("parse requests: %w", err)
+		}
+	} else {
+		var body slides.BatchUpdatePresentationRequest
+		if err := dec.Decode(&body); err != nil {
+			return nil, fmt.Errorf("parse requests: %w", err)
+		}
+		reqs = body.Requests
+	}
+	if len(reqs) == 0 {
+		return nil, usage("no requests in --requests-file")
+	}
+	for i, req := range reqs {
+		if req == nil || slidesRequestKind(req) == "" {
+			return nil, usagef("request %d is empty", i+1)
+		}
+	}
+	return reqs, nil
+}
+
+func slidesRequestKind(req *slides.Request) string {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
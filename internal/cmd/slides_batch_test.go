@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestParseSlidesRequests(t *testing.T) {
+	reqs, err := parseSlidesRequests([]byte(`[{"deleteObject":{"objectId":"s1"}},{"createSlide":{"objectId":"s9"}}]`))
+	if err != nil || len(reqs) != 2 || slidesRequestKind(reqs[1]) != "createSlide" {
+		t.Fatalf("array: %v %#v", err, reqs)
+	}
+	reqs, err = parseSlidesRequests([]byte(`{"requests":[{"deleteObject":{"objectId":"s1"}}]}`))
+	if err != nil || len(reqs) != 1 || reqs[0].DeleteObject.ObjectId != "s1" {
+		t.Fatalf("object: %v %#v", err, reqs)
+	}
+	for _, bad := range []string{``, `[]`, `[{}]`, `[{"deleteObjekt":{"objectId":"s1"}}]`, `{"requests":[{"deleteObject":{"objectId":"s1"}}],"extra":1}`} {
+		if _, err := parseSlidesRequests([]byte(bad)); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestExecute_SlidesBatchUpdate(t *testing.T) {
+	origSlides := newSlidesService
+	t.Cleanup(func() { newSlidesService = origSlides })
+
+	calls := 0
+	var batch slides.BatchUpdatePresentationRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/deck1:batchUpdate" {
+			calls++
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"presentationId": "deck1",
+				"replies":        []map[string]any{{"replaceAllText": map[string]any{"occurrencesChanged": 3}}},
+			})
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	ssvc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return ssvc, nil }
+
+	path := filepath.Join(t.TempDir(), "req.json")
+	body := `[{"replaceAllText":{"containsText":{"text":"{{x}}","matchCase":true},"replaceText":"y"}}]`
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	dry := captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "slides", "batch-update", "deck1", "--requests-file", path, "--dry-run"}); err != nil {
+			t.Fatalf("dry-run: %v", err)
+		}
+	})
+	if calls != 0 || !strings.Contains(dry, "1\treplaceAllText") {
+		t.Fatalf("unexpected dry run (calls=%d): %q", calls, dry)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "slides", "batch-update", "deck1", "--requests-file", path}); err != nil {
+			t.Fatalf("batch-update: %v", err)
+		}
+	})
+	if calls != 1 || len(batch.Requests) != 1 || batch.Requests[0].ReplaceAllText.ReplaceText != "y" {
+		t.Fatalf("unexpected batch: %#v", batch)
+	}
+	if !strings.Contains(out, `"occurrencesChanged": 3`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesCatCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	NoNotes        bool   `name:"no-notes" help:"Omit speaker notes"`
+}
+
+type slideTextContent struct {
+	Number   int      `json:"number"`
+	ObjectID string   `json:"objectId"`
+	Title    string   `json:"title,omitempty"`
+	Body     []string `json:"body,omitempty"`
+	Notes    string   `json:"notes,omitempty"`
+}
+
+func (c *SlidesCatCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.PresentationID)
+	if id == "" {
+		return usage("empty presentationId")
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := svc.Presentations.Get(id).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	out := make([]slideTextContent, 0, len(pres.Slides))
+	for i, page := range pres.Slides {
+		content := slideText(page)
+		content.Number = i + 1
+		if c.NoNotes {
+			content.Notes = ""
+		}
+		out = append(out, content)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"presentationId": pres.PresentationId,
+			"title":          pres.Title,
+			"slides":         out,
+		})
+	}
+	for i, s := range out {
+		if i > 0 {
+			u.Out().Println("")
+		}
+		header := fmt.Sprintf("--- Slide %d", s.Number)
+		if s.Title != "" {
+			header += ": " + s.Title
+		}
+		u.Out().Println(header + " ---")
+		for _, block := range s.Body {
+			u.Out().Println(block)
+		}
+		if s.Notes != "" {
+			u.Out().Println("")
+			u.Out().Println("Notes:")
+			u.Out().Println(s.Notes)
+		}
+	}
+	return nil
+}
+
+// slideText collects the text of a slide: title placeholders become the title,
+// every other shape or table cell becomes a body block, in page order.
+func slideText(page *slides.Page) slideTextContent {
+	content := slideTextContent{ObjectID: page.ObjectId}
+	var walk func([]*slides.PageElement)
+	walk = func(elements []*slides.PageElement) {
+		for _, el := range elements {
+			switch {
+			case el.ElementGroup != nil:
+				walk(el.ElementGroup.Children)
+			case el.Shape != nil:
+				text := slideTextContentOf(el.Shape.Text)
+				if text == "" {
+					continue
+				}
+				if p := el.Shape.Placeholder; p != nil && (p.Type == "TITLE" || p.Type == "CENTERED_TITLE") && content.Title == "" {
+					content.Title = strings.Join(strings.Fields(text), " ")
+					continue
+				}
+				content.Body = append(content.Body, text)
+			case el.Table != nil:
+				for _, row := range el.Table.TableRows {
+					var cells []string
+					for _, cell := range row.TableCells {
+						cells = append(cells, strings.ReplaceAll(slideTextContentOf(cell.Text), "\n", " "))
+					}
+					if strings.TrimSpace(strings.Join(cells, "")) != "" {
+						content.Body = append(content.Body, strings.Join(cells, "\t"))
+					}
+				}
+			}
+		}
+	}
+	walk(page.PageElements)
+
+	if props := page.SlideProperties; props != nil && props.NotesPage != nil && props.NotesPage.NotesProperties != nil {
+		notesID := props.NotesPage.NotesProperties.SpeakerNotesObjectId
+		for _, el := range props.NotesPage.PageElements {
+			if el.ObjectId == notesID && el.Shape != nil {
+				content.Notes = slideTextContentOf(el.Shape.Text)
+			}
+		}
+	}
+	return content
+}
+
+func slideTextContentOf(t *slides.TextContent) string {
+	if t == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, el := range t.TextElements {
+		if el.TextRun != nil {
+			b.WriteString(el.TextRun.Content)
+		}
+	}
+	// Slides uses vertical tab for soft line breaks inside a paragraph.
+	return strings.TrimSpace(strings.ReplaceAll(b.String(), "\v", "\n"))
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestExecute_SlidesCat(t *testing.T) {
+	origSlides := newSlidesService
+	t.Cleanup(func() { newSlidesService = origSlides })
+
+	text := func(s string) map[string]any {
+		return map[string]any{"textElements": []map[string]any{{"textRun": map[string]any{"content": s}}}}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/presentations/p1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"presentationId": "p1",
+			"title":          "Deck",
+			"slides": []map[string]any{
+				{
+					"objectId": "s1",
+					"pageElements": []map[string]any{
+						{"objectId": "t1", "shape": map[string]any{"placeholder": map[string]any{"type": "TITLE"}, "text": text("Results\n")}},
+						{"objectId": "b1", "shape": map[string]any{"placeholder": map[string]any{"type": "BODY"}, "text": text("Revenue up\nCosts flat\n")}},
+						{"objectId": "tb", "table": map[string]any{"tableRows": []map[string]any{
+							{"tableCells": []map[string]any{{"text": text("Q1\n")}, {"text": text("10\n")}}},
+						}}},
+					},
+					"slideProperties": map[string]any{"notesPage": map[string]any{
+						"notesProperties": map[string]any{"speakerNotesObjectId": "n1"},
+						"pageElements":    []map[string]any{{"objectId": "n1", "shape": map[string]any{"text": text("Say thanks\n")}}},
+					}},
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "slides", "cat", "p1"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	for _, want := range []string{"--- Slide 1: Results ---", "Revenue up\nCosts flat", "Q1\t10", "Notes:\nSay thanks"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "slides", "cat", "p1", "--no-notes"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	var parsed struct {
+		Slides []slideTextContent `json:"slides"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Slides) != 1 || parsed.Slides[0].Title != "Results" || len(parsed.Slides[0].Body) != 2 || parsed.Slides[0].Notes != "" {
+		t.Fatalf("unexpected slides: %#v", parsed.Slides)
+	}
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesDiffCmd struct {
+	PresentationA string `arg:"" name:"presentationA" help:"First (old) presentation ID"`
+	PresentationB string `arg:"" name:"presentationB" help:"Second (new) presentation ID"`
+	NoNotes       bool   `name:"no-notes" help:"Ignore speaker notes"`
+	ExitCode      bool   `name:"exit-code" help:"Exit with status 1 when the decks differ"`
+}
+
+type slideDiffEntry struct {
+	Status string   `json:"status"` // added, removed, changed
+	SlideA int      `json:"slideA,omitempty"`
+	SlideB int      `json:"slideB,omitempty"`
+	TitleA string   `json:"titleA,omitempty"`
+	TitleB string   `json:"titleB,omitempty"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+func (c *SlidesDiffCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	idA, idB := strings.TrimSpace(c.PresentationA), strings.TrimSpace(c.PresentationB)
+	if idA == "" || idB == "" {
+		return usage("empty presentation ID")
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	var decks [2][]slideTextContent
+	for i, id := range []string{idA, idB} {
+		pres, getErr := svc.Presentations.Get(id).Context(ctx).Do()
+		if getErr != nil {
+			return fmt.Errorf("%s: %w", id, getErr)
+		}
+		for n, page := range pres.Slides {
+			content := slideText(page)
+			content.Number = n + 1
+			if c.NoNotes {
+				content.Notes = ""
+			}
+			decks[i] = append(decks[i], content)
+		}
+	}
+
+	changes := diffSlides(decks[0], decks[1])
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSON(os.Stdout, map[string]any{
+			"presentationA": idA,
+			"presentationB": idB,
+			"slidesA":       len(decks[0]),
+			"slidesB":       len(decks[1]),
+			"identical":     len(changes) == 0,
+			"changes":       changes,
+		}); err != nil {
+			return err
+		}
+	} else if len(changes) == 0 {
+		u.Out().Printf("identical\t%d slides", len(decks[0]))
+	} else {
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "STATUS\tA\tB\tTITLE\tFIELDS")
+		for _, ch := range changes {
+			title := ch.TitleB
+			if title == "" {
+				title = ch.TitleA
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", ch.Status, slideDiffNumber(ch.SlideA), slideDiffNumber(ch.SlideB), title, strings.Join(ch.Fields, ","))
+		}
+		flush()
+	}
+
+	if c.ExitCode && len(changes) > 0 {
+		return &ExitError{Code: 1, Err: errors.New("presentations differ")}
+	}
+	return nil
+}
+
+func slideDiffNumber(n int) string {
+	if n == 0 {
+		return "-"
+	}
+	return fmt.Sprint(n)
+}
+
+// diffSlides aligns the two decks on slides with identical text (longest
+// common subsequence), then pairs the unmatched slides between two anchors by
+// position as changed; leftovers are reported as removed or added.
+func diffSlides(a, b []slideTextContent) []slideDiffEntry {
+	key := func(s slideTextContent) string {
+		return s.Title + "\x00" + strings.Join(s.Body, "\x01") + "\x00" + s.Notes
+	}
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if key(a[i]) == key(b[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []slideDiffEntry
+	var gapA, gapB []slideTextContent
+	flushGap := func() {
+		n := min(len(gapA), len(gapB))
+		for k := 0; k < n; k++ {
+			out = append(out, slideDiffEntry{
+				Status: "changed",
+				SlideA: gapA[k].Number, SlideB: gapB[k].Number,
+				TitleA: gapA[k].Title, TitleB: gapB[k].Title,
+				Fields: slideDiffFields(gapA[k], gapB[k]),
+			})
+		}
+		for _, s := range gapA[n:] {
+			out = append(out, slideDiffEntry{Status: "removed", SlideA: s.Number, TitleA: s.Title})
+		}
+		for _, s := range gapB[n:] {
+			out = append(out, slideDiffEntry{Status: "added", SlideB: s.Number, TitleB: s.Title})
+		}
+		gapA, gapB = nil, nil
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && key(a[i]) == key(b[j]):
+			flushGap()
+			i++
+			j++
+		case j >= len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			gapA = append(gapA, a[i])
+			i++
+		default:
+			gapB = append(gapB, b[j])
+			j++
+		}
+	}
+	flushGap()
+	return out
+}
+
+func slideDiffFields(a, b slideTextContent) []string {
+	var fields []string
+	if a.Title != b.Title {
+		fields = append(fields, "title")
+	}
+	if !slices.Equal(a.Body, b.Body) {
+		fields = append(fields, "body")
+	}
+	if a.Notes != b.Notes {
+		fields = append(fields, "notes")
+	}
+	return fields
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestDiffSlides(t *testing.T) {
+	s := func(n int, title string, body ...string) slideTextContent {
+		return slideTextContent{Number: n, Title: title, Body: body}
+	}
+	a := []slideTextContent{s(1, "Intro"), s(2, "Agenda", "one"), s(3, "Old"), s(4, "End")}
+	b := []slideTextContent{s(1, "Intro"), s(2, "Agenda", "one", "two"), s(3, "End"), s(4, "Extra")}
+
+	got := diffSlides(a, b)
+	if len(got) != 3 {
+		t.Fatalf("unexpected diff: %#v", got)
+	}
+	if got[0].Status != "changed" || got[0].SlideA != 2 || got[0].SlideB != 2 || strings.Join(got[0].Fields, ",") != "body" {
+		t.Fatalf("unexpected changed entry: %#v", got[0])
+	}
+	if got[1].Status != "removed" || got[1].SlideA != 3 || got[1].TitleA != "Old" {
+		t.Fatalf("unexpected removed entry: %#v", got[1])
+	}
+	if got[2].Status != "added" || got[2].SlideB != 4 || got[2].TitleB != "Extra" {
+		t.Fatalf("unexpected added entry: %#v", got[2])
+	}
+	if d := diffSlides(a, a); len(d) != 0 {
+		t.Fatalf("expected identical decks, got %#v", d)
+	}
+}
+
+func TestExecute_SlidesDiff_ExitCode(t *testing.T) {
+	origSlides := newSlidesService
+	t.Cleanup(func() { newSlidesService = origSlides })
+
+	deck := func(titles ...string) map[string]any {
+		var pages []map[string]any
+		for i, title := range titles {
+			pages = append(pages, map[string]any{"objectId": fmt.Sprintf("p%d", i+1), "pageElements": []map[string]any{{"shape": map[string]any{
+				"placeholder": map[string]any{"type": "TITLE"},
+				"text":        map[string]any{"textElements": []map[string]any{{"textRun": map[string]any{"content": title}}}},
+			}}}})
+		}
+		return map[string]any{"slides": pages}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/presentations/a":
+			_ = json.NewEncoder(w).Encode(deck("Intro", "Body"))
+		case "/v1/presentations/b":
+			_ = json.NewEncoder(w).Encode(deck("Intro", "Body", "Thanks"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ssvc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return ssvc, nil }
+
+	var runErr error
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			runErr = Execute([]string{"--json", "--account", "a@b.com", "slides", "diff", "a", "b", "--exit-code"})
+		})
+	})
+	if ExitCode(runErr) != 1 {
+		t.Fatalf("expected exit code 1, got %v", runErr)
+	}
+	if !strings.Contains(out, `"status": "added"`) || !strings.Contains(out, `"titleB": "Thanks"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "slides", "diff", "a", "a", "--exit-code"}); err != nil {
+			t.Fatalf("identical decks: %v", err)
+		}
+	})
+}
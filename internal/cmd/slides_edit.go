@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	gapi "google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesAddSlideCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	Layout         string `name:"layout" help:"Predefined layout (TITLE_AND_BODY, TITLE, TITLE_ONLY, SECTION_HEADER, BLANK, ...)" default:"TITLE_AND_BODY"`
+	Title          string `name:"title" help:"Slide title"`
+	Body           string `name:"body" help:"Body text (Markdown list items become bullets)"`
+	BodyFile       string `name:"body-file" help:"Read body text from a file ('-' for stdin)"`
+	At             int    `name:"at" help:"Insert as slide N (1-based; default: append)"`
+}
+
+func (c *SlidesAddSlideCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.PresentationID)
+	if id == "" {
+		return usage("empty presentationId")
+	}
+	if c.Body != "" && strings.TrimSpace(c.BodyFile) != "" {
+		return usage("use only one of --body or --body-file")
+	}
+	if c.At < 0 {
+		return usage("--at must be >= 1")
+	}
+	body := c.Body
+	if path := strings.TrimSpace(c.BodyFile); path != "" {
+		data, readErr := readInputFile(path)
+		if readErr != nil {
+			return readErr
+		}
+		body = string(data)
+	}
+
+	slideID := fmt.Sprintf("gog_%d", time.Now().UnixNano())
+	content := markdownSlide{Title: strings.TrimSpace(c.Title), Body: markdownSlideBody(body)}
+	reqs, err := slideContentRequests(content, slideID, strings.ToUpper(strings.TrimSpace(c.Layout)), int64(c.At)-1)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if _, err := svc.Presentations.BatchUpdate(id, &slides.BatchUpdatePresentationRequest{Requests: reqs}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"presentationId": id, "slideId": slideID})
+	}
+	u.Out().Printf("presentationId\t%s", id)
+	u.Out().Printf("slideId\t%s", slideID)
+	return nil
+}
+
+type SlidesInsertImageCmd struct {
+	PresentationID string  `arg:"" name:"presentationId" help:"Presentation ID"`
+	Slide          int     `name:"slide" help:"Slide number (1-based)" required:""`
+	File           string  `name:"file" help:"Local image file (uploaded to Drive temporarily)"`
+	URL            string  `name:"url" help:"Public image URL"`
+	X              float64 `name:"x" help:"Left offset in points"`
+	Y              float64 `name:"y" help:"Top offset in points"`
+	Width          float64 `name:"width" help:"Width in points (keeps aspect ratio when --height is omitted)"`
+	Height         float64 `name:"height" help:"Height in points"`
+}
+
+func (c *SlidesInsertImageCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.PresentationID)
+	if id == "" {
+		return usage("empty presentationId")
+	}
+	file, imageURL := strings.TrimSpace(c.File), strings.TrimSpace(c.URL)
+	if (file == "") == (imageURL == "") {
+		return usage("specify exactly one of --file or --url")
+	}
+	if c.Slide <= 0 {
+		return usage("--slide must be >= 1")
+	}
+	if c.Width < 0 || c.Height < 0 {
+		return usage("--width/--height must be positive")
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := svc.Presentations.Get(id).Fields("slides.objectId").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if c.Slide > len(pres.Slides) {
+		return usagef("--slide %d out of range (presentation has %d slides)", c.Slide, len(pres.Slides))
+	}
+	pageID := pres.Slides[c.Slide-1].ObjectId
+
+	if file != "" {
+		dsvc, svcErr := newDriveService(ctx, account)
+		if svcErr != nil {
+			return svcErr
+		}
+		uploaded, uploadErr := uploadTempDriveImage(ctx, dsvc, file)
+		if uploadErr != nil {
+			return uploadErr
+		}
+		// Slides stores its own copy of the image, so the upload is only needed
+		// until the batchUpdate returns.
+		defer func() { _ = dsvc.Files.Delete(uploaded).SupportsAllDrives(true).Context(ctx).Do() }()
+		var cleanup func()
+		imageURL, cleanup, err = slidesImageURL(ctx, dsvc, uploaded)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
+
+	imageID := fmt.Sprintf("gog_img_%d", time.Now().UnixNano())
+	props := &slides.PageElementProperties{PageObjectId: pageID}
+	if c.Width > 0 || c.Height > 0 {
+		props.Size = &slides.Size{}
+		if c.Width > 0 {
+			props.Size.Width = &slides.Dimension{Magnitude: c.Width, Unit: "PT"}
+		}
+		if c.Height > 0 {
+			props.Size.Height = &slides.Dimension{Magnitude: c.Height, Unit: "PT"}
+		}
+	}
+	if c.X != 0 || c.Y != 0 {
+		props.Transform = &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: c.X, TranslateY: c.Y, Unit: "PT"}
+	}
+
+	_, err = svc.Presentations.BatchUpdate(id, &slides.BatchUpdatePresentationRequest{Requests: []*slides.Request{{
+		CreateImage: &slides.CreateImageRequest{ObjectId: imageID, Url: imageURL, ElementProperties: props},
+	}}}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"presentationId": id, "slideId": pageID, "imageId": imageID})
+	}
+	u.Out().Printf("slideId\t%s", pageID)
+	u.Out().Printf("imageId\t%s", imageID)
+	return nil
+}
+
+func uploadTempDriveImage(ctx context.Context, svc *drive.Service, path string) (string, error) {
+	path, err := config.ExpandPath(path)
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(path) //nolint:gosec // user-provided path
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	created, err := svc.Files.Create(&drive.File{Name: "gog-slides-upload-" + filepath.Base(path)}).
+		SupportsAllDrives(true).
+		Media(f, gapi.ContentType(guessMimeType(path))).
+		Fields("id").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", err
+	}
+	return created.Id, nil
+}
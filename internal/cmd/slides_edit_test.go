@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestExecute_SlidesAddSlide_JSON(t *testing.T) {
+	origSlides := newSlidesService
+	t.Cleanup(func() { newSlidesService = origSlides })
+
+	var batch slides.BatchUpdatePresentationRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/deck1:batchUpdate" {
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{"presentationId": "deck1"})
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	ssvc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return ssvc, nil }
+
+	bodyPath := filepath.Join(t.TempDir(), "body.md")
+	if err := os.WriteFile(bodyPath, []byte("Intro\n- one\n  - nested\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{
+			"--json", "--account", "a@b.com", "slides", "add-slide", "deck1",
+			"--title", "Agenda", "--body-file", bodyPath, "--at", "2",
+		}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+
+	if len(batch.Requests) != 4 {
+		t.Fatalf("unexpected requests: %#v", batch.Requests)
+	}
+	create := batch.Requests[0].CreateSlide
+	if create == nil || create.InsertionIndex != 1 || create.SlideLayoutReference.PredefinedLayout != "TITLE_AND_BODY" {
+		t.Fatalf("unexpected create request: %#v", batch.Requests[0])
+	}
+	if r := batch.Requests[2].InsertText; r == nil || r.Text != "Intro\none\n\tnested" {
+		t.Fatalf("unexpected body request: %#v", batch.Requests[2])
+	}
+	if !strings.Contains(out, `"slideId": "`+create.ObjectId+`"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestExecute_SlidesInsertImage_File(t *testing.T) {
+	origDrive, origSlides := newDriveService, newSlidesService
+	t.Cleanup(func() { newDriveService, newSlidesService = origDrive, origSlides })
+
+	var batch slides.BatchUpdatePresentationRequest
+	var uploaded, deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/deck1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"slides": []map[string]any{{"objectId": "s1"}, {"objectId": "s2"}}})
+		case r.Method == http.MethodPost && strings.Contains(r.URL.Path, "/upload/drive/v3/files"):
+			uploaded = true
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "up1"})
+		case r.Method == http.MethodPost && r.URL.Path == "/files/up1/permissions":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "perm1"})
+		case r.Method == http.MethodDelete && r.URL.Path == "/files/up1/permissions/perm1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/files/up1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/deck1:batchUpdate":
+			if !uploaded || deleted {
+				http.Error(w, "upload missing during batchUpdate", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{"presentationId": "deck1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	dsvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	ssvc, err := slides.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return dsvc, nil }
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return ssvc, nil }
+
+	imgPath := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(imgPath, []byte("\x89PNG"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{
+			"--json", "--account", "a@b.com", "slides", "insert-image", "deck1",
+			"--slide", "2", "--file", imgPath, "--width", "200", "--x", "50", "--y", "40",
+		}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+
+	if !deleted {
+		t.Fatalf("expected temporary upload to be deleted")
+	}
+	if len(batch.Requests) != 1 || batch.Requests[0].CreateImage == nil {
+		t.Fatalf("unexpected requests: %#v", batch.Requests)
+	}
+	img := batch.Requests[0].CreateImage
+	props := img.ElementProperties
+	if props.PageObjectId != "s2" || !strings.Contains(img.Url, "id=up1") {
+		t.Fatalf("unexpected image request: %#v", img)
+	}
+	if props.Size == nil || props.Size.Width.Magnitude != 200 || props.Size.Height != nil {
+		t.Fatalf("unexpected size: %#v", props.Size)
+	}
+	if props.Transform == nil || props.Transform.TranslateX != 50 || props.Transform.TranslateY != 40 {
+		t.Fatalf("unexpected transform: %#v", props.Transform)
+	}
+	if !strings.Contains(out, `"slideId": "s2"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
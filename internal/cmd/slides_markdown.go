@@ -0,0 +1,256 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"google.golang.org/api/slides/v1"
+)
+
+type markdownSlide struct {
+	Title string
+	Body  []markdownSlideLine
+	Notes []string
+}
+
+type markdownSlideLine struct {
+	Text   string
+	Bullet bool
+	Level  int
+}
+
+// parseSlidesMarkdown splits a Markdown deck into slides. A line of `---` or an
+// H1 heading starts a new slide; the first heading becomes the slide title,
+// list items become bullets (two spaces or a tab per nesting level) and lines
+// inside a `<!-- notes ... -->` block or after `Notes:` go to speaker notes.
+func parseSlidesMarkdown(src string) []markdownSlide {
+	var out []markdownSlide
+	cur := markdownSlide{}
+	inNotes := false
+	flush := func() {
+		if cur.Title != "" || len(cur.Body) > 0 || len(cur.Notes) > 0 {
+			out = append(out, cur)
+		}
+		cur = markdownSlide{}
+		inNotes = false
+	}
+
+	for _, raw := range strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n") {
+		line := strings.TrimRight(raw, " \t")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "---" || trimmed == "***":
+			flush()
+			continue
+		case inNotes:
+			if rest, ok := strings.CutSuffix(trimmed, "-->"); ok {
+				if rest = strings.TrimSpace(rest); rest != "" {
+					cur.Notes = append(cur.Notes, rest)
+				}
+				inNotes = false
+			} else if trimmed != "" {
+				cur.Notes = append(cur.Notes, trimmed)
+			}
+			continue
+		case strings.HasPrefix(trimmed, "<!-- notes") || strings.HasPrefix(trimmed, "<!--notes"):
+			rest := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(trimmed, "<!--"), " "), "notes")
+			rest = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rest), ":"))
+			if body, ok := strings.CutSuffix(rest, "-->"); ok {
+				if body = strings.TrimSpace(body); body != "" {
+					cur.Notes = append(cur.Notes, body)
+				}
+			} else {
+				if rest != "" {
+					cur.Notes = append(cur.Notes, rest)
+				}
+				inNotes = true
+			}
+			continue
+		case strings.HasPrefix(trimmed, "Notes:"):
+			cur.Notes = append(cur.Notes, strings.TrimSpace(strings.TrimPrefix(trimmed, "Notes:")))
+			continue
+		case trimmed == "":
+			continue
+		}
+
+		if heading, level := markdownHeading(trimmed); level > 0 {
+			if level == 1 && (cur.Title != "" || len(cur.Body) > 0) {
+				flush()
+			}
+			if cur.Title == "" {
+				cur.Title = heading
+			} else {
+				cur.Body = append(cur.Body, markdownSlideLine{Text: heading})
+			}
+			continue
+		}
+
+		cur.Body = append(cur.Body, markdownBodyLine(line, trimmed))
+	}
+	flush()
+	return out
+}
+
+func markdownHeading(line string) (string, int) {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || level >= len(line) || line[level] != ' ' {
+		return "", 0
+	}
+	return strings.TrimSpace(line[level:]), level
+}
+
+func markdownListItem(line string) (string, bool) {
+	for _, marker := range []string{"- ", "* ", "+ "} {
+		if rest, ok := strings.CutPrefix(line, marker); ok {
+			return strings.TrimSpace(rest), true
+		}
+	}
+	digits := 0
+	for digits < len(line) && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && digits+1 < len(line) && (line[digits] == '.' || line[digits] == ')') && line[digits+1] == ' ' {
+		return strings.TrimSpace(line[digits+2:]), true
+	}
+	return "", false
+}
+
+// slideLayoutPlaceholders maps predefined layouts to their title and body
+// placeholder types ("" when the layout has none).
+var slideLayoutPlaceholders = map[string][2]string{
+	"BLANK":                         {"", ""},
+	"CAPTION_ONLY":                  {"", "BODY"},
+	"TITLE":                         {"CENTERED_TITLE", "SUBTITLE"},
+	"TITLE_AND_BODY":                {"TITLE", "BODY"},
+	"TITLE_AND_TWO_COLUMNS":         {"TITLE", "BODY"},
+	"TITLE_ONLY":                    {"TITLE", ""},
+	"SECTION_HEADER":                {"TITLE", ""},
+	"SECTION_TITLE_AND_DESCRIPTION": {"TITLE", "BODY"},
+	"ONE_COLUMN_TEXT":               {"TITLE", "BODY"},
+	"MAIN_POINT":                    {"TITLE", ""},
+	"BIG_NUMBER":                    {"TITLE", "BODY"},
+}
+
+// markdownSlideRequests builds the batchUpdate requests that append one slide
+// per parsed Markdown slide. Object IDs are derived from prefix so callers can
+// address the created slides afterwards.
+func markdownSlideRequests(deck []markdownSlide, prefix string) []*slides.Request {
+	var reqs []*slides.Request
+	for i, s := range deck {
+		layout := "TITLE_AND_BODY"
+		if len(s.Body) == 0 {
+			layout = "TITLE_ONLY"
+		}
+		slideReqs, _ := slideContentRequests(s, fmt.Sprintf("%s_%d", prefix, i+1), layout, -1)
+		reqs = append(reqs, slideReqs...)
+	}
+	return reqs
+}
+
+// slideContentRequests creates one slide with the given predefined layout
+// (inserted at the 0-based index, or appended when index < 0) and fills its
+// title and body placeholders.
+func slideContentRequests(s markdownSlide, slideID, layout string, index int64) ([]*slides.Request, error) {
+	placeholders, ok := slideLayoutPlaceholders[layout]
+	if !ok {
+		return nil, usagef("unknown layout %q", layout)
+	}
+	titleID, bodyID := slideID+"_title", slideID+"_body"
+	var mappings []*slides.LayoutPlaceholderIdMapping
+	if s.Title != "" {
+		if placeholders[0] == "" {
+			return nil, usagef("layout %s has no title placeholder", layout)
+		}
+		mappings = append(mappings, &slides.LayoutPlaceholderIdMapping{LayoutPlaceholder: &slides.Placeholder{Type: placeholders[0]}, ObjectId: titleID})
+	}
+	if len(s.Body) > 0 {
+		if placeholders[1] == "" {
+			return nil, usagef("layout %s has no body placeholder", layout)
+		}
+		mappings = append(mappings, &slides.LayoutPlaceholderIdMapping{LayoutPlaceholder: &slides.Placeholder{Type: placeholders[1]}, ObjectId: bodyID})
+	}
+
+	create := &slides.CreateSlideRequest{
+		ObjectId:              slideID,
+		SlideLayoutReference:  &slides.LayoutReference{PredefinedLayout: layout},
+		PlaceholderIdMappings: mappings,
+	}
+	if index >= 0 {
+		create.InsertionIndex = index
+		create.ForceSendFields = []string{"InsertionIndex"}
+	}
+	reqs := []*slides.Request{{CreateSlide: create}}
+	if s.Title != "" {
+		reqs = append(reqs, &slides.Request{InsertText: &slides.InsertTextRequest{ObjectId: titleID, Text: s.Title}})
+	}
+	if len(s.Body) == 0 {
+		return reqs, nil
+	}
+
+	var text strings.Builder
+	type bulletRun struct{ start, end int64 }
+	var runs []bulletRun
+	var offset int64
+	for j, line := range s.Body {
+		if j > 0 {
+			text.WriteString("\n")
+			offset++
+		}
+		content := line.Text
+		if line.Bullet {
+			// Leading tabs set the nesting level when bullets are applied.
+			content = strings.Repeat("\t", line.Level) + content
+		}
+		n := int64(len(utf16.Encode([]rune(content))))
+		if line.Bullet {
+			if len(runs) > 0 && runs[len(runs)-1].end == offset-1 {
+				runs[len(runs)-1].end = offset + n
+			} else {
+				runs = append(runs, bulletRun{start: offset, end: offset + n})
+			}
+		}
+		text.WriteString(content)
+		offset += n
+	}
+	reqs = append(reqs, &slides.Request{InsertText: &slides.InsertTextRequest{ObjectId: bodyID, Text: text.String()}})
+	// Apply bullets from the last run backwards: removing the leading tabs
+	// shifts the indexes of everything that follows.
+	for j := len(runs) - 1; j >= 0; j-- {
+		start, end := runs[j].start, runs[j].end
+		reqs = append(reqs, &slides.Request{CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+			ObjectId:     bodyID,
+			TextRange:    &slides.Range{Type: "FIXED_RANGE", StartIndex: &start, EndIndex: &end},
+			BulletPreset: "BULLET_DISC_CIRCLE_SQUARE",
+		}})
+	}
+	return reqs, nil
+}
+
+// markdownSlideBody turns plain text into body lines, recognising Markdown
+// list items as (nested) bullets.
+func markdownSlideBody(src string) []markdownSlideLine {
+	var out []markdownSlideLine
+	for _, raw := range strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n") {
+		line := strings.TrimRight(raw, " \t")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		out = append(out, markdownBodyLine(line, trimmed))
+	}
+	return out
+}
+
+func markdownBodyLine(line, trimmed string) markdownSlideLine {
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	indent += strings.Count(line[:indent], "\t") // a tab counts as two spaces
+	if item, ok := markdownListItem(trimmed); ok {
+		return markdownSlideLine{Text: item, Bullet: true, Level: indent / 2}
+	}
+	return markdownSlideLine{Text: trimmed}
+}
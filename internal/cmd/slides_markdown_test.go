@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+const testSlidesMarkdown = `# Quarterly review
+
+Where we are
+
+# Results
+- Revenue up
+  - EMEA strongest
+- Costs flat
+Plain closing line
+<!-- notes: mention the hiring freeze -->
+
+---
+
+## Next steps
+1. Ship it
+`
+
+func TestParseSlidesMarkdown(t *testing.T) {
+	deck := parseSlidesMarkdown(testSlidesMarkdown)
+	if len(deck) != 3 {
+		t.Fatalf("expected 3 slides, got %d: %#v", len(deck), deck)
+	}
+	if deck[0].Title != "Quarterly review" || len(deck[0].Body) != 1 || deck[0].Body[0].Bullet {
+		t.Fatalf("unexpected first slide: %#v", deck[0])
+	}
+	body := deck[1].Body
+	if deck[1].Title != "Results" || len(body) != 4 {
+		t.Fatalf("unexpected second slide: %#v", deck[1])
+	}
+	if !body[0].Bullet || body[1].Level != 1 || !body[2].Bullet || body[3].Bullet {
+		t.Fatalf("unexpected bullets: %#v", body)
+	}
+	if len(deck[1].Notes) != 1 || deck[1].Notes[0] != "mention the hiring freeze" {
+		t.Fatalf("unexpected notes: %#v", deck[1].Notes)
+	}
+	if deck[2].Title != "Next steps" || deck[2].Body[0].Text != "Ship it" {
+		t.Fatalf("unexpected third slide: %#v", deck[2])
+	}
+
+	reqs := markdownSlideRequests(deck[1:2], "md")
+	var bullets []*slides.CreateParagraphBulletsRequest
+	var bodyText string
+	for _, r := range reqs {
+		if r.CreateParagraphBullets != nil {
+			bullets = append(bullets, r.CreateParagraphBullets)
+		}
+		if r.InsertText != nil && r.InsertText.ObjectId == "md_1_body" {
+			bodyText = r.InsertText.Text
+		}
+	}
+	if bodyText != "Revenue up\n\tEMEA strongest\nCosts flat\nPlain closing line" {
+		t.Fatalf("unexpected body text: %q", bodyText)
+	}
+	if len(bullets) != 1 || *bullets[0].TextRange.StartIndex != 0 || *bullets[0].TextRange.EndIndex != 37 {
+		t.Fatalf("unexpected bullet ranges: %#v", bullets)
+	}
+}
+
+func TestExecute_SlidesCreateMarkdown_JSON(t *testing.T) {
+	origDrive, origSlides := newDriveService, newSlidesService
+	t.Cleanup(func() { newDriveService, newSlidesService = origDrive, origSlides })
+
+	var batches []slides.BatchUpdatePresentationRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/files":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "p1", "name": "Deck", "mimeType": "application/vnd.google-apps.presentation"})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/p1" && len(batches) == 0:
+			_ = json.NewEncoder(w).Encode(map[string]any{"slides": []map[string]any{{"objectId": "p"}}})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/p1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"slides": []map[string]any{
+				{"objectId": "gog_md_1", "slideProperties": map[string]any{"notesPage": map[string]any{"notesProperties": map[string]any{"speakerNotesObjectId": "n1"}}}},
+				{"objectId": "gog_md_2", "slideProperties": map[string]any{"notesPage": map[string]any{"notesProperties": map[string]any{"speakerNotesObjectId": "n2"}}}},
+				{"objectId": "gog_md_3"},
+			}})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/p1:batchUpdate":
+			var req slides.BatchUpdatePresentationRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			batches = append(batches, req)
+			_ = json.NewEncoder(w).Encode(map[string]any{"presentationId": "p1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	dsvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	ssvc, err := slides.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return dsvc, nil }
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return ssvc, nil }
+
+	path := filepath.Join(t.TempDir(), "deck.md")
+	if err := os.WriteFile(path, []byte(testSlidesMarkdown), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "slides", "create", "Deck", "--markdown", path}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, `"slides": 3`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if len(batches) != 2 {
+		t.Fatalf("expected slides + notes batchUpdates, got %d", len(batches))
+	}
+	creates, deletes := 0, 0
+	for _, r := range batches[0].Requests {
+		if r.CreateSlide != nil {
+			creates++
+		}
+		if r.DeleteObject != nil {
+			deletes++
+		}
+	}
+	if creates != 3 || deletes != 1 {
+		t.Fatalf("unexpected first batch: creates=%d deletes=%d", creates, deletes)
+	}
+	notes := batches[1].Requests
+	if len(notes) != 1 || notes[0].InsertText.ObjectId != "n2" || notes[0].InsertText.Text != "mention the hiring freeze" {
+		t.Fatalf("unexpected notes batch: %#v", notes)
+	}
+}
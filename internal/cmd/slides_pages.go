@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesListCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	Thumbnails     bool   `name:"thumbnails" help:"Include thumbnail URLs (one extra API call per slide; URLs expire after ~30 minutes)"`
+	Size           string `name:"size" help:"Thumbnail size with --thumbnails: small|medium|large" default:"medium"`
+}
+
+type slideListItem struct {
+	Number       int    `json:"number"`
+	ObjectID     string `json:"objectId"`
+	Title        string `json:"title,omitempty"`
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+}
+
+func (c *SlidesListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.PresentationID)
+	if id == "" {
+		return usage("empty presentationId")
+	}
+	size := strings.ToUpper(strings.TrimSpace(c.Size))
+	switch size {
+	case "SMALL", "MEDIUM", "LARGE":
+	default:
+		return usagef("invalid --size %q (expected small|medium|large)", strings.ToLower(size))
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := svc.Presentations.Get(id).
+		Fields("presentationId,title,slides(objectId,pageElements(objectId,shape(placeholder,text),elementGroup))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+
+	items := make([]slideListItem, 0, len(pres.Slides))
+	for i, page := range pres.Slides {
+		item := slideListItem{Number: i + 1, ObjectID: page.ObjectId, Title: slideText(page).Title}
+		if c.Thumbnails {
+			thumb, thumbErr := svc.Presentations.Pages.GetThumbnail(id, page.ObjectId).
+				ThumbnailPropertiesMimeType("PNG").
+				ThumbnailPropertiesThumbnailSize(size).
+				Context(ctx).
+				Do()
+			if thumbErr != nil {
+				return fmt.Errorf("thumbnail for slide %d: %w", i+1, thumbErr)
+			}
+			item.ThumbnailURL = thumb.ContentUrl
+		}
+		items = append(items, item)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"presentationId": pres.PresentationId,
+			"title":          pres.Title,
+			"slides":         items,
+		})
+	}
+	if len(items) == 0 {
+		u.Err().Println("No slides")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	if c.Thumbnails {
+		fmt.Fprintln(w, "#\tID\tTITLE\tTHUMBNAIL")
+	} else {
+		fmt.Fprintln(w, "#\tID\tTITLE")
+	}
+	for _, item := range items {
+		if c.Thumbnails {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", item.Number, item.ObjectID, sanitizeTab(item.Title), item.ThumbnailURL)
+		} else {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", item.Number, item.ObjectID, sanitizeTab(item.Title))
+		}
+	}
+	return nil
+}
+
+type SlidesReorderCmd struct {
+	PresentationID string   `arg:"" name:"presentationId" help:"Presentation ID"`
+	Slides         []string `name:"slide" help:"Slide object ID(s) to move (comma-separated or repeatable; kept in deck order)" required:""`
+	To             int      `name:"to" help:"New position (1-based, counted before the move)" required:""`
+}
+
+func (c *SlidesReorderCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.PresentationID)
+	if id == "" {
+		return usage("empty presentationId")
+	}
+	slideIDs := splitCSV(strings.Join(c.Slides, ","))
+	if len(slideIDs) == 0 {
+		return usage("empty --slide")
+	}
+	if c.To <= 0 {
+		return usage("--to must be >= 1")
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	// The API rejects IDs that are not listed in their current deck order.
+	pres, err := svc.Presentations.Get(id).Fields("slides.objectId").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	slideIDs, err = slidesInDeckOrder(pres, slideIDs)
+	if err != nil {
+		return err
+	}
+	req := &slides.UpdateSlidesPositionRequest{SlideObjectIds: slideIDs, InsertionIndex: int64(c.To - 1)}
+	req.ForceSendFields = []string{"InsertionIndex"}
+	if _, err := svc.Presentations.BatchUpdate(id, &slides.BatchUpdatePresentationRequest{Requests: []*slides.Request{{
+		UpdateSlidesPosition: req,
+	}}}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"presentationId": id, "slides": slideIDs, "to": c.To})
+	}
+	u.Out().Printf("moved\t%s", strings.Join(slideIDs, ","))
+	u.Out().Printf("to\t%d", c.To)
+	return nil
+}
+
+// slidesInDeckOrder returns ids sorted by their position in pres, dropping
+// duplicates.
+func slidesInDeckOrder(pres *slides.Presentation, ids []string) ([]string, error) {
+	want := make(map[string]bool, len(ids))
+	for _, sid := range ids {
+		want[sid] = true
+	}
+	ordered := make([]string, 0, len(want))
+	for _, page := range pres.Slides {
+		if want[page.ObjectId] {
+			ordered = append(ordered, page.ObjectId)
+			delete(want, page.ObjectId)
+		}
+	}
+	for _, sid := range ids {
+		if want[sid] {
+			return nil, usagef("slide %q not found in presentation", sid)
+		}
+	}
+	return ordered, nil
+}
+
+type SlidesDeleteCmd struct {
+	PresentationID string   `arg:"" name:"presentationId" help:"Presentation ID"`
+	Slides         []string `name:"slide" help:"Slide object ID(s) to delete (comma-separated or repeatable)" required:""`
+}
+
+func (c *SlidesDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.PresentationID)
+	if id == "" {
+		return usage("empty presentationId")
+	}
+	slideIDs := splitCSV(strings.Join(c.Slides, ","))
+	if len(slideIDs) == 0 {
+		return usage("empty --slide")
+	}
+	if err := confirmDestructive(ctx, flags, fmt.Sprintf("delete slide(s) %s from presentation %s", strings.Join(slideIDs, ", "), id)); err != nil {
+		return err
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	reqs := make([]*slides.Request, 0, len(slideIDs))
+	for _, slideID := range slideIDs {
+		reqs = append(reqs, &slides.Request{DeleteObject: &slides.DeleteObjectRequest{ObjectId: slideID}})
+	}
+	if _, err := svc.Presentations.BatchUpdate(id, &slides.BatchUpdatePresentationRequest{Requests: reqs}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"presentationId": id, "deleted": slideIDs})
+	}
+	u.Out().Printf("deleted\t%s", strings.Join(slideIDs, ","))
+	return nil
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestExecute_SlidesListReorderDelete(t *testing.T) {
+	origSlides := newSlidesService
+	t.Cleanup(func() { newSlidesService = origSlides })
+
+	var batches []slides.BatchUpdatePresentationRequest
+	var rawBatches []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/deck1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"presentationId": "deck1",
+				"title":          "Deck",
+				"slides": []map[string]any{
+					{"objectId": "s1", "pageElements": []map[string]any{{"objectId": "t1", "shape": map[string]any{
+						"placeholder": map[string]any{"type": "TITLE"},
+						"text":        map[string]any{"textElements": []map[string]any{{"textRun": map[string]any{"content": "Intro\n"}}}},
+					}}}},
+					{"objectId": "s2"},
+				},
+			})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/presentations/deck1/pages/") && strings.HasSuffix(r.URL.Path, "/thumbnail"):
+			page := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/presentations/deck1/pages/"), "/thumbnail")
+			_ = json.NewEncoder(w).Encode(map[string]any{"contentUrl": "https://thumbs.example/" + page + ".png"})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/deck1:batchUpdate":
+			data, _ := io.ReadAll(r.Body)
+			var req slides.BatchUpdatePresentationRequest
+			_ = json.Unmarshal(data, &req)
+			batches = append(batches, req)
+			rawBatches = append(rawBatches, string(data))
+			_ = json.NewEncoder(w).Encode(map[string]any{"presentationId": "deck1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ssvc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return ssvc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "slides", "list", "deck1", "--thumbnails"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	var listed struct {
+		Slides []slideListItem `json:"slides"`
+	}
+	if err := json.Unmarshal([]byte(out), &listed); err != nil {
+		t.Fatalf("unmarshal: %v (%q)", err, out)
+	}
+	if len(listed.Slides) != 2 || listed.Slides[0].Title != "Intro" || listed.Slides[1].ThumbnailURL != "https://thumbs.example/s2.png" {
+		t.Fatalf("unexpected list: %#v", listed.Slides)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "slides", "reorder", "deck1", "--slide", "s2,s1", "--to", "1"}); err != nil {
+			t.Fatalf("reorder: %v", err)
+		}
+		if err := Execute([]string{"--json", "--force", "--account", "a@b.com", "slides", "delete", "deck1", "--slide", "s1,s2"}); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+	})
+
+	if len(batches) != 2 {
+		t.Fatalf("unexpected batches: %#v", batches)
+	}
+	move := batches[0].Requests[0].UpdateSlidesPosition
+	if move == nil || strings.Join(move.SlideObjectIds, ",") != "s1,s2" || !strings.Contains(rawBatches[0], `"insertionIndex":0`) {
+		t.Fatalf("unexpected reorder request: %s", rawBatches[0])
+	}
+	if len(batches[1].Requests) != 2 || batches[1].Requests[1].DeleteObject.ObjectId != "s2" {
+		t.Fatalf("unexpected delete request: %s", rawBatches[1])
+	}
+
+	if err := Execute([]string{"--json", "--account", "a@b.com", "slides", "reorder", "deck1", "--slide", "s9", "--to", "1"}); err == nil || len(batches) != 2 {
+		t.Fatalf("expected unknown slide to be rejected before batchUpdate, err=%v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesMergeTemplateCmd struct {
+	TemplateID string   `arg:"" name:"templateId" help:"Template presentation ID"`
+	Title      string   `name:"title" help:"Title of the generated presentation" required:""`
+	Vars       []string `name:"vars" sep:"none" help:"Replace {{key}} with value (key=value, repeatable)"`
+	ImageVars  []string `name:"image-vars" sep:"none" help:"Replace shapes containing {{key}} with an image (key=<url|driveFileId>, repeatable)"`
+	Parent     string   `name:"parent" help:"Destination folder ID"`
+}
+
+func (c *SlidesMergeTemplateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	templateID := strings.TrimSpace(c.TemplateID)
+	if templateID == "" {
+		return usage("empty templateId")
+	}
+	title := strings.TrimSpace(c.Title)
+	if title == "" {
+		return usage("empty --title")
+	}
+	vars, err := parseTemplateVars(c.Vars)
+	if err != nil {
+		return err
+	}
+	imageVars := map[string]string{}
+	for _, pair := range c.ImageVars {
+		key, value, ok := strings.Cut(pair, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return usagef("invalid --image-vars %q (expected key=<url|driveFileId>)", pair)
+		}
+		imageVars[key] = value
+	}
+	if len(vars) == 0 && len(imageVars) == 0 {
+		return usage("specify at least one --vars or --image-vars")
+	}
+
+	dsvc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+	copyReq := &drive.File{Name: title}
+	if parent := strings.TrimSpace(c.Parent); parent != "" {
+		copyReq.Parents = []string{parent}
+	}
+	created, err := dsvc.Files.Copy(templateID, copyReq).
+		SupportsAllDrives(true).
+		Fields("id, name, mimeType, webViewLink").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if created == nil {
+		return errors.New("copy failed")
+	}
+
+	var reqs []*slides.Request
+	var cleanups []func()
+	defer func() {
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}()
+	for _, key := range sortedKeys(vars) {
+		reqs = append(reqs, &slides.Request{ReplaceAllText: &slides.ReplaceAllTextRequest{
+			ContainsText: &slides.SubstringMatchCriteria{Text: "{{" + key + "}}", MatchCase: true},
+			ReplaceText:  vars[key],
+		}})
+	}
+	for _, key := range sortedKeys(imageVars) {
+		imageURL, cleanup, imgErr := slidesImageURL(ctx, dsvc, imageVars[key])
+		if imgErr != nil {
+			return fmt.Errorf("--image-vars %s: %w", key, imgErr)
+		}
+		if cleanup != nil {
+			u.Err().Printf("Temporarily sharing Drive file %s by link so Slides can fetch it", imageVars[key])
+			cleanups = append(cleanups, cleanup)
+		}
+		reqs = append(reqs, &slides.Request{ReplaceAllShapesWithImage: &slides.ReplaceAllShapesWithImageRequest{
+			ContainsText:       &slides.SubstringMatchCriteria{Text: "{{" + key + "}}", MatchCase: true},
+			ImageUrl:           imageURL,
+			ImageReplaceMethod: "CENTER_INSIDE",
+		}})
+	}
+
+	ssvc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	resp, err := ssvc.Presentations.BatchUpdate(created.Id, &slides.BatchUpdatePresentationRequest{Requests: reqs}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("presentation %s copied, but replacing placeholders failed: %w", created.Id, err)
+	}
+	textCount, imageCount := int64(0), int64(0)
+	for _, reply := range resp.Replies {
+		if reply.ReplaceAllText != nil {
+			textCount += reply.ReplaceAllText.OccurrencesChanged
+		}
+		if reply.ReplaceAllShapesWithImage != nil {
+			imageCount += reply.ReplaceAllShapesWithImage.OccurrencesChanged
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			strFile:             created,
+			"textReplacements":  textCount,
+			"imageReplacements": imageCount,
+		})
+	}
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("name\t%s", created.Name)
+	u.Out().Printf("text_replacements\t%d", textCount)
+	u.Out().Printf("image_replacements\t%d", imageCount)
+	if created.WebViewLink != "" {
+		u.Out().Printf("link\t%s", created.WebViewLink)
+	}
+	return nil
+}
+
+// slidesImageURL returns a URL the Slides API can fetch. Drive file IDs are
+// shared by link for the duration of the request; the returned cleanup
+// removes that permission again.
+func slidesImageURL(ctx context.Context, svc *drive.Service, value string) (string, func(), error) {
+	if u, err := url.Parse(value); err == nil && (u.Scheme == "https" || u.Scheme == "http") {
+		return value, nil, nil
+	}
+	perm, err := svc.Permissions.Create(value, &drive.Permission{Type: "anyone", Role: "reader"}).
+		SupportsAllDrives(true).
+		Fields("id").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() {
+		_ = svc.Permissions.Delete(value, perm.Id).SupportsAllDrives(true).Context(ctx).Do()
+	}
+	return "https://drive.google.com/uc?export=download&id=" + url.QueryEscape(value), cleanup, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
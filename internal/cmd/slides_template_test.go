@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestExecute_SlidesMergeTemplate_JSON(t *testing.T) {
+	origDrive, origSlides := newDriveService, newSlidesService
+	t.Cleanup(func() { newDriveService, newSlidesService = origDrive, origSlides })
+
+	var batch slides.BatchUpdatePresentationRequest
+	var sharedCreated, sharedDeleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/files/tpl/copy":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "deck1", "name": "Report"})
+		case r.Method == http.MethodPost && r.URL.Path == "/files/img1/permissions":
+			sharedCreated = true
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "perm1"})
+		case r.Method == http.MethodDelete && r.URL.Path == "/files/img1/permissions/perm1":
+			sharedDeleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/deck1:batchUpdate":
+			if !sharedCreated || sharedDeleted {
+				http.Error(w, "image not shared during batchUpdate", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{"replies": []map[string]any{
+				{"replaceAllText": map[string]any{"occurrencesChanged": 2}},
+				{"replaceAllText": map[string]any{"occurrencesChanged": 1}},
+				{"replaceAllShapesWithImage": map[string]any{"occurrencesChanged": 1}},
+				{"replaceAllShapesWithImage": map[string]any{"occurrencesChanged": 1}},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	dsvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	ssvc, err := slides.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return dsvc, nil }
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return ssvc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{
+				"--json", "--account", "a@b.com", "slides", "merge-template", "tpl",
+				"--title", "Report",
+				"--vars", "quarter=Q3",
+				"--vars", "owner=Ops, EMEA",
+				"--image-vars", "logo=img1",
+				"--image-vars", "chart=https://example.com/chart.png",
+			}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	if !sharedDeleted {
+		t.Fatalf("expected temporary share to be removed")
+	}
+	if len(batch.Requests) != 4 {
+		t.Fatalf("unexpected requests: %#v", batch.Requests)
+	}
+	if r := batch.Requests[0].ReplaceAllText; r == nil || r.ContainsText.Text != "{{owner}}" || r.ReplaceText != "Ops, EMEA" {
+		t.Fatalf("unexpected first text request: %#v", batch.Requests[0])
+	}
+	if r := batch.Requests[2].ReplaceAllShapesWithImage; r == nil || r.ImageUrl != "https://example.com/chart.png" {
+		t.Fatalf("unexpected url image request: %#v", batch.Requests[2])
+	}
+	if r := batch.Requests[3].ReplaceAllShapesWithImage; r == nil || !strings.Contains(r.ImageUrl, "id=img1") {
+		t.Fatalf("unexpected drive image request: %#v", batch.Requests[3])
+	}
+	if !strings.Contains(out, `"textReplacements": 3`) || !strings.Contains(out, `"imageReplacements": 2`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// exportSlideImage renders one slide through the Slides thumbnail endpoint
+// (PNG only) and converts it to JPEG when asked.
+func exportSlideImage(ctx context.Context, flags *RootFlags, presentationID string, slide int, format, size, outPath string) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	presentationID = strings.TrimSpace(presentationID)
+	if presentationID == "" {
+		return usage("empty presentationId")
+	}
+	switch format {
+	case "":
+		format = "png"
+	case "jpg":
+		format = "jpeg"
+	case "png", "jpeg":
+	default:
+		return usagef("--slide exports images; use --format png|jpeg (got %q)", format)
+	}
+	if slide <= 0 {
+		return usage("--format png|jpeg requires --slide N (1-based)")
+	}
+	size = strings.ToUpper(strings.TrimSpace(size))
+	switch size {
+	case "SMALL", "MEDIUM", "LARGE":
+	default:
+		return usagef("invalid --size %q (expected small|medium|large)", strings.ToLower(size))
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := svc.Presentations.Get(presentationID).Fields("slides.objectId").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if slide > len(pres.Slides) {
+		return usagef("--slide %d out of range (presentation has %d slides)", slide, len(pres.Slides))
+	}
+	pageID := pres.Slides[slide-1].ObjectId
+
+	thumb, err := svc.Presentations.Pages.GetThumbnail(presentationID, pageID).
+		ThumbnailPropertiesMimeType("PNG").
+		ThumbnailPropertiesThumbnailSize(size).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	data, err := downloadSlideThumbnail(ctx, thumb.ContentUrl)
+	if err != nil {
+		return err
+	}
+	if format == "jpeg" {
+		img, _, decodeErr := image.Decode(bytes.NewReader(data))
+		if decodeErr != nil {
+			return fmt.Errorf("decode thumbnail: %w", decodeErr)
+		}
+		var buf bytes.Buffer
+		if encodeErr := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); encodeErr != nil {
+			return fmt.Errorf("encode jpeg: %w", encodeErr)
+		}
+		data = buf.Bytes()
+	}
+
+	dest, err := slideImagePath(outPath, fmt.Sprintf("%s_slide%d.%s", presentationID, slide, format))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(dest, data, 0o600); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"path":   dest,
+			"size":   len(data),
+			"slide":  slide,
+			"pageId": pageID,
+			"width":  thumb.Width,
+			"height": thumb.Height,
+		})
+	}
+	u.Out().Printf("path\t%s", dest)
+	u.Out().Printf("size\t%s", formatDriveSize(int64(len(data))))
+	u.Out().Printf("slide\t%d", slide)
+	return nil
+}
+
+func downloadSlideThumbnail(ctx context.Context, contentURL string) ([]byte, error) {
+	if contentURL == "" {
+		return nil, fmt.Errorf("thumbnail response has no content URL")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, contentURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download thumbnail: %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func slideImagePath(outPath, defaultName string) (string, error) {
+	dest := strings.TrimSpace(outPath)
+	if dest == "" {
+		dir, err := config.EnsureDriveDownloadsDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(dir, defaultName), nil
+	}
+	dest, err := config.ExpandPath(dest)
+	if err != nil {
+		return "", err
+	}
+	if st, statErr := os.Stat(dest); statErr == nil && st.IsDir() {
+		return filepath.Join(dest, defaultName), nil
+	}
+	return dest, nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestExecute_SlidesExportSlideImage(t *testing.T) {
+	origSlides := newSlidesService
+	t.Cleanup(func() { newSlidesService = origSlides })
+
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, image.NewRGBA(image.Rect(0, 0, 4, 3))); err != nil {
+		t.Fatalf("png: %v", err)
+	}
+
+	var thumbQuery string
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/presentations/p1":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"slides": []map[string]any{{"objectId": "s1"}, {"objectId": "s2"}}})
+		case r.URL.Path == "/v1/presentations/p1/pages/s2/thumbnail":
+			thumbQuery = r.URL.RawQuery
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"contentUrl": srv.URL + "/thumb.png", "width": 4, "height": 3})
+		case r.URL.Path == "/thumb.png":
+			_, _ = w.Write(pngData.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return svc, nil }
+
+	dir := t.TempDir()
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "slides", "export", "p1", "--slide", "2", "--format", "jpeg", "--size", "medium", "--out", dir}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	if !strings.Contains(thumbQuery, "thumbnailProperties.thumbnailSize=MEDIUM") {
+		t.Fatalf("unexpected thumbnail query: %q", thumbQuery)
+	}
+	var parsed struct {
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Path != filepath.Join(dir, "p1_slide2.jpeg") {
+		t.Fatalf("unexpected path: %q", parsed.Path)
+	}
+	data, err := os.ReadFile(parsed.Path)
+	if err != nil || !bytes.HasPrefix(data, []byte{0xff, 0xd8}) {
+		t.Fatalf("expected a JPEG file, err=%v", err)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "slides", "export", "p1", "--slide", "3"}); err == nil || !strings.Contains(err.Error(), "out of range") {
+			t.Fatalf("expected out of range error, got %v", err)
+		}
+	})
+}
@@ -7,13 +7,17 @@ import (
 var newTasksService = googleapi.NewTasks
 
 type TasksCmd struct {
-	Lists  TasksListsCmd  `cmd:"" name:"lists" help:"List task lists"`
-	List   TasksListCmd   `cmd:"" name:"list" help:"List tasks"`
-	Get    TasksGetCmd    `cmd:"" name:"get" help:"Get a task"`
-	Add    TasksAddCmd    `cmd:"" name:"add" help:"Add a task" aliases:"create"`
-	Update TasksUpdateCmd `cmd:"" name:"update" help:"Update a task"`
-	Done   TasksDoneCmd   `cmd:"" name:"done" help:"Mark task completed" aliases:"complete"`
-	Undo   TasksUndoCmd   `cmd:"" name:"undo" help:"Mark task needs action" aliases:"uncomplete,undone"`
-	Delete TasksDeleteCmd `cmd:"" name:"delete" help:"Delete a task" aliases:"rm,del"`
-	Clear  TasksClearCmd  `cmd:"" name:"clear" help:"Clear completed tasks"`
+	Lists     TasksListsCmd     `cmd:"" name:"lists" help:"List task lists"`
+	List      TasksListCmd      `cmd:"" name:"list" help:"List tasks"`
+	Get       TasksGetCmd       `cmd:"" name:"get" help:"Get a task"`
+	Add       TasksAddCmd       `cmd:"" name:"add" help:"Add a task" aliases:"create"`
+	Update    TasksUpdateCmd    `cmd:"" name:"update" help:"Update a task"`
+	Done      TasksDoneCmd      `cmd:"" name:"done" help:"Mark task completed" aliases:"complete"`
+	Undo      TasksUndoCmd      `cmd:"" name:"undo" help:"Mark task needs action" aliases:"uncomplete,undone"`
+	Delete    TasksDeleteCmd    `cmd:"" name:"delete" help:"Delete a task" aliases:"rm,del"`
+	Move      TasksMoveCmd      `cmd:"" name:"move" help:"Reorder a task or change its parent" aliases:"mv"`
+	Clear     TasksClearCmd     `cmd:"" name:"clear" help:"Clear completed tasks"`
+	Bulk      TasksBulkCmd      `cmd:"" name:"bulk" help:"Delete, complete or move all tasks matching a filter"`
+	Recur     TasksRecurCmd     `cmd:"" name:"recur" help:"Emulated recurring tasks"`
+	FromEmail TasksFromEmailCmd `cmd:"" name:"from-email" help:"Create a task from a Gmail message (subject + link to the thread)"`
 }
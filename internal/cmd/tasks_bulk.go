@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// TasksBulkCmd applies one action to every task in a list matching the filters.
+type TasksBulkCmd struct {
+	List            string `name:"list" help:"Task list ID" required:""`
+	CompletedBefore string `name:"completed-before" help:"Completed tasks finished before this (age like 90d/4w, or a date)"`
+	Overdue         bool   `name:"overdue" help:"Open tasks whose due date has passed"`
+	DueBefore       string `name:"due-before" help:"Open tasks due before this date"`
+	Delete          bool   `name:"delete" help:"Delete matching tasks"`
+	Complete        bool   `name:"complete" help:"Mark matching tasks completed"`
+	MoveTo          string `name:"move-to" help:"Move matching tasks to this task list"`
+	DryRun          bool   `name:"dry-run" help:"Only list matching tasks; do not modify"`
+}
+
+type tasksBulkSummary struct {
+	Tasklist string        `json:"tasklist"`
+	Action   string        `json:"action"`
+	MoveTo   string        `json:"moveTo,omitempty"`
+	Matched  int           `json:"matched"`
+	Changed  int           `json:"changed"`
+	DryRun   bool          `json:"dryRun,omitempty"`
+	Tasks    []*tasks.Task `json:"tasks"`
+}
+
+func (c *TasksBulkCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	tasklistID := strings.TrimSpace(c.List)
+	if tasklistID == "" {
+		return usage("empty --list")
+	}
+	moveTo := strings.TrimSpace(c.MoveTo)
+
+	action := ""
+	actions := 0
+	if c.Delete {
+		action, actions = "delete", actions+1
+	}
+	if c.Complete {
+		action, actions = "complete", actions+1
+	}
+	if moveTo != "" {
+		action, actions = "move", actions+1
+	}
+	if actions != 1 {
+		return usage("specify exactly one action (--delete, --complete, --move-to)")
+	}
+	if moveTo == tasklistID {
+		return usage("--move-to must differ from --list")
+	}
+
+	completedBefore := strings.TrimSpace(c.CompletedBefore)
+	openFilter := c.Overdue || strings.TrimSpace(c.DueBefore) != ""
+	switch {
+	case completedBefore == "" && !openFilter:
+		return usage("specify a filter (--completed-before, --overdue, --due-before)")
+	case completedBefore != "" && openFilter:
+		return usage("--completed-before cannot be combined with --overdue/--due-before")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.Tasks.List(tasklistID).MaxResults(100)
+	if completedBefore != "" {
+		cutoff, cutoffErr := taskAgeCutoff(completedBefore)
+		if cutoffErr != nil {
+			return cutoffErr
+		}
+		call = call.ShowCompleted(true).ShowHidden(true).CompletedMax(cutoff)
+	} else {
+		dueMax := ""
+		if c.Overdue {
+			dueMax = utcDate(time.Now()).Format(time.RFC3339)
+		}
+		if strings.TrimSpace(c.DueBefore) != "" {
+			bound, boundErr := taskDueBound(c.DueBefore)
+			if boundErr != nil {
+				return boundErr
+			}
+			if dueMax == "" || bound < dueMax {
+				dueMax = bound
+			}
+		}
+		call = call.ShowCompleted(false).DueMax(dueMax)
+	}
+
+	var matched []*tasks.Task
+	for page := ""; ; {
+		resp, listErr := call.PageToken(page).Do()
+		if listErr != nil {
+			return listErr
+		}
+		for _, t := range resp.Items {
+			if t == nil {
+				continue
+			}
+			if completedBefore == "" && (t.Status == "completed" || strings.TrimSpace(t.Due) == "") {
+				continue
+			}
+			matched = append(matched, t)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		page = resp.NextPageToken
+	}
+	if completedBefore == "" {
+		matched = dropDueToday(matched, c.Overdue)
+	}
+
+	summary := tasksBulkSummary{
+		Tasklist: tasklistID,
+		Action:   action,
+		MoveTo:   moveTo,
+		Matched:  len(matched),
+		DryRun:   c.DryRun,
+		Tasks:    matched,
+	}
+	if summary.Tasks == nil {
+		summary.Tasks = []*tasks.Task{}
+	}
+
+	if !c.DryRun && len(matched) > 0 {
+		if action == "delete" {
+			if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete %d tasks from list %s", len(matched), tasklistID)); confirmErr != nil {
+				return confirmErr
+			}
+		}
+		for _, t := range matched {
+			var opErr error
+			switch action {
+			case "delete":
+				opErr = svc.Tasks.Delete(tasklistID, t.Id).Do()
+			case "complete":
+				_, opErr = svc.Tasks.Patch(tasklistID, t.Id, &tasks.Task{Status: "completed"}).Do()
+			case "move":
+				_, opErr = svc.Tasks.Move(tasklistID, t.Id).DestinationTasklist(moveTo).Do()
+			}
+			if opErr != nil {
+				return fmt.Errorf("%s %s: %w", action, t.Id, opErr)
+			}
+			summary.Changed++
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, summary)
+	}
+	if len(matched) == 0 {
+		u.Err().Println("No matching tasks")
+		return nil
+	}
+	if c.DryRun {
+		w, flush := tableWriter(ctx)
+		fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tDUE\tCOMPLETED")
+		for _, t := range matched {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, t.Title, t.Status, strings.TrimSpace(t.Due), taskCompletedAt(t))
+		}
+		flush()
+		u.Out().Printf("Dry run: %d tasks would be affected (%s)", summary.Matched, action)
+		return nil
+	}
+	u.Out().Printf("%s\t%d", action, summary.Changed)
+	return nil
+}
+
+func taskCompletedAt(t *tasks.Task) string {
+	if t.Completed == nil {
+		return ""
+	}
+	return *t.Completed
+}
+
+// dropDueToday removes tasks due today when only overdue tasks are wanted, since
+// the API's dueMax bound is inclusive.
+func dropDueToday(items []*tasks.Task, overdue bool) []*tasks.Task {
+	if !overdue {
+		return items
+	}
+	today := utcDate(time.Now())
+	out := items[:0]
+	for _, t := range items {
+		if due, _, err := parseTaskDate(t.Due); err == nil && !utcDate(due).Before(today) {
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// taskAgeCutoff turns "90d"/"4w" (ago) or a date expression into an RFC3339 bound.
+func taskAgeCutoff(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	for suffix, days := range map[string]int{"d": 1, "w": 7} {
+		if n, err := strconv.Atoi(strings.TrimSuffix(value, suffix)); err == nil && strings.HasSuffix(value, suffix) && n >= 0 {
+			return time.Now().AddDate(0, 0, -n*days).UTC().Format(time.RFC3339), nil
+		}
+	}
+	t, _, err := parseTaskDate(value)
+	if err != nil {
+		return "", err
+	}
+	return t.UTC().Format(time.RFC3339), nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"strings"
+	"time"
 
 	"github.com/steipete/gogcli/internal/ui"
 )
@@ -30,3 +31,13 @@ func normalizeTaskDue(value string) (string, error) {
 	}
 	return formatTaskDue(parsed, hasTime), nil
 }
+
+// taskDueBound turns a date expression into the RFC3339 midnight-UTC bound
+// used by the dueMin/dueMax filters (due dates are stored as UTC midnight).
+func taskDueBound(value string) (string, error) {
+	t, _, err := parseTaskDate(value)
+	if err != nil {
+		return "", err
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Format(time.RFC3339), nil
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type TasksFromEmailCmd struct {
+	MessageID string `arg:"" name:"messageId" help:"Gmail message ID"`
+	List      string `name:"list" help:"Task list ID (@default for the default list)" default:"@default"`
+	Title     string `name:"title" help:"Task title (default: the message subject)"`
+	Notes     string `name:"notes" help:"Extra notes placed above the link to the thread"`
+	Due       string `name:"due" help:"Due date (RFC3339, YYYY-MM-DD, today, tomorrow or a weekday)"`
+}
+
+func (c *TasksFromEmailCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	messageID := strings.TrimSpace(c.MessageID)
+	if messageID == "" {
+		return usage("empty messageId")
+	}
+	tasklistID := strings.TrimSpace(c.List)
+	if tasklistID == "" {
+		return usage("empty --list")
+	}
+	due, err := normalizeTaskDue(c.Due)
+	if err != nil {
+		return err
+	}
+
+	gsvc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+	msg, err := gsvc.Users.Messages.Get("me", messageID).
+		Format(gmailFormatMetadata).
+		MetadataHeaders("Subject", "From").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+
+	title := strings.TrimSpace(c.Title)
+	if title == "" {
+		title = strings.TrimSpace(headerValue(msg.Payload, "Subject"))
+	}
+	if title == "" {
+		title = "(no subject)"
+	}
+	threadURL := gmailThreadURL(account, msg.ThreadId)
+
+	var notes []string
+	if extra := strings.TrimSpace(c.Notes); extra != "" {
+		notes = append(notes, extra, "")
+	}
+	if from := strings.TrimSpace(headerValue(msg.Payload, "From")); from != "" {
+		notes = append(notes, "From: "+from)
+	}
+	notes = append(notes, threadURL)
+
+	tsvc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+	created, err := tsvc.Tasks.Insert(tasklistID, &tasks.Task{
+		Title: title,
+		Notes: strings.Join(notes, "\n"),
+		Due:   due,
+	}).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"task":      created,
+			"messageId": msg.Id,
+			"threadId":  msg.ThreadId,
+			"threadUrl": threadURL,
+		})
+	}
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("title\t%s", created.Title)
+	if strings.TrimSpace(created.Due) != "" {
+		u.Out().Printf("due\t%s", created.Due)
+	}
+	u.Out().Printf("thread\t%s", threadURL)
+	return nil
+}
@@ -20,13 +20,16 @@ const (
 )
 
 type TasksListCmd struct {
-	TasklistID    string `arg:"" name:"tasklistId" help:"Task list ID"`
+	TasklistID    string `arg:"" optional:"" name:"tasklistId" help:"Task list ID (or --list)"`
+	List          string `name:"list" help:"Task list ID (@default for the default list)"`
 	Max           int64  `name:"max" aliases:"limit" help:"Max results (max allowed: 100)" default:"20"`
 	Page          string `name:"page" help:"Page token"`
-	ShowCompleted bool   `name:"show-completed" help:"Include completed tasks (requires --show-hidden for some clients)" default:"true"`
+	ShowCompleted bool   `name:"show-completed" help:"Include completed tasks (also shows tasks hidden by other clients)"`
 	ShowDeleted   bool   `name:"show-deleted" help:"Include deleted tasks"`
 	ShowHidden    bool   `name:"show-hidden" help:"Include hidden tasks"`
 	ShowAssigned  bool   `name:"show-assigned" help:"Include tasks assigned to current user" default:"true"`
+	DueBefore     string `name:"due-before" help:"Only tasks due before this date (YYYY-MM-DD, today, tomorrow, friday, ...)"`
+	DueAfter      string `name:"due-after" help:"Only tasks due on or after this date (YYYY-MM-DD, today, tomorrow, friday, ...)"`
 	DueMin        string `name:"due-min" help:"Lower bound for due date filter (RFC3339)"`
 	DueMax        string `name:"due-max" help:"Upper bound for due date filter (RFC3339)"`
 	CompletedMin  string `name:"completed-min" help:"Lower bound for completion date filter (RFC3339)"`
@@ -40,9 +43,20 @@ func (c *TasksListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	tasklistID := strings.TrimSpace(c.TasklistID)
-	if tasklistID == "" {
-		return usage("empty tasklistId")
+	tasklistID, err := resolveTasklistArg(c.TasklistID, c.List)
+	if err != nil {
+		return err
+	}
+	dueMin, dueMax := strings.TrimSpace(c.DueMin), strings.TrimSpace(c.DueMax)
+	if strings.TrimSpace(c.DueAfter) != "" {
+		if dueMin, err = taskDueBound(c.DueAfter); err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(c.DueBefore) != "" {
+		if dueMax, err = taskDueBound(c.DueBefore); err != nil {
+			return err
+		}
 	}
 
 	svc, err := newTasksService(ctx, account)
@@ -55,13 +69,13 @@ func (c *TasksListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		PageToken(c.Page).
 		ShowCompleted(c.ShowCompleted).
 		ShowDeleted(c.ShowDeleted).
-		ShowHidden(c.ShowHidden).
+		ShowHidden(c.ShowHidden || c.ShowCompleted).
 		ShowAssigned(c.ShowAssigned)
-	if strings.TrimSpace(c.DueMin) != "" {
-		call = call.DueMin(strings.TrimSpace(c.DueMin))
+	if dueMin != "" {
+		call = call.DueMin(dueMin)
 	}
-	if strings.TrimSpace(c.DueMax) != "" {
-		call = call.DueMax(strings.TrimSpace(c.DueMax))
+	if dueMax != "" {
+		call = call.DueMax(dueMax)
 	}
 	if strings.TrimSpace(c.CompletedMin) != "" {
 		call = call.CompletedMin(strings.TrimSpace(c.CompletedMin))
@@ -93,20 +107,23 @@ func (c *TasksListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	w, flush := tableWriter(ctx)
 	defer flush()
 	fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tDUE\tUPDATED")
-	for _, t := range resp.Items {
+	for _, row := range orderTaskTree(resp.Items) {
+		t := row.task
 		status := strings.TrimSpace(t.Status)
 		if status == "" {
 			status = taskStatusNeedsAction
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, t.Title, status, strings.TrimSpace(t.Due), strings.TrimSpace(t.Updated))
+		title := strings.Repeat("  ", row.depth) + t.Title
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, title, status, strings.TrimSpace(t.Due), strings.TrimSpace(t.Updated))
 	}
 	printNextPageHint(u, resp.NextPageToken)
 	return nil
 }
 
 type TasksGetCmd struct {
-	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID"`
-	TaskID     string `arg:"" name:"taskId" help:"Task ID"`
+	TasklistID string `arg:"" optional:"" name:"tasklistId" help:"Task list ID (omit with --list)"`
+	TaskID     string `arg:"" optional:"" name:"taskId" help:"Task ID"`
+	List       string `name:"list" help:"Task list ID (lets you pass only the task ID)"`
 }
 
 func (c *TasksGetCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -115,13 +132,9 @@ func (c *TasksGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	tasklistID := strings.TrimSpace(c.TasklistID)
-	taskID := strings.TrimSpace(c.TaskID)
-	if tasklistID == "" {
-		return usage("empty tasklistId")
-	}
-	if taskID == "" {
-		return usage("empty taskId")
+	tasklistID, taskID, err := resolveTaskArgs(c.TasklistID, c.TaskID, c.List)
+	if err != nil {
+		return err
 	}
 
 	svc, err := newTasksService(ctx, account)
@@ -151,15 +164,17 @@ func (c *TasksGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type TasksAddCmd struct {
-	TasklistID  string `arg:"" name:"tasklistId" help:"Task list ID"`
-	Title       string `name:"title" help:"Task title (required)"`
-	Notes       string `name:"notes" help:"Task notes/description"`
-	Due         string `name:"due" help:"Due date (RFC3339 or YYYY-MM-DD; time may be ignored by Google Tasks)"`
-	Parent      string `name:"parent" help:"Parent task ID (create as subtask)"`
-	Previous    string `name:"previous" help:"Previous sibling task ID (controls ordering)"`
-	Repeat      string `name:"repeat" help:"Repeat task: daily, weekly, monthly, yearly"`
-	RepeatCount int    `name:"repeat-count" help:"Number of occurrences to create (requires --repeat)"`
-	RepeatUntil string `name:"repeat-until" help:"Repeat until date/time (RFC3339 or YYYY-MM-DD; requires --repeat)"`
+	TasklistID  string   `arg:"" optional:"" name:"tasklistId" help:"Task list ID (with --list: the first word of the title)"`
+	TitleArgs   []string `arg:"" optional:"" name:"title" help:"Task title (alternative to --title)"`
+	List        string   `name:"list" help:"Task list ID; all arguments form the title (@default for the default list)"`
+	Title       string   `name:"title" help:"Task title"`
+	Notes       string   `name:"notes" help:"Task notes/description"`
+	Due         string   `name:"due" help:"Due date (RFC3339 or YYYY-MM-DD; time may be ignored by Google Tasks)"`
+	Parent      string   `name:"parent" help:"Parent task ID (create as subtask)"`
+	Previous    string   `name:"previous" help:"Previous sibling task ID (controls ordering)"`
+	Repeat      string   `name:"repeat" help:"Repeat task: daily, weekly, monthly, yearly (creates copies), or a rule like \"every monday\" kept in the notes for tasks recur run"`
+	RepeatCount int      `name:"repeat-count" help:"Number of occurrences to create (requires --repeat)"`
+	RepeatUntil string   `name:"repeat-until" help:"Repeat until date/time (RFC3339 or YYYY-MM-DD; requires --repeat)"`
 }
 
 func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -168,15 +183,28 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	tasklistID := strings.TrimSpace(c.TasklistID)
-	if tasklistID == "" {
-		return usage("empty tasklistId")
+	tasklistID, title, err := c.resolveListAndTitle()
+	if err != nil {
+		return err
 	}
-	if strings.TrimSpace(c.Title) == "" {
-		return usage("required: --title")
+
+	notes, due, repeat := strings.TrimSpace(c.Notes), c.Due, c.Repeat
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(repeat)), "every ") {
+		if c.RepeatCount != 0 || strings.TrimSpace(c.RepeatUntil) != "" {
+			return usage("--repeat-count/--repeat-until only apply to daily, weekly, monthly or yearly")
+		}
+		rule, ruleErr := parseTaskRecurrence(repeat)
+		if ruleErr != nil {
+			return ruleErr
+		}
+		notes = setTaskRecurrence(notes, rule.rule)
+		if strings.TrimSpace(due) == "" {
+			due = formatTaskDue(rule.first(utcDate(time.Now())), false)
+		}
+		repeat = ""
 	}
 
-	repeatUnit, err := parseRepeatUnit(c.Repeat)
+	repeatUnit, err := parseRepeatUnit(repeat)
 	if err != nil {
 		return err
 	}
@@ -189,14 +217,14 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 		if svcErr != nil {
 			return svcErr
 		}
-		warnTasksDueTime(u, c.Due)
-		dueValue, dueErr := normalizeTaskDue(c.Due)
+		warnTasksDueTime(u, due)
+		dueValue, dueErr := normalizeTaskDue(due)
 		if dueErr != nil {
 			return dueErr
 		}
 		task := &tasks.Task{
-			Title: strings.TrimSpace(c.Title),
-			Notes: strings.TrimSpace(c.Notes),
+			Title: title,
+			Notes: notes,
 			Due:   dueValue,
 		}
 		call := svc.Tasks.Insert(tasklistID, task)
@@ -281,7 +309,7 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 	parent := strings.TrimSpace(c.Parent)
 	previous := strings.TrimSpace(c.Previous)
-	baseTitle := strings.TrimSpace(c.Title)
+	baseTitle := title
 	createdTasks := make([]*tasks.Task, 0, len(schedule))
 
 	for i, due := range schedule {
@@ -342,9 +370,39 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 	return nil
 }
 
+// resolveListAndTitle accepts `<tasklistId> [title...]`, `<tasklistId> --title T`
+// and `<title...> --list <tasklistId>`.
+func (c *TasksAddCmd) resolveListAndTitle() (string, string, error) {
+	list := strings.TrimSpace(c.List)
+	words := c.TitleArgs
+	tasklistID := strings.TrimSpace(c.TasklistID)
+	if list != "" {
+		if tasklistID != "" {
+			words = append([]string{tasklistID}, words...)
+		}
+		tasklistID = list
+	}
+	if tasklistID == "" {
+		return "", "", usage("empty tasklistId")
+	}
+	title := strings.TrimSpace(c.Title)
+	argTitle := strings.TrimSpace(strings.Join(words, " "))
+	switch {
+	case title != "" && argTitle != "":
+		return "", "", usage("pass the title either as arguments or with --title, not both")
+	case title == "":
+		title = argTitle
+	}
+	if title == "" {
+		return "", "", usage("required: --title (or pass the title as arguments with --list)")
+	}
+	return tasklistID, title, nil
+}
+
 type TasksUpdateCmd struct {
-	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID"`
-	TaskID     string `arg:"" name:"taskId" help:"Task ID"`
+	TasklistID string `arg:"" optional:"" name:"tasklistId" help:"Task list ID (omit with --list)"`
+	TaskID     string `arg:"" optional:"" name:"taskId" help:"Task ID"`
+	List       string `name:"list" help:"Task list ID (lets you pass only the task ID)"`
 	Title      string `name:"title" help:"New title (set empty to clear)"`
 	Notes      string `name:"notes" help:"New notes (set empty to clear)"`
 	Due        string `name:"due" help:"New due date (RFC3339 or YYYY-MM-DD; time may be ignored; set empty to clear)"`
@@ -357,13 +415,9 @@ func (c *TasksUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *Roo
 	if err != nil {
 		return err
 	}
-	tasklistID := strings.TrimSpace(c.TasklistID)
-	taskID := strings.TrimSpace(c.TaskID)
-	if tasklistID == "" {
-		return usage("empty tasklistId")
-	}
-	if taskID == "" {
-		return usage("empty taskId")
+	tasklistID, taskID, err := resolveTaskArgs(c.TasklistID, c.TaskID, c.List)
+	if err != nil {
+		return err
 	}
 
 	patch := &tasks.Task{}
@@ -426,8 +480,9 @@ func (c *TasksUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *Roo
 }
 
 type TasksDoneCmd struct {
-	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID"`
-	TaskID     string `arg:"" name:"taskId" help:"Task ID"`
+	TasklistID string `arg:"" optional:"" name:"tasklistId" help:"Task list ID (omit with --list)"`
+	TaskID     string `arg:"" optional:"" name:"taskId" help:"Task ID"`
+	List       string `name:"list" help:"Task list ID (lets you pass only the task ID)"`
 }
 
 func (c *TasksDoneCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -436,13 +491,9 @@ func (c *TasksDoneCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	tasklistID := strings.TrimSpace(c.TasklistID)
-	taskID := strings.TrimSpace(c.TaskID)
-	if tasklistID == "" {
-		return usage("empty tasklistId")
-	}
-	if taskID == "" {
-		return usage("empty taskId")
+	tasklistID, taskID, err := resolveTaskArgs(c.TasklistID, c.TaskID, c.List)
+	if err != nil {
+		return err
 	}
 
 	svc, err := newTasksService(ctx, account)
@@ -463,8 +514,9 @@ func (c *TasksDoneCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type TasksUndoCmd struct {
-	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID"`
-	TaskID     string `arg:"" name:"taskId" help:"Task ID"`
+	TasklistID string `arg:"" optional:"" name:"tasklistId" help:"Task list ID (omit with --list)"`
+	TaskID     string `arg:"" optional:"" name:"taskId" help:"Task ID"`
+	List       string `name:"list" help:"Task list ID (lets you pass only the task ID)"`
 }
 
 func (c *TasksUndoCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -473,13 +525,9 @@ func (c *TasksUndoCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	tasklistID := strings.TrimSpace(c.TasklistID)
-	taskID := strings.TrimSpace(c.TaskID)
-	if tasklistID == "" {
-		return usage("empty tasklistId")
-	}
-	if taskID == "" {
-		return usage("empty taskId")
+	tasklistID, taskID, err := resolveTaskArgs(c.TasklistID, c.TaskID, c.List)
+	if err != nil {
+		return err
 	}
 
 	svc, err := newTasksService(ctx, account)
@@ -500,8 +548,9 @@ func (c *TasksUndoCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type TasksDeleteCmd struct {
-	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID"`
-	TaskID     string `arg:"" name:"taskId" help:"Task ID"`
+	TasklistID string `arg:"" optional:"" name:"tasklistId" help:"Task list ID (omit with --list)"`
+	TaskID     string `arg:"" optional:"" name:"taskId" help:"Task ID"`
+	List       string `name:"list" help:"Task list ID (lets you pass only the task ID)"`
 }
 
 func (c *TasksDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -510,13 +559,9 @@ func (c *TasksDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	tasklistID := strings.TrimSpace(c.TasklistID)
-	taskID := strings.TrimSpace(c.TaskID)
-	if tasklistID == "" {
-		return usage("empty tasklistId")
-	}
-	if taskID == "" {
-		return usage("empty taskId")
+	tasklistID, taskID, err := resolveTaskArgs(c.TasklistID, c.TaskID, c.List)
+	if err != nil {
+		return err
 	}
 
 	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete task %s from list %s", taskID, tasklistID)); confirmErr != nil {
@@ -579,3 +624,39 @@ func (c *TasksClearCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u.Out().Printf("tasklistId\t%s", tasklistID)
 	return nil
 }
+
+// resolveTaskArgs accepts `<tasklistId> <taskId>` or `<taskId> --list <tasklistId>`.
+func resolveTaskArgs(tasklistArg, taskArg, list string) (string, string, error) {
+	tasklistID := strings.TrimSpace(tasklistArg)
+	taskID := strings.TrimSpace(taskArg)
+	list = strings.TrimSpace(list)
+	if list != "" {
+		switch {
+		case taskID == "":
+			tasklistID, taskID = list, tasklistID
+		case tasklistID != list:
+			return "", "", usagef("--list %q conflicts with tasklistId %q", list, tasklistID)
+		}
+	}
+	if tasklistID == "" {
+		return "", "", usage("empty tasklistId")
+	}
+	if taskID == "" {
+		return "", "", usage("empty taskId (pass <tasklistId> <taskId> or <taskId> --list <tasklistId>)")
+	}
+	return tasklistID, taskID, nil
+}
+
+func resolveTasklistArg(tasklistArg, list string) (string, error) {
+	tasklistID := strings.TrimSpace(tasklistArg)
+	list = strings.TrimSpace(list)
+	switch {
+	case tasklistID == "" && list == "":
+		return "", usage("empty tasklistId (pass it as an argument or with --list)")
+	case tasklistID == "":
+		return list, nil
+	case list != "" && list != tasklistID:
+		return "", usagef("--list %q conflicts with tasklistId %q", list, tasklistID)
+	}
+	return tasklistID, nil
+}
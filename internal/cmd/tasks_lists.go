@@ -15,6 +15,8 @@ import (
 type TasksListsCmd struct {
 	List   TasksListsListCmd   `cmd:"" default:"withargs" help:"List task lists"`
 	Create TasksListsCreateCmd `cmd:"" name:"create" help:"Create a task list" aliases:"add,new"`
+	Rename TasksListsRenameCmd `cmd:"" name:"rename" help:"Rename a task list"`
+	Delete TasksListsDeleteCmd `cmd:"" name:"delete" help:"Delete a task list and its tasks" aliases:"rm,del"`
 }
 
 type TasksListsListCmd struct {
@@ -94,3 +96,79 @@ func (c *TasksListsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u.Out().Printf("title\t%s", created.Title)
 	return nil
 }
+
+type TasksListsRenameCmd struct {
+	TasklistID string   `arg:"" name:"tasklistId" help:"Task list ID"`
+	Title      []string `arg:"" name:"title" help:"New title"`
+}
+
+func (c *TasksListsRenameCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	tasklistID := strings.TrimSpace(c.TasklistID)
+	if tasklistID == "" {
+		return usage("empty tasklistId")
+	}
+	title := strings.TrimSpace(strings.Join(c.Title, " "))
+	if title == "" {
+		return usage("empty title")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	updated, err := svc.Tasklists.Patch(tasklistID, &tasks.TaskList{Title: title}).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"tasklist": updated})
+	}
+	u.Out().Printf("id\t%s", updated.Id)
+	u.Out().Printf("title\t%s", updated.Title)
+	return nil
+}
+
+type TasksListsDeleteCmd struct {
+	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID"`
+}
+
+func (c *TasksListsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	tasklistID := strings.TrimSpace(c.TasklistID)
+	if tasklistID == "" {
+		return usage("empty tasklistId")
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete task list %s and all of its tasks", tasklistID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.Tasklists.Delete(tasklistID).Do(); err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{
+			"deleted": true,
+			"id":      tasklistID,
+		})
+	}
+	u.Out().Printf("deleted\ttrue")
+	u.Out().Printf("id\t%s", tasklistID)
+	return nil
+}
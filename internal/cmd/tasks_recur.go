@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// taskRecurrenceMarker prefixes the notes line that carries an emulated
+// recurrence rule; Google Tasks has no recurrence support in its API.
+const taskRecurrenceMarker = "gog-repeat:"
+
+type taskRecurrence struct {
+	rule     string
+	unit     repeatUnit
+	interval int
+	weekdays map[time.Weekday]bool
+}
+
+// parseTaskRecurrence parses rules like "every day", "every 2 weeks",
+// "every weekday" and "every monday,thursday".
+func parseTaskRecurrence(raw string) (taskRecurrence, error) {
+	rule := strings.Join(strings.Fields(strings.ToLower(raw)), " ")
+	rest, ok := strings.CutPrefix(rule, "every ")
+	if !ok {
+		return taskRecurrence{}, fmt.Errorf("invalid repeat rule %q (expected e.g. \"every monday\" or \"every 2 weeks\")", raw)
+	}
+	r := taskRecurrence{rule: rule, interval: 1}
+
+	fields := strings.Fields(rest)
+	if n, err := strconv.Atoi(fields[0]); err == nil && len(fields) == 2 {
+		if n <= 0 {
+			return taskRecurrence{}, fmt.Errorf("invalid repeat interval in %q", raw)
+		}
+		r.interval, rest = n, fields[1]
+	}
+	switch strings.TrimSuffix(rest, "s") {
+	case "day":
+		r.unit = repeatDaily
+		return r, nil
+	case "week":
+		r.unit = repeatWeekly
+		return r, nil
+	case "month":
+		r.unit = repeatMonthly
+		return r, nil
+	case "year":
+		r.unit = repeatYearly
+		return r, nil
+	}
+	if r.interval != 1 {
+		return taskRecurrence{}, fmt.Errorf("invalid repeat rule %q", raw)
+	}
+
+	r.unit = repeatWeekly
+	r.weekdays = map[time.Weekday]bool{}
+	if rest == "weekday" || rest == "weekdays" {
+		for d := time.Monday; d <= time.Friday; d++ {
+			r.weekdays[d] = true
+		}
+		return r, nil
+	}
+	for _, name := range strings.FieldsFunc(strings.ReplaceAll(rest, " and ", ","), func(c rune) bool { return c == ',' || c == ' ' }) {
+		day, ok := parseWeekday(name, time.Now())
+		if !ok {
+			return taskRecurrence{}, fmt.Errorf("invalid weekday %q in repeat rule %q", name, raw)
+		}
+		r.weekdays[day.Weekday()] = true
+	}
+	return r, nil
+}
+
+// next returns the first occurrence strictly after from (a UTC date).
+func (r taskRecurrence) next(from time.Time) time.Time {
+	if len(r.weekdays) > 0 {
+		d := from.AddDate(0, 0, 1)
+		for !r.weekdays[d.Weekday()] {
+			d = d.AddDate(0, 0, 1)
+		}
+		return d
+	}
+	return addRepeat(from, r.unit, r.interval)
+}
+
+// first returns the first occurrence on or after day.
+func (r taskRecurrence) first(day time.Time) time.Time {
+	if len(r.weekdays) > 0 {
+		return r.next(day.AddDate(0, 0, -1))
+	}
+	return day
+}
+
+// taskRecurrenceFromNotes returns the rule stored in task notes, if any.
+func taskRecurrenceFromNotes(notes string) (taskRecurrence, bool) {
+	for _, line := range strings.Split(notes, "\n") {
+		if rule, ok := strings.CutPrefix(strings.TrimSpace(line), taskRecurrenceMarker); ok {
+			r, err := parseTaskRecurrence(rule)
+			return r, err == nil
+		}
+	}
+	return taskRecurrence{}, false
+}
+
+// setTaskRecurrence replaces any recurrence line in notes with rule (or
+// removes it when rule is empty).
+func setTaskRecurrence(notes, rule string) string {
+	var kept []string
+	for _, line := range strings.Split(notes, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), taskRecurrenceMarker) {
+			kept = append(kept, line)
+		}
+	}
+	out := strings.TrimSpace(strings.Join(kept, "\n"))
+	if rule == "" {
+		return out
+	}
+	if out != "" {
+		out += "\n\n"
+	}
+	return out + taskRecurrenceMarker + " " + rule
+}
+
+func utcDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+type TasksRecurCmd struct {
+	Run TasksRecurRunCmd `cmd:"" name:"run" help:"Re-create completed recurring tasks (run from cron or a scheduler)"`
+}
+
+type TasksRecurRunCmd struct {
+	List   string `name:"list" help:"Only process this task list (default: all lists)"`
+	DryRun bool   `name:"dry-run" help:"Show what would be created without changing anything"`
+}
+
+type taskRecurrenceResult struct {
+	Tasklist string      `json:"tasklist"`
+	From     string      `json:"from"`
+	Rule     string      `json:"rule"`
+	Due      string      `json:"due"`
+	Task     *tasks.Task `json:"task,omitempty"`
+}
+
+func (c *TasksRecurRunCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	listIDs := []string{strings.TrimSpace(c.List)}
+	if listIDs[0] == "" {
+		listIDs = nil
+		page := ""
+		for {
+			resp, listErr := svc.Tasklists.List().MaxResults(100).PageToken(page).Do()
+			if listErr != nil {
+				return listErr
+			}
+			for _, l := range resp.Items {
+				listIDs = append(listIDs, l.Id)
+			}
+			if resp.NextPageToken == "" {
+				break
+			}
+			page = resp.NextPageToken
+		}
+	}
+
+	today := utcDate(time.Now())
+	results := []taskRecurrenceResult{}
+	for _, listID := range listIDs {
+		page := ""
+		for {
+			resp, listErr := svc.Tasks.List(listID).
+				ShowCompleted(true).
+				ShowHidden(true).
+				MaxResults(100).
+				PageToken(page).
+				Do()
+			if listErr != nil {
+				return listErr
+			}
+			for _, t := range resp.Items {
+				if t == nil || t.Status != "completed" {
+					continue
+				}
+				rule, ok := taskRecurrenceFromNotes(t.Notes)
+				if !ok {
+					continue
+				}
+				res, runErr := c.recreate(svc, listID, t, rule, today)
+				if runErr != nil {
+					return runErr
+				}
+				results = append(results, res)
+			}
+			if resp.NextPageToken == "" {
+				break
+			}
+			page = resp.NextPageToken
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"created": results, "dryRun": c.DryRun})
+	}
+	if len(results) == 0 {
+		u.Err().Println("No completed recurring tasks")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "TASKLIST\tFROM\tNEW\tDUE\tRULE")
+	for _, r := range results {
+		id := "(dry-run)"
+		if r.Task != nil {
+			id = r.Task.Id
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Tasklist, r.From, id, r.Due, r.Rule)
+	}
+	return nil
+}
+
+// recreate inserts the next occurrence of a completed recurring task and moves
+// the rule off the completed task so later runs do not repeat it again.
+func (c *TasksRecurRunCmd) recreate(svc *tasks.Service, listID string, done *tasks.Task, rule taskRecurrence, today time.Time) (taskRecurrenceResult, error) {
+	base := today.AddDate(0, 0, -1)
+	if due, _, err := parseTaskDate(done.Due); err == nil {
+		base = utcDate(due)
+	}
+	due := rule.next(base)
+	for due.Before(today) {
+		due = rule.next(due)
+	}
+
+	res := taskRecurrenceResult{Tasklist: listID, From: done.Id, Rule: rule.rule, Due: formatTaskDue(due, false)}
+	if c.DryRun {
+		return res, nil
+	}
+
+	call := svc.Tasks.Insert(listID, &tasks.Task{Title: done.Title, Notes: done.Notes, Due: res.Due})
+	if strings.TrimSpace(done.Parent) != "" {
+		call = call.Parent(done.Parent)
+	}
+	created, err := call.Do()
+	if err != nil {
+		return res, err
+	}
+	res.Task = created
+
+	if _, err := svc.Tasks.Patch(listID, done.Id, &tasks.Task{
+		Notes:           setTaskRecurrence(done.Notes, ""),
+		ForceSendFields: []string{"Notes"},
+	}).Do(); err != nil {
+		return res, fmt.Errorf("created %s but could not clear the rule on %s: %w", created.Id, done.Id, err)
+	}
+	return res, nil
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"google.golang.org/api/option"
 	"google.golang.org/api/tasks/v1"
@@ -167,3 +168,43 @@ func TestTasksAddCmd_RepeatUntilDateOnlyWithTimeDue(t *testing.T) {
 		t.Fatalf("unexpected due schedule: %#v", gotDue)
 	}
 }
+
+func TestParseTaskRecurrence(t *testing.T) {
+	monday := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		rule string
+		want string
+	}{
+		{"every day", "2025-01-07"},
+		{"Every 2 weeks", "2025-01-20"},
+		{"every month", "2025-02-06"},
+		{"every monday", "2025-01-13"},
+		{"every tuesday, friday", "2025-01-07"},
+		{"every weekday", "2025-01-07"},
+	}
+	for _, tc := range cases {
+		r, err := parseTaskRecurrence(tc.rule)
+		if err != nil {
+			t.Fatalf("parse %q: %v", tc.rule, err)
+		}
+		if got := r.next(monday).Format("2006-01-02"); got != tc.want {
+			t.Fatalf("%q: next = %s, want %s", tc.rule, got, tc.want)
+		}
+	}
+	for _, bad := range []string{"daily", "every", "every 0 days", "every blursday", "every 2 mondays"} {
+		if _, err := parseTaskRecurrence(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+
+	notes := setTaskRecurrence("Buy milk", "every monday")
+	if notes != "Buy milk\n\ngog-repeat: every monday" {
+		t.Fatalf("unexpected notes: %q", notes)
+	}
+	if r, ok := taskRecurrenceFromNotes(notes); !ok || r.rule != "every monday" {
+		t.Fatalf("rule not found in %q", notes)
+	}
+	if got := setTaskRecurrence(notes, ""); got != "Buy milk" {
+		t.Fatalf("unexpected cleared notes: %q", got)
+	}
+}
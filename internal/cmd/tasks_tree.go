@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type TasksMoveCmd struct {
+	TasklistID string `arg:"" optional:"" name:"tasklistId" help:"Task list ID (omit with --list)"`
+	TaskID     string `arg:"" optional:"" name:"taskId" help:"Task ID"`
+	List       string `name:"list" help:"Task list ID (lets you pass only the task ID)"`
+	After      string `name:"after" aliases:"previous" help:"Place the task after this sibling (default: first position)"`
+	Parent     string `name:"parent" help:"Make the task a subtask of this task"`
+}
+
+func (c *TasksMoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	tasklistID, taskID, err := resolveTaskArgs(c.TasklistID, c.TaskID, c.List)
+	if err != nil {
+		return err
+	}
+	after := strings.TrimSpace(c.After)
+	parent := strings.TrimSpace(c.Parent)
+	if after == taskID || parent == taskID {
+		return usage("a task cannot be moved relative to itself")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.Tasks.Move(tasklistID, taskID)
+	if parent != "" {
+		call = call.Parent(parent)
+	}
+	if after != "" {
+		call = call.Previous(after)
+	}
+	moved, err := call.Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(os.Stdout, map[string]any{"task": moved})
+	}
+	u.Out().Printf("id\t%s", moved.Id)
+	u.Out().Printf("title\t%s", moved.Title)
+	if strings.TrimSpace(moved.Parent) != "" {
+		u.Out().Printf("parent\t%s", moved.Parent)
+	}
+	u.Out().Printf("position\t%s", moved.Position)
+	return nil
+}
+
+type taskTreeRow struct {
+	task  *tasks.Task
+	depth int
+}
+
+// orderTaskTree sorts tasks by position with subtasks directly below their
+// parent. Subtasks whose parent is not in items (another page, or hidden)
+// are shown at the top level.
+func orderTaskTree(items []*tasks.Task) []taskTreeRow {
+	byID := make(map[string]bool, len(items))
+	for _, t := range items {
+		if t != nil {
+			byID[t.Id] = true
+		}
+	}
+	children := map[string][]*tasks.Task{}
+	for _, t := range items {
+		if t == nil {
+			continue
+		}
+		parent := t.Parent
+		if !byID[parent] {
+			parent = ""
+		}
+		children[parent] = append(children[parent], t)
+	}
+	for _, list := range children {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Position < list[j].Position })
+	}
+
+	rows := make([]taskTreeRow, 0, len(items))
+	var walk func(parent string, depth int)
+	walk = func(parent string, depth int) {
+		for _, t := range children[parent] {
+			rows = append(rows, taskTreeRow{task: t, depth: depth})
+			walk(t.Id, depth+1)
+		}
+	}
+	walk("", 0)
+	return rows
+}
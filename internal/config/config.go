@@ -10,11 +10,13 @@ import (
 )
 
 type File struct {
-	KeyringBackend  string            `json:"keyring_backend,omitempty"`
-	DefaultTimezone string            `json:"default_timezone,omitempty"`
-	AccountAliases  map[string]string `json:"account_aliases,omitempty"`
-	AccountClients  map[string]string `json:"account_clients,omitempty"`
-	ClientDomains   map[string]string `json:"client_domains,omitempty"`
+	KeyringBackend  string             `json:"keyring_backend,omitempty"`
+	DefaultTimezone string             `json:"default_timezone,omitempty"`
+	AccountAliases  map[string]string  `json:"account_aliases,omitempty"`
+	AccountClients  map[string]string  `json:"account_clients,omitempty"`
+	ClientDomains   map[string]string  `json:"client_domains,omitempty"`
+	Profiles        map[string]Profile `json:"profiles,omitempty"`
+	CurrentProfile  string             `json:"current_profile,omitempty"`
 }
 
 func ConfigPath() (string, error) {
@@ -135,6 +135,94 @@ func GmailWatchDir() (string, error) {
 	return filepath.Join(dir, "state", "gmail-watch"), nil
 }
 
+func GmailTemplatesDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "gmail-templates"), nil
+}
+
+func EnsureGmailTemplatesDir() (string, error) {
+	dir, err := GmailTemplatesDir()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("ensure gmail templates dir: %w", err)
+	}
+
+	return dir, nil
+}
+
+func GmailStatsDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "state", "gmail-stats"), nil
+}
+
+func EnsureGmailStatsDir() (string, error) {
+	dir, err := GmailStatsDir()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("ensure gmail stats dir: %w", err)
+	}
+
+	return dir, nil
+}
+
+func GmailIndexDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "state", "gmail-index"), nil
+}
+
+func EnsureGmailIndexDir() (string, error) {
+	dir, err := GmailIndexDir()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("ensure gmail index dir: %w", err)
+	}
+
+	return dir, nil
+}
+
+func GmailDeleteUndoDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "state", "gmail-delete"), nil
+}
+
+func EnsureGmailDeleteUndoDir() (string, error) {
+	dir, err := GmailDeleteUndoDir()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("ensure gmail delete undo dir: %w", err)
+	}
+
+	return dir, nil
+}
+
 func KeepServiceAccountPath(email string) (string, error) {
 	dir, err := Dir()
 	if err != nil {
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Profile groups an account with default settings so work and personal
+// identities can be switched with --profile, GOG_PROFILE or
+// `gog auth profile use`.
+type Profile struct {
+	Account     string `json:"account"`
+	Client      string `json:"client,omitempty"`
+	Output      string `json:"output,omitempty"`
+	Calendar    string `json:"calendar,omitempty"`
+	DriveFolder string `json:"drive_folder,omitempty"`
+}
+
+var errUnknownProfile = errors.New("unknown profile")
+
+func NormalizeProfileName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Project returns the profile as a defaults layer shaped like .gogcli.toml.
+func (p Profile) Project() Project {
+	return Project{
+		Account:     p.Account,
+		Client:      p.Client,
+		Output:      p.Output,
+		Calendar:    p.Calendar,
+		DriveFolder: p.DriveFolder,
+	}
+}
+
+func GetProfile(name string) (Profile, error) {
+	name = NormalizeProfileName(name)
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		return Profile{}, err
+	}
+
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("%w %q (see: gog auth profile list)", errUnknownProfile, name)
+	}
+
+	return p, nil
+}
+
+func SetProfile(name string, p Profile) error {
+	name = NormalizeProfileName(name)
+	if name == "" {
+		return errors.New("empty profile name")
+	}
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]Profile{}
+	}
+
+	p.Account = strings.ToLower(strings.TrimSpace(p.Account))
+	cfg.Profiles[name] = p
+
+	return WriteConfig(cfg)
+}
+
+func DeleteProfile(name string) (bool, error) {
+	name = NormalizeProfileName(name)
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		return false, err
+	}
+
+	if _, ok := cfg.Profiles[name]; !ok {
+		return false, nil
+	}
+
+	delete(cfg.Profiles, name)
+
+	if cfg.CurrentProfile == name {
+		cfg.CurrentProfile = ""
+	}
+
+	return true, WriteConfig(cfg)
+}
+
+// UseProfile makes name the current profile; "" clears it.
+func UseProfile(name string) error {
+	name = NormalizeProfileName(name)
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		return err
+	}
+
+	if name != "" {
+		if _, ok := cfg.Profiles[name]; !ok {
+			return fmt.Errorf("%w %q (see: gog auth profile list)", errUnknownProfile, name)
+		}
+	}
+
+	cfg.CurrentProfile = name
+
+	return WriteConfig(cfg)
+}
+
+// ListProfiles returns all profiles and the current one.
+func ListProfiles() (map[string]Profile, string, error) {
+	cfg, err := ReadConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	out := make(map[string]Profile, len(cfg.Profiles))
+	for k, v := range cfg.Profiles {
+		out[k] = v
+	}
+
+	return out, cfg.CurrentProfile, nil
+}
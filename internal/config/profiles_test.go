@@ -0,0 +1,48 @@
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfilesCRUD(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	if err := SetProfile("Work", Profile{Account: "Me@Company.com", Output: "json"}); err != nil {
+		t.Fatalf("set profile: %v", err)
+	}
+
+	p, err := GetProfile("work")
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+
+	if p.Account != "me@company.com" || p.Project().Output != "json" {
+		t.Fatalf("unexpected profile: %#v", p)
+	}
+
+	if err := UseProfile("nope"); !errors.Is(err, errUnknownProfile) {
+		t.Fatalf("expected unknown profile, got %v", err)
+	}
+
+	if err := UseProfile("work"); err != nil {
+		t.Fatalf("use profile: %v", err)
+	}
+
+	profiles, current, err := ListProfiles()
+	if err != nil || current != "work" || len(profiles) != 1 {
+		t.Fatalf("unexpected list: %#v %q %v", profiles, current, err)
+	}
+
+	deleted, err := DeleteProfile("work")
+	if err != nil || !deleted {
+		t.Fatalf("delete profile: %v %v", deleted, err)
+	}
+
+	if _, current, _ := ListProfiles(); current != "" {
+		t.Fatalf("expected current profile cleared, got %q", current)
+	}
+}
@@ -0,0 +1,213 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ProjectFileName is the per-directory config discovered upward from the
+// working directory.
+const ProjectFileName = ".gogcli.toml"
+
+// Project pins defaults for everything run below the directory holding the
+// file. Flags and environment variables still win.
+type Project struct {
+	Path        string `json:"path"`
+	Account     string `json:"account,omitempty"`
+	Client      string `json:"client,omitempty"`
+	Output      string `json:"output,omitempty"`
+	Calendar    string `json:"calendar,omitempty"`
+	DriveFolder string `json:"drive_folder,omitempty"`
+
+	// Ignored lists keys this version does not know, e.g. ones added by a
+	// newer gog or typos.
+	Ignored []string `json:"ignored,omitempty"`
+}
+
+var errProjectNotFound = errors.New("no project config found")
+
+// FindProjectFile walks up from dir and returns the nearest .gogcli.toml.
+func FindProjectFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		path := filepath.Join(dir, ProjectFileName)
+		if st, statErr := os.Stat(path); statErr == nil && !st.IsDir() {
+			return path, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errProjectNotFound
+		}
+
+		dir = parent
+	}
+}
+
+// LoadProject reads the nearest .gogcli.toml above the working directory.
+// ok is false when there is none.
+func LoadProject() (Project, bool, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return Project{}, false, nil //nolint:nilerr // no working directory, no project config
+	}
+
+	path, err := FindProjectFile(wd)
+	if errors.Is(err, errProjectNotFound) {
+		return Project{}, false, nil
+	}
+
+	if err != nil {
+		return Project{}, false, err
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // discovered config file
+	if err != nil {
+		return Project{}, false, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	p, err := ParseProject(data)
+	if err != nil {
+		return Project{}, false, fmt.Errorf("%s: %w", path, err)
+	}
+
+	p.Path = path
+
+	return p, true, nil
+}
+
+// ParseProject parses the small TOML subset used by .gogcli.toml: comments,
+// [calendar]/[drive] tables (or dotted keys) and string values. Unknown keys
+// are collected in Ignored rather than rejected.
+//
+//	account = "work@company.com"
+//	client = "work"
+//	output = "json"    # json | plain | text
+//
+//	[calendar]
+//	default = "team@group.calendar.google.com"
+//
+//	[drive]
+//	folder = "<folderId>"
+func ParseProject(data []byte) (Project, error) {
+	var p Project
+
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(stripTOMLComment(scanner.Text()))
+
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || strings.HasPrefix(line, "[[") {
+				return Project{}, fmt.Errorf("line %d: invalid table header %q", lineNo, line)
+			}
+
+			section = strings.TrimSpace(line[1 : len(line)-1])
+
+			continue
+		}
+
+		key, raw, found := strings.Cut(line, "=")
+		if !found {
+			return Project{}, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+
+		key = strings.TrimSpace(key)
+		if section != "" {
+			key = section + "." + key
+		}
+
+		value, err := parseTOMLValue(strings.TrimSpace(raw))
+		if err != nil {
+			return Project{}, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+
+		switch key {
+		case "account":
+			p.Account = value
+		case "client":
+			p.Client = value
+		case "output":
+			switch strings.ToLower(value) {
+			case "json", "plain", "text", "":
+				p.Output = strings.ToLower(value)
+			default:
+				return Project{}, fmt.Errorf("line %d: output must be json, plain or text", lineNo)
+			}
+		case "calendar", "calendar.default", "calendar.id":
+			p.Calendar = value
+		case "drive_folder", "drive.folder":
+			p.DriveFolder = value
+		default:
+			p.Ignored = append(p.Ignored, key)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Project{}, err
+	}
+
+	return p, nil
+}
+
+func stripTOMLComment(line string) string {
+	var quote byte
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+func parseTOMLValue(raw string) (string, error) {
+	switch {
+	case raw == "":
+		return "", errors.New("missing value")
+	case strings.HasPrefix(raw, `"`):
+		v, err := strconv.Unquote(raw)
+		if err != nil {
+			return "", fmt.Errorf("invalid string %s", raw)
+		}
+
+		return strings.TrimSpace(v), nil
+	case strings.HasPrefix(raw, "'"):
+		if len(raw) < 2 || !strings.HasSuffix(raw, "'") || strings.Contains(raw[1:len(raw)-1], "'") {
+			return "", fmt.Errorf("invalid string %s", raw)
+		}
+
+		return strings.TrimSpace(raw[1 : len(raw)-1]), nil
+	default:
+		return "", fmt.Errorf("value must be a quoted string, got %s", raw)
+	}
+}
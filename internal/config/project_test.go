@@ -0,0 +1,100 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseProject(t *testing.T) {
+	p, err := ParseProject([]byte(`# pinned for this repo
+account = "work@company.com"  # trailing comment
+client = 'work'
+output = "JSON"
+
+[calendar]
+default = "team#1@group.calendar.google.com"
+
+[drive]
+folder = "0AbC"
+`))
+	if err != nil {
+		t.Fatalf("ParseProject: %v", err)
+	}
+
+	if p.Account != "work@company.com" || p.Client != "work" || p.Output != "json" {
+		t.Fatalf("unexpected top-level values: %+v", p)
+	}
+
+	if p.Calendar != "team#1@group.calendar.google.com" || p.DriveFolder != "0AbC" {
+		t.Fatalf("unexpected table values: %+v", p)
+	}
+
+	p, err = ParseProject([]byte("calendar.default = \"c1\"\ndrive_folder = \"f1\"\n"))
+	if err != nil || p.Calendar != "c1" || p.DriveFolder != "f1" {
+		t.Fatalf("dotted keys: %+v (%v)", p, err)
+	}
+}
+
+func TestParseProjectIgnoresUnknownKeys(t *testing.T) {
+	p, err := ParseProject([]byte("acount = \"x\"\naccount = \"a@b.com\"\n\n[drive]\nshared = \"y\"\n"))
+	if err != nil {
+		t.Fatalf("ParseProject: %v", err)
+	}
+
+	if p.Account != "a@b.com" || len(p.Ignored) != 2 || p.Ignored[0] != "acount" || p.Ignored[1] != "drive.shared" {
+		t.Fatalf("unexpected project: %+v", p)
+	}
+}
+
+func TestParseProjectErrors(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want string
+	}{
+		{"account = x", "quoted string"},
+		{"\noutput = \"yaml\"", "line 2: output must be"},
+		{"[drive\nfolder = \"x\"", "invalid table header"},
+		{"account", "expected key = value"},
+	} {
+		if _, err := ParseProject([]byte(tc.in)); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%q: expected %q, got %v", tc.in, tc.want, err)
+		}
+	}
+}
+
+func TestLoadProjectWalksUp(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "a", "b")
+
+	if err := os.MkdirAll(nested, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	t.Chdir(nested)
+
+	if _, ok, err := LoadProject(); err != nil || ok {
+		t.Fatalf("expected no project config, got ok=%v err=%v", ok, err)
+	}
+
+	path := filepath.Join(root, ProjectFileName)
+	if err := os.WriteFile(path, []byte(`account = "a@b.com"`), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	p, ok, err := LoadProject()
+	if err != nil || !ok {
+		t.Fatalf("LoadProject: ok=%v err=%v", ok, err)
+	}
+
+	if p.Account != "a@b.com" {
+		t.Fatalf("unexpected account: %q", p.Account)
+	}
+
+	if resolved, _ := filepath.EvalSymlinks(p.Path); resolved != path {
+		if evalPath, _ := filepath.EvalSymlinks(path); resolved != evalPath {
+			t.Fatalf("unexpected path: %q", p.Path)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !windows
+
+package config
+
+import (
+	"net"
+	"syscall"
+)
+
+// ListenPrivateSocket listens on a unix socket at path that only the current
+// user can connect to. The socket is created 0600 under a restrictive umask;
+// a chmod after Listen would leave it open to other users for a moment.
+func ListenPrivateSocket(path string) (net.Listener, error) {
+	old := syscall.Umask(0o177)
+	defer syscall.Umask(old)
+
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,11 @@
+//go:build windows
+
+package config
+
+import "net"
+
+// ListenPrivateSocket listens on a unix socket at path. Windows has no umask;
+// the socket inherits the ACL of its directory.
+func ListenPrivateSocket(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
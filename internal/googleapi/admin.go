@@ -0,0 +1,35 @@
+package googleapi
+
+import (
+	"context"
+	"fmt"
+
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+const (
+	scopeAdminDirectoryUser   = "https://www.googleapis.com/auth/admin.directory.user"
+	scopeAdminDirectoryUserRO = "https://www.googleapis.com/auth/admin.directory.user.readonly"
+)
+
+// NewAdminDirectory creates an Admin SDK Directory service for reading users.
+// The account must be a Workspace admin (or impersonated by a service account).
+func NewAdminDirectory(ctx context.Context, email string) (*admin.Service, error) {
+	return newAdminDirectory(ctx, email, scopeAdminDirectoryUserRO)
+}
+
+// NewAdminDirectoryWriter creates an Admin SDK Directory service that can
+// update users (suspend/restore).
+func NewAdminDirectoryWriter(ctx context.Context, email string) (*admin.Service, error) {
+	return newAdminDirectory(ctx, email, scopeAdminDirectoryUser)
+}
+
+func newAdminDirectory(ctx context.Context, email string, scope string) (*admin.Service, error) {
+	if opts, err := optionsForAccountScopes(ctx, "admin", email, []string{scope}); err != nil {
+		return nil, fmt.Errorf("admin options: %w", err)
+	} else if svc, err := admin.NewService(ctx, opts...); err != nil {
+		return nil, fmt.Errorf("create admin service: %w", err)
+	} else {
+		return svc, nil
+	}
+}
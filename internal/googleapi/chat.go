@@ -3,6 +3,7 @@ package googleapi
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"google.golang.org/api/chat/v1"
 )
@@ -12,6 +13,7 @@ const (
 	scopeChatMessages    = "https://www.googleapis.com/auth/chat.messages"
 	scopeChatMemberships = "https://www.googleapis.com/auth/chat.memberships"
 	scopeChatReadStateRO = "https://www.googleapis.com/auth/chat.users.readstate.readonly"
+	scopeChatBot         = "https://www.googleapis.com/auth/chat.bot"
 )
 
 func NewChat(ctx context.Context, email string) (*chat.Service, error) {
@@ -23,3 +25,21 @@ func NewChat(ctx context.Context, email string) (*chat.Service, error) {
 		return svc, nil
 	}
 }
+
+// NewChatApp authenticates as a Chat app using its service account key (no
+// user impersonation), so messages are posted by the app itself.
+func NewChatApp(ctx context.Context, keyPath string) (*chat.Service, error) {
+	data, err := os.ReadFile(keyPath) //nolint:gosec // user-provided path
+	if err != nil {
+		return nil, fmt.Errorf("read chat app key: %w", err)
+	}
+	ts, err := newServiceAccountTokenSource(ctx, data, "", []string{scopeChatBot})
+	if err != nil {
+		return nil, err
+	}
+	svc, err := chat.NewService(ctx, optionsForTokenSource(ts)...)
+	if err != nil {
+		return nil, fmt.Errorf("create chat service: %w", err)
+	}
+	return svc, nil
+}
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/api/option"
 
 	"github.com/steipete/gogcli/internal/authclient"
+	"github.com/steipete/gogcli/internal/authdaemon"
 	"github.com/steipete/gogcli/internal/config"
 	"github.com/steipete/gogcli/internal/googleauth"
 	"github.com/steipete/gogcli/internal/secrets"
@@ -50,6 +51,20 @@ func tokenSourceForAccount(ctx context.Context, service googleauth.Service, emai
 }
 
 func tokenSourceForAccountScopes(ctx context.Context, serviceLabel string, email string, client string, clientID string, clientSecret string, requiredScopes []string) (oauth2.TokenSource, error) {
+	// A running `gog auth daemon` hands out fresh access tokens without
+	// touching the keyring; the stored refresh token is only the fallback.
+	if socket := authdaemon.SocketPath(); socket != "" {
+		req := authdaemon.Request{Client: client, Email: email, Scopes: requiredScopes}
+
+		return oauth2.ReuseTokenSource(nil, authdaemon.NewTokenSource(ctx, socket, req, func() (oauth2.TokenSource, error) {
+			return storedTokenSource(ctx, serviceLabel, email, client, clientID, clientSecret, requiredScopes)
+		})), nil
+	}
+
+	return storedTokenSource(ctx, serviceLabel, email, client, clientID, clientSecret, requiredScopes)
+}
+
+func storedTokenSource(ctx context.Context, serviceLabel string, email string, client string, clientID string, clientSecret string, requiredScopes []string) (oauth2.TokenSource, error) {
 	var store secrets.Store
 
 	if s, err := openSecretsStore(); err != nil {
@@ -93,6 +108,18 @@ func optionsForAccount(ctx context.Context, service googleauth.Service, email st
 }
 
 func optionsForAccountScopes(ctx context.Context, serviceLabel string, email string, scopes []string) ([]option.ClientOption, error) {
+	c, err := httpClientForAccountScopes(ctx, serviceLabel, email, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return []option.ClientOption{option.WithHTTPClient(c)}, nil
+}
+
+// httpClientForAccountScopes returns an authorized HTTP client (service
+// account when configured, else the stored OAuth token) for APIs without a
+// generated Go client.
+func httpClientForAccountScopes(ctx context.Context, serviceLabel string, email string, scopes []string) (*http.Client, error) {
 	slog.Debug("creating client options with custom scopes", "serviceLabel", serviceLabel, "email", email)
 
 	var creds config.ClientCredentials
@@ -104,6 +131,8 @@ func optionsForAccountScopes(ctx context.Context, serviceLabel string, email str
 	} else if ok {
 		slog.Debug("using service account credentials", "email", email, "path", saPath)
 		ts = serviceAccountTS
+	} else if authclient.ServiceAccountOnly(ctx) {
+		return nil, fmt.Errorf("no service account key stored for %s (run: gog auth service-account add --key <key.json> --subject %s)", email, email)
 	} else {
 		client, err := authclient.ResolveClient(ctx, email)
 		if err != nil {
@@ -122,6 +151,17 @@ func optionsForAccountScopes(ctx context.Context, serviceLabel string, email str
 			ts = tokenSource
 		}
 	}
+
+	slog.Debug("client options with custom scopes created successfully", "serviceLabel", serviceLabel, "email", email)
+
+	return httpClientForTokenSource(ts), nil
+}
+
+func optionsForTokenSource(ts oauth2.TokenSource) []option.ClientOption {
+	return []option.ClientOption{option.WithHTTPClient(httpClientForTokenSource(ts))}
+}
+
+func httpClientForTokenSource(ts oauth2.TokenSource) *http.Client {
 	baseTransport := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			MinVersion: tls.VersionTLS12,
@@ -132,12 +172,8 @@ func optionsForAccountScopes(ctx context.Context, serviceLabel string, email str
 		Source: ts,
 		Base:   baseTransport,
 	})
-	c := &http.Client{
+	return &http.Client{
 		Transport: retryTransport,
 		Timeout:   defaultHTTPTimeout,
 	}
-
-	slog.Debug("client options with custom scopes created successfully", "serviceLabel", serviceLabel, "email", email)
-
-	return []option.ClientOption{option.WithHTTPClient(c)}, nil
 }
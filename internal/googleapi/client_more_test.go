@@ -5,11 +5,15 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/99designs/keyring"
 	"golang.org/x/oauth2"
 
+	"github.com/steipete/gogcli/internal/authclient"
+	"github.com/steipete/gogcli/internal/authdaemon"
 	"github.com/steipete/gogcli/internal/config"
 	"github.com/steipete/gogcli/internal/googleauth"
 	"github.com/steipete/gogcli/internal/secrets"
@@ -121,6 +125,68 @@ func TestTokenSourceForAccountScopes_HappyPath(t *testing.T) {
 	}
 }
 
+func TestTokenSourceForAccountScopes_UsesAuthDaemon(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gogd")
+	if err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	socket := filepath.Join(dir, "auth.sock")
+
+	ln, err := authdaemon.Listen(socket)
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+
+	srv := authdaemon.NewServer([]authdaemon.Account{{Client: "default", Email: "a@b.com"}}, func(_ context.Context, acc authdaemon.Account, scopes []string) (string, time.Time, error) {
+		return "from-daemon:" + acc.Client + ":" + strings.Join(scopes, ","), time.Now().Add(time.Hour), nil
+	}, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() { _ = srv.Serve(ctx, ln) }()
+
+	t.Setenv(authdaemon.SocketEnv, socket)
+
+	origOpen := openSecretsStore
+
+	t.Cleanup(func() { openSecretsStore = origOpen })
+
+	openSecretsStore = func() (secrets.Store, error) {
+		t.Fatalf("keyring must not be opened while the daemon serves the account")
+		return nil, errBoom
+	}
+
+	ts, err := tokenSourceForAccountScopes(context.Background(), "svc", "a@b.com", "default", "id", "secret", []string{"s1"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	tok, err := ts.Token()
+	if err != nil || tok.AccessToken != "from-daemon:default:s1" {
+		t.Fatalf("unexpected token: %#v %v", tok, err)
+	}
+
+	// The daemon does not hold a token for another OAuth client of the same
+	// account; that must come from the keyring, not the daemon's client.
+	store := &stubStore{tok: secrets.Token{Email: "a@b.com", RefreshToken: "rt"}}
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	ts, err = tokenSourceForAccountScopes(context.Background(), "svc", "a@b.com", "work", "id", "secret", []string{"s1"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	_, _ = ts.Token()
+
+	if store.lastClient != "work" {
+		t.Fatalf("expected keyring fallback for client work, got %q", store.lastClient)
+	}
+}
+
 func TestTokenSourceForAccount_ReadCredsError(t *testing.T) {
 	origRead := readClientCredentials
 
@@ -257,3 +323,81 @@ func TestOptionsForAccountScopes_ServiceAccountPreferred(t *testing.T) {
 		t.Fatalf("expected client options")
 	}
 }
+
+func TestOptionsForAccountScopes_ServiceAccountOnlyNoKey(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	origOpen := openSecretsStore
+
+	t.Cleanup(func() { openSecretsStore = origOpen })
+
+	openSecretsStore = func() (secrets.Store, error) {
+		t.Fatalf("openSecretsStore should not be called")
+		return nil, errBoom
+	}
+
+	ctx := authclient.WithServiceAccountOnly(context.Background(), true)
+
+	_, err := optionsForAccountScopes(ctx, "svc", "a@b.com", []string{"s1"})
+	if err == nil || !strings.Contains(err.Error(), "no service account key stored for a@b.com") {
+		t.Fatalf("expected missing service account error, got: %v", err)
+	}
+}
+
+func TestOptionsForAccountScopes_ImpersonateUsesDelegatingKey(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	saPath, err := config.ServiceAccountPath("admin@b.com")
+	if err != nil {
+		t.Fatalf("ServiceAccountPath: %v", err)
+	}
+
+	if _, ensureErr := config.EnsureDir(); ensureErr != nil {
+		t.Fatalf("EnsureDir: %v", ensureErr)
+	}
+
+	if writeErr := os.WriteFile(saPath, []byte(`{"type":"service_account","client_email":"admin-key"}`), 0o600); writeErr != nil {
+		t.Fatalf("write sa: %v", writeErr)
+	}
+
+	origSA := newServiceAccountTokenSource
+
+	t.Cleanup(func() { newServiceAccountTokenSource = origSA })
+
+	var subjects []string
+	newServiceAccountTokenSource = func(_ context.Context, keyJSON []byte, subject string, _ []string) (oauth2.TokenSource, error) {
+		if !strings.Contains(string(keyJSON), "admin-key") {
+			t.Fatalf("unexpected key: %s", keyJSON)
+		}
+
+		subjects = append(subjects, subject)
+
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "t"}), nil
+	}
+
+	// No explicit key account: the only stored key is used.
+	ctx := authclient.WithImpersonation(context.Background(), "user@b.com", "")
+	if _, err := optionsForAccountScopes(ctx, "svc", "user@b.com", []string{"s1"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	ctx = authclient.WithImpersonation(context.Background(), "user@b.com", "admin@b.com")
+	if _, err := optionsForAccountScopes(ctx, "svc", "user@b.com", []string{"s1"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if len(subjects) != 2 || subjects[0] != "user@b.com" || subjects[1] != "user@b.com" {
+		t.Fatalf("unexpected subjects: %v", subjects)
+	}
+
+	ctx = authclient.WithImpersonation(context.Background(), "user@b.com", "other@b.com")
+
+	_, err = optionsForAccountScopes(ctx, "svc", "user@b.com", []string{"s1"})
+	if err == nil || !strings.Contains(err.Error(), "needs a service account key for other@b.com") {
+		t.Fatalf("expected missing key error, got: %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 )
 
 const (
+	scopeCloudIdentityGroups   = "https://www.googleapis.com/auth/cloud-identity.groups"
 	scopeCloudIdentityGroupsRO = "https://www.googleapis.com/auth/cloud-identity.groups.readonly"
 )
 
@@ -22,3 +23,15 @@ func NewCloudIdentityGroups(ctx context.Context, email string) (*cloudidentity.S
 		return svc, nil
 	}
 }
+
+// NewCloudIdentityGroupsWriter creates a Cloud Identity service that can
+// change group memberships (group owners/managers or admins).
+func NewCloudIdentityGroupsWriter(ctx context.Context, email string) (*cloudidentity.Service, error) {
+	if opts, err := optionsForAccountScopes(ctx, "cloudidentity", email, []string{scopeCloudIdentityGroups}); err != nil {
+		return nil, fmt.Errorf("cloudidentity options: %w", err)
+	} else if svc, err := cloudidentity.NewService(ctx, opts...); err != nil {
+		return nil, fmt.Errorf("create cloudidentity service: %w", err)
+	} else {
+		return svc, nil
+	}
+}
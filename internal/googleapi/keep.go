@@ -12,6 +12,8 @@ import (
 	"github.com/steipete/gogcli/internal/googleauth"
 )
 
+const scopeKeep = "https://www.googleapis.com/auth/keep"
+
 func NewKeep(ctx context.Context, email string) (*keep.Service, error) {
 	if opts, err := optionsForAccount(ctx, googleauth.ServiceKeep, email); err != nil {
 		return nil, fmt.Errorf("keep options: %w", err)
@@ -23,14 +25,24 @@ func NewKeep(ctx context.Context, email string) (*keep.Service, error) {
 }
 
 func NewKeepWithServiceAccount(ctx context.Context, serviceAccountPath, impersonateEmail string) (*keep.Service, error) {
-	data, err := os.ReadFile(serviceAccountPath) //nolint:gosec // user-provided path (or stored config file)
+	scopes, err := googleauth.Scopes(googleauth.ServiceKeep)
 	if err != nil {
-		return nil, fmt.Errorf("read service account file: %w", err)
+		return nil, fmt.Errorf("keep scopes: %w", err)
 	}
 
-	scopes, err := googleauth.Scopes(googleauth.ServiceKeep)
+	return newKeepWithServiceAccountScopes(ctx, serviceAccountPath, impersonateEmail, scopes)
+}
+
+// NewKeepWriterWithServiceAccount uses the full keep scope, which creating
+// notes requires; the domain-wide delegation grant must include it.
+func NewKeepWriterWithServiceAccount(ctx context.Context, serviceAccountPath, impersonateEmail string) (*keep.Service, error) {
+	return newKeepWithServiceAccountScopes(ctx, serviceAccountPath, impersonateEmail, []string{scopeKeep})
+}
+
+func newKeepWithServiceAccountScopes(ctx context.Context, serviceAccountPath, impersonateEmail string, scopes []string) (*keep.Service, error) {
+	data, err := os.ReadFile(serviceAccountPath) //nolint:gosec // user-provided path (or stored config file)
 	if err != nil {
-		return nil, fmt.Errorf("keep scopes: %w", err)
+		return nil, fmt.Errorf("read service account file: %w", err)
 	}
 
 	config, err := google.JWTConfigFromJSON(data, scopes...)
@@ -0,0 +1,304 @@
+package googleapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	gapi "google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/googleauth"
+)
+
+// There is no generated Go client for the Photos Library API, so Photos is a
+// small hand-written REST client covering what the CLI needs.
+
+const (
+	photosBaseURL = "https://photoslibrary.googleapis.com/v1/"
+
+	// Uploads and downloads move whole media files; the default API timeout is
+	// too short for videos.
+	photosTransferTimeout = 10 * time.Minute
+)
+
+type Photos struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewPhotos(ctx context.Context, email string) (*Photos, error) {
+	scopes, err := googleauth.Scopes(googleauth.ServicePhotos)
+	if err != nil {
+		return nil, fmt.Errorf("photos scopes: %w", err)
+	}
+	client, err := httpClientForAccountScopes(ctx, string(googleauth.ServicePhotos), email, scopes)
+	if err != nil {
+		return nil, fmt.Errorf("photos options: %w", err)
+	}
+	client.Timeout = photosTransferTimeout
+	return NewPhotosWithClient(client, photosBaseURL), nil
+}
+
+// NewPhotosWithClient uses an already authorized client, e.g. in tests.
+func NewPhotosWithClient(client *http.Client, baseURL string) *Photos {
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return &Photos{client: client, baseURL: baseURL}
+}
+
+type PhotosMediaItem struct {
+	ID            string               `json:"id"`
+	Description   string               `json:"description,omitempty"`
+	ProductURL    string               `json:"productUrl,omitempty"`
+	BaseURL       string               `json:"baseUrl,omitempty"`
+	MimeType      string               `json:"mimeType,omitempty"`
+	Filename      string               `json:"filename,omitempty"`
+	MediaMetadata *PhotosMediaMetadata `json:"mediaMetadata,omitempty"`
+}
+
+type PhotosMediaMetadata struct {
+	CreationTime string          `json:"creationTime,omitempty"`
+	Width        string          `json:"width,omitempty"`
+	Height       string          `json:"height,omitempty"`
+	Photo        json.RawMessage `json:"photo,omitempty"`
+	Video        json.RawMessage `json:"video,omitempty"`
+}
+
+// IsVideo reports whether the item is a video (downloads need "=dv").
+func (m *PhotosMediaItem) IsVideo() bool {
+	return m.MediaMetadata != nil && len(m.MediaMetadata.Video) > 0
+}
+
+type PhotosAlbum struct {
+	ID              string `json:"id"`
+	Title           string `json:"title"`
+	ProductURL      string `json:"productUrl,omitempty"`
+	MediaItemsCount string `json:"mediaItemsCount,omitempty"`
+	IsWriteable     bool   `json:"isWriteable,omitempty"`
+}
+
+type PhotosDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// PhotosSearch is a mediaItems:search request. The API rejects AlbumID
+// combined with date ranges.
+type PhotosSearch struct {
+	AlbumID   string
+	Start     *PhotosDate
+	End       *PhotosDate
+	PageSize  int64
+	PageToken string
+}
+
+type PhotosMediaItemsPage struct {
+	MediaItems    []*PhotosMediaItem `json:"mediaItems"`
+	NextPageToken string             `json:"nextPageToken"`
+}
+
+type PhotosAlbumsPage struct {
+	Albums        []*PhotosAlbum `json:"albums"`
+	NextPageToken string         `json:"nextPageToken"`
+}
+
+type PhotosNewMediaItem struct {
+	UploadToken string
+	FileName    string
+	Description string
+}
+
+type PhotosNewMediaItemResult struct {
+	UploadToken string           `json:"uploadToken"`
+	Status      *PhotosStatus    `json:"status,omitempty"`
+	MediaItem   *PhotosMediaItem `json:"mediaItem,omitempty"`
+}
+
+type PhotosStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// PhotosBatchCreateLimit is the maximum number of items per batchCreate call.
+const PhotosBatchCreateLimit = 50
+
+func (p *Photos) ListMediaItems(ctx context.Context, pageSize int64, pageToken string) (*PhotosMediaItemsPage, error) {
+	var page PhotosMediaItemsPage
+	if err := p.doJSON(ctx, http.MethodGet, "mediaItems"+photosPageQuery(pageSize, pageToken), nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+func (p *Photos) SearchMediaItems(ctx context.Context, search PhotosSearch) (*PhotosMediaItemsPage, error) {
+	body := map[string]any{}
+	if search.PageSize > 0 {
+		body["pageSize"] = search.PageSize
+	}
+	if search.PageToken != "" {
+		body["pageToken"] = search.PageToken
+	}
+	if search.AlbumID != "" {
+		body["albumId"] = search.AlbumID
+	}
+	if search.Start != nil || search.End != nil {
+		r := map[string]any{}
+		if search.Start != nil {
+			r["startDate"] = search.Start
+		}
+		if search.End != nil {
+			r["endDate"] = search.End
+		}
+		body["filters"] = map[string]any{"dateFilter": map[string]any{"ranges": []any{r}}}
+	}
+	var page PhotosMediaItemsPage
+	if err := p.doJSON(ctx, http.MethodPost, "mediaItems:search", body, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+func (p *Photos) ListAlbums(ctx context.Context, pageSize int64, pageToken string) (*PhotosAlbumsPage, error) {
+	var page PhotosAlbumsPage
+	if err := p.doJSON(ctx, http.MethodGet, "albums"+photosPageQuery(pageSize, pageToken), nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+func (p *Photos) CreateAlbum(ctx context.Context, title string) (*PhotosAlbum, error) {
+	var album PhotosAlbum
+	if err := p.doJSON(ctx, http.MethodPost, "albums", map[string]any{"album": map[string]string{"title": title}}, &album); err != nil {
+		return nil, err
+	}
+	return &album, nil
+}
+
+// Upload sends raw bytes and returns the upload token for BatchCreate.
+func (p *Photos) Upload(ctx context.Context, r io.Reader, fileName, mimeType string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"uploads", r)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("X-Goog-Upload-Protocol", "raw")
+	req.Header.Set("X-Goog-Upload-File-Name", fileName)
+	if mimeType != "" {
+		req.Header.Set("X-Goog-Upload-Content-Type", mimeType)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err := gapi.CheckResponse(resp); err != nil {
+		return "", err
+	}
+	token, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// BatchCreate turns upload tokens into media items (at most
+// PhotosBatchCreateLimit per call), optionally adding them to an album.
+func (p *Photos) BatchCreate(ctx context.Context, albumID string, items []PhotosNewMediaItem) ([]*PhotosNewMediaItemResult, error) {
+	newItems := make([]map[string]any, 0, len(items))
+	for _, it := range items {
+		newItems = append(newItems, map[string]any{
+			"description":     it.Description,
+			"simpleMediaItem": map[string]string{"uploadToken": it.UploadToken, "fileName": it.FileName},
+		})
+	}
+	body := map[string]any{"newMediaItems": newItems}
+	if albumID != "" {
+		body["albumId"] = albumID
+	}
+	var resp struct {
+		Results []*PhotosNewMediaItemResult `json:"newMediaItemResults"`
+	}
+	if err := p.doJSON(ctx, http.MethodPost, "mediaItems:batchCreate", body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// Download fetches the original bytes of a media item via its baseUrl.
+func (p *Photos) Download(ctx context.Context, item *PhotosMediaItem) (*http.Response, error) {
+	if item == nil || item.BaseURL == "" {
+		return nil, fmt.Errorf("media item has no baseUrl")
+	}
+	suffix := "=d"
+	if item.IsVideo() {
+		suffix = "=dv"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, item.BaseURL+suffix, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := gapi.CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+func photosPageQuery(pageSize int64, pageToken string) string {
+	q := url.Values{}
+	if pageSize > 0 {
+		q.Set("pageSize", fmt.Sprint(pageSize))
+	}
+	if pageToken != "" {
+		q.Set("pageToken", pageToken)
+	}
+	if len(q) == 0 {
+		return ""
+	}
+	return "?" + q.Encode()
+}
+
+func (p *Photos) doJSON(ctx context.Context, method, path string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := gapi.CheckResponse(resp); err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode photos response: %w", err)
+	}
+	return nil
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 
+	"github.com/steipete/gogcli/internal/authclient"
 	"github.com/steipete/gogcli/internal/config"
 )
 
@@ -26,6 +28,91 @@ var newServiceAccountTokenSource = func(ctx context.Context, keyJSON []byte, sub
 }
 
 func tokenSourceForServiceAccountScopes(ctx context.Context, email string, scopes []string) (oauth2.TokenSource, string, bool, error) {
+	keyEmail := email
+	subject, keyAccount, impersonating := authclient.Impersonation(ctx)
+	impersonating = impersonating && strings.EqualFold(subject, email)
+	if impersonating {
+		resolved, err := impersonationKeyAccount(keyAccount)
+		if err != nil {
+			return nil, "", false, err
+		}
+		keyEmail = resolved
+	}
+
+	data, saPath, ok, err := readStoredServiceAccountKey(keyEmail)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if !ok {
+		if impersonating {
+			return nil, "", false, fmt.Errorf("--impersonate needs a service account key for %s (run: gog auth service-account add --key <key.json> --subject %s)", keyEmail, keyEmail)
+		}
+		return nil, "", false, nil
+	}
+
+	ts, err := newServiceAccountTokenSource(ctx, data, email, scopes)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return ts, saPath, true, nil
+}
+
+// StoredServiceAccountKeyPath returns the stored key used for email, honoring
+// --impersonate (the key of the delegating account is used for the subject).
+func StoredServiceAccountKeyPath(ctx context.Context, email string) (string, bool, error) {
+	if subject, keyAccount, ok := authclient.Impersonation(ctx); ok && strings.EqualFold(subject, email) {
+		resolved, err := impersonationKeyAccount(keyAccount)
+		if err != nil {
+			return "", false, err
+		}
+		email = resolved
+	}
+
+	_, path, ok, err := readStoredServiceAccountKey(email)
+
+	return path, ok, err
+}
+
+// ServiceAccountAccessToken mints an access token for email from its stored
+// service account key (honoring --impersonate); ok is false when none is stored.
+func ServiceAccountAccessToken(ctx context.Context, email string, scopes []string) (*oauth2.Token, bool, error) {
+	ts, _, ok, err := tokenSourceForServiceAccountScopes(ctx, email, scopes)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	tok, err := ts.Token()
+	if err != nil {
+		return nil, true, fmt.Errorf("service account token: %w", err)
+	}
+
+	return tok, true, nil
+}
+
+// impersonationKeyAccount picks whose stored key signs impersonated tokens:
+// the explicit account, else the only configured service account.
+func impersonationKeyAccount(keyAccount string) (string, error) {
+	if keyAccount = strings.TrimSpace(keyAccount); keyAccount != "" {
+		return keyAccount, nil
+	}
+
+	emails, err := config.ListServiceAccountEmails()
+	if err != nil {
+		return "", err
+	}
+
+	switch len(emails) {
+	case 1:
+		return emails[0], nil
+	case 0:
+		return "", fmt.Errorf("--impersonate needs a stored service account key (run: gog auth service-account add --key <key.json> --subject <admin@domain>)")
+	default:
+		return "", fmt.Errorf("--impersonate: multiple service accounts configured; pass --account to pick the key")
+	}
+}
+
+func readStoredServiceAccountKey(email string) ([]byte, string, bool, error) {
 	saPath, err := config.ServiceAccountPath(email)
 	if err != nil {
 		return nil, "", false, fmt.Errorf("service account path: %w", err)
@@ -33,12 +120,7 @@ func tokenSourceForServiceAccountScopes(ctx context.Context, email string, scope
 
 	data, readErr := os.ReadFile(saPath) //nolint:gosec // stored in user config dir
 	if readErr == nil {
-		ts, tokenErr := newServiceAccountTokenSource(ctx, data, email, scopes)
-		if tokenErr != nil {
-			return nil, "", false, tokenErr
-		}
-
-		return ts, saPath, true, nil
+		return data, saPath, true, nil
 	}
 
 	if !os.IsNotExist(readErr) {
@@ -50,12 +132,7 @@ func tokenSourceForServiceAccountScopes(ctx context.Context, email string, scope
 	if keepErr == nil {
 		data, readErr := os.ReadFile(keepSAPath) //nolint:gosec // stored in user config dir
 		if readErr == nil {
-			ts, tokenErr := newServiceAccountTokenSource(ctx, data, email, scopes)
-			if tokenErr != nil {
-				return nil, "", false, tokenErr
-			}
-
-			return ts, keepSAPath, true, nil
+			return data, keepSAPath, true, nil
 		}
 
 		if !os.IsNotExist(readErr) {
@@ -67,12 +144,7 @@ func tokenSourceForServiceAccountScopes(ctx context.Context, email string, scope
 	if legacyErr == nil {
 		data, readErr := os.ReadFile(legacyPath) //nolint:gosec // stored in user config dir
 		if readErr == nil {
-			ts, tokenErr := newServiceAccountTokenSource(ctx, data, email, scopes)
-			if tokenErr != nil {
-				return nil, "", false, tokenErr
-			}
-
-			return ts, legacyPath, true, nil
+			return data, legacyPath, true, nil
 		}
 
 		if !os.IsNotExist(readErr) {
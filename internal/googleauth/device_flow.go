@@ -0,0 +1,98 @@
+package googleauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+// Google device codes expire after 30 minutes; give the user most of that.
+const defaultDeviceAuthTimeout = 15 * time.Minute
+
+// deviceFlowScopes are the scopes Google accepts from "TVs and Limited Input
+// devices" clients. Anything else fails with invalid_scope.
+var deviceFlowScopes = map[string]bool{
+	scopeOpenID:        true,
+	scopeEmail:         true,
+	"profile":          true,
+	scopeUserinfoEmail: true,
+	"https://www.googleapis.com/auth/userinfo.profile": true,
+	"https://www.googleapis.com/auth/drive.file":       true,
+	"https://www.googleapis.com/auth/drive.appdata":    true,
+	"https://www.googleapis.com/auth/youtube":          true,
+	"https://www.googleapis.com/auth/youtube.readonly": true,
+}
+
+var errDeviceFlowScope = errors.New("scopes not allowed in the device flow")
+
+// ValidateDeviceScopes rejects scopes Google does not allow in the device
+// flow, so the user is not sent off to enter a code that can only fail.
+func ValidateDeviceScopes(scopes []string) error {
+	var rejected []string
+
+	for _, s := range scopes {
+		if !deviceFlowScopes[s] {
+			rejected = append(rejected, strings.TrimPrefix(s, "https://www.googleapis.com/auth/"))
+		}
+	}
+
+	if len(rejected) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s (allowed: openid, email, profile, drive.file, drive.appdata, youtube; try --services drive --drive-scope file, or use --manual)",
+		errDeviceFlowScope, strings.Join(rejected, ", "))
+}
+
+// authorizeDevice runs the OAuth device authorization grant: the user opens a
+// URL on any device with a browser and enters a code while we poll for the
+// token. It needs an OAuth client of type "TVs and Limited Input devices".
+func authorizeDevice(ctx context.Context, creds config.ClientCredentials, opts AuthorizeOptions) (string, error) {
+	if err := ValidateDeviceScopes(opts.Scopes); err != nil {
+		return "", err
+	}
+
+	cfg := oauth2.Config{
+		ClientID:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+		Endpoint:     oauthEndpoint,
+		Scopes:       opts.Scopes,
+	}
+
+	da, err := cfg.DeviceAuth(ctx)
+	if err != nil {
+		return "", fmt.Errorf("request device code (needs a \"TVs and Limited Input devices\" OAuth client): %w", err)
+	}
+
+	verifyURL := da.VerificationURIComplete
+	if verifyURL == "" {
+		verifyURL = da.VerificationURI
+	}
+
+	fmt.Fprintln(os.Stderr, "On any device with a browser, visit:")
+	fmt.Fprintln(os.Stderr, "  "+verifyURL)
+	fmt.Fprintln(os.Stderr, "and enter the code:")
+	fmt.Fprintln(os.Stderr, "  "+da.UserCode)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Waiting for authorization…")
+
+	tok, err := cfg.DeviceAccessToken(ctx, da)
+	if err != nil {
+		return "", fmt.Errorf("device authorization: %w", err)
+	}
+
+	if tok.RefreshToken == "" {
+		return "", errNoRefreshToken
+	}
+
+	fmt.Fprintln(os.Stderr, "Authorization received.")
+
+	return tok.RefreshToken, nil
+}
@@ -0,0 +1,147 @@
+package googleauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+func TestAuthorize_Device_PollsUntilGranted(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+
+	var polls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/device/code":
+			if r.Form.Get("client_id") != "id" || r.Form.Get("scope") != "openid https://www.googleapis.com/auth/drive.file" {
+				http.Error(w, "bad device request", http.StatusBadRequest)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"device_code":      "dc",
+				"user_code":        "ABCD-EFGH",
+				"verification_url": "https://www.google.com/device",
+				"expires_in":       60,
+				"interval":         1,
+			})
+		case "/token":
+			if r.Form.Get("grant_type") != "urn:ietf:params:oauth:grant-type:device_code" || r.Form.Get("device_code") != "dc" {
+				http.Error(w, "bad token request", http.StatusBadRequest)
+				return
+			}
+
+			if polls.Add(1) == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "authorization_pending"})
+
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"access_token":  "at",
+				"refresh_token": "rt-device",
+				"token_type":    "Bearer",
+				"expires_in":    3600,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	oauthEndpoint = oauth2.Endpoint{
+		DeviceAuthURL: srv.URL + "/device/code",
+		TokenURL:      srv.URL + "/token",
+		AuthStyle:     oauth2.AuthStyleInParams,
+	}
+
+	rt, err := Authorize(context.Background(), AuthorizeOptions{Scopes: []string{"openid", "https://www.googleapis.com/auth/drive.file"}, Device: true})
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+
+	if rt != "rt-device" {
+		t.Fatalf("unexpected refresh token: %q", rt)
+	}
+
+	if polls.Load() != 2 {
+		t.Fatalf("expected 2 polls, got %d", polls.Load())
+	}
+}
+
+func TestValidateDeviceScopes(t *testing.T) {
+	allowed := []string{scopeOpenID, scopeEmail, scopeUserinfoEmail, "https://www.googleapis.com/auth/drive.file"}
+	if err := ValidateDeviceScopes(allowed); err != nil {
+		t.Fatalf("allowed scopes rejected: %v", err)
+	}
+
+	err := ValidateDeviceScopes(append(allowed, "https://www.googleapis.com/auth/drive", "https://www.googleapis.com/auth/gmail.modify"))
+	if !errors.Is(err, errDeviceFlowScope) {
+		t.Fatalf("expected errDeviceFlowScope, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), ": drive, gmail.modify (") {
+		t.Fatalf("unexpected message: %v", err)
+	}
+}
+
+func TestAuthorize_Device_RejectsScopesBeforeStarting(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+
+	var hits atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		http.Error(w, "unexpected", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	oauthEndpoint = oauth2.Endpoint{DeviceAuthURL: srv.URL + "/device/code", TokenURL: srv.URL + "/token"}
+
+	_, err := Authorize(context.Background(), AuthorizeOptions{Scopes: []string{"https://www.googleapis.com/auth/drive"}, Device: true})
+	if !errors.Is(err, errDeviceFlowScope) {
+		t.Fatalf("expected errDeviceFlowScope, got %v", err)
+	}
+
+	if hits.Load() != 0 {
+		t.Fatalf("device code requested for a rejected scope (%d requests)", hits.Load())
+	}
+}
@@ -26,11 +26,22 @@ type AuthorizeOptions struct {
 	Services     []Service
 	Scopes       []string
 	Manual       bool
+	Device       bool
 	ForceConsent bool
 	Timeout      time.Duration
 	Client       string
+	// Remote skips opening a browser and prints the auth URL plus the SSH
+	// port-forward that makes the loopback callback reachable from a laptop.
+	Remote bool
+	// ListenPort fixes the loopback callback port (0: ephemeral, or
+	// DefaultRemoteListenPort with Remote).
+	ListenPort int
 }
 
+// DefaultRemoteListenPort is the callback port used by --remote when none is
+// given; a fixed port lets the SSH forward be set up before the flow starts.
+const DefaultRemoteListenPort = 8085
+
 // postSuccessDisplaySeconds is the number of seconds the success page remains
 // visible before the local OAuth server shuts down.
 const postSuccessDisplaySeconds = 30
@@ -46,6 +57,7 @@ type successTemplateData struct {
 var (
 	readClientCredentials = config.ReadClientCredentialsFor
 	openBrowserFn         = openBrowser
+	remoteInstructionsFn  = printRemoteInstructions
 	oauthEndpoint         = google.Endpoint
 	randomStateFn         = randomState
 )
@@ -62,6 +74,9 @@ var (
 func Authorize(ctx context.Context, opts AuthorizeOptions) (string, error) {
 	if opts.Timeout <= 0 {
 		opts.Timeout = 2 * time.Minute
+		if opts.Device {
+			opts.Timeout = defaultDeviceAuthTimeout
+		}
 	}
 
 	if len(opts.Scopes) == 0 {
@@ -76,14 +91,18 @@ func Authorize(ctx context.Context, opts AuthorizeOptions) (string, error) {
 		creds = c
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	if opts.Device {
+		return authorizeDevice(ctx, creds, opts)
+	}
+
 	state, err := randomStateFn()
 	if err != nil {
 		return "", err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
-	defer cancel()
-
 	if opts.Manual {
 		redirectURI := "http://localhost:1"
 		cfg := oauth2.Config{
@@ -136,8 +155,17 @@ func Authorize(ctx context.Context, opts AuthorizeOptions) (string, error) {
 		return tok.RefreshToken, nil
 	}
 
-	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "127.0.0.1:0")
+	listenPort := opts.ListenPort
+	if listenPort == 0 && opts.Remote {
+		listenPort = DefaultRemoteListenPort
+	}
+
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", fmt.Sprintf("127.0.0.1:%d", listenPort))
 	if err != nil {
+		if listenPort != 0 {
+			return "", fmt.Errorf("listen for callback on port %d (in use? pick another with --port): %w", listenPort, err)
+		}
+
 		return "", fmt.Errorf("listen for callback: %w", err)
 	}
 
@@ -231,10 +259,42 @@ func Authorize(ctx context.Context, opts AuthorizeOptions) (string, error) {
 
 	authURL := cfg.AuthCodeURL(state, authURLParams(opts.ForceConsent)...)
 
-	fmt.Fprintln(os.Stderr, "Opening browser for authorization…")
-	fmt.Fprintln(os.Stderr, "If the browser doesn't open, visit this URL:")
-	fmt.Fprintln(os.Stderr, authURL)
-	_ = openBrowserFn(authURL)
+	if opts.Remote {
+		remoteInstructionsFn(port, authURL)
+
+		// Without a forward the browser lands on a page that won't load;
+		// pasting its URL here finishes the flow as well.
+		go func() {
+			line, readErr := input.PromptLine(ctx, "Or paste the redirect URL here: ")
+			if readErr != nil || strings.TrimSpace(line) == "" {
+				return
+			}
+
+			code, gotState, parseErr := extractCodeAndState(strings.TrimSpace(line))
+			if parseErr == nil && gotState != "" && gotState != state {
+				parseErr = errStateMismatch
+			}
+
+			if parseErr != nil {
+				select {
+				case errCh <- parseErr:
+				default:
+				}
+
+				return
+			}
+
+			select {
+			case codeCh <- code:
+			default:
+			}
+		}()
+	} else {
+		fmt.Fprintln(os.Stderr, "Opening browser for authorization…")
+		fmt.Fprintln(os.Stderr, "If the browser doesn't open, visit this URL:")
+		fmt.Fprintln(os.Stderr, authURL)
+		_ = openBrowserFn(authURL)
+	}
 
 	select {
 	case code := <-codeCh:
@@ -270,6 +330,30 @@ func Authorize(ctx context.Context, opts AuthorizeOptions) (string, error) {
 	}
 }
 
+func printRemoteInstructions(port int, authURL string) {
+	fmt.Fprintln(os.Stderr, "Remote login: the browser on your laptop must reach this machine's loopback callback.")
+	fmt.Fprintln(os.Stderr, "1. On your laptop, forward the callback port over SSH (leave it running):")
+	fmt.Fprintln(os.Stderr, "     "+SSHForwardCommand(port, os.Getenv("USER"), os.Getenv("SSH_CONNECTION")))
+	fmt.Fprintln(os.Stderr, "2. Open this URL in the laptop's browser and approve access:")
+	fmt.Fprintln(os.Stderr, authURL)
+	fmt.Fprintln(os.Stderr)
+}
+
+// SSHForwardCommand returns the ssh invocation that forwards the callback port
+// from a laptop to this machine; the host comes from SSH_CONNECTION when set.
+func SSHForwardCommand(port int, user string, sshConnection string) string {
+	host := "<this-host>"
+	if fields := strings.Fields(sshConnection); len(fields) >= 3 {
+		host = fields[2]
+	}
+
+	if user = strings.TrimSpace(user); user != "" {
+		host = user + "@" + host
+	}
+
+	return fmt.Sprintf("ssh -N -L %d:127.0.0.1:%d %s", port, port, host)
+}
+
 func authURLParams(forceConsent bool) []oauth2.AuthCodeOption {
 	opts := []oauth2.AuthCodeOption{
 		oauth2.AccessTypeOffline,
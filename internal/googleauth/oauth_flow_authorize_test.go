@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -419,3 +420,84 @@ func oauth2EndpointForTest(base string) oauth2.Endpoint {
 func fmtSprintf(format string, v string) string {
 	return strings.ReplaceAll(format, "%s", v)
 }
+
+func TestAuthorize_RemoteFixedPort(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+	origOpen := openBrowserFn
+	origRemote := remoteInstructionsFn
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+		openBrowserFn = origOpen
+		remoteInstructionsFn = origRemote
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+
+	tokenSrv := newTokenServer(t)
+	defer tokenSrv.Close()
+	oauthEndpoint = oauth2EndpointForTest(tokenSrv.URL)
+
+	openBrowserFn = func(string) error {
+		t.Fatal("remote flow must not open a browser")
+		return nil
+	}
+
+	// Grab a free port, then let Authorize listen on it.
+	probe := httptest.NewServer(http.NotFoundHandler())
+	port := probe.Listener.Addr().(*net.TCPAddr).Port
+	probe.Close()
+
+	var gotPort int
+
+	remoteInstructionsFn = func(p int, authURL string) {
+		gotPort = p
+
+		u, err := url.Parse(authURL)
+		if err != nil {
+			t.Errorf("parse auth url: %v", err)
+			return
+		}
+
+		q := u.Query()
+		if want := fmt.Sprintf("http://127.0.0.1:%d/oauth2/callback", port); q.Get("redirect_uri") != want {
+			t.Errorf("redirect_uri = %q, want %q", q.Get("redirect_uri"), want)
+		}
+
+		go func() {
+			resp, err := http.Get(q.Get("redirect_uri") + "?code=abc&state=" + url.QueryEscape(q.Get("state"))) //nolint:noctx // test callback
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+
+	rt, err := Authorize(context.Background(), AuthorizeOptions{
+		Scopes:     []string{"s1"},
+		Timeout:    2 * time.Second,
+		Remote:     true,
+		ListenPort: port,
+	})
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+
+	if rt != "rt" || gotPort != port {
+		t.Fatalf("unexpected result: rt=%q port=%d", rt, gotPort)
+	}
+}
+
+func TestSSHForwardCommand(t *testing.T) {
+	got := SSHForwardCommand(8085, "dev", "203.0.113.9 51234 198.51.100.7 22")
+	if got != "ssh -N -L 8085:127.0.0.1:8085 dev@198.51.100.7" {
+		t.Fatalf("unexpected command: %q", got)
+	}
+
+	if got := SSHForwardCommand(9000, "", ""); got != "ssh -N -L 9000:127.0.0.1:9000 <this-host>" {
+		t.Fatalf("unexpected fallback: %q", got)
+	}
+}
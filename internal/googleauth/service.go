@@ -16,12 +16,17 @@ const (
 	ServiceClassroom Service = "classroom"
 	ServiceDrive     Service = "drive"
 	ServiceDocs      Service = "docs"
+	ServiceSlides    Service = "slides"
+	ServiceForms     Service = "forms"
 	ServiceContacts  Service = "contacts"
 	ServiceTasks     Service = "tasks"
 	ServicePeople    Service = "people"
 	ServiceSheets    Service = "sheets"
 	ServiceGroups    Service = "groups"
 	ServiceKeep      Service = "keep"
+	ServiceAdmin     Service = "admin"
+	ServiceMeet      Service = "meet"
+	ServicePhotos    Service = "photos"
 )
 
 const (
@@ -62,12 +67,17 @@ var serviceOrder = []Service{
 	ServiceClassroom,
 	ServiceDrive,
 	ServiceDocs,
+	ServiceSlides,
+	ServiceForms,
 	ServiceContacts,
 	ServiceTasks,
 	ServiceSheets,
 	ServicePeople,
 	ServiceGroups,
 	ServiceKeep,
+	ServiceAdmin,
+	ServiceMeet,
+	ServicePhotos,
 }
 
 var serviceInfoByService = map[Service]serviceInfo{
@@ -127,6 +137,24 @@ var serviceInfoByService = map[Service]serviceInfo{
 		apis: []string{"Docs API", "Drive API"},
 		note: "Export/copy/create via Drive",
 	},
+	ServiceSlides: {
+		scopes: []string{
+			"https://www.googleapis.com/auth/drive",
+			"https://www.googleapis.com/auth/presentations",
+		},
+		user: true,
+		apis: []string{"Slides API", "Drive API"},
+		note: "Export/copy via Drive",
+	},
+	ServiceForms: {
+		scopes: []string{
+			"https://www.googleapis.com/auth/drive",
+			"https://www.googleapis.com/auth/forms.body",
+			"https://www.googleapis.com/auth/forms.responses.readonly",
+		},
+		user: true,
+		apis: []string{"Forms API", "Drive API"},
+	},
 	ServiceContacts: {
 		scopes: []string{
 			"https://www.googleapis.com/auth/contacts",
@@ -159,10 +187,10 @@ var serviceInfoByService = map[Service]serviceInfo{
 		note: "Export via Drive",
 	},
 	ServiceGroups: {
-		scopes: []string{"https://www.googleapis.com/auth/cloud-identity.groups.readonly"},
+		scopes: []string{"https://www.googleapis.com/auth/cloud-identity.groups"},
 		user:   false,
 		apis:   []string{"Cloud Identity API"},
-		note:   "Workspace only",
+		note:   "Workspace only; --readonly for listing only",
 	},
 	ServiceKeep: {
 		scopes: []string{"https://www.googleapis.com/auth/keep.readonly"},
@@ -170,6 +198,30 @@ var serviceInfoByService = map[Service]serviceInfo{
 		apis:   []string{"Keep API"},
 		note:   "Workspace only; service account (domain-wide delegation)",
 	},
+	ServiceAdmin: {
+		scopes: []string{"https://www.googleapis.com/auth/admin.directory.user"},
+		user:   false,
+		apis:   []string{"Admin SDK API"},
+		note:   "Workspace admins only; --readonly for list/get",
+	},
+	ServiceMeet: {
+		scopes: []string{
+			"https://www.googleapis.com/auth/meetings.space.readonly",
+			"https://www.googleapis.com/auth/drive.readonly",
+		},
+		user: false,
+		apis: []string{"Meet API", "Drive API"},
+		note: "Conference records; recordings/transcripts downloaded via Drive",
+	},
+	ServicePhotos: {
+		scopes: []string{
+			"https://www.googleapis.com/auth/photoslibrary.appendonly",
+			"https://www.googleapis.com/auth/photoslibrary.readonly.appcreateddata",
+		},
+		user: false,
+		apis: []string{"Photos Library API"},
+		note: "Upload + albums; list/download only media uploaded by gog (API limit)",
+	},
 }
 
 func ParseService(s string) (Service, error) {
@@ -432,6 +484,20 @@ func scopesForServiceWithOptions(service Service, opts ScopeOptions) ([]string,
 		}
 
 		return []string{driveScopeValue(), docScope}, nil
+	case ServiceSlides:
+		slidesScope := "https://www.googleapis.com/auth/presentations"
+		if opts.Readonly {
+			slidesScope = "https://www.googleapis.com/auth/presentations.readonly"
+		}
+
+		return []string{driveScopeValue(), slidesScope}, nil
+	case ServiceForms:
+		formsScope := "https://www.googleapis.com/auth/forms.body"
+		if opts.Readonly {
+			formsScope = "https://www.googleapis.com/auth/forms.body.readonly"
+		}
+
+		return []string{driveScopeValue(), formsScope, "https://www.googleapis.com/auth/forms.responses.readonly"}, nil
 	case ServiceContacts:
 		contactsScope := "https://www.googleapis.com/auth/contacts"
 		if opts.Readonly {
@@ -460,8 +526,24 @@ func scopesForServiceWithOptions(service Service, opts ScopeOptions) ([]string,
 
 		return []string{driveScopeValue(), sheetsScope}, nil
 	case ServiceGroups:
+		if opts.Readonly {
+			return []string{"https://www.googleapis.com/auth/cloud-identity.groups.readonly"}, nil
+		}
+
+		return Scopes(service)
+	case ServiceKeep, ServiceMeet:
+		return Scopes(service)
+	case ServicePhotos:
+		if opts.Readonly {
+			return []string{"https://www.googleapis.com/auth/photoslibrary.readonly.appcreateddata"}, nil
+		}
+
 		return Scopes(service)
-	case ServiceKeep:
+	case ServiceAdmin:
+		if opts.Readonly {
+			return []string{"https://www.googleapis.com/auth/admin.directory.user.readonly"}, nil
+		}
+
 		return Scopes(service)
 	default:
 		return nil, errUnknownService
@@ -497,6 +579,74 @@ func mergeScopes(scopes []string, extras []string) []string {
 	return out
 }
 
+// IdentityScopes are the OIDC scopes every user token carries so the account
+// can be identified.
+func IdentityScopes() []string {
+	return []string{scopeOpenID, scopeEmail, scopeUserinfoEmail}
+}
+
+// MergeScopes returns the sorted union of two scope lists.
+func MergeScopes(a []string, b []string) []string {
+	return mergeScopes(a, b)
+}
+
+// MissingScopes returns the scopes in wanted that granted lacks, sorted.
+func MissingScopes(granted []string, wanted []string) []string {
+	have := make(map[string]struct{}, len(granted))
+	for _, s := range granted {
+		have[s] = struct{}{}
+	}
+
+	var out []string
+
+	for _, s := range wanted {
+		if _, ok := have[s]; ok || s == "" {
+			continue
+		}
+
+		have[s] = struct{}{}
+		out = append(out, s)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+// Scope coverage levels reported by ScopeCoverage.
+const (
+	CoverageFull     = "full"
+	CoverageReadonly = "readonly"
+	CoverageLimited  = "limited"
+	CoverageMissing  = "missing"
+)
+
+// ScopeCoverage reports how far granted covers service: every scope of the
+// default set, the read-only set, Drive's per-file scope, or none of them.
+func ScopeCoverage(service Service, granted []string) (string, error) {
+	levels := []struct {
+		level string
+		opts  ScopeOptions
+	}{
+		{CoverageFull, ScopeOptions{}},
+		{CoverageReadonly, ScopeOptions{Readonly: true}},
+		{CoverageLimited, ScopeOptions{DriveScope: DriveScopeFile}},
+	}
+
+	for _, l := range levels {
+		scopes, err := scopesForServiceWithOptions(service, l.opts)
+		if err != nil {
+			return "", err
+		}
+
+		if len(scopes) > 0 && len(MissingScopes(granted, scopes)) == 0 {
+			return l.level, nil
+		}
+	}
+
+	return CoverageMissing, nil
+}
+
 func UserServiceCSV() string {
 	return serviceNames(UserServices(), ",")
 }
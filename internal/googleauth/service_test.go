@@ -14,12 +14,17 @@ func TestParseService(t *testing.T) {
 		{"classroom", ServiceClassroom},
 		{"drive", ServiceDrive},
 		{"docs", ServiceDocs},
+		{"slides", ServiceSlides},
+		{"forms", ServiceForms},
 		{"contacts", ServiceContacts},
 		{"tasks", ServiceTasks},
 		{"people", ServicePeople},
 		{"sheets", ServiceSheets},
 		{"groups", ServiceGroups},
 		{"keep", ServiceKeep},
+		{"admin", ServiceAdmin},
+		{"meet", ServiceMeet},
+		{"photos", ServicePhotos},
 	}
 	for _, tt := range tests {
 		got, err := ParseService(tt.in)
@@ -62,7 +67,7 @@ func TestExtractCodeAndState_Errors(t *testing.T) {
 
 func TestAllServices(t *testing.T) {
 	svcs := AllServices()
-	if len(svcs) != 12 {
+	if len(svcs) != 17 {
 		t.Fatalf("unexpected: %v", svcs)
 	}
 	seen := make(map[Service]bool)
@@ -71,7 +76,7 @@ func TestAllServices(t *testing.T) {
 		seen[s] = true
 	}
 
-	for _, want := range []Service{ServiceGmail, ServiceCalendar, ServiceChat, ServiceClassroom, ServiceDrive, ServiceDocs, ServiceContacts, ServiceTasks, ServicePeople, ServiceSheets, ServiceGroups, ServiceKeep} {
+	for _, want := range []Service{ServiceGmail, ServiceCalendar, ServiceChat, ServiceClassroom, ServiceDrive, ServiceDocs, ServiceSlides, ServiceForms, ServiceContacts, ServiceTasks, ServicePeople, ServiceSheets, ServiceGroups, ServiceKeep, ServiceAdmin, ServiceMeet, ServicePhotos} {
 		if !seen[want] {
 			t.Fatalf("missing %q", want)
 		}
@@ -80,7 +85,7 @@ func TestAllServices(t *testing.T) {
 
 func TestUserServices(t *testing.T) {
 	svcs := UserServices()
-	if len(svcs) != 10 {
+	if len(svcs) != 12 {
 		t.Fatalf("unexpected: %v", svcs)
 	}
 
@@ -101,7 +106,7 @@ func TestUserServices(t *testing.T) {
 }
 
 func TestUserServiceCSV(t *testing.T) {
-	want := "gmail,calendar,chat,classroom,drive,docs,contacts,tasks,sheets,people"
+	want := "gmail,calendar,chat,classroom,drive,docs,slides,forms,contacts,tasks,sheets,people"
 	if got := UserServiceCSV(); got != want {
 		t.Fatalf("unexpected user services csv: %q", got)
 	}
@@ -285,6 +290,46 @@ func TestScopesForServiceWithOptions_ServiceKeep_Readonly(t *testing.T) {
 	}
 }
 
+func TestScopesForServiceWithOptions_ServiceGroups(t *testing.T) {
+	scopes, err := scopesForServiceWithOptions(ServiceGroups, ScopeOptions{})
+	if err != nil {
+		t.Fatalf("scopesForServiceWithOptions: %v", err)
+	}
+
+	if len(scopes) != 1 || scopes[0] != "https://www.googleapis.com/auth/cloud-identity.groups" {
+		t.Fatalf("unexpected groups scopes: %#v", scopes)
+	}
+
+	scopes, err = scopesForServiceWithOptions(ServiceGroups, ScopeOptions{Readonly: true})
+	if err != nil {
+		t.Fatalf("scopesForServiceWithOptions: %v", err)
+	}
+
+	if len(scopes) != 1 || scopes[0] != "https://www.googleapis.com/auth/cloud-identity.groups.readonly" {
+		t.Fatalf("unexpected groups readonly scopes: %#v", scopes)
+	}
+}
+
+func TestScopesForServiceWithOptions_ServiceAdmin(t *testing.T) {
+	scopes, err := scopesForServiceWithOptions(ServiceAdmin, ScopeOptions{})
+	if err != nil {
+		t.Fatalf("scopesForServiceWithOptions: %v", err)
+	}
+
+	if len(scopes) != 1 || scopes[0] != "https://www.googleapis.com/auth/admin.directory.user" {
+		t.Fatalf("unexpected admin scopes: %#v", scopes)
+	}
+
+	scopes, err = scopesForServiceWithOptions(ServiceAdmin, ScopeOptions{Readonly: true})
+	if err != nil {
+		t.Fatalf("scopesForServiceWithOptions: %v", err)
+	}
+
+	if len(scopes) != 1 || scopes[0] != "https://www.googleapis.com/auth/admin.directory.user.readonly" {
+		t.Fatalf("unexpected admin readonly scopes: %#v", scopes)
+	}
+}
+
 func TestScopesForManageWithOptions_DriveScopeFile(t *testing.T) {
 	scopes, err := ScopesForManageWithOptions([]Service{ServiceDrive, ServiceDocs}, ScopeOptions{
 		DriveScope: DriveScopeFile,
@@ -425,3 +470,48 @@ func TestScopes_UnknownService(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestMissingScopes(t *testing.T) {
+	got := MissingScopes([]string{"a", "b"}, []string{"c", "b", "", "a", "c", "d"})
+	if len(got) != 2 || got[0] != "c" || got[1] != "d" {
+		t.Fatalf("unexpected missing scopes: %#v", got)
+	}
+
+	if got := MissingScopes([]string{"a"}, []string{"a"}); len(got) != 0 {
+		t.Fatalf("expected nothing missing, got %#v", got)
+	}
+}
+
+func TestScopeCoverage(t *testing.T) {
+	full, err := scopesForServiceWithOptions(ServiceDrive, ScopeOptions{})
+	if err != nil {
+		t.Fatalf("scopes: %v", err)
+	}
+
+	readonly, err := scopesForServiceWithOptions(ServiceGmail, ScopeOptions{Readonly: true})
+	if err != nil {
+		t.Fatalf("scopes: %v", err)
+	}
+
+	cases := []struct {
+		service Service
+		granted []string
+		want    string
+	}{
+		{ServiceDrive, full, CoverageFull},
+		{ServiceGmail, readonly, CoverageReadonly},
+		{ServiceDrive, []string{"https://www.googleapis.com/auth/drive.file"}, CoverageLimited},
+		{ServiceCalendar, full, CoverageMissing},
+	}
+
+	for _, tc := range cases {
+		got, err := ScopeCoverage(tc.service, tc.granted)
+		if err != nil {
+			t.Fatalf("ScopeCoverage(%s): %v", tc.service, err)
+		}
+
+		if got != tc.want {
+			t.Fatalf("ScopeCoverage(%s) = %q, want %q", tc.service, got, tc.want)
+		}
+	}
+}
@@ -2,21 +2,46 @@ package googleauth
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
 )
 
+// ErrTokenRevoked reports a refresh token Google no longer accepts (revoked by
+// the user, expired, or invalidated by a password change).
+var ErrTokenRevoked = errors.New("refresh token revoked or expired")
+
+// RefreshResult describes the access token minted by a forced refresh.
+type RefreshResult struct {
+	AccessToken  string
+	Expiry       time.Time
+	Scopes       []string
+	RefreshToken string
+}
+
 func CheckRefreshToken(ctx context.Context, client string, refreshToken string, scopes []string, timeout time.Duration) error {
+	_, err := RefreshAccessToken(ctx, client, refreshToken, scopes, timeout)
+	return err
+}
+
+// RefreshAccessToken exchanges refreshToken for a fresh access token and
+// returns its expiry and the scopes Google reports as granted. RefreshToken is
+// only set when Google rotated it.
+func RefreshAccessToken(ctx context.Context, client string, refreshToken string, scopes []string, timeout time.Duration) (RefreshResult, error) {
 	if timeout <= 0 {
 		timeout = 15 * time.Second
 	}
 
 	creds, err := readClientCredentials(client)
 	if err != nil {
-		return fmt.Errorf("read credentials: %w", err)
+		return RefreshResult{}, fmt.Errorf("read credentials: %w", err)
 	}
 
 	cfg := oauth2.Config{
@@ -32,9 +57,103 @@ func CheckRefreshToken(ctx context.Context, client string, refreshToken string,
 	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: timeout})
 
 	ts := cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken})
-	if _, err := ts.Token(); err != nil {
-		return fmt.Errorf("refresh access token: %w", err)
+
+	tok, err := ts.Token()
+	if err != nil {
+		var re *oauth2.RetrieveError
+		if errors.As(err, &re) && re.ErrorCode == "invalid_grant" {
+			return RefreshResult{}, fmt.Errorf("refresh access token: %w: %w", ErrTokenRevoked, err)
+		}
+
+		return RefreshResult{}, fmt.Errorf("refresh access token: %w", err)
+	}
+
+	res := RefreshResult{AccessToken: tok.AccessToken, Expiry: tok.Expiry}
+	if granted, ok := tok.Extra("scope").(string); ok {
+		res.Scopes = strings.Fields(granted)
+	}
+
+	if tok.RefreshToken != "" && tok.RefreshToken != refreshToken {
+		res.RefreshToken = tok.RefreshToken
+	}
+
+	return res, nil
+}
+
+// MintAccessToken returns a fresh access token for refreshToken. With scopes
+// set the token is narrowed to them (they must already be granted): Google
+// accepts a scope parameter on refresh, but x/oauth2 never sends one.
+func MintAccessToken(ctx context.Context, client string, refreshToken string, scopes []string, timeout time.Duration) (RefreshResult, error) {
+	if len(scopes) == 0 {
+		return RefreshAccessToken(ctx, client, refreshToken, nil, timeout)
+	}
+
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+
+	creds, err := readClientCredentials(client)
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("read credentials: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {creds.ClientID},
+		"client_secret": {creds.ClientSecret},
+		"scope":         {strings.Join(scopes, " ")},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oauthEndpoint.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return RefreshResult{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := (&http.Client{Timeout: timeout}).Do(req)
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("refresh access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("refresh access token: %w", err)
+	}
+
+	var payload struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Scope            string `json:"scope"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return RefreshResult{}, fmt.Errorf("refresh access token: HTTP %d: %w", resp.StatusCode, err)
+	}
+
+	if payload.Error != "" || resp.StatusCode != http.StatusOK || payload.AccessToken == "" {
+		msg := strings.TrimSpace(payload.Error + " " + payload.ErrorDescription)
+		if msg == "" {
+			msg = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		}
+
+		if payload.Error == "invalid_grant" {
+			return RefreshResult{}, fmt.Errorf("refresh access token: %w: %s", ErrTokenRevoked, msg)
+		}
+
+		return RefreshResult{}, fmt.Errorf("refresh access token: %s", msg)
+	}
+
+	res := RefreshResult{AccessToken: payload.AccessToken, Scopes: strings.Fields(payload.Scope)}
+	if payload.ExpiresIn > 0 {
+		res.Expiry = time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
 	}
 
-	return nil
+	return res, nil
 }
@@ -3,6 +3,7 @@ package googleauth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -92,3 +93,139 @@ func TestCheckRefreshTokenReadCredentialsError(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestRefreshAccessTokenReportsScopesAndExpiry(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"scope":        "openid https://www.googleapis.com/auth/gmail.modify",
+		})
+	}))
+	defer srv.Close()
+
+	oauthEndpoint = oauth2.Endpoint{AuthURL: srv.URL, TokenURL: srv.URL}
+
+	res, err := RefreshAccessToken(context.Background(), "default", "good", nil, time.Second)
+	if err != nil {
+		t.Fatalf("RefreshAccessToken: %v", err)
+	}
+
+	if len(res.Scopes) != 2 || res.Scopes[1] != "https://www.googleapis.com/auth/gmail.modify" {
+		t.Fatalf("unexpected scopes: %#v", res.Scopes)
+	}
+
+	if res.Expiry.Before(time.Now().Add(30 * time.Minute)) {
+		t.Fatalf("unexpected expiry: %v", res.Expiry)
+	}
+
+	if res.RefreshToken != "" {
+		t.Fatalf("unexpected rotated refresh token: %q", res.RefreshToken)
+	}
+}
+
+func TestRefreshAccessTokenInvalidGrant(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"error":             "invalid_grant",
+			"error_description": "Token has been expired or revoked.",
+		})
+	}))
+	defer srv.Close()
+
+	oauthEndpoint = oauth2.Endpoint{AuthURL: srv.URL, TokenURL: srv.URL}
+
+	_, err := RefreshAccessToken(context.Background(), "default", "revoked", nil, time.Second)
+	if !errors.Is(err, ErrTokenRevoked) {
+		t.Fatalf("expected ErrTokenRevoked, got %v", err)
+	}
+}
+
+func TestMintAccessTokenSendsScope(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+
+	var gotScope string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+
+		gotScope = r.Form.Get("scope")
+
+		if r.Form.Get("refresh_token") != "good" || r.Form.Get("client_id") != "id" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "invalid_grant"})
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "narrow",
+			"token_type":   "Bearer",
+			"expires_in":   3599,
+			"scope":        r.Form.Get("scope"),
+		})
+	}))
+	defer srv.Close()
+
+	oauthEndpoint = oauth2.Endpoint{AuthURL: srv.URL, TokenURL: srv.URL}
+
+	scopes := []string{"https://www.googleapis.com/auth/gmail.readonly", "openid"}
+
+	res, err := MintAccessToken(context.Background(), "default", "good", scopes, time.Second)
+	if err != nil {
+		t.Fatalf("MintAccessToken: %v", err)
+	}
+
+	if gotScope != "https://www.googleapis.com/auth/gmail.readonly openid" {
+		t.Fatalf("unexpected scope param: %q", gotScope)
+	}
+
+	if res.AccessToken != "narrow" || len(res.Scopes) != 2 || res.Expiry.Before(time.Now().Add(30*time.Minute)) {
+		t.Fatalf("unexpected result: %#v", res)
+	}
+
+	if _, err := MintAccessToken(context.Background(), "default", "revoked", scopes, time.Second); !errors.Is(err, ErrTokenRevoked) {
+		t.Fatalf("expected ErrTokenRevoked, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// emailTagStyles maps opening tags emitted by goldmark to inline-styled
+// replacements. Mail clients drop <style> blocks, so styling has to live on
+// each element.
+var emailTagStyles = []struct {
+	from string
+	to   string
+}{
+	{"<pre><code", `<pre style="background:#f6f8fa;padding:12px;border-radius:6px;overflow:auto;"><code style="font-family:Menlo,Consolas,monospace;font-size:13px;"`},
+	{"<code>", `<code style="font-family:Menlo,Consolas,monospace;font-size:90%;background:#f6f8fa;padding:1px 4px;border-radius:4px;">`},
+	{"<h1>", `<h1 style="font-size:24px;margin:16px 0 8px;">`},
+	{"<h2>", `<h2 style="font-size:20px;margin:16px 0 8px;">`},
+	{"<h3>", `<h3 style="font-size:17px;margin:12px 0 6px;">`},
+	{"<p>", `<p style="margin:0 0 12px;">`},
+	{"<blockquote>", `<blockquote style="margin:0 0 12px;padding:0 12px;border-left:4px solid #d0d7de;color:#57606a;">`},
+	{"<table>", `<table style="border-collapse:collapse;margin:0 0 12px;">`},
+	{"<th>", `<th style="border:1px solid #d0d7de;padding:4px 8px;text-align:left;">`},
+	{"<td>", `<td style="border:1px solid #d0d7de;padding:4px 8px;">`},
+	{"<ul>", `<ul style="margin:0 0 12px;padding-left:24px;">`},
+	{"<ol>", `<ol style="margin:0 0 12px;padding-left:24px;">`},
+	{"<hr>", `<hr style="border:none;border-top:1px solid #d0d7de;margin:16px 0;">`},
+}
+
+// EmailHTML renders markdown as an HTML fragment with inline styles suitable
+// for email bodies. Raw HTML in the source is omitted.
+func EmailHTML(content string) (string, error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(html.WithHardWraps()),
+	)
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(content), &buf); err != nil {
+		return "", err
+	}
+	out := buf.String()
+	for _, s := range emailTagStyles {
+		out = strings.ReplaceAll(out, s.from, s.to)
+	}
+	return `<div style="font-family:-apple-system,Segoe UI,Helvetica,Arial,sans-serif;font-size:14px;line-height:1.5;color:#1f2328;">` + "\n" + out + "</div>\n", nil
+}
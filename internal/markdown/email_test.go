@@ -0,0 +1,37 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmailHTML(t *testing.T) {
+	got, err := EmailHTML("# Title\n\nHello **world** and `code`.\n\n| a | b |\n|---|---|\n| 1 | 2 |\n\n<script>x</script>\n")
+	if err != nil {
+		t.Fatalf("EmailHTML: %v", err)
+	}
+	for _, want := range []string{
+		`<h1 style="`,
+		"<strong>world</strong>",
+		`<code style="`,
+		`<table style="`,
+		`<td style="`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "<script>") {
+		t.Fatalf("expected raw HTML to be omitted, got:\n%s", got)
+	}
+}
+
+func TestEmailHTML_CodeBlock(t *testing.T) {
+	got, err := EmailHTML("```go\nfmt.Println(1)\n```\n")
+	if err != nil {
+		t.Fatalf("EmailHTML: %v", err)
+	}
+	if !strings.Contains(got, `<pre style="`) || !strings.Contains(got, `class="language-go"`) {
+		t.Fatalf("unexpected code block output:\n%s", got)
+	}
+}
@@ -1,4 +1,4 @@
-// Package markdown converts markdown text to Google Docs formatting requests.
+// Package markdown converts markdown text to Google Docs formatting requests and inline-styled email HTML.
 package markdown
 
 import (
@@ -0,0 +1,467 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+// agentSocketEnv overrides where `gog auth unlock` keeps its agent socket.
+const agentSocketEnv = "GOG_KEYRING_AGENT_SOCK"
+
+const (
+	agentClientTimeout = 2 * time.Second
+	agentMaxBody       = 1 << 20
+)
+
+var (
+	ErrAgentNotRunning  = errors.New("keyring agent not running")
+	errAgentLocked      = errors.New("keyring agent locked")
+	errAgentRunning     = errors.New("keyring agent already running")
+	errAgentStillUp     = errors.New("keyring agent did not exit")
+	errWrongPassphrase  = errors.New("wrong keyring passphrase")
+	errEmptyPassphrase  = errors.New("empty keyring passphrase")
+	agentKeyringFunc    = openAgentKeyring
+	agentSocketPathFunc = AgentSocketPath
+)
+
+// Agent keeps the file keyring open for a limited time and performs keyring
+// operations for other gog processes (like ssh-agent), so they need no
+// passphrase. The passphrase never leaves the agent process; clients only
+// ever see the items they ask for.
+type Agent struct {
+	mu        sync.Mutex
+	ring      keyring.Keyring
+	expiresAt time.Time
+	now       func() time.Time
+
+	lockOnce sync.Once
+	locked   chan struct{}
+}
+
+// AgentStatus is what the agent reports about itself.
+type AgentStatus struct {
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewAgent serves ring, the unlocked file keyring, for ttl.
+func NewAgent(ring keyring.Keyring, ttl time.Duration) *Agent {
+	return &Agent{
+		ring:      ring,
+		expiresAt: time.Now().Add(ttl),
+		now:       time.Now,
+		locked:    make(chan struct{}),
+	}
+}
+
+// ExpiresAt is when the agent closes the keyring and exits.
+func (a *Agent) ExpiresAt() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.expiresAt
+}
+
+// withRing runs fn on the keyring while the agent is unlocked. Calls are
+// serialized; the file backend is not safe for concurrent writes.
+func (a *Agent) withRing(fn func(keyring.Keyring) error) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.ring == nil || !a.now().Before(a.expiresAt) {
+		return errAgentLocked
+	}
+
+	return fn(a.ring)
+}
+
+// Lock drops the keyring and stops Serve.
+func (a *Agent) Lock() {
+	a.lockOnce.Do(func() {
+		a.mu.Lock()
+		a.ring = nil
+		a.mu.Unlock()
+
+		close(a.locked)
+	})
+}
+
+// Handler serves GET /v1/keys, GET/PUT/DELETE /v1/item?key=<key>,
+// GET /v1/status and POST /v1/lock.
+func (a *Agent) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/keys", func(w http.ResponseWriter, _ *http.Request) {
+		var keys []string
+
+		err := a.withRing(func(ring keyring.Keyring) error {
+			var err error
+			keys, err = ring.Keys()
+
+			return err
+		})
+		writeAgentResult(w, err, map[string][]string{"keys": keys})
+	})
+
+	mux.HandleFunc("GET /v1/item", func(w http.ResponseWriter, r *http.Request) {
+		var item keyring.Item
+
+		err := a.withRing(func(ring keyring.Keyring) error {
+			var err error
+			item, err = ring.Get(r.URL.Query().Get("key"))
+
+			return err
+		})
+		writeAgentResult(w, err, item)
+	})
+
+	mux.HandleFunc("PUT /v1/item", func(w http.ResponseWriter, r *http.Request) {
+		var item keyring.Item
+		if err := json.NewDecoder(io.LimitReader(r.Body, agentMaxBody)).Decode(&item); err != nil {
+			writeAgentJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+
+		item.Key = r.URL.Query().Get("key")
+		err := a.withRing(func(ring keyring.Keyring) error { return ring.Set(item) })
+		writeAgentResult(w, err, map[string]bool{"stored": true})
+	})
+
+	mux.HandleFunc("DELETE /v1/item", func(w http.ResponseWriter, r *http.Request) {
+		err := a.withRing(func(ring keyring.Keyring) error { return ring.Remove(r.URL.Query().Get("key")) })
+		writeAgentResult(w, err, map[string]bool{"removed": true})
+	})
+
+	mux.HandleFunc("GET /v1/status", func(w http.ResponseWriter, _ *http.Request) {
+		writeAgentJSON(w, http.StatusOK, AgentStatus{ExpiresAt: a.ExpiresAt()})
+	})
+
+	mux.HandleFunc("POST /v1/lock", func(w http.ResponseWriter, _ *http.Request) {
+		writeAgentJSON(w, http.StatusOK, map[string]bool{"locked": true})
+
+		// Respond before shutting the server down.
+		go a.Lock()
+	})
+
+	return mux
+}
+
+// Serve answers requests on ln until ctx is done, the TTL runs out or the
+// agent is locked. The keyring is dropped on return.
+func (a *Agent) Serve(ctx context.Context, ln net.Listener) error {
+	srv := &http.Server{Handler: a.Handler(), ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		timer := time.NewTimer(time.Until(a.ExpiresAt()))
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		case <-a.locked:
+		}
+
+		a.Lock()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		a.Lock()
+
+		return err
+	}
+
+	return nil
+}
+
+// AgentSocketPath is GOG_KEYRING_AGENT_SOCK or <config dir>/keyring-agent.sock.
+func AgentSocketPath() (string, error) {
+	if path := strings.TrimSpace(os.Getenv(agentSocketEnv)); path != "" {
+		return config.ExpandPath(path)
+	}
+
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "keyring-agent.sock"), nil
+}
+
+// ListenAgent opens the agent socket at path, accessible by the current user
+// only. A stale socket is replaced; a running agent is an error.
+func ListenAgent(path string) (net.Listener, error) {
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("%w on %s", errAgentRunning, path)
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("remove stale socket: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("ensure socket dir: %w", err)
+	}
+
+	ln, err := config.ListenPrivateSocket(path)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s: %w", path, err)
+	}
+
+	return ln, nil
+}
+
+// AgentUnlocked reports whether a running agent holds the keyring open, and
+// until when.
+func AgentUnlocked(ctx context.Context) (time.Time, bool) {
+	var status AgentStatus
+	if err := agentRequest(ctx, http.MethodGet, "/v1/status", nil, &status); err != nil {
+		return time.Time{}, false
+	}
+
+	return status.ExpiresAt, true
+}
+
+// LockAgent tells a running agent to close the keyring and exit, and waits
+// until its socket stops answering so a new agent can take it over. It
+// returns ErrAgentNotRunning when there is nothing to lock.
+func LockAgent(ctx context.Context) error {
+	if err := agentRequest(ctx, http.MethodPost, "/v1/lock", nil, nil); err != nil {
+		return err
+	}
+
+	socket, err := agentSocketPathFunc()
+	if err != nil {
+		return err
+	}
+
+	// The agent shuts down within its 2s grace period; allow a little more.
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		conn, err := net.DialTimeout("unix", socket, 100*time.Millisecond)
+		if err != nil {
+			return nil
+		}
+
+		_ = conn.Close()
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w on %s", errAgentStillUp, socket)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+}
+
+// agentKeyring is the file keyring as served by a running agent.
+type agentKeyring struct{}
+
+// openAgentKeyring returns the agent's keyring when one is unlocked.
+func openAgentKeyring() (keyring.Keyring, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), agentClientTimeout)
+	defer cancel()
+
+	if _, ok := AgentUnlocked(ctx); !ok {
+		return nil, false
+	}
+
+	return agentKeyring{}, true
+}
+
+func (agentKeyring) Get(key string) (keyring.Item, error) {
+	var item keyring.Item
+	err := agentCall(http.MethodGet, "/v1/item?key="+url.QueryEscape(key), nil, &item)
+
+	return item, err
+}
+
+func (agentKeyring) GetMetadata(_ string) (keyring.Metadata, error) {
+	return keyring.Metadata{}, keyring.ErrMetadataNotSupported
+}
+
+func (agentKeyring) Set(item keyring.Item) error {
+	return agentCall(http.MethodPut, "/v1/item?key="+url.QueryEscape(item.Key), item, nil)
+}
+
+func (agentKeyring) Remove(key string) error {
+	return agentCall(http.MethodDelete, "/v1/item?key="+url.QueryEscape(key), nil, nil)
+}
+
+func (agentKeyring) Keys() ([]string, error) {
+	var payload struct {
+		Keys []string `json:"keys"`
+	}
+	err := agentCall(http.MethodGet, "/v1/keys", nil, &payload)
+
+	return payload.Keys, err
+}
+
+func agentCall(method string, path string, in any, out any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), agentClientTimeout)
+	defer cancel()
+
+	return agentRequest(ctx, method, path, in, out)
+}
+
+func agentRequest(ctx context.Context, method string, path string, in any, out any) error {
+	socket, err := agentSocketPathFunc()
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Timeout: agentClientTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+
+	var body io.Reader
+
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(data)
+	}
+
+	// The host is ignored; requests go to the socket.
+	req, err := http.NewRequestWithContext(ctx, method, "http://gog-keyring-agent"+path, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrAgentNotRunning, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, agentMaxBody))
+	if err != nil {
+		return fmt.Errorf("keyring agent: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return keyring.ErrKeyNotFound
+	case http.StatusGone:
+		return errAgentLocked
+	default:
+		var payload struct {
+			Error string `json:"error"`
+		}
+
+		_ = json.Unmarshal(data, &payload)
+
+		return fmt.Errorf("keyring agent: HTTP %d: %s", resp.StatusCode, payload.Error)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode keyring agent response: %w", err)
+	}
+
+	return nil
+}
+
+// writeAgentResult maps keyring errors to status codes the client turns back
+// into the same errors.
+func writeAgentResult(w http.ResponseWriter, err error, v any) {
+	switch {
+	case err == nil:
+		writeAgentJSON(w, http.StatusOK, v)
+	case errors.Is(err, keyring.ErrKeyNotFound):
+		writeAgentJSON(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, errAgentLocked):
+		writeAgentJSON(w, http.StatusGone, map[string]string{"error": err.Error()})
+	default:
+		writeAgentJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
+
+func writeAgentJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// OpenFileKeyring opens the file keyring with passphrase and checks it by
+// decrypting one stored entry. An empty keyring accepts any passphrase, since
+// the first write will use it.
+func OpenFileKeyring(passphrase string) (keyring.Keyring, error) {
+	if passphrase == "" {
+		return nil, errEmptyPassphrase
+	}
+
+	dir, err := config.EnsureKeyringDir()
+	if err != nil {
+		return nil, err
+	}
+
+	ring, err := keyringOpenFunc(keyring.Config{
+		ServiceName:      config.AppName,
+		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
+		FileDir:          dir,
+		FilePasswordFunc: keyring.FixedStringPrompt(passphrase),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open keyring: %w", err)
+	}
+
+	keys, err := ring.Keys()
+	if err != nil {
+		return nil, fmt.Errorf("list keyring keys: %w", err)
+	}
+
+	if len(keys) == 0 {
+		return ring, nil
+	}
+
+	if _, err := ring.Get(keys[0]); err != nil {
+		return nil, fmt.Errorf("%w: %w", errWrongPassphrase, err)
+	}
+
+	return ring, nil
+}
+
+// VerifyFilePassphrase checks passphrase against the file keyring.
+func VerifyFilePassphrase(passphrase string) error {
+	_, err := OpenFileKeyring(passphrase)
+
+	return err
+}
@@ -0,0 +1,214 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+func agentSocket(t *testing.T) string {
+	t.Helper()
+
+	// Unix socket paths are limited to ~100 bytes; t.TempDir can be longer.
+	dir, err := os.MkdirTemp("", "gogk")
+	if err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "agent.sock")
+	t.Setenv(agentSocketEnv, path)
+
+	return path
+}
+
+func serveAgent(t *testing.T, agent *Agent, path string) <-chan error {
+	t.Helper()
+
+	ln, err := ListenAgent(path)
+	if err != nil {
+		t.Fatalf("ListenAgent: %v", err)
+	}
+
+	done := make(chan error, 1)
+
+	go func() { done <- agent.Serve(context.Background(), ln) }()
+
+	t.Cleanup(agent.Lock)
+
+	return done
+}
+
+func waitServed(t *testing.T, done <-chan error) {
+	t.Helper()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Serve: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("agent did not stop")
+	}
+}
+
+func TestAgentServesKeyringUntilLocked(t *testing.T) {
+	path := agentSocket(t)
+	agent := NewAgent(keyring.NewArrayKeyring([]keyring.Item{{Key: "a", Data: []byte("1")}}), time.Hour)
+	done := serveAgent(t, agent, path)
+
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("unexpected socket mode: %v %v", info, err)
+	}
+
+	ring, ok := openAgentKeyring()
+	if !ok {
+		t.Fatal("agent keyring not available")
+	}
+
+	if item, err := ring.Get("a"); err != nil || string(item.Data) != "1" {
+		t.Fatalf("Get: %q %v", item.Data, err)
+	}
+
+	if err := ring.Set(keyring.Item{Key: "token:default:a@b.com", Data: []byte("2")}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if keys, err := ring.Keys(); err != nil || len(keys) != 2 {
+		t.Fatalf("Keys: %v %v", keys, err)
+	}
+
+	if err := ring.Remove("a"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+
+	if _, err := ring.Get("a"); !errors.Is(err, keyring.ErrKeyNotFound) {
+		t.Fatalf("expected ErrKeyNotFound, got %v", err)
+	}
+
+	// The passphrase is never served.
+	if err := agentRequest(context.Background(), http.MethodGet, "/v1/passphrase", nil, nil); err == nil {
+		t.Fatal("agent answered /v1/passphrase")
+	}
+
+	if expires, ok := AgentUnlocked(context.Background()); !ok || !expires.Equal(agent.ExpiresAt()) {
+		t.Fatalf("AgentUnlocked = %v, %v", expires, ok)
+	}
+
+	if _, err := ListenAgent(path); !errors.Is(err, errAgentRunning) {
+		t.Fatalf("expected errAgentRunning, got %v", err)
+	}
+
+	if err := LockAgent(context.Background()); err != nil {
+		t.Fatalf("LockAgent: %v", err)
+	}
+
+	// LockAgent waits for the agent to let go of the socket, so a new one can
+	// listen right away.
+	ln, err := ListenAgent(path)
+	if err != nil {
+		t.Fatalf("ListenAgent after lock: %v", err)
+	}
+
+	_ = ln.Close()
+
+	waitServed(t, done)
+
+	if err := agent.withRing(func(keyring.Keyring) error { return nil }); !errors.Is(err, errAgentLocked) {
+		t.Fatalf("keyring kept after lock: %v", err)
+	}
+
+	if _, ok := openAgentKeyring(); ok {
+		t.Fatal("agent keyring available after lock")
+	}
+
+	if err := LockAgent(context.Background()); !errors.Is(err, ErrAgentNotRunning) {
+		t.Fatalf("expected ErrAgentNotRunning, got %v", err)
+	}
+}
+
+func TestAgentExpires(t *testing.T) {
+	path := agentSocket(t)
+	agent := NewAgent(keyring.NewArrayKeyring(nil), 50*time.Millisecond)
+
+	waitServed(t, serveAgent(t, agent, path))
+
+	if err := agent.withRing(func(keyring.Keyring) error { return nil }); !errors.Is(err, errAgentLocked) {
+		t.Fatalf("keyring kept after TTL: %v", err)
+	}
+}
+
+func TestOpenKeyringUsesAgent(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv(keyringBackendEnv, "file")
+	t.Setenv(keyringPasswordEnv, "")
+
+	orig := agentKeyringFunc
+	t.Cleanup(func() { agentKeyringFunc = orig })
+
+	agentRing := keyring.NewArrayKeyring(nil)
+	agentKeyringFunc = func() (keyring.Keyring, bool) { return agentRing, true }
+
+	if ring, err := openKeyring(); err != nil || ring != agentRing {
+		t.Fatalf("expected the agent keyring, got %T %v", ring, err)
+	}
+
+	// An explicit password bypasses the agent.
+	t.Setenv(keyringPasswordEnv, "pw")
+
+	if ring, err := openKeyring(); err != nil || ring == agentRing {
+		t.Fatalf("expected the file keyring, got %T %v", ring, err)
+	}
+}
+
+func TestVerifyFilePassphrase(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	if err := VerifyFilePassphrase("anything"); err != nil {
+		t.Fatalf("empty keyring should accept any passphrase: %v", err)
+	}
+
+	dir, err := config.EnsureKeyringDir()
+	if err != nil {
+		t.Fatalf("EnsureKeyringDir: %v", err)
+	}
+
+	ring, err := keyring.Open(keyring.Config{
+		ServiceName:      config.AppName,
+		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
+		FileDir:          dir,
+		FilePasswordFunc: keyring.FixedStringPrompt("right"),
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	if err := ring.Set(keyring.Item{Key: "k", Data: []byte("v")}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+
+	if err := VerifyFilePassphrase("right"); err != nil {
+		t.Fatalf("right passphrase rejected: %v", err)
+	}
+
+	if err := VerifyFilePassphrase("wrong"); !errors.Is(err, errWrongPassphrase) {
+		t.Fatalf("expected errWrongPassphrase, got %v", err)
+	}
+
+	if err := VerifyFilePassphrase(""); !errors.Is(err, errEmptyPassphrase) {
+		t.Fatalf("expected errEmptyPassphrase, got %v", err)
+	}
+}
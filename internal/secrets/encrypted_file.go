@@ -0,0 +1,278 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+const (
+	keyringBackendAge = "age"
+	keyringBackendGPG = "gpg"
+
+	keyringDirEnv        = "GOG_KEYRING_DIR"
+	keyringRecipientsEnv = "GOG_KEYRING_RECIPIENTS"
+	ageIdentityEnv       = "GOG_AGE_IDENTITY"
+	ageCmdEnv            = "GOG_AGE_CMD"
+	gpgCmdEnv            = "GOG_GPG_CMD"
+)
+
+var (
+	errNoRecipients = errors.New("no recipients configured; set " + keyringRecipientsEnv)
+	errNoIdentity   = errors.New("no age identity configured; set " + ageIdentityEnv)
+)
+
+// cryptFunc runs an encrypt or decrypt step over a whole payload.
+type cryptFunc func(in []byte) ([]byte, error)
+
+// encryptedFileKeyring keeps one public-key encrypted file per key (age or
+// GPG), so the directory can be synced between machines and decrypted with
+// whatever identity the tool supports, including hardware keys.
+type encryptedFileKeyring struct {
+	dir     string
+	ext     string
+	encrypt cryptFunc
+	decrypt cryptFunc
+}
+
+func openEncryptedFileKeyring(backend string) (keyring.Keyring, error) {
+	dir := strings.TrimSpace(os.Getenv(keyringDirEnv))
+	if dir == "" {
+		base, err := config.Dir()
+		if err != nil {
+			return nil, err
+		}
+
+		dir = filepath.Join(base, "keyring-"+backend)
+	} else {
+		expanded, err := config.ExpandPath(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		dir = expanded
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("ensure keyring dir: %w", err)
+	}
+
+	recipients := splitList(os.Getenv(keyringRecipientsEnv))
+
+	switch backend {
+	case keyringBackendAge:
+		bin := envOrDefault(ageCmdEnv, "age")
+		if _, err := exec.LookPath(bin); err != nil {
+			return nil, fmt.Errorf("age backend needs the age CLI (https://age-encryption.org): %w", err)
+		}
+
+		return &encryptedFileKeyring{
+			dir:     dir,
+			ext:     ".age",
+			encrypt: cliCrypt(bin, ageEncryptArgs(recipients)),
+			decrypt: cliCrypt(bin, ageDecryptArgs(splitList(os.Getenv(ageIdentityEnv)))),
+		}, nil
+	case keyringBackendGPG:
+		bin := envOrDefault(gpgCmdEnv, "gpg")
+		if _, err := exec.LookPath(bin); err != nil {
+			return nil, fmt.Errorf("gpg backend needs the gpg CLI: %w", err)
+		}
+
+		return &encryptedFileKeyring{
+			dir:     dir,
+			ext:     ".gpg",
+			encrypt: cliCrypt(bin, gpgEncryptArgs(recipients)),
+			decrypt: cliCrypt(bin, func() ([]string, error) { return []string{"--quiet", "--decrypt"}, nil }),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errInvalidKeyringBackend, backend)
+	}
+}
+
+func ageEncryptArgs(recipients []string) func() ([]string, error) {
+	return func() ([]string, error) {
+		if len(recipients) == 0 {
+			return nil, errNoRecipients
+		}
+
+		args := []string{"--encrypt", "--armor"}
+		for _, r := range recipients {
+			args = append(args, "-r", r)
+		}
+
+		return args, nil
+	}
+}
+
+func ageDecryptArgs(identities []string) func() ([]string, error) {
+	return func() ([]string, error) {
+		if len(identities) == 0 {
+			return nil, errNoIdentity
+		}
+
+		args := []string{"--decrypt"}
+
+		for _, id := range identities {
+			path, err := config.ExpandPath(id)
+			if err != nil {
+				return nil, err
+			}
+
+			args = append(args, "-i", path)
+		}
+
+		return args, nil
+	}
+}
+
+func gpgEncryptArgs(recipients []string) func() ([]string, error) {
+	return func() ([]string, error) {
+		if len(recipients) == 0 {
+			return nil, errNoRecipients
+		}
+
+		// The recipients are named explicitly, so skip the web-of-trust check.
+		args := []string{"--batch", "--yes", "--quiet", "--armor", "--trust-model", "always", "--encrypt"}
+		for _, r := range recipients {
+			args = append(args, "-r", r)
+		}
+
+		return args, nil
+	}
+}
+
+// cliCrypt pipes the payload through bin. stderr stays attached so PIN and
+// touch prompts from hardware keys reach the user.
+func cliCrypt(bin string, argsFn func() ([]string, error)) cryptFunc {
+	return func(in []byte) ([]byte, error) {
+		args, err := argsFn()
+		if err != nil {
+			return nil, err
+		}
+
+		cmd := exec.Command(bin, args...) //nolint:gosec // binary and args from user config
+		cmd.Stdin = bytes.NewReader(in)
+		cmd.Stderr = os.Stderr
+
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", filepath.Base(bin), args[0], err)
+		}
+
+		return out, nil
+	}
+}
+
+func (k *encryptedFileKeyring) path(key string) string {
+	return filepath.Join(k.dir, url.QueryEscape(key)+k.ext)
+}
+
+func (k *encryptedFileKeyring) Get(key string) (keyring.Item, error) {
+	data, err := os.ReadFile(k.path(key))
+	if os.IsNotExist(err) {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	}
+
+	if err != nil {
+		return keyring.Item{}, err
+	}
+
+	plain, err := k.decrypt(data)
+	if err != nil {
+		return keyring.Item{}, fmt.Errorf("decrypt %s: %w", key, err)
+	}
+
+	return keyring.Item{Key: key, Data: plain}, nil
+}
+
+func (k *encryptedFileKeyring) GetMetadata(_ string) (keyring.Metadata, error) {
+	return keyring.Metadata{}, keyring.ErrMetadataNotSupported
+}
+
+func (k *encryptedFileKeyring) Set(item keyring.Item) error {
+	sealed, err := k.encrypt(item.Data)
+	if err != nil {
+		return fmt.Errorf("encrypt %s: %w", item.Key, err)
+	}
+
+	tmp, err := os.CreateTemp(k.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(sealed); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), k.path(item.Key))
+}
+
+func (k *encryptedFileKeyring) Remove(key string) error {
+	err := os.Remove(k.path(key))
+	if os.IsNotExist(err) {
+		return keyring.ErrKeyNotFound
+	}
+
+	return err
+}
+
+func (k *encryptedFileKeyring) Keys() ([]string, error) {
+	entries, err := os.ReadDir(k.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(entries))
+
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), k.ext)
+		if !ok || e.IsDir() || strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		key, err := url.QueryUnescape(name)
+		if err != nil {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func splitList(raw string) []string {
+	var out []string
+
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+
+	return out
+}
+
+func envOrDefault(name string, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(name)); v != "" {
+		return v
+	}
+
+	return fallback
+}
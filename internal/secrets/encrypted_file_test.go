@@ -0,0 +1,89 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/99designs/keyring"
+)
+
+func TestEncryptedFileKeyring_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	sealedPrefix := []byte("SEALED:")
+	ring := &encryptedFileKeyring{
+		dir: dir,
+		ext: ".age",
+		encrypt: func(in []byte) ([]byte, error) {
+			return append(slices.Clone(sealedPrefix), in...), nil
+		},
+		decrypt: func(in []byte) ([]byte, error) {
+			if !bytes.HasPrefix(in, sealedPrefix) {
+				return nil, errors.New("not sealed")
+			}
+
+			return in[len(sealedPrefix):], nil
+		},
+	}
+	store := &KeyringStore{ring: ring}
+
+	if err := store.SetToken("work", "a@b.com", Token{RefreshToken: "rt", Services: []string{"gmail"}}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+
+	if len(entries) != 1 || !strings.HasSuffix(entries[0].Name(), ".age") || strings.Contains(entries[0].Name(), ":") {
+		t.Fatalf("unexpected files: %v", entries)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil || !bytes.HasPrefix(raw, sealedPrefix) {
+		t.Fatalf("expected sealed file, got %q (%v)", raw, err)
+	}
+
+	tok, err := store.GetToken("work", "a@b.com")
+	if err != nil || tok.RefreshToken != "rt" {
+		t.Fatalf("GetToken: %#v %v", tok, err)
+	}
+
+	keys, err := store.Keys()
+	if err != nil || len(keys) != 1 || keys[0] != "token:work:a@b.com" {
+		t.Fatalf("unexpected keys: %v %v", keys, err)
+	}
+
+	if err := store.DeleteToken("work", "a@b.com"); err != nil {
+		t.Fatalf("DeleteToken: %v", err)
+	}
+
+	if _, err := ring.Get("token:work:a@b.com"); !errors.Is(err, keyring.ErrKeyNotFound) {
+		t.Fatalf("expected ErrKeyNotFound, got %v", err)
+	}
+}
+
+func TestEncryptedFileArgs(t *testing.T) {
+	if _, err := ageEncryptArgs(nil)(); !errors.Is(err, errNoRecipients) {
+		t.Fatalf("expected errNoRecipients, got %v", err)
+	}
+
+	if _, err := ageDecryptArgs(nil)(); !errors.Is(err, errNoIdentity) {
+		t.Fatalf("expected errNoIdentity, got %v", err)
+	}
+
+	args, err := ageEncryptArgs(splitList("age1abc, age1def"))()
+	if err != nil || !slices.Equal(args, []string{"--encrypt", "--armor", "-r", "age1abc", "-r", "age1def"}) {
+		t.Fatalf("unexpected age args: %v %v", args, err)
+	}
+
+	args, err = gpgEncryptArgs([]string{"me@example.com"})()
+	if err != nil || args[len(args)-2] != "-r" || args[len(args)-1] != "me@example.com" || !slices.Contains(args, "--encrypt") {
+		t.Fatalf("unexpected gpg args: %v %v", args, err)
+	}
+}
@@ -0,0 +1,220 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+const (
+	onePasswordVaultEnv = "GOG_OP_VAULT"
+	onePasswordCmdEnv   = "GOG_OP_CMD"
+)
+
+var errOnePasswordNotFound = errors.New("1password item not found")
+
+// onePasswordKeyring keeps each secret as a Password item in a 1Password vault
+// through the op CLI. Items are titled "gogcli/<key>" and tagged "gogcli";
+// values are base64 so binary payloads survive the round trip.
+type onePasswordKeyring struct {
+	vault string
+	run   func(stdin []byte, args ...string) ([]byte, error)
+}
+
+func openOnePasswordKeyring() (keyring.Keyring, error) {
+	bin := envOrDefault(onePasswordCmdEnv, "op")
+	if _, err := exec.LookPath(bin); err != nil {
+		return nil, fmt.Errorf("1password backend needs the op CLI (https://developer.1password.com/docs/cli): %w", err)
+	}
+
+	return &onePasswordKeyring{
+		vault: strings.TrimSpace(os.Getenv(onePasswordVaultEnv)),
+		run:   opRunner(bin),
+	}, nil
+}
+
+func opRunner(bin string) func(stdin []byte, args ...string) ([]byte, error) {
+	return func(stdin []byte, args ...string) ([]byte, error) {
+		cmd := exec.Command(bin, args...) //nolint:gosec // op binary from config
+		if stdin != nil {
+			cmd.Stdin = bytes.NewReader(stdin)
+		}
+
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+
+		out, err := cmd.Output()
+		if err != nil {
+			msg := strings.TrimSpace(stderr.String())
+			if strings.Contains(msg, "isn't an item") || strings.Contains(msg, "not found") {
+				return nil, fmt.Errorf("%w: %s", errOnePasswordNotFound, msg)
+			}
+
+			if msg != "" {
+				return nil, fmt.Errorf("op %s: %s", args[0], msg)
+			}
+
+			return nil, fmt.Errorf("op %s: %w", args[0], err)
+		}
+
+		return out, nil
+	}
+}
+
+func (k *onePasswordKeyring) title(key string) string {
+	return config.AppName + "/" + key
+}
+
+func (k *onePasswordKeyring) withVault(args ...string) []string {
+	if k.vault != "" {
+		args = append(args, "--vault", k.vault)
+	}
+
+	return args
+}
+
+func (k *onePasswordKeyring) Get(key string) (keyring.Item, error) {
+	out, err := k.run(nil, k.withVault("item", "get", k.title(key), "--fields", "label=password", "--reveal")...)
+	if errors.Is(err, errOnePasswordNotFound) {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	}
+
+	if err != nil {
+		return keyring.Item{}, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+	if err != nil {
+		return keyring.Item{}, fmt.Errorf("decode 1password item %s: %w", k.title(key), err)
+	}
+
+	return keyring.Item{Key: key, Data: data}, nil
+}
+
+func (k *onePasswordKeyring) GetMetadata(_ string) (keyring.Metadata, error) {
+	return keyring.Metadata{}, keyring.ErrMetadataNotSupported
+}
+
+// Set creates the new item before deleting the previous one, so a failed
+// create leaves the old secret in place. The value goes through a stdin
+// template so it never shows up in the process list.
+func (k *onePasswordKeyring) Set(item keyring.Item) error {
+	previous, err := k.itemIDs(item.Key)
+	if err != nil {
+		return err
+	}
+
+	template, err := json.Marshal(map[string]any{
+		"title":    k.title(item.Key),
+		"category": "PASSWORD",
+		"tags":     []string{config.AppName},
+		"fields": []map[string]string{{
+			"id":      "password",
+			"type":    "CONCEALED",
+			"purpose": "PASSWORD",
+			"label":   "password",
+			"value":   base64.StdEncoding.EncodeToString(item.Data),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	out, err := k.run(template, k.withVault("item", "create", "--format", "json", "-")...)
+	if err != nil {
+		return err
+	}
+
+	var created onePasswordItem
+	if err := json.Unmarshal(out, &created); err != nil {
+		return fmt.Errorf("decode op item create: %w", err)
+	}
+
+	// Delete by ID: the title now matches the new item too.
+	for _, id := range previous {
+		if id == created.ID {
+			continue
+		}
+
+		if _, err := k.run(nil, k.withVault("item", "delete", id)...); err != nil && !errors.Is(err, errOnePasswordNotFound) {
+			return fmt.Errorf("remove previous 1password item %s: %w", k.title(item.Key), err)
+		}
+	}
+
+	return nil
+}
+
+func (k *onePasswordKeyring) Remove(key string) error {
+	_, err := k.run(nil, k.withVault("item", "delete", k.title(key))...)
+	if errors.Is(err, errOnePasswordNotFound) {
+		return keyring.ErrKeyNotFound
+	}
+
+	return err
+}
+
+func (k *onePasswordKeyring) Keys() ([]string, error) {
+	items, err := k.items()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := config.AppName + "/"
+	keys := make([]string, 0, len(items))
+
+	for _, it := range items {
+		if key, ok := strings.CutPrefix(it.Title, prefix); ok && key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+type onePasswordItem struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// items lists everything tagged for gogcli in the vault.
+func (k *onePasswordKeyring) items() ([]onePasswordItem, error) {
+	out, err := k.run(nil, k.withVault("item", "list", "--tags", config.AppName, "--format", "json")...)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []onePasswordItem
+	if err := json.Unmarshal(out, &items); err != nil {
+		return nil, fmt.Errorf("decode op item list: %w", err)
+	}
+
+	return items, nil
+}
+
+// itemIDs returns the IDs of the items stored under key; more than one only
+// if an earlier Set was interrupted between create and delete.
+func (k *onePasswordKeyring) itemIDs(key string) ([]string, error) {
+	items, err := k.items()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	for _, it := range items {
+		if it.Title == k.title(key) {
+			ids = append(ids, it.ID)
+		}
+	}
+
+	return ids, nil
+}
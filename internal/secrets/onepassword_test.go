@@ -0,0 +1,195 @@
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/99designs/keyring"
+)
+
+// fakeOP emulates the subset of the op CLI the 1Password backend uses.
+type fakeOP struct {
+	items      map[string]fakeOPItem // id -> item
+	nextID     int
+	failCreate bool
+	calls      [][]string
+}
+
+type fakeOPItem struct {
+	title    string
+	password string
+}
+
+// lookup resolves an item by ID or by unique title, like op does.
+func (f *fakeOP) lookup(ref string) (string, error) {
+	if _, ok := f.items[ref]; ok {
+		return ref, nil
+	}
+
+	var matches []string
+
+	for id, it := range f.items {
+		if it.title == ref {
+			matches = append(matches, id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", errOnePasswordNotFound
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("more than one item matches %q", ref)
+	}
+}
+
+func (f *fakeOP) run(stdin []byte, args ...string) ([]byte, error) {
+	f.calls = append(f.calls, args)
+
+	if !slices.Contains(args, "--vault") || args[slices.Index(args, "--vault")+1] != "Team" {
+		return nil, fmt.Errorf("missing --vault Team in %v", args)
+	}
+
+	switch {
+	case args[0] == "item" && args[1] == "get":
+		id, err := f.lookup(args[2])
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(f.items[id].password + "\n"), nil
+	case args[0] == "item" && args[1] == "delete":
+		id, err := f.lookup(args[2])
+		if err != nil {
+			return nil, err
+		}
+
+		delete(f.items, id)
+
+		return nil, nil
+	case args[0] == "item" && args[1] == "create":
+		if f.failCreate {
+			return nil, errors.New("op item create: vault is read-only")
+		}
+
+		var tpl struct {
+			Title  string `json:"title"`
+			Fields []struct {
+				Value string `json:"value"`
+			} `json:"fields"`
+		}
+		if err := json.Unmarshal(stdin, &tpl); err != nil {
+			return nil, err
+		}
+
+		f.nextID++
+		id := fmt.Sprintf("id%d", f.nextID)
+		f.items[id] = fakeOPItem{title: tpl.Title, password: tpl.Fields[0].Value}
+
+		return json.Marshal(map[string]string{"id": id, "title": tpl.Title})
+	case args[0] == "item" && args[1] == "list":
+		out := make([]map[string]string, 0, len(f.items))
+		for id, it := range f.items {
+			out = append(out, map[string]string{"id": id, "title": it.title})
+		}
+
+		return json.Marshal(append(out, map[string]string{"id": "other", "title": "unrelated"}))
+	}
+
+	return nil, fmt.Errorf("unexpected op call %v", args)
+}
+
+func TestOnePasswordKeyring_RoundTrip(t *testing.T) {
+	op := &fakeOP{items: map[string]fakeOPItem{}}
+	ring := &onePasswordKeyring{vault: "Team", run: op.run}
+	store := &KeyringStore{ring: ring}
+
+	if err := store.SetToken("work", "a@b.com", Token{RefreshToken: "rt", Services: []string{"gmail"}}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+
+	for _, call := range op.calls {
+		if strings.Contains(strings.Join(call, " "), "rt") {
+			t.Fatalf("secret passed on the command line: %v", call)
+		}
+	}
+
+	tok, err := store.GetToken("work", "a@b.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+
+	if tok.RefreshToken != "rt" || len(tok.Services) != 1 {
+		t.Fatalf("unexpected token: %#v", tok)
+	}
+
+	// Overwrite replaces the existing item instead of duplicating it.
+	if err := store.SetToken("work", "a@b.com", Token{RefreshToken: "rt2"}); err != nil {
+		t.Fatalf("SetToken again: %v", err)
+	}
+
+	keys, err := store.Keys()
+	if err != nil {
+		t.Fatalf("Keys: %v", err)
+	}
+
+	if len(keys) != 1 || keys[0] != "token:work:a@b.com" {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+
+	if err := store.DeleteToken("work", "a@b.com"); err != nil {
+		t.Fatalf("DeleteToken: %v", err)
+	}
+
+	if _, err := ring.Get("token:work:a@b.com"); !errors.Is(err, keyring.ErrKeyNotFound) {
+		t.Fatalf("expected ErrKeyNotFound, got %v", err)
+	}
+}
+
+func TestOnePasswordKeyring_SetKeepsOldItemWhenCreateFails(t *testing.T) {
+	op := &fakeOP{items: map[string]fakeOPItem{}}
+	ring := &onePasswordKeyring{vault: "Team", run: op.run}
+
+	if err := ring.Set(keyring.Item{Key: "k", Data: []byte("old")}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	op.failCreate = true
+
+	if err := ring.Set(keyring.Item{Key: "k", Data: []byte("new")}); err == nil {
+		t.Fatal("expected create error")
+	}
+
+	item, err := ring.Get("k")
+	if err != nil || string(item.Data) != "old" {
+		t.Fatalf("old item lost after failed create: %q %v", item.Data, err)
+	}
+
+	op.failCreate = false
+	op.calls = nil
+
+	if err := ring.Set(keyring.Item{Key: "k", Data: []byte("new")}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	var order []string
+
+	for _, call := range op.calls {
+		if call[1] == "create" || call[1] == "delete" {
+			order = append(order, call[1])
+		}
+	}
+
+	if strings.Join(order, ",") != "create,delete" {
+		t.Fatalf("expected create before delete, got %v", order)
+	}
+
+	if item, err := ring.Get("k"); err != nil || string(item.Data) != "new" {
+		t.Fatalf("unexpected item after replace: %q %v", item.Data, err)
+	}
+}
@@ -29,12 +29,17 @@ type KeyringStore struct {
 	ring keyring.Keyring
 }
 
+// Token is a stored OAuth refresh token. RefreshedAt and Expiry record the
+// last forced refresh (gog auth tokens refresh) and the access token expiry
+// Google reported then.
 type Token struct {
 	Client       string    `json:"client,omitempty"`
 	Email        string    `json:"email"`
 	Services     []string  `json:"services,omitempty"`
 	Scopes       []string  `json:"scopes,omitempty"`
 	CreatedAt    time.Time `json:"created_at,omitempty"`
+	RefreshedAt  time.Time `json:"refreshed_at,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
 	RefreshToken string    `json:"-"`
 }
 
@@ -64,6 +69,9 @@ const (
 	keyringBackendSourceConfig  = "config"
 	keyringBackendSourceDefault = "default"
 	keyringBackendAuto          = "auto"
+	keyringBackendPass          = "pass"
+	keyringBackendOnePassword   = "1password"
+	keyringBackendVault         = "vault"
 )
 
 func ResolveKeyringBackendInfo() (KeyringBackendInfo, error) {
@@ -93,8 +101,10 @@ func allowedBackends(info KeyringBackendInfo) ([]keyring.BackendType, error) {
 		return []keyring.BackendType{keyring.KeychainBackend}, nil
 	case "file":
 		return []keyring.BackendType{keyring.FileBackend}, nil
+	case keyringBackendPass:
+		return []keyring.BackendType{keyring.PassBackend}, nil
 	default:
-		return nil, fmt.Errorf("%w: %q (expected %s, keychain, or file)", errInvalidKeyringBackend, info.Value, keyringBackendAuto)
+		return nil, fmt.Errorf("%w: %q (expected %s, keychain, file, age, gpg, pass, 1password, or vault)", errInvalidKeyringBackend, info.Value, keyringBackendAuto)
 	}
 }
 
@@ -142,6 +152,16 @@ func shouldForceFileBackend(goos string, backendInfo KeyringBackendInfo, dbusAdd
 	return goos == "linux" && backendInfo.Value == keyringBackendAuto && dbusAddr == ""
 }
 
+// EffectiveKeyringBackend names the backend openKeyring will actually use for
+// info: "auto" becomes "file" on Linux without a D-Bus session.
+func EffectiveKeyringBackend(info KeyringBackendInfo) string {
+	if shouldForceFileBackend(runtime.GOOS, info, os.Getenv("DBUS_SESSION_BUS_ADDRESS")) {
+		return "file"
+	}
+
+	return info.Value
+}
+
 func shouldUseKeyringTimeout(goos string, backendInfo KeyringBackendInfo, dbusAddr string) bool {
 	return goos == "linux" && backendInfo.Value == "auto" && dbusAddr != ""
 }
@@ -150,14 +170,32 @@ func openKeyring() (keyring.Keyring, error) {
 	// On Linux/WSL/containers, OS keychains (secret-service/kwallet) may be unavailable.
 	// In that case github.com/99designs/keyring falls back to the "file" backend,
 	// which *requires* both a directory and a password prompt function.
-	keyringDir, err := config.EnsureKeyringDir()
+	backendInfo, err := ResolveKeyringBackendInfo()
 	if err != nil {
-		return nil, fmt.Errorf("ensure keyring dir: %w", err)
+		return nil, err
 	}
 
-	backendInfo, err := ResolveKeyringBackendInfo()
+	// External secret managers bypass github.com/99designs/keyring entirely.
+	switch backendInfo.Value {
+	case keyringBackendOnePassword:
+		return openOnePasswordKeyring()
+	case keyringBackendVault:
+		return openVaultKeyring()
+	case keyringBackendAge, keyringBackendGPG:
+		return openEncryptedFileKeyring(backendInfo.Value)
+	}
+
+	// After `gog auth unlock`, the agent does file keyring operations for us
+	// and no passphrase is needed. GOG_KEYRING_PASSWORD still wins.
+	if EffectiveKeyringBackend(backendInfo) == "file" && os.Getenv(keyringPasswordEnv) == "" {
+		if ring, ok := agentKeyringFunc(); ok {
+			return ring, nil
+		}
+	}
+
+	keyringDir, err := config.EnsureKeyringDir()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("ensure keyring dir: %w", err)
 	}
 
 	backends, err := allowedBackends(backendInfo)
@@ -185,6 +223,8 @@ func openKeyring() (keyring.Keyring, error) {
 		AllowedBackends:          backends,
 		FileDir:                  keyringDir,
 		FilePasswordFunc:         fileKeyringPasswordFunc(),
+		// pass entries live under gogcli/ in the password store.
+		PassPrefix: config.AppName,
 	}
 
 	// On Linux with D-Bus present, keyring.Open() can still hang if SecretService
@@ -299,6 +339,8 @@ type storedToken struct {
 	Services     []string  `json:"services,omitempty"`
 	Scopes       []string  `json:"scopes,omitempty"`
 	CreatedAt    time.Time `json:"created_at,omitempty"`
+	RefreshedAt  time.Time `json:"refreshed_at,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
 }
 
 func (s *KeyringStore) SetToken(client string, email string, tok Token) error {
@@ -325,6 +367,8 @@ func (s *KeyringStore) SetToken(client string, email string, tok Token) error {
 		Services:     tok.Services,
 		Scopes:       tok.Scopes,
 		CreatedAt:    tok.CreatedAt,
+		RefreshedAt:  tok.RefreshedAt,
+		Expiry:       tok.Expiry,
 	})
 	if err != nil {
 		return fmt.Errorf("encode token: %w", err)
@@ -390,6 +434,8 @@ func (s *KeyringStore) GetToken(client string, email string) (Token, error) {
 		Services:     st.Services,
 		Scopes:       st.Scopes,
 		CreatedAt:    st.CreatedAt,
+		RefreshedAt:  st.RefreshedAt,
+		Expiry:       st.Expiry,
 		RefreshToken: st.RefreshToken,
 	}, nil
 }
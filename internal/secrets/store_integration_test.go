@@ -38,14 +38,15 @@ func TestKeyringStore_TokenRoundTrip(t *testing.T) {
 	store := &KeyringStore{ring: ring}
 	client := config.DefaultClientName
 
-	tok := Token{RefreshToken: "rt", Services: []string{"gmail"}, Scopes: []string{"s"}, CreatedAt: time.Now()}
+	expiry := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+	tok := Token{RefreshToken: "rt", Services: []string{"gmail"}, Scopes: []string{"s"}, CreatedAt: time.Now(), Expiry: expiry}
 	if err := store.SetToken(client, "a@b.com", tok); err != nil {
 		t.Fatalf("SetToken: %v", err)
 	}
 
 	if got, err := store.GetToken(client, "a@b.com"); err != nil {
 		t.Fatalf("GetToken: %v", err)
-	} else if got.RefreshToken != "rt" {
+	} else if got.RefreshToken != "rt" || !got.Expiry.Equal(expiry) {
 		t.Fatalf("unexpected token: %#v", got)
 	}
 
@@ -86,6 +86,10 @@ func TestAllowedBackends(t *testing.T) {
 	if _, err := allowedBackends(KeyringBackendInfo{Value: "file"}); err != nil {
 		t.Fatalf("file allowed: %v", err)
 	}
+
+	if got, err := allowedBackends(KeyringBackendInfo{Value: "pass"}); err != nil || len(got) != 1 || got[0] != keyring.PassBackend {
+		t.Fatalf("pass allowed: %v %v", got, err)
+	}
 }
 
 func TestWrapKeychainError(t *testing.T) {
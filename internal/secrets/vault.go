@@ -0,0 +1,247 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+const (
+	vaultAddrEnv      = "VAULT_ADDR"
+	vaultTokenEnv     = "VAULT_TOKEN" //nolint:gosec // env var name, not a credential
+	vaultNamespaceEnv = "VAULT_NAMESPACE"
+	vaultMountEnv     = "GOG_VAULT_MOUNT"
+	vaultPathEnv      = "GOG_VAULT_PATH"
+
+	vaultHTTPTimeout = 15 * time.Second
+)
+
+var errVaultNotConfigured = errors.New("vault backend needs VAULT_ADDR and a token (VAULT_TOKEN or ~/.vault-token)")
+
+// vaultKeyring stores secrets in a HashiCorp Vault KV v2 engine, one entry per
+// key under <mount>/<path>/ (default secret/gogcli/), value base64 in "value".
+type vaultKeyring struct {
+	addr      string
+	token     string
+	namespace string
+	mount     string
+	path      string
+	client    *http.Client
+}
+
+func openVaultKeyring() (keyring.Keyring, error) {
+	addr := strings.TrimRight(strings.TrimSpace(os.Getenv(vaultAddrEnv)), "/")
+	token := strings.TrimSpace(os.Getenv(vaultTokenEnv))
+
+	if token == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			if b, readErr := os.ReadFile(filepath.Join(home, ".vault-token")); readErr == nil { //nolint:gosec // vault CLI token helper file
+				token = strings.TrimSpace(string(b))
+			}
+		}
+	}
+
+	if addr == "" || token == "" {
+		return nil, errVaultNotConfigured
+	}
+
+	mount := strings.Trim(strings.TrimSpace(os.Getenv(vaultMountEnv)), "/")
+	if mount == "" {
+		mount = "secret"
+	}
+
+	path := strings.Trim(strings.TrimSpace(os.Getenv(vaultPathEnv)), "/")
+	if path == "" {
+		path = config.AppName
+	}
+
+	return &vaultKeyring{
+		addr:      addr,
+		token:     token,
+		namespace: strings.TrimSpace(os.Getenv(vaultNamespaceEnv)),
+		mount:     mount,
+		path:      path,
+		client:    &http.Client{Timeout: vaultHTTPTimeout},
+	}, nil
+}
+
+func (k *vaultKeyring) url(kind string, key string) string {
+	u := k.addr + "/v1/" + k.mount + "/" + kind + "/" + k.path
+	if key != "" {
+		u += "/" + url.PathEscape(key)
+	}
+
+	return u
+}
+
+func (k *vaultKeyring) do(method string, rawURL string, body any) (int, []byte, error) {
+	var reader io.Reader
+
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, rawURL, reader) //nolint:noctx // keyring interface has no context
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req.Header.Set("X-Vault-Token", k.token)
+
+	if k.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", k.namespace)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("vault %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("vault %s: %w", method, err)
+	}
+
+	return resp.StatusCode, data, nil
+}
+
+func vaultError(status int, data []byte) error {
+	var payload struct {
+		Errors []string `json:"errors"`
+	}
+	if json.Unmarshal(data, &payload) == nil && len(payload.Errors) > 0 {
+		return fmt.Errorf("vault: %s (HTTP %d)", strings.Join(payload.Errors, "; "), status)
+	}
+
+	return fmt.Errorf("vault: HTTP %d", status)
+}
+
+func (k *vaultKeyring) Get(key string) (keyring.Item, error) {
+	status, data, err := k.do(http.MethodGet, k.url("data", key), nil)
+	if err != nil {
+		return keyring.Item{}, err
+	}
+
+	if status == http.StatusNotFound {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	}
+
+	if status != http.StatusOK {
+		return keyring.Item{}, vaultError(status, data)
+	}
+
+	var payload struct {
+		Data struct {
+			Data struct {
+				Value string `json:"value"`
+			} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return keyring.Item{}, fmt.Errorf("decode vault secret: %w", err)
+	}
+
+	value, err := base64.StdEncoding.DecodeString(payload.Data.Data.Value)
+	if err != nil {
+		return keyring.Item{}, fmt.Errorf("decode vault secret %s: %w", key, err)
+	}
+
+	return keyring.Item{Key: key, Data: value}, nil
+}
+
+func (k *vaultKeyring) GetMetadata(_ string) (keyring.Metadata, error) {
+	return keyring.Metadata{}, keyring.ErrMetadataNotSupported
+}
+
+func (k *vaultKeyring) Set(item keyring.Item) error {
+	status, data, err := k.do(http.MethodPost, k.url("data", item.Key), map[string]any{
+		"data": map[string]string{"value": base64.StdEncoding.EncodeToString(item.Data)},
+	})
+	if err != nil {
+		return err
+	}
+
+	if status != http.StatusOK && status != http.StatusNoContent {
+		return vaultError(status, data)
+	}
+
+	return nil
+}
+
+// Remove deletes every version and the metadata of the entry.
+func (k *vaultKeyring) Remove(key string) error {
+	status, data, err := k.do(http.MethodDelete, k.url("metadata", key), nil)
+	if err != nil {
+		return err
+	}
+
+	if status == http.StatusNotFound {
+		return keyring.ErrKeyNotFound
+	}
+
+	if status != http.StatusOK && status != http.StatusNoContent {
+		return vaultError(status, data)
+	}
+
+	return nil
+}
+
+func (k *vaultKeyring) Keys() ([]string, error) {
+	status, data, err := k.do(http.MethodGet, k.url("metadata", "")+"?list=true", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusNotFound {
+		return []string{}, nil
+	}
+
+	if status != http.StatusOK {
+		return nil, vaultError(status, data)
+	}
+
+	var payload struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("decode vault list: %w", err)
+	}
+
+	keys := make([]string, 0, len(payload.Data.Keys))
+
+	for _, key := range payload.Data.Keys {
+		// Trailing slashes are sub-folders, not entries.
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
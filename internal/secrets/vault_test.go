@@ -0,0 +1,158 @@
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/99designs/keyring"
+)
+
+// newFakeVault serves a minimal KV v2 engine mounted at secret/.
+func newFakeVault(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+
+	data := map[string]string{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "tok" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		path, err := url.PathUnescape(r.URL.EscapedPath())
+		if err != nil {
+			t.Fatalf("unescape: %v", err)
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("list") == "true":
+			prefix := strings.TrimPrefix(path, "/v1/secret/metadata/") + "/"
+			keys := []string{}
+
+			for k := range data {
+				if rest, ok := strings.CutPrefix(k, prefix); ok {
+					keys = append(keys, rest)
+				}
+			}
+
+			if len(keys) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"keys": append(keys, "nested/")}})
+		case r.Method == http.MethodGet:
+			v, ok := data[strings.TrimPrefix(path, "/v1/secret/data/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": map[string]string{"value": v}}})
+		case r.Method == http.MethodPost:
+			var body struct {
+				Data map[string]string `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+
+			data[strings.TrimPrefix(path, "/v1/secret/data/")] = body.Data["value"]
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodDelete:
+			delete(data, strings.TrimPrefix(path, "/v1/secret/metadata/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func TestVaultKeyring_RoundTrip(t *testing.T) {
+	srv := newFakeVault(t)
+	defer srv.Close()
+
+	t.Setenv("VAULT_ADDR", srv.URL+"/")
+	t.Setenv("VAULT_TOKEN", "tok")
+	t.Setenv("GOG_VAULT_MOUNT", "")
+	t.Setenv("GOG_VAULT_PATH", "team/gog")
+
+	ring, err := openVaultKeyring()
+	if err != nil {
+		t.Fatalf("openVaultKeyring: %v", err)
+	}
+
+	store := &KeyringStore{ring: ring}
+
+	if keys, keysErr := store.Keys(); keysErr != nil || len(keys) != 0 {
+		t.Fatalf("expected empty keys, got %v %v", keys, keysErr)
+	}
+
+	if err := store.SetToken("work", "a@b.com", Token{RefreshToken: "rt", Scopes: []string{"s"}}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+
+	tok, err := store.GetToken("work", "a@b.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+
+	if tok.RefreshToken != "rt" || len(tok.Scopes) != 1 {
+		t.Fatalf("unexpected token: %#v", tok)
+	}
+
+	keys, err := store.Keys()
+	if err != nil {
+		t.Fatalf("Keys: %v", err)
+	}
+
+	if len(keys) != 1 || keys[0] != "token:work:a@b.com" {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+
+	if err := store.DeleteToken("work", "a@b.com"); err != nil {
+		t.Fatalf("DeleteToken: %v", err)
+	}
+
+	if _, err := ring.Get("token:work:a@b.com"); !errors.Is(err, keyring.ErrKeyNotFound) {
+		t.Fatalf("expected ErrKeyNotFound, got %v", err)
+	}
+}
+
+func TestVaultKeyring_Errors(t *testing.T) {
+	srv := newFakeVault(t)
+	defer srv.Close()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("VAULT_ADDR", "")
+	t.Setenv("VAULT_TOKEN", "")
+
+	if _, err := openVaultKeyring(); !errors.Is(err, errVaultNotConfigured) {
+		t.Fatalf("expected errVaultNotConfigured, got %v", err)
+	}
+
+	t.Setenv("VAULT_ADDR", srv.URL)
+	t.Setenv("VAULT_TOKEN", "wrong")
+
+	ring, err := openVaultKeyring()
+	if err != nil {
+		t.Fatalf("openVaultKeyring: %v", err)
+	}
+
+	if err := ring.Set(keyring.Item{Key: "k", Data: []byte("v")}); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+}
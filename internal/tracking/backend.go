@@ -0,0 +1,281 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tracking backend providers. Every backend serves the same HTTP API (pixel,
+// link redirect, query and admin endpoints), so after setup the CLI only needs
+// the base URL and keys regardless of where it runs.
+const (
+	ProviderCloudflare = "cloudflare"
+	ProviderHTTP       = "http"
+	ProviderLambda     = "lambda"
+)
+
+var (
+	errUnknownProvider = errors.New("unknown tracking provider (use cloudflare, http or lambda)")
+	errSAMNotFound     = errors.New("sam (AWS SAM CLI) not found in PATH")
+	errMissingStackURL = errors.New("stack output TrackerUrl not found")
+)
+
+// DeployResult is what a backend learned while deploying.
+type DeployResult struct {
+	URL        string // public base URL, when the provider assigns one
+	DatabaseID string
+}
+
+// Backend provisions (or verifies) a tracking endpoint.
+type Backend interface {
+	Name() string
+	// DefaultDir is the bundled template directory ("" if none).
+	DefaultDir() string
+	Deploy(ctx context.Context, logger DeployLogger, opts DeployOptions) (*DeployResult, error)
+	// ManualSteps describes how to deploy by hand when --deploy is not used.
+	ManualSteps(opts DeployOptions) []string
+}
+
+// NormalizeProvider validates a provider name, defaulting to cloudflare.
+func NormalizeProvider(provider string) (string, error) {
+	switch p := strings.ToLower(strings.TrimSpace(provider)); p {
+	case "", ProviderCloudflare:
+		return ProviderCloudflare, nil
+	case ProviderHTTP, ProviderLambda:
+		return p, nil
+	default:
+		return "", errUnknownProvider
+	}
+}
+
+// BackendFor returns the backend for a provider name.
+func BackendFor(provider string) (Backend, error) {
+	p, err := NormalizeProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p {
+	case ProviderHTTP:
+		return httpBackend{}, nil
+	case ProviderLambda:
+		return lambdaBackend{}, nil
+	default:
+		return cloudflareBackend{}, nil
+	}
+}
+
+type cloudflareBackend struct{}
+
+func (cloudflareBackend) Name() string { return ProviderCloudflare }
+
+func (cloudflareBackend) DefaultDir() string {
+	return filepath.Join("internal", "tracking", "worker")
+}
+
+func (cloudflareBackend) Deploy(ctx context.Context, logger DeployLogger, opts DeployOptions) (*DeployResult, error) {
+	dbID, err := DeployWorker(ctx, logger, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeployResult{DatabaseID: dbID}, nil
+}
+
+func (cloudflareBackend) ManualSteps(opts DeployOptions) []string {
+	return []string{
+		"cd " + opts.WorkerDir,
+		"use these values when prompted:",
+		"  TRACKING_KEY=" + opts.TrackingKey,
+		"  ADMIN_KEY=" + opts.AdminKey,
+		"wrangler d1 create " + opts.DatabaseName,
+		"wrangler d1 execute <db> --file schema.sql --remote",
+		fmt.Sprintf("set wrangler.toml name=%s + database_id", opts.WorkerName),
+		"wrangler secret put TRACKING_KEY",
+		"wrangler secret put ADMIN_KEY",
+		"wrangler deploy",
+	}
+}
+
+// httpBackend is any self-hosted server implementing the worker's HTTP API.
+// There is nothing to provision; deploy only checks that /health answers.
+type httpBackend struct{}
+
+func (httpBackend) Name() string { return ProviderHTTP }
+
+func (httpBackend) DefaultDir() string { return "" }
+
+func (httpBackend) Deploy(ctx context.Context, logger DeployLogger, opts DeployOptions) (*DeployResult, error) {
+	if err := CheckHealth(ctx, opts.URL); err != nil {
+		return nil, err
+	}
+	if logger != nil {
+		logger.Printf("health\tok")
+	}
+
+	return &DeployResult{}, nil
+}
+
+func (httpBackend) ManualSteps(opts DeployOptions) []string {
+	return []string{
+		"serve the tracking API (see docs/email-tracking-worker.md) at " + opts.URL,
+		"configure the server with:",
+		"  TRACKING_KEY=" + opts.TrackingKey,
+		"  ADMIN_KEY=" + opts.AdminKey,
+		"verify: curl " + strings.TrimRight(opts.URL, "/") + "/health",
+	}
+}
+
+// lambdaBackend deploys the bundled AWS SAM template (Lambda function URL +
+// DynamoDB) with the SAM CLI.
+type lambdaBackend struct{}
+
+func (lambdaBackend) Name() string { return ProviderLambda }
+
+func (lambdaBackend) DefaultDir() string {
+	return filepath.Join("internal", "tracking", "lambda")
+}
+
+func (lambdaBackend) Deploy(ctx context.Context, logger DeployLogger, opts DeployOptions) (*DeployResult, error) {
+	if _, err := exec.LookPath("sam"); err != nil {
+		return nil, errSAMNotFound
+	}
+
+	dir := filepath.Clean(opts.WorkerDir)
+	if _, err := os.Stat(filepath.Join(dir, "template.yaml")); err != nil {
+		return nil, fmt.Errorf("lambda dir missing template.yaml: %s", dir)
+	}
+
+	if logger != nil {
+		logger.Printf("deploy\tstarting (stack=%s)", opts.WorkerName)
+	}
+
+	configPath, cleanup, err := writeSAMConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	if _, err := runCommandOutput(ctx, dir, "sam", "deploy",
+		"--template-file", "template.yaml",
+		"--stack-name", opts.WorkerName,
+		"--capabilities", "CAPABILITY_IAM",
+		"--resolve-s3",
+		"--no-confirm-changeset",
+		"--no-fail-on-empty-changeset",
+		"--config-file", configPath,
+	); err != nil {
+		return nil, err
+	}
+
+	out, err := runCommandOutput(ctx, dir, "sam", "list", "stack-outputs", "--stack-name", opts.WorkerName, "--output", "json")
+	if err != nil {
+		return nil, err
+	}
+	url, err := parseStackURL(out)
+	if err != nil {
+		return nil, err
+	}
+
+	if logger != nil {
+		logger.Printf("deploy\tok")
+	}
+
+	return &DeployResult{URL: url}, nil
+}
+
+func (lambdaBackend) ManualSteps(opts DeployOptions) []string {
+	return []string{
+		"cd " + opts.WorkerDir,
+		fmt.Sprintf("sam deploy --guided --stack-name %s --capabilities CAPABILITY_IAM", opts.WorkerName),
+		"use these parameter values when prompted:",
+		"  TrackingKey=" + opts.TrackingKey,
+		"  AdminKey=" + opts.AdminKey,
+		"  RetentionDays=" + strconv.Itoa(opts.RetentionDays),
+		"re-run setup with --worker-url set to the TrackerUrl stack output",
+	}
+}
+
+// writeSAMConfig puts the stack parameters in a private samconfig.toml so the
+// keys never appear on the sam command line, where other users can see them.
+func writeSAMConfig(opts DeployOptions) (string, func(), error) {
+	dir, err := os.MkdirTemp("", "gog-sam-")
+	if err != nil {
+		return "", nil, fmt.Errorf("create sam config dir: %w", err)
+	}
+
+	cleanup := func() { _ = os.RemoveAll(dir) }
+	overrides := fmt.Sprintf("TrackingKey=%q AdminKey=%q RetentionDays=%d", opts.TrackingKey, opts.AdminKey, opts.RetentionDays)
+	body := "version = 0.1\n\n[default.deploy.parameters]\nparameter_overrides = " + strconv.Quote(overrides) + "\n"
+
+	path := filepath.Join(dir, "samconfig.toml")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		cleanup()
+
+		return "", nil, fmt.Errorf("write sam config: %w", err)
+	}
+
+	return path, cleanup, nil
+}
+
+func parseStackURL(out string) (string, error) {
+	var outputs []struct {
+		OutputKey   string `json:"OutputKey"`
+		OutputValue string `json:"OutputValue"`
+	}
+	if err := json.Unmarshal([]byte(out), &outputs); err != nil {
+		return "", fmt.Errorf("parse stack outputs: %w", err)
+	}
+
+	for _, o := range outputs {
+		if o.OutputKey == "TrackerUrl" && o.OutputValue != "" {
+			return strings.TrimRight(o.OutputValue, "/"), nil
+		}
+	}
+
+	return "", errMissingStackURL
+}
+
+// CheckHealth verifies that a tracking endpoint answers GET /health.
+func CheckHealth(ctx context.Context, baseURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(baseURL, "/")+"/health", nil)
+	if err != nil {
+		return fmt.Errorf("build health request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("health check: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check returned %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func runCommandOutput(ctx context.Context, dir, name string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("%s %s failed: %w\n%s", name, args[0], err, strings.TrimSpace(string(out)))
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,125 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestBackendFor(t *testing.T) {
+	cases := map[string]string{"": ProviderCloudflare, "Cloudflare": ProviderCloudflare, "http": ProviderHTTP, " lambda ": ProviderLambda}
+	for in, want := range cases {
+		b, err := BackendFor(in)
+		if err != nil || b.Name() != want {
+			t.Fatalf("BackendFor(%q) = %v, %v; want %s", in, b, err, want)
+		}
+	}
+	if _, err := BackendFor("gcp"); err == nil {
+		t.Fatal("expected error for unknown provider")
+	}
+}
+
+func TestParseStackURL(t *testing.T) {
+	got, err := parseStackURL(`[{"OutputKey":"Other","OutputValue":"x"},{"OutputKey":"TrackerUrl","OutputValue":"https://abc.lambda-url.us-east-1.on.aws/"}]`)
+	if err != nil || got != "https://abc.lambda-url.us-east-1.on.aws" {
+		t.Fatalf("parseStackURL = %q, %v", got, err)
+	}
+	if _, err := parseStackURL(`[]`); err == nil {
+		t.Fatal("expected error without TrackerUrl")
+	}
+}
+
+func TestHTTPBackendDeployChecksHealth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	b, _ := BackendFor(ProviderHTTP)
+	if _, err := b.Deploy(context.Background(), nil, DeployOptions{URL: srv.URL + "/"}); err != nil {
+		t.Fatalf("Deploy: %v", err)
+	}
+	if _, err := b.Deploy(context.Background(), nil, DeployOptions{URL: srv.URL + "/nope"}); err == nil {
+		t.Fatal("expected health check failure")
+	}
+}
+
+func TestLambdaBackendDeployKeepsKeysOffCommandLine(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sam stub uses shell script")
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "template.yaml"), []byte("{}\n"), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+
+	log := filepath.Join(dir, "sam.log")
+	stub := `#!/bin/sh
+echo "$@" >> "` + log + `"
+if [ "$1" = "list" ]; then
+  echo '[{"OutputKey":"TrackerUrl","OutputValue":"https://abc.lambda-url.us-east-1.on.aws/"}]'
+  exit 0
+fi
+while [ $# -gt 0 ]; do
+  if [ "$1" = "--config-file" ]; then
+    while IFS= read -r line; do echo "$line" >> "` + log + `"; done < "$2"
+  fi
+  shift
+done
+`
+	if err := os.WriteFile(filepath.Join(dir, "sam"), []byte(stub), 0o700); err != nil { //nolint:gosec // test stub must be executable
+		t.Fatalf("write sam stub: %v", err)
+	}
+
+	t.Setenv("PATH", dir)
+
+	b, _ := BackendFor(ProviderLambda)
+
+	res, err := b.Deploy(context.Background(), nil, DeployOptions{
+		WorkerDir:     dir,
+		WorkerName:    "gog-tracker",
+		TrackingKey:   "track+key=",
+		AdminKey:      "admin-key",
+		RetentionDays: 30,
+	})
+	if err != nil {
+		t.Fatalf("Deploy: %v", err)
+	}
+
+	if res.URL != "https://abc.lambda-url.us-east-1.on.aws" {
+		t.Fatalf("unexpected url: %q", res.URL)
+	}
+
+	data, err := os.ReadFile(log) //nolint:gosec // test file
+	if err != nil {
+		t.Fatalf("read log: %v", err)
+	}
+
+	var argv, config []string
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if strings.HasPrefix(line, "deploy ") || strings.HasPrefix(line, "list ") {
+			argv = append(argv, line)
+		} else {
+			config = append(config, line)
+		}
+	}
+
+	if strings.Contains(strings.Join(argv, "\n"), "track+key=") || strings.Contains(strings.Join(argv, "\n"), "admin-key") {
+		t.Fatalf("keys passed on the command line: %q", argv)
+	}
+
+	want := `parameter_overrides = "TrackingKey=\"track+key=\" AdminKey=\"admin-key\" RetentionDays=30"`
+	if !strings.Contains(strings.Join(config, "\n"), want) {
+		t.Fatalf("unexpected sam config: %q", config)
+	}
+}
@@ -19,6 +19,7 @@ const trackingConfigVersion = 1
 // Config holds tracking configuration for a single account.
 type Config struct {
 	Enabled          bool   `json:"enabled"`
+	Provider         string `json:"provider,omitempty"` // cloudflare (default), http or lambda
 	WorkerURL        string `json:"worker_url"`
 	WorkerName       string `json:"worker_name,omitempty"`
 	DatabaseName     string `json:"database_name,omitempty"`
@@ -26,6 +27,15 @@ type Config struct {
 	SecretsInKeyring bool   `json:"secrets_in_keyring,omitempty"`
 	TrackingKey      string `json:"tracking_key,omitempty"`
 	AdminKey         string `json:"admin_key,omitempty"`
+
+	// Open-event webhook (forwarded by the worker).
+	WebhookURL         string `json:"webhook_url,omitempty"`
+	WebhookFormat      string `json:"webhook_format,omitempty"`
+	WebhookIncludeBots bool   `json:"webhook_include_bots,omitempty"`
+	WebhookSecret      string `json:"webhook_secret,omitempty"`
+
+	// Days of data the backend keeps before its scheduled purge (0 = forever).
+	RetentionDays int `json:"retention_days,omitempty"`
 }
 
 type fileConfig struct {
@@ -148,6 +158,7 @@ func SaveConfig(account string, cfg *Config) error {
 	if cfg.SecretsInKeyring {
 		toSave.TrackingKey = ""
 		toSave.AdminKey = ""
+		toSave.WebhookSecret = ""
 	}
 
 	fileCfg.Accounts[account] = &toSave
@@ -192,6 +203,15 @@ func hydrateConfig(account string, cfg *Config) (*Config, error) {
 		}
 	}
 
+	if cfg.SecretsInKeyring && strings.TrimSpace(cfg.WebhookURL) != "" && strings.TrimSpace(cfg.WebhookSecret) == "" {
+		webhookSecret, secretErr := LoadWebhookSecret(account)
+		if secretErr != nil {
+			return nil, secretErr
+		}
+
+		cfg.WebhookSecret = webhookSecret
+	}
+
 	return cfg, nil
 }
 
@@ -18,6 +18,7 @@ type PixelPayload struct {
 	Recipient   string `json:"r"`
 	SubjectHash string `json:"s"`
 	SentAt      int64  `json:"t"`
+	URL         string `json:"u,omitempty"` // link target (click tracking only)
 }
 
 // Encrypt encrypts a PixelPayload into a URL-safe base64 blob using AES-GCM
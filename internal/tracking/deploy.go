@@ -9,6 +9,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -16,12 +18,18 @@ type DeployLogger interface {
 	Printf(format string, args ...any)
 }
 
+// DeployOptions configures a backend deploy. WorkerDir and WorkerName are the
+// template directory and deployment name (worker name or stack name).
 type DeployOptions struct {
 	WorkerDir    string
 	WorkerName   string
+	URL          string
 	DatabaseName string
 	TrackingKey  string
 	AdminKey     string
+
+	// RetentionDays enables the scheduled purge of older data (0 = keep).
+	RetentionDays int
 }
 
 var (
@@ -80,7 +88,7 @@ func DeployWorker(ctx context.Context, logger DeployLogger, opts DeployOptions)
 		return "", err
 	}
 
-	if runErr := runWranglerCommand(ctx, workerDir, nil, "d1", "execute", opts.DatabaseName, "--file", "schema.sql", "--remote"); runErr != nil {
+	if runErr := ApplyWorkerSchema(ctx, workerDir, opts.DatabaseName); runErr != nil {
 		return "", runErr
 	}
 
@@ -92,13 +100,13 @@ func DeployWorker(ctx context.Context, logger DeployLogger, opts DeployOptions)
 		return "", runErr
 	}
 
-	configPath, err := writeWranglerConfig(workerDir, opts.WorkerName, opts.DatabaseName, dbID)
-	if err != nil {
-		return "", err
+	if opts.RetentionDays > 0 {
+		if runErr := runWranglerCommand(ctx, workerDir, strings.NewReader(strconv.Itoa(opts.RetentionDays)+"\n"), "secret", "put", "RETENTION_DAYS", "--name", opts.WorkerName); runErr != nil {
+			return "", runErr
+		}
 	}
-	defer os.Remove(configPath)
 
-	if runErr := runWranglerCommand(ctx, workerDir, nil, "deploy", "--config", configPath, "--name", opts.WorkerName); runErr != nil {
+	if runErr := deployWorkerCode(ctx, workerDir, opts.WorkerName, opts.DatabaseName, dbID); runErr != nil {
 		return "", runErr
 	}
 
@@ -109,6 +117,77 @@ func DeployWorker(ctx context.Context, logger DeployLogger, opts DeployOptions)
 	return dbID, nil
 }
 
+// UpgradeWorker redeploys the bundled worker code to an existing deployment
+// without touching its database or secrets.
+func UpgradeWorker(ctx context.Context, workerDir, workerName, dbName, dbID string) error {
+	if _, err := exec.LookPath("wrangler"); err != nil {
+		return errWranglerNotFound
+	}
+
+	workerDir = filepath.Clean(workerDir)
+	if _, err := os.Stat(filepath.Join(workerDir, "wrangler.toml")); err != nil {
+		return fmt.Errorf("%w: %s", errWorkerConfigMissing, workerDir)
+	}
+
+	return deployWorkerCode(ctx, workerDir, workerName, dbName, dbID)
+}
+
+// ApplyWorkerSchema runs the bundled schema.sql (idempotent) against D1.
+func ApplyWorkerSchema(ctx context.Context, workerDir, dbName string) error {
+	return runWranglerCommand(ctx, filepath.Clean(workerDir), nil, "d1", "execute", dbName, "--file", "schema.sql", "--remote")
+}
+
+func deployWorkerCode(ctx context.Context, workerDir, workerName, dbName, dbID string) error {
+	configPath, err := writeWranglerConfig(workerDir, workerName, dbName, dbID)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(configPath)
+
+	return runWranglerCommand(ctx, workerDir, nil, "deploy", "--config", configPath, "--name", workerName)
+}
+
+// PutWorkerSecrets uploads secrets to an already deployed worker. Empty values
+// are skipped.
+func PutWorkerSecrets(ctx context.Context, workerDir, workerName string, values map[string]string) error {
+	if _, err := exec.LookPath("wrangler"); err != nil {
+		return errWranglerNotFound
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if values[name] == "" {
+			continue
+		}
+
+		if err := runWranglerCommand(ctx, filepath.Clean(workerDir), strings.NewReader(values[name]+"\n"), "secret", "put", name, "--name", workerName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteWorkerSecrets removes secrets from a deployed worker.
+func DeleteWorkerSecrets(ctx context.Context, workerDir, workerName string, names ...string) error {
+	if _, err := exec.LookPath("wrangler"); err != nil {
+		return errWranglerNotFound
+	}
+
+	for _, name := range names {
+		if err := runWranglerCommand(ctx, filepath.Clean(workerDir), strings.NewReader("y\n"), "secret", "delete", name, "--name", workerName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func ensureD1Database(ctx context.Context, workerDir, dbName string) (string, error) {
 	out, err := runWranglerCommandOutput(ctx, workerDir, nil, "d1", "create", dbName)
 	if err != nil {
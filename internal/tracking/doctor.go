@@ -0,0 +1,112 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SchemaVersion is the tracking schema bundled with this CLI (worker
+// schema.sql and src/schema.ts).
+const SchemaVersion = 1
+
+var (
+	ErrUnauthorized       = errors.New("unauthorized: admin key may be incorrect")
+	ErrVersionUnsupported = errors.New("tracker does not report a schema version (deployed before versioning)")
+)
+
+// VersionInfo is the schema version of the database and the one the deployed
+// worker code expects.
+type VersionInfo struct {
+	SchemaVersion       int `json:"schema_version"`
+	WorkerSchemaVersion int `json:"worker_schema_version"`
+}
+
+// FetchVersion queries the admin /version endpoint.
+func FetchVersion(ctx context.Context, baseURL, adminKey string) (*VersionInfo, error) {
+	var info VersionInfo
+	if err := adminRequest(ctx, http.MethodGet, baseURL, "/version", adminKey, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// MigrateColumns asks the worker to add columns missing from existing tables
+// and returns the ones it added ("table.column").
+func MigrateColumns(ctx context.Context, baseURL, adminKey string) ([]string, error) {
+	var result struct {
+		Applied []string `json:"applied"`
+	}
+	if err := adminRequest(ctx, http.MethodPost, baseURL, "/migrate", adminKey, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Applied, nil
+}
+
+// CheckPixel fetches a pixel with an invalid tracking ID: the tracker must
+// still serve the GIF, and records nothing.
+func CheckPixel(ctx context.Context, baseURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(baseURL, "/")+"/p/doctor.gif", nil)
+	if err != nil {
+		return fmt.Errorf("build pixel request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("pixel request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("pixel returned %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "image/gif") {
+		return fmt.Errorf("pixel returned content type %q", ct)
+	}
+
+	return nil
+}
+
+func adminRequest(ctx context.Context, method, baseURL, path, adminKey string, out any) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(baseURL, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+adminKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("query tracker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrVersionUnsupported
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tracker returned %d: %s", resp.StatusCode, body)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return nil
+}
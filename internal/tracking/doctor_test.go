@@ -0,0 +1,53 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path != "/version":
+			http.NotFound(w, r)
+		case r.Header.Get("Authorization") != "Bearer admin":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			_, _ = w.Write([]byte(`{"schema_version":0,"worker_schema_version":1}`))
+		}
+	}))
+	defer srv.Close()
+
+	info, err := FetchVersion(context.Background(), srv.URL, "admin")
+	if err != nil || info.SchemaVersion != 0 || info.WorkerSchemaVersion != 1 {
+		t.Fatalf("unexpected version: %#v err=%v", info, err)
+	}
+	if _, err := FetchVersion(context.Background(), srv.URL, "wrong"); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+	if _, err := MigrateColumns(context.Background(), srv.URL, "admin"); !errors.Is(err, ErrVersionUnsupported) {
+		t.Fatalf("expected ErrVersionUnsupported, got %v", err)
+	}
+}
+
+func TestCheckPixel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/p/doctor.gif" {
+			_, _ = w.Write([]byte("ok"))
+			return
+		}
+		w.Header().Set("Content-Type", "image/gif")
+		_, _ = w.Write([]byte("GIF89a"))
+	}))
+	defer srv.Close()
+
+	if err := CheckPixel(context.Background(), srv.URL); err != nil {
+		t.Fatalf("CheckPixel: %v", err)
+	}
+	if err := CheckPixel(context.Background(), srv.URL+"/nested"); err == nil {
+		t.Fatalf("expected content type error")
+	}
+}
@@ -0,0 +1,500 @@
+// gog email tracker for AWS Lambda (function URL, payload v2).
+// Serves the same HTTP API as the Cloudflare worker in ../worker, backed by a
+// single DynamoDB table (pk = event kind, sk = timestamp-ordered key).
+import { DynamoDBClient } from '@aws-sdk/client-dynamodb';
+import { BatchWriteCommand, DynamoDBDocumentClient, PutCommand, QueryCommand } from '@aws-sdk/lib-dynamodb';
+
+const db = DynamoDBDocumentClient.from(new DynamoDBClient({}));
+const TABLE = process.env.TABLE_NAME;
+
+// DynamoDB is schemaless; reported so doctor/migrate see the same API version
+// as the worker (../worker/src/schema.ts).
+const SCHEMA_VERSION = 1;
+
+// 1x1 transparent GIF
+const PIXEL = Buffer.from('R0lGODlhAQABAIAAAP///wAAACH5BAEAAAAALAAAAAABAAEAAAICRAEAOw==', 'base64');
+
+export async function handler(event) {
+  // Daily schedule (template.yaml): drop data older than RETENTION_DAYS.
+  if (event.source === 'aws.events') {
+    const days = Number.parseInt(process.env.RETENTION_DAYS || '', 10);
+    if (days > 0) {
+      const before = new Date(Date.now() - days * 24 * 60 * 60 * 1000).toISOString();
+      console.log('Retention purge:', JSON.stringify(await purgeBefore(before)));
+    }
+    return;
+  }
+
+  const path = event.rawPath || '/';
+  const method = event.requestContext?.http?.method || 'GET';
+  const query = event.queryStringParameters || {};
+
+  try {
+    if (path.startsWith('/p/') && path.endsWith('.gif')) return await handlePixel(event, path.slice(3, -4));
+    if (path.startsWith('/l/')) return await handleLink(event, path.slice(3));
+    if (path.startsWith('/c/')) return await handleClicks(path.slice(3));
+    if (path.startsWith('/q/')) return await handleQuery(path.slice(3));
+    if (path === '/opens') return isAdmin(event) ? await handleAdminOpens(query) : text(401, 'Unauthorized');
+    if (path === '/sends' && method === 'POST') return isAdmin(event) ? await handleRegisterSend(event) : text(401, 'Unauthorized');
+    if (path.startsWith('/group/')) return isAdmin(event) ? await handleGroup(path.slice(7)) : text(401, 'Unauthorized');
+    if (path === '/events') return isAdmin(event) ? await handleEvents(query) : text(401, 'Unauthorized');
+    if (path === '/campaigns') return isAdmin(event) ? await handleCampaigns(query) : text(401, 'Unauthorized');
+    if (path.startsWith('/campaigns/')) return isAdmin(event) ? await handleCampaign(decodeURIComponent(path.slice(11))) : text(401, 'Unauthorized');
+    if (path === '/report') return isAdmin(event) ? await handleReport(query) : text(401, 'Unauthorized');
+    if (path === '/purge' && method === 'POST') return isAdmin(event) ? await handlePurge(query) : text(401, 'Unauthorized');
+    if (path === '/version') return isAdmin(event) ? json({ schema_version: SCHEMA_VERSION, worker_schema_version: SCHEMA_VERSION }) : text(401, 'Unauthorized');
+    if (path === '/migrate' && method === 'POST') return isAdmin(event) ? json({ applied: [] }) : text(401, 'Unauthorized');
+    if (path === '/health') return text(200, 'ok');
+    return text(404, 'Not Found');
+  } catch (error) {
+    console.error('Handler error:', error);
+    return text(500, 'Internal Error');
+  }
+}
+
+async function handlePixel(event, blob) {
+  let payload;
+  try {
+    payload = await decrypt(blob);
+  } catch {
+    return pixel();
+  }
+
+  const meta = requestMeta(event, payload);
+  await put('open', {
+    tracking_id: blob,
+    recipient: payload.r,
+    subject_hash: payload.s,
+    sent_at: meta.sentAt,
+    opened_at: meta.now,
+    ip: meta.ip,
+    user_agent: meta.userAgent,
+    is_bot: meta.isBot,
+    bot_type: meta.botType,
+  }, meta.now).catch(error => console.error('Failed to record open:', error));
+
+  return pixel();
+}
+
+async function handleLink(event, blob) {
+  let payload;
+  try {
+    payload = await decrypt(blob);
+  } catch {
+    return text(400, 'Invalid link');
+  }
+  if (!/^https?:\/\//i.test(payload.u || '')) return text(400, 'Invalid link');
+
+  const meta = requestMeta(event, payload, 'click');
+  await put('click', {
+    recipient: payload.r,
+    subject_hash: payload.s,
+    sent_at: meta.sentAt,
+    url: payload.u,
+    clicked_at: meta.now,
+    ip: meta.ip,
+    user_agent: meta.userAgent,
+    is_bot: meta.isBot,
+    bot_type: meta.botType,
+  }, meta.now).catch(error => console.error('Failed to record click:', error));
+
+  return { statusCode: 302, headers: { Location: payload.u, 'Cache-Control': 'no-store' }, body: '' };
+}
+
+async function handleQuery(blob) {
+  let payload;
+  try {
+    payload = await decrypt(blob);
+  } catch {
+    return text(400, 'Invalid tracking ID');
+  }
+
+  const opens = (await queryKind('open')).filter(o => o.tracking_id === blob).map(o => ({
+    at: o.opened_at,
+    is_bot: o.is_bot,
+    bot_type: o.bot_type,
+    location: null,
+  }));
+  const human = opens.filter(o => !o.is_bot);
+
+  return json({
+    tracking_id: blob,
+    recipient: payload.r,
+    sent_at: new Date(payload.t * 1000).toISOString(),
+    opens,
+    total_opens: opens.length,
+    human_opens: human.length,
+    first_human_open: human[0] || null,
+  });
+}
+
+async function handleClicks(blob) {
+  let payload;
+  try {
+    payload = await decrypt(blob);
+  } catch {
+    return text(400, 'Invalid tracking ID');
+  }
+
+  const sentAt = new Date(payload.t * 1000).toISOString();
+  const clicks = (await queryKind('click'))
+    .filter(c => c.recipient === payload.r && c.subject_hash === payload.s && c.sent_at === sentAt)
+    .map(c => ({ url: c.url, at: c.clicked_at, is_bot: c.is_bot, bot_type: c.bot_type, location: null }));
+
+  return json({
+    tracking_id: blob,
+    recipient: payload.r,
+    sent_at: sentAt,
+    clicks,
+    total_clicks: clicks.length,
+    human_clicks: clicks.filter(c => !c.is_bot).length,
+  });
+}
+
+async function handleAdminOpens(query) {
+  const limit = parseInt(query.limit || '100', 10);
+  const opens = (await queryKind('open', query.since))
+    .filter(o => !query.recipient || o.recipient === query.recipient)
+    .reverse()
+    .slice(0, limit)
+    .map(o => ({
+      tracking_id: o.tracking_id,
+      recipient: o.recipient,
+      subject_hash: o.subject_hash,
+      sent_at: o.sent_at,
+      opened_at: o.opened_at,
+      is_bot: o.is_bot,
+      bot_type: o.bot_type,
+      location: null,
+    }));
+  return json({ opens });
+}
+
+async function handleRegisterSend(event) {
+  let body;
+  try {
+    body = JSON.parse(event.isBase64Encoded ? Buffer.from(event.body, 'base64').toString() : event.body || '');
+  } catch {
+    return text(400, 'Invalid JSON');
+  }
+
+  let payload;
+  try {
+    payload = await decrypt(body.tracking_id || '');
+  } catch {
+    return text(400, 'Invalid tracking ID');
+  }
+
+  const sentAt = new Date(payload.t * 1000).toISOString();
+  await db.send(new PutCommand({
+    TableName: TABLE,
+    Item: {
+      pk: 'send',
+      sk: `${sentAt}#${body.tracking_id}`,
+      tracking_id: body.tracking_id,
+      message_id: body.message_id || null,
+      group_id: body.group_id || null,
+      recipient: payload.r,
+      subject_hash: payload.s,
+      sent_at: sentAt,
+      campaign: body.campaign || null,
+    },
+  }));
+  return { statusCode: 204, body: '' };
+}
+
+const REPORT_KEYS = {
+  recipient: s => s.recipient,
+  subject: s => s.subject_hash,
+  campaign: s => s.campaign || '(none)',
+};
+
+async function handleReport(query) {
+  const groupBy = query.group_by || 'recipient';
+  const keyOf = REPORT_KEYS[groupBy];
+  if (!keyOf) return text(400, 'Invalid group_by');
+  const since = query.since || '1970-01-01T00:00:00Z';
+
+  const [sends, opens, clicks] = await Promise.all([
+    queryKind('send', since),
+    queryKind('open', since),
+    queryKind('click', since),
+  ]);
+  const rows = sendStats(sends, opens, clicks, keyOf)
+    .map(({ first_sent, last_sent, ...row }) => row)
+    .sort((a, b) => b.sent - a.sent || a.key.localeCompare(b.key));
+
+  return json({ group_by: groupBy, since, rows });
+}
+
+async function handleCampaigns(query) {
+  const since = query.since || '1970-01-01T00:00:00Z';
+  const [sends, opens, clicks] = await Promise.all([queryKind('send', since), queryKind('open'), queryKind('click')]);
+  const campaigns = sendStats(sends.filter(s => s.campaign), opens, clicks, s => s.campaign)
+    .map(({ key, ...row }) => ({ campaign: key, ...row }))
+    .sort((a, b) => b.last_sent.localeCompare(a.last_sent));
+  return json({ since, campaigns });
+}
+
+async function handleCampaign(campaign) {
+  const [sends, opens, clicks] = await Promise.all([queryKind('send'), queryKind('open'), queryKind('click')]);
+  const members = sends.filter(s => s.campaign === campaign);
+  if (members.length === 0) return text(404, 'Campaign not found');
+
+  const [{ key, ...summary }] = sendStats(members, opens, clicks, () => campaign);
+  return json({
+    campaign,
+    ...summary,
+    sends: members
+      .sort((a, b) => b.sent_at.localeCompare(a.sent_at) || a.recipient.localeCompare(b.recipient))
+      .map(s => {
+        const own = opens.filter(o => o.tracking_id === s.tracking_id);
+        const ownClicks = clicks.filter(c => clickKey(c) === clickKey(s));
+        return {
+          recipient: s.recipient,
+          message_id: s.message_id || null,
+          tracking_id: s.tracking_id,
+          sent_at: s.sent_at,
+          subject_hash: s.subject_hash,
+          opens: own.length,
+          human_opens: own.filter(o => !o.is_bot).length,
+          clicks: ownClicks.length,
+          human_clicks: ownClicks.filter(c => !c.is_bot).length,
+        };
+      }),
+  });
+}
+
+// Clicks match their send by recipient, subject hash and send time.
+const clickKey = c => `${c.recipient}|${c.subject_hash}|${c.sent_at}`;
+
+// Mirrors SEND_STATS in the worker: per group, how many sends had at least one
+// open/click, raw and excluding bot-flagged events.
+function sendStats(sends, opens, clicks, keyOf) {
+  const opened = new Set(opens.map(o => o.tracking_id));
+  const openedHuman = new Set(opens.filter(o => !o.is_bot).map(o => o.tracking_id));
+  const clicked = new Set(clicks.map(clickKey));
+  const clickedHuman = new Set(clicks.filter(c => !c.is_bot).map(clickKey));
+
+  const groups = new Map();
+  for (const s of sends) {
+    const key = keyOf(s);
+    const row = groups.get(key) || {
+      key, sent: 0, opened: 0, clicked: 0, opened_human: 0, clicked_human: 0, first_sent: s.sent_at, last_sent: s.sent_at,
+    };
+    row.sent++;
+    if (opened.has(s.tracking_id)) row.opened++;
+    if (clicked.has(clickKey(s))) row.clicked++;
+    if (openedHuman.has(s.tracking_id)) row.opened_human++;
+    if (clickedHuman.has(clickKey(s))) row.clicked_human++;
+    if (s.sent_at < row.first_sent) row.first_sent = s.sent_at;
+    if (s.sent_at > row.last_sent) row.last_sent = s.sent_at;
+    groups.set(key, row);
+  }
+  return [...groups.values()];
+}
+
+async function handleGroup(trackingId) {
+  const [sends, opens, clicks] = await Promise.all([queryKind('send'), queryKind('open'), queryKind('click')]);
+  const self = sends.find(s => s.tracking_id === trackingId);
+  if (!self) return text(404, 'Send not registered');
+
+  const members = sends
+    .filter(s => s.tracking_id === trackingId || (self.group_id && s.group_id === self.group_id))
+    .sort((a, b) => a.recipient.localeCompare(b.recipient));
+
+  return json({
+    group_id: self.group_id || null,
+    recipients: members.map(s => {
+      const own = opens.filter(o => o.tracking_id === s.tracking_id);
+      const all = own.map(o => o.opened_at).sort();
+      const human = own.filter(o => !o.is_bot).map(o => o.opened_at).sort();
+      const ownClicks = clicks.filter(c => c.recipient === s.recipient && c.subject_hash === s.subject_hash && c.sent_at === s.sent_at);
+      return {
+        recipient: s.recipient,
+        message_id: s.message_id || null,
+        tracking_id: s.tracking_id,
+        sent_at: s.sent_at,
+        opens: own.length,
+        human_opens: human.length,
+        first_open: all[0] || null,
+        first_human_open: human[0] || null,
+        clicks: ownClicks.length,
+        human_clicks: ownClicks.filter(c => !c.is_bot).length,
+      };
+    }),
+  });
+}
+
+async function handleEvents(query) {
+  const since = query.since || '1970-01-01T00:00:00Z';
+  const limit = Math.min(parseInt(query.limit || '1000', 10) || 1000, 5000);
+  const offset = parseInt(query.offset || '0', 10) || 0;
+
+  const [sends, opens, clicks] = await Promise.all([
+    queryKind('send'),
+    queryKind('open', since),
+    queryKind('click', since),
+  ]);
+  const byTracking = new Map(sends.map(s => [s.tracking_id, s]));
+  const byMessage = new Map(sends.map(s => [`${s.recipient}|${s.subject_hash}|${s.sent_at}`, s]));
+
+  const events = [
+    ...opens.map(o => ({ type: 'open', at: o.opened_at, send: byTracking.get(o.tracking_id), tracking_id: o.tracking_id, ...o })),
+    ...clicks.map(c => {
+      const send = byMessage.get(`${c.recipient}|${c.subject_hash}|${c.sent_at}`);
+      return { type: 'click', at: c.clicked_at, send, tracking_id: send?.tracking_id || null, ...c };
+    }),
+  ].sort((a, b) => a.at.localeCompare(b.at)).slice(offset, offset + limit);
+
+  return json({
+    events: events.map(e => ({
+      type: e.type,
+      at: e.at,
+      tracking_id: e.tracking_id,
+      message_id: e.send?.message_id || null,
+      campaign: e.send?.campaign || null,
+      recipient: e.recipient,
+      subject_hash: e.subject_hash,
+      url: e.url || null,
+      ip: e.ip,
+      user_agent: e.user_agent,
+      country: null,
+      region: null,
+      city: null,
+      is_bot: e.is_bot,
+      bot_type: e.bot_type,
+    })),
+  });
+}
+
+// --- storage -----------------------------------------------------------------
+
+async function put(kind, item, at) {
+  await db.send(new PutCommand({
+    TableName: TABLE,
+    Item: { pk: kind, sk: `${at}#${crypto.randomUUID()}`, ...item },
+  }));
+}
+
+async function queryKind(kind, since) {
+  const items = [];
+  let startKey;
+  do {
+    const out = await db.send(new QueryCommand({
+      TableName: TABLE,
+      KeyConditionExpression: since ? 'pk = :pk AND sk >= :since' : 'pk = :pk',
+      ExpressionAttributeValues: since ? { ':pk': kind, ':since': since } : { ':pk': kind },
+      ExclusiveStartKey: startKey,
+    }));
+    items.push(...(out.Items || []));
+    startKey = out.LastEvaluatedKey;
+  } while (startKey);
+  return items;
+}
+
+async function handlePurge(query) {
+  if (Number.isNaN(Date.parse(query.before || ''))) return text(400, 'Invalid before');
+  return json(await purgeBefore(new Date(query.before).toISOString()));
+}
+
+// Sort keys start with the event timestamp (sent_at for sends), so "older
+// than" is a key range per kind.
+async function purgeBefore(before) {
+  const result = { before };
+  for (const [kind, field] of [['open', 'opens'], ['click', 'clicks'], ['send', 'sends']]) {
+    const keys = [];
+    let startKey;
+    do {
+      const out = await db.send(new QueryCommand({
+        TableName: TABLE,
+        KeyConditionExpression: 'pk = :pk AND sk < :before',
+        ExpressionAttributeValues: { ':pk': kind, ':before': before },
+        ProjectionExpression: 'pk, sk',
+        ExclusiveStartKey: startKey,
+      }));
+      keys.push(...(out.Items || []));
+      startKey = out.LastEvaluatedKey;
+    } while (startKey);
+
+    for (let i = 0; i < keys.length; i += 25) {
+      let requests = keys.slice(i, i + 25).map(Key => ({ DeleteRequest: { Key } }));
+      while (requests.length > 0) {
+        const out = await db.send(new BatchWriteCommand({ RequestItems: { [TABLE]: requests } }));
+        requests = out.UnprocessedItems?.[TABLE] || [];
+      }
+    }
+    result[field] = keys.length;
+  }
+  return result;
+}
+
+// --- helpers -----------------------------------------------------------------
+
+function requestMeta(event, payload, kind = 'open') {
+  const http = event.requestContext?.http || {};
+  const ip = http.sourceIp || 'unknown';
+  const userAgent = http.userAgent || 'unknown';
+  const sentAtMs = payload.t * 1000;
+  const { isBot, botType } = detectBot(userAgent, ip, Date.now() - sentAtMs, kind);
+  return {
+    ip,
+    userAgent,
+    isBot,
+    botType,
+    sentAt: new Date(sentAtMs).toISOString(),
+    now: new Date().toISOString(),
+  };
+}
+
+// Mirrors ../worker/src/bot.ts.
+const SCANNER_UA = /Barracuda|Symantec|Proofpoint|Mimecast|Trend ?Micro|Sophos|Fortinet|FortiGuard|IronPort|Cisco|SafeLinks|Microsoft Defender|Office 365|ZScaler|Forcepoint/i;
+const AUTOMATED_UA = /bot\b|crawler|spider|HeadlessChrome|^curl\/|^Wget\/|python-requests|python-urllib|aiohttp|Go-http-client|^Java\/|okhttp/i;
+
+function detectBot(userAgent, ip, timeSinceDeliveryMs, kind = 'open') {
+  const rapid = timeSinceDeliveryMs !== null && timeSinceDeliveryMs < (kind === 'click' ? 10000 : 2000);
+  if (userAgent.includes('GoogleImageProxy')) {
+    return rapid ? { isBot: true, botType: 'gmail_prefetch' } : { isBot: false, botType: 'gmail_proxy' };
+  }
+  if (['17.', '104.28.'].some(prefix => ip.startsWith(prefix))) return { isBot: true, botType: 'apple_mpp' };
+  if (/Outlook-iOS|Microsoft Outlook|ms-office/.test(userAgent)) return { isBot: true, botType: 'outlook_prefetch' };
+  if (rapid) return { isBot: true, botType: kind === 'click' ? 'link_scanner' : 'prefetch' };
+  if (SCANNER_UA.test(userAgent)) return { isBot: true, botType: 'security_scanner' };
+  if (AUTOMATED_UA.test(userAgent)) return { isBot: true, botType: 'automated' };
+  if (userAgent.trim() === 'Mozilla/5.0') return { isBot: true, botType: 'apple_mpp' };
+  return { isBot: false, botType: null };
+}
+
+function isAdmin(event) {
+  const auth = event.headers?.authorization || event.headers?.Authorization;
+  return !!auth && auth === `Bearer ${process.env.ADMIN_KEY}`;
+}
+
+let cachedKey;
+async function decrypt(blob) {
+  cachedKey ??= await crypto.subtle.importKey(
+    'raw', Buffer.from(process.env.TRACKING_KEY, 'base64'), { name: 'AES-GCM' }, false, ['decrypt']
+  );
+  const combined = Buffer.from(blob, 'base64url');
+  const plaintext = await crypto.subtle.decrypt(
+    { name: 'AES-GCM', iv: combined.subarray(0, 12) }, cachedKey, combined.subarray(12)
+  );
+  return JSON.parse(new TextDecoder().decode(plaintext));
+}
+
+function pixel() {
+  return {
+    statusCode: 200,
+    headers: { 'Content-Type': 'image/gif', 'Cache-Control': 'no-cache, no-store, must-revalidate' },
+    body: PIXEL.toString('base64'),
+    isBase64Encoded: true,
+  };
+}
+
+function json(value) {
+  return { statusCode: 200, headers: { 'Content-Type': 'application/json' }, body: JSON.stringify(value) };
+}
+
+function text(statusCode, body) {
+  return { statusCode, headers: { 'Content-Type': 'text/plain' }, body };
+}
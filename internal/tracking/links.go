@@ -0,0 +1,75 @@
+package tracking
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	anchorHrefPattern = regexp.MustCompile(`(?i)(<a\b[^>]*?\bhref\s*=\s*)("[^"]*"|'[^']*')`)
+	pixelBlobPattern  = regexp.MustCompile(`/p/([A-Za-z0-9_-]+)\.gif`)
+)
+
+// RewriteLinks routes every http(s) anchor in htmlBody through the worker's
+// /l/ redirect so clicks are logged. Each link carries the same recipient,
+// subject hash and send time as the pixel, which is how clicks are matched
+// back to a message. Returns the rewritten HTML and the number of links.
+func RewriteLinks(cfg *Config, htmlBody, recipient, subject string, sentAt time.Time) (string, int, error) {
+	if !cfg.IsConfigured() {
+		return "", 0, errTrackingNotConfigured
+	}
+
+	workerPrefix := strings.TrimRight(cfg.WorkerURL, "/") + "/"
+	subjectHash := hashSubject(subject)
+	count := 0
+	var rewriteErr error
+
+	out := anchorHrefPattern.ReplaceAllStringFunc(htmlBody, func(match string) string {
+		if rewriteErr != nil {
+			return match
+		}
+		parts := anchorHrefPattern.FindStringSubmatch(match)
+		quoted := parts[2]
+		target := strings.TrimSpace(html.UnescapeString(quoted[1 : len(quoted)-1]))
+		lower := strings.ToLower(target)
+		if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+			return match
+		}
+		if strings.HasPrefix(target, workerPrefix) {
+			return match
+		}
+
+		blob, err := Encrypt(&PixelPayload{
+			Recipient:   recipient,
+			SubjectHash: subjectHash,
+			SentAt:      sentAt.Unix(),
+			URL:         target,
+		}, cfg.TrackingKey)
+		if err != nil {
+			rewriteErr = fmt.Errorf("encrypt link: %w", err)
+			return match
+		}
+
+		count++
+		return fmt.Sprintf(`%s"%s/l/%s"`, parts[1], strings.TrimRight(cfg.WorkerURL, "/"), blob)
+	})
+	if rewriteErr != nil {
+		return "", 0, rewriteErr
+	}
+
+	return out, count, nil
+}
+
+// FindTrackingID returns the pixel blob embedded in a sent HTML body, or "".
+func FindTrackingID(cfg *Config, htmlBody string) string {
+	prefix := strings.TrimRight(cfg.WorkerURL, "/")
+	for _, m := range pixelBlobPattern.FindAllStringSubmatchIndex(htmlBody, -1) {
+		if strings.HasSuffix(htmlBody[:m[0]], prefix) {
+			return htmlBody[m[2]:m[3]]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,58 @@
+package tracking
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRewriteLinks(t *testing.T) {
+	key, _ := GenerateKey()
+	cfg := &Config{Enabled: true, WorkerURL: "https://t.example.dev", TrackingKey: key}
+	sentAt := time.Unix(1700000000, 0)
+
+	in := `<p><a href="https://example.com/a?x=1&amp;y=2">A</a> <a class="b" href='http://example.org'>B</a> ` +
+		`<a href="mailto:me@example.com">M</a> <a href="https://t.example.dev/keep">K</a></p>`
+	out, n, err := RewriteLinks(cfg, in, "ada@example.com", "Hello", sentAt)
+	if err != nil {
+		t.Fatalf("RewriteLinks: %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("expected 2 rewritten links, got %d: %s", n, out)
+	}
+	if !strings.Contains(out, `href="mailto:me@example.com"`) || !strings.Contains(out, `href="https://t.example.dev/keep"`) {
+		t.Fatalf("non-http or worker links should be untouched: %s", out)
+	}
+
+	start := strings.Index(out, "https://t.example.dev/l/")
+	if start == -1 {
+		t.Fatalf("missing link URL: %s", out)
+	}
+	blob := out[start+len("https://t.example.dev/l/"):]
+	blob = blob[:strings.IndexByte(blob, '"')]
+	payload, err := Decrypt(blob, key)
+	if err != nil {
+		t.Fatalf("Decrypt: %v", err)
+	}
+	if payload.URL != "https://example.com/a?x=1&y=2" || payload.Recipient != "ada@example.com" ||
+		payload.SentAt != sentAt.Unix() || payload.SubjectHash != hashSubject("Hello") {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+}
+
+func TestFindTrackingID(t *testing.T) {
+	key, _ := GenerateKey()
+	cfg := &Config{Enabled: true, WorkerURL: "https://t.example.dev", TrackingKey: key}
+	pixelURL, blob, err := GeneratePixelURL(cfg, "ada@example.com", "Hello")
+	if err != nil {
+		t.Fatalf("GeneratePixelURL: %v", err)
+	}
+
+	body := `<img src="https://other.example/p/zzz.gif">` + GeneratePixelHTML(pixelURL)
+	if got := FindTrackingID(cfg, body); got != blob {
+		t.Fatalf("FindTrackingID = %q, want %q", got, blob)
+	}
+	if got := FindTrackingID(cfg, "<p>no pixel</p>"); got != "" {
+		t.Fatalf("expected empty id, got %q", got)
+	}
+}
@@ -10,6 +10,12 @@ import (
 
 // GeneratePixelURL creates a tracking pixel URL for an email
 func GeneratePixelURL(cfg *Config, recipient, subject string) (string, string, error) {
+	return GeneratePixelURLAt(cfg, recipient, subject, time.Now())
+}
+
+// GeneratePixelURLAt is GeneratePixelURL with an explicit send time. Links
+// rewritten with the same time correlate with the pixel (see RewriteLinks).
+func GeneratePixelURLAt(cfg *Config, recipient, subject string, sentAt time.Time) (string, string, error) {
 	if !cfg.IsConfigured() {
 		return "", "", errTrackingNotConfigured
 	}
@@ -20,7 +26,7 @@ func GeneratePixelURL(cfg *Config, recipient, subject string) (string, string, e
 	payload := &PixelPayload{
 		Recipient:   recipient,
 		SubjectHash: subjectHash,
-		SentAt:      time.Now().Unix(),
+		SentAt:      sentAt.Unix(),
 	}
 
 	blob, err := Encrypt(payload, cfg.TrackingKey)
@@ -20,6 +20,7 @@ const (
 	legacyAdminKeySecretKey    = "tracking/admin_key"
 	trackingKeySecretSuffix    = "tracking_key"
 	adminKeySecretSuffix       = "admin_key"
+	webhookSecretSuffix        = "webhook_secret"
 )
 
 func SaveSecrets(account, trackingKey, adminKey string) error {
@@ -66,6 +67,37 @@ func LoadSecrets(account string) (trackingKey, adminKey string, err error) {
 	return trackingKey, adminKey, nil
 }
 
+func SaveWebhookSecret(account, secret string) error {
+	account = normalizeAccount(account)
+	if account == "" {
+		return errMissingAccount
+	}
+
+	if err := secrets.SetSecret(scopedSecretKey(account, webhookSecretSuffix), []byte(secret)); err != nil {
+		return fmt.Errorf("store webhook secret: %w", err)
+	}
+
+	return nil
+}
+
+func LoadWebhookSecret(account string) (string, error) {
+	account = normalizeAccount(account)
+	if account == "" {
+		return "", errMissingAccount
+	}
+
+	val, err := secrets.GetSecret(scopedSecretKey(account, webhookSecretSuffix))
+	if err == nil {
+		return string(val), nil
+	}
+
+	if errors.Is(err, keyring.ErrKeyNotFound) {
+		return "", nil
+	}
+
+	return "", fmt.Errorf("read webhook secret: %w", err)
+}
+
 func readSecretWithFallback(primary, legacy string) (string, error) {
 	val, err := secrets.GetSecret(primary)
 	if err == nil {
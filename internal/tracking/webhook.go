@@ -0,0 +1,153 @@
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Webhook formats understood by the worker.
+const (
+	WebhookFormatJSON  = "json"
+	WebhookFormatSlack = "slack"
+)
+
+// Headers set on signed webhook deliveries. The signature is
+// HMAC-SHA256(secret, timestamp + "." + body), hex encoded.
+const (
+	WebhookSignatureHeader = "X-Gog-Signature"
+	WebhookTimestampHeader = "X-Gog-Timestamp"
+)
+
+var (
+	errWebhookNotConfigured = errors.New("tracking webhook not configured")
+	errInvalidWebhookFormat = errors.New("invalid webhook format (use json or slack)")
+)
+
+// WebhookEvent is the payload the worker posts for each open.
+type WebhookEvent struct {
+	Event       string           `json:"event"`
+	TrackingID  string           `json:"tracking_id"`
+	Recipient   string           `json:"recipient"`
+	SubjectHash string           `json:"subject_hash"`
+	SentAt      string           `json:"sent_at"`
+	OpenedAt    string           `json:"opened_at"`
+	IsBot       bool             `json:"is_bot"`
+	BotType     string           `json:"bot_type,omitempty"`
+	Location    *WebhookLocation `json:"location,omitempty"`
+	Test        bool             `json:"test,omitempty"`
+}
+
+type WebhookLocation struct {
+	City    string `json:"city,omitempty"`
+	Region  string `json:"region,omitempty"`
+	Country string `json:"country,omitempty"`
+}
+
+// NormalizeWebhookFormat validates a format name, defaulting to json.
+func NormalizeWebhookFormat(format string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", WebhookFormatJSON:
+		return WebhookFormatJSON, nil
+	case WebhookFormatSlack:
+		return WebhookFormatSlack, nil
+	default:
+		return "", errInvalidWebhookFormat
+	}
+}
+
+// SignWebhookPayload returns the hex HMAC-SHA256 signature for body.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// BuildWebhookBody renders event in the configured format (mirrors the worker).
+func BuildWebhookBody(format string, event *WebhookEvent) ([]byte, error) {
+	if format == WebhookFormatSlack {
+		text := fmt.Sprintf("Email opened by %s (tracking %s)", event.Recipient, shortTrackingID(event.TrackingID))
+		if event.Location != nil && event.Location.City != "" {
+			text += fmt.Sprintf(" from %s, %s", event.Location.City, event.Location.Country)
+		}
+		if event.IsBot {
+			text += fmt.Sprintf(" [bot: %s]", event.BotType)
+		}
+		if event.Test {
+			text = "[test] " + text
+		}
+		return json.Marshal(map[string]string{"text": text})
+	}
+	return json.Marshal(event)
+}
+
+// SendTestWebhook posts a sample open event to the configured webhook, signed
+// the same way the worker signs real events.
+func SendTestWebhook(ctx context.Context, client *http.Client, cfg *Config) (int, error) {
+	if cfg == nil || strings.TrimSpace(cfg.WebhookURL) == "" {
+		return 0, errWebhookNotConfigured
+	}
+	format, err := NormalizeWebhookFormat(cfg.WebhookFormat)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+	body, err := BuildWebhookBody(format, &WebhookEvent{
+		Event:       "open",
+		TrackingID:  "test",
+		Recipient:   "test@example.com",
+		SubjectHash: hashSubject("test"),
+		SentAt:      now.Add(-time.Minute).Format(time.RFC3339),
+		OpenedAt:    now.Format(time.RFC3339),
+		Test:        true,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("encode webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if cfg.WebhookSecret != "" {
+		ts := now.Unix()
+		req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(ts, 10))
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(cfg.WebhookSecret, ts, body))
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook returned %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+func shortTrackingID(id string) string {
+	if len(id) > 12 {
+		return id[:12] + "…"
+	}
+	return id
+}
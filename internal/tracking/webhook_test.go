@@ -0,0 +1,70 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	// Shared with worker/src/webhook.test.ts.
+	got := SignWebhookPayload("secret", 1700000000, []byte(`{"a":1}`))
+	if got != "49f24e537407743fa4a0242bb63b94b9a47ee99cbbe071ccd8a22550ae411686" {
+		t.Fatalf("unexpected signature: %s", got)
+	}
+}
+
+func TestBuildWebhookBodySlack(t *testing.T) {
+	body, err := BuildWebhookBody(WebhookFormatSlack, &WebhookEvent{
+		Event:      "open",
+		TrackingID: "abcdefghijklmnop",
+		Recipient:  "ada@example.com",
+		Location:   &WebhookLocation{City: "London", Country: "GB"},
+	})
+	if err != nil {
+		t.Fatalf("BuildWebhookBody: %v", err)
+	}
+	var payload map[string]string
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if payload["text"] != "Email opened by ada@example.com (tracking abcdefghijkl…) from London, GB" {
+		t.Fatalf("unexpected text: %q", payload["text"])
+	}
+}
+
+func TestSendTestWebhookSigned(t *testing.T) {
+	var gotSig, gotTS string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get(WebhookSignatureHeader)
+		gotTS = r.Header.Get(WebhookTimestampHeader)
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	status, err := SendTestWebhook(context.Background(), srv.Client(), &Config{WebhookURL: srv.URL, WebhookSecret: "s3cret"})
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("SendTestWebhook: status=%d err=%v", status, err)
+	}
+	ts, err := strconv.ParseInt(gotTS, 10, 64)
+	if err != nil {
+		t.Fatalf("bad timestamp %q", gotTS)
+	}
+	if gotSig != "sha256="+SignWebhookPayload("s3cret", ts, gotBody) {
+		t.Fatalf("signature mismatch: %s", gotSig)
+	}
+	if !strings.Contains(string(gotBody), `"test":true`) {
+		t.Fatalf("unexpected body: %s", gotBody)
+	}
+
+	if _, err := SendTestWebhook(context.Background(), nil, &Config{}); err == nil {
+		t.Fatal("expected error without webhook url")
+	}
+}
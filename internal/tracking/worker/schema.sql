@@ -32,3 +32,51 @@ CREATE INDEX IF NOT EXISTS idx_opens_recipient ON opens(recipient);
 CREATE INDEX IF NOT EXISTS idx_opens_sent_at ON opens(sent_at);
 CREATE INDEX IF NOT EXISTS idx_opens_opened_at ON opens(opened_at);
 CREATE INDEX IF NOT EXISTS idx_opens_recipient_subject ON opens(recipient, subject_hash, sent_at);
+
+-- Link clicks (tracked sends with --track-links)
+CREATE TABLE IF NOT EXISTS clicks (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+
+  -- Decrypted from link payload; matches the pixel of the same message
+  recipient TEXT NOT NULL,
+  subject_hash TEXT NOT NULL,
+  sent_at TEXT NOT NULL,
+  url TEXT NOT NULL,
+
+  -- Recorded on click
+  clicked_at TEXT NOT NULL DEFAULT (datetime('now')),
+  ip TEXT,
+  user_agent TEXT,
+  country TEXT,
+  region TEXT,
+  city TEXT,
+  is_bot INTEGER NOT NULL DEFAULT 0,
+  bot_type TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_clicks_message ON clicks(recipient, subject_hash, sent_at);
+CREATE INDEX IF NOT EXISTS idx_clicks_clicked_at ON clicks(clicked_at);
+
+-- Tracked sends (registered by the CLI; denominators for reports)
+CREATE TABLE IF NOT EXISTS sends (
+  tracking_id TEXT PRIMARY KEY,
+  message_id TEXT,
+  group_id TEXT, -- shared by the per-recipient copies of one send
+  recipient TEXT NOT NULL,
+  subject_hash TEXT NOT NULL,
+  sent_at TEXT NOT NULL,
+  campaign TEXT,
+  registered_at TEXT NOT NULL DEFAULT (datetime('now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_sends_sent_at ON sends(sent_at);
+CREATE INDEX IF NOT EXISTS idx_sends_campaign ON sends(campaign);
+CREATE INDEX IF NOT EXISTS idx_sends_group_id ON sends(group_id);
+
+-- Schema version (see SCHEMA_VERSION in src/schema.ts; `gog gmail track doctor`)
+CREATE TABLE IF NOT EXISTS meta (
+  key TEXT PRIMARY KEY,
+  value TEXT NOT NULL
+);
+
+INSERT OR REPLACE INTO meta (key, value) VALUES ('schema_version', '1');
@@ -26,6 +26,30 @@ describe('detectBot', () => {
     expect(result.botType).toBe('prefetch');
   });
 
+  it('flags Gmail proxy fetches right after delivery', () => {
+    const result = detectBot('Mozilla/5.0 (via ggpht.com GoogleImageProxy)', '66.249.88.1', 800);
+    expect(result).toEqual({ isBot: true, botType: 'gmail_prefetch' });
+  });
+
+  it('detects Apple MPP by its bare user agent', () => {
+    expect(detectBot('Mozilla/5.0', '203.0.113.9', 60000)).toEqual({ isBot: true, botType: 'apple_mpp' });
+  });
+
+  it('detects security gateways', () => {
+    expect(detectBot('Mimecast URL Protect', '1.2.3.4', 60000).botType).toBe('security_scanner');
+    expect(detectBot('Mozilla/5.0 Trend Micro', '1.2.3.4', 60000).botType).toBe('security_scanner');
+  });
+
+  it('detects automated clients', () => {
+    expect(detectBot('python-requests/2.31', '1.2.3.4', 60000).botType).toBe('automated');
+    expect(detectBot('Mozilla/5.0 HeadlessChrome/120.0', '1.2.3.4', 60000).botType).toBe('automated');
+  });
+
+  it('uses a longer window for link scanners', () => {
+    expect(detectBot('Mozilla/5.0 Chrome', '1.2.3.4', 5000, 'click')).toEqual({ isBot: true, botType: 'link_scanner' });
+    expect(detectBot('Mozilla/5.0 Chrome', '1.2.3.4', 5000, 'open').isBot).toBe(false);
+  });
+
   it('treats normal opens as human', () => {
     const result = detectBot('Mozilla/5.0 Chrome', '1.2.3.4', 5000);
     expect(result.isBot).toBe(false);
@@ -9,13 +9,52 @@ const APPLE_RELAY_PREFIXES = [
   '104.28.', // Cloudflare for Apple
 ];
 
+// Mail security gateways and link scanners that fetch images/links on delivery.
+const SCANNER_PATTERNS = [
+  /Barracuda/i,
+  /Symantec/i,
+  /Proofpoint/i,
+  /Mimecast/i,
+  /Trend ?Micro/i,
+  /Sophos/i,
+  /Fortinet|FortiGuard/i,
+  /IronPort|Cisco/i,
+  /SafeLinks|Microsoft Defender|Office 365/i,
+  /ZScaler/i,
+  /Forcepoint/i,
+];
+
+// Generic HTTP clients and crawlers; real mail clients never look like this.
+const AUTOMATED_PATTERNS = [
+  /bot\b|crawler|spider/i,
+  /HeadlessChrome/i,
+  /^curl\//i,
+  /^Wget\//i,
+  /python-requests|python-urllib|aiohttp/i,
+  /Go-http-client/i,
+  /^Java\//i,
+  /okhttp/i,
+];
+
+// Opens this soon after sending are prefetches; link scanners are slower.
+const PREFETCH_WINDOW_MS = 2000;
+const LINK_SCAN_WINDOW_MS = 10000;
+
 export function detectBot(
   userAgent: string,
   ip: string,
-  timeSinceDeliveryMs: number | null
+  timeSinceDeliveryMs: number | null,
+  kind: 'open' | 'click' = 'open'
 ): BotDetectionResult {
-  // Gmail Image Proxy = real human (Gmail proxies on their behalf)
+  const rapid = timeSinceDeliveryMs !== null &&
+    timeSinceDeliveryMs < (kind === 'click' ? LINK_SCAN_WINDOW_MS : PREFETCH_WINDOW_MS);
+
+  // Gmail Image Proxy = real human (Gmail proxies on their behalf), unless it
+  // fetched right after delivery.
   if (userAgent.includes('GoogleImageProxy')) {
+    if (rapid) {
+      return { isBot: true, botType: 'gmail_prefetch' };
+    }
     return { isBot: false, botType: 'gmail_proxy' };
   }
 
@@ -31,17 +70,26 @@ export function detectBot(
     return { isBot: true, botType: 'outlook_prefetch' };
   }
 
-  // Time-based detection: opens < 2 seconds after delivery are suspicious
-  if (timeSinceDeliveryMs !== null && timeSinceDeliveryMs < 2000) {
-    return { isBot: true, botType: 'prefetch' };
+  // Time-based detection: opens < 2 seconds (clicks < 10 seconds) after
+  // delivery are suspicious
+  if (rapid) {
+    return { isBot: true, botType: kind === 'click' ? 'link_scanner' : 'prefetch' };
   }
 
   // Security scanners
-  if (userAgent.includes('Barracuda') ||
-      userAgent.includes('Symantec') ||
-      userAgent.includes('Proofpoint')) {
+  if (SCANNER_PATTERNS.some(re => re.test(userAgent))) {
     return { isBot: true, botType: 'security_scanner' };
   }
 
+  if (AUTOMATED_PATTERNS.some(re => re.test(userAgent))) {
+    return { isBot: true, botType: 'automated' };
+  }
+
+  // Apple MPP fetches with a bare "Mozilla/5.0" user agent from relay IPs
+  // outside Apple's own range.
+  if (userAgent.trim() === 'Mozilla/5.0') {
+    return { isBot: true, botType: 'apple_mpp' };
+  }
+
   return { isBot: false, botType: null };
 }
@@ -2,16 +2,29 @@ import type { Env, PixelPayload } from './types';
 import { importKey, decrypt } from './crypto';
 import { detectBot } from './bot';
 import { pixelResponse } from './pixel';
+import { sendWebhook, webhookEnabled } from './webhook';
+import { purgeBefore, retentionCutoff } from './retention';
+import { SCHEMA_VERSION, migrateColumns, schemaVersion } from './schema';
 
 export default {
-  async fetch(request: Request, env: Env): Promise<Response> {
+  async fetch(request: Request, env: Env, ctx: ExecutionContext): Promise<Response> {
     const url = new URL(request.url);
     const path = url.pathname;
 
     try {
       // Pixel endpoint: GET /p/:blob.gif
       if (path.startsWith('/p/') && path.endsWith('.gif')) {
-        return await handlePixel(request, env, path);
+        return await handlePixel(request, env, ctx, path);
+      }
+
+      // Link redirect: GET /l/:blob
+      if (path.startsWith('/l/')) {
+        return await handleLink(request, env, path);
+      }
+
+      // Clicks for a message: GET /c/:blob (pixel blob)
+      if (path.startsWith('/c/')) {
+        return await handleClicks(env, path);
       }
 
       // Query endpoint: GET /q/:blob
@@ -24,6 +37,49 @@ export default {
         return await handleAdminOpens(request, env, url);
       }
 
+      // Admin send registration: POST /sends
+      if (path === '/sends' && request.method === 'POST') {
+        return await handleRegisterSend(request, env);
+      }
+
+      // Admin per-recipient status for a send: GET /group/:tracking_id
+      if (path.startsWith('/group/')) {
+        return await handleGroup(request, env, path);
+      }
+
+      // Admin raw event export: GET /events
+      if (path === '/events') {
+        return await handleEvents(request, env, url);
+      }
+
+      // Admin campaigns: GET /campaigns, GET /campaigns/:name
+      if (path === '/campaigns') {
+        return await handleCampaigns(request, env, url);
+      }
+      if (path.startsWith('/campaigns/')) {
+        return await handleCampaign(request, env, path);
+      }
+
+      // Admin aggregate report: GET /report
+      if (path === '/report') {
+        return await handleReport(request, env, url);
+      }
+
+      // Admin purge: POST /purge?before=<iso>
+      if (path === '/purge' && request.method === 'POST') {
+        return await handlePurge(request, env, url);
+      }
+
+      // Admin schema version: GET /version
+      if (path === '/version') {
+        return await handleVersion(request, env);
+      }
+
+      // Admin in-place column migrations: POST /migrate
+      if (path === '/migrate' && request.method === 'POST') {
+        return await handleMigrate(request, env);
+      }
+
       // Health check
       if (path === '/health') {
         return new Response('ok', { status: 200 });
@@ -35,9 +91,22 @@ export default {
       return new Response('Internal Error', { status: 500 });
     }
   },
+
+  // Cron trigger (wrangler.toml): drop data older than RETENTION_DAYS.
+  async scheduled(_event: ScheduledController, env: Env, ctx: ExecutionContext): Promise<void> {
+    const before = retentionCutoff(env.RETENTION_DAYS, Date.now());
+    if (!before) {
+      return;
+    }
+    ctx.waitUntil(
+      purgeBefore(env, before)
+        .then(result => console.log('Retention purge:', JSON.stringify(result)))
+        .catch(error => console.error('Retention purge failed:', error))
+    );
+  },
 };
 
-async function handlePixel(request: Request, env: Env, path: string): Promise<Response> {
+async function handlePixel(request: Request, env: Env, ctx: ExecutionContext, path: string): Promise<Response> {
   // Extract blob from /p/:blob.gif
   const blob = path.slice(3, -4); // Remove '/p/' and '.gif'
 
@@ -93,9 +162,121 @@ async function handlePixel(request: Request, env: Env, path: string): Promise<Re
     console.error('Failed to record open:', error);
   }
 
+  if (webhookEnabled(env)) {
+    ctx.waitUntil(sendWebhook(env, {
+      event: 'open',
+      tracking_id: blob,
+      recipient: payload.r,
+      subject_hash: payload.s,
+      sent_at: new Date(sentAt).toISOString(),
+      opened_at: openedAt,
+      is_bot: isBot,
+      bot_type: botType ?? undefined,
+      location: cf.city ? { city: cf.city, region: cf.region, country: cf.country } : undefined,
+    }));
+  }
+
   return pixelResponse();
 }
 
+async function handleLink(request: Request, env: Env, path: string): Promise<Response> {
+  const blob = path.slice(3); // Remove '/l/'
+
+  const key = await importKey(env.TRACKING_KEY);
+  let payload: PixelPayload;
+
+  try {
+    payload = await decrypt(blob, key);
+  } catch {
+    return new Response('Invalid link', { status: 400 });
+  }
+
+  // Only redirect to targets we encrypted ourselves (no open redirect).
+  const target = payload.u || '';
+  if (!/^https?:\/\//i.test(target)) {
+    return new Response('Invalid link', { status: 400 });
+  }
+
+  const ip = request.headers.get('CF-Connecting-IP') || 'unknown';
+  const userAgent = request.headers.get('User-Agent') || 'unknown';
+  const cf = (request as any).cf || {};
+  const sentAt = payload.t * 1000;
+  const { isBot, botType } = detectBot(userAgent, ip, Date.now() - sentAt, 'click');
+
+  try {
+    await env.DB.prepare(`
+      INSERT INTO clicks (
+        recipient, subject_hash, sent_at, url, clicked_at,
+        ip, user_agent, country, region, city,
+        is_bot, bot_type
+      ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `).bind(
+      payload.r,
+      payload.s,
+      new Date(sentAt).toISOString(),
+      target,
+      new Date().toISOString(),
+      ip,
+      userAgent,
+      cf.country || null,
+      cf.region || null,
+      cf.city || null,
+      isBot ? 1 : 0,
+      botType
+    ).run();
+  } catch (error) {
+    console.error('Failed to record click:', error);
+  }
+
+  return Response.redirect(target, 302);
+}
+
+async function handleClicks(env: Env, path: string): Promise<Response> {
+  const blob = path.slice(3); // Remove '/c/'
+
+  const key = await importKey(env.TRACKING_KEY);
+  let payload: PixelPayload;
+
+  try {
+    payload = await decrypt(blob, key);
+  } catch {
+    return new Response('Invalid tracking ID', { status: 400 });
+  }
+
+  const sentAt = new Date(payload.t * 1000).toISOString();
+  const result = await env.DB.prepare(`
+    SELECT url, clicked_at, city, region, country, is_bot, bot_type
+    FROM clicks
+    WHERE recipient = ? AND subject_hash = ? AND sent_at = ?
+    ORDER BY clicked_at ASC
+  `).bind(
+    payload.r,
+    payload.s,
+    sentAt
+  ).all();
+
+  const clicks = result.results.map((row: any) => ({
+    url: row.url,
+    at: row.clicked_at,
+    is_bot: row.is_bot === 1,
+    bot_type: row.bot_type,
+    location: row.city ? {
+      city: row.city,
+      region: row.region,
+      country: row.country,
+    } : null,
+  }));
+
+  return Response.json({
+    tracking_id: blob,
+    recipient: payload.r,
+    sent_at: sentAt,
+    clicks,
+    total_clicks: clicks.length,
+    human_clicks: clicks.filter((c: any) => !c.is_bot).length,
+  });
+}
+
 async function handleQuery(request: Request, env: Env, path: string): Promise<Response> {
   const blob = path.slice(3); // Remove '/q/'
 
@@ -144,9 +325,7 @@ async function handleQuery(request: Request, env: Env, path: string): Promise<Re
 }
 
 async function handleAdminOpens(request: Request, env: Env, url: URL): Promise<Response> {
-  // Verify admin key
-  const authHeader = request.headers.get('Authorization');
-  if (!authHeader || authHeader !== `Bearer ${env.ADMIN_KEY}`) {
+  if (!isAdmin(request, env)) {
     return new Response('Unauthorized', { status: 401 });
   }
 
@@ -189,3 +368,289 @@ async function handleAdminOpens(request: Request, env: Env, url: URL): Promise<R
     })),
   });
 }
+
+function isAdmin(request: Request, env: Env): boolean {
+  const authHeader = request.headers.get('Authorization');
+  return !!authHeader && authHeader === `Bearer ${env.ADMIN_KEY}`;
+}
+
+async function handleRegisterSend(request: Request, env: Env): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  let body: { tracking_id?: string; message_id?: string; group_id?: string; campaign?: string };
+  try {
+    body = await request.json();
+  } catch {
+    return new Response('Invalid JSON', { status: 400 });
+  }
+
+  // Recipient, subject hash and send time come from the tracking ID itself so
+  // they always match what the pixel and links record. The subject itself is
+  // never stored.
+  const key = await importKey(env.TRACKING_KEY);
+  let payload: PixelPayload;
+  try {
+    payload = await decrypt(body.tracking_id || '', key);
+  } catch {
+    return new Response('Invalid tracking ID', { status: 400 });
+  }
+
+  await env.DB.prepare(`
+    INSERT OR REPLACE INTO sends (tracking_id, message_id, group_id, recipient, subject_hash, sent_at, campaign)
+    VALUES (?, ?, ?, ?, ?, ?, ?)
+  `).bind(
+    body.tracking_id,
+    body.message_id || null,
+    body.group_id || null,
+    payload.r,
+    payload.s,
+    new Date(payload.t * 1000).toISOString(),
+    body.campaign || null
+  ).run();
+
+  return new Response(null, { status: 204 });
+}
+
+// Per-group send counts over the sends table (alias s): how many sends had at
+// least one open/click, raw and excluding bot-flagged events.
+const SEND_STATS = `
+      COUNT(*) AS sent,
+      SUM(CASE WHEN EXISTS (
+        SELECT 1 FROM opens o WHERE o.tracking_id = s.tracking_id
+      ) THEN 1 ELSE 0 END) AS opened,
+      SUM(CASE WHEN EXISTS (
+        SELECT 1 FROM clicks c
+        WHERE c.recipient = s.recipient AND c.subject_hash = s.subject_hash AND c.sent_at = s.sent_at
+      ) THEN 1 ELSE 0 END) AS clicked,
+      SUM(CASE WHEN EXISTS (
+        SELECT 1 FROM opens o WHERE o.tracking_id = s.tracking_id AND o.is_bot = 0
+      ) THEN 1 ELSE 0 END) AS opened_human,
+      SUM(CASE WHEN EXISTS (
+        SELECT 1 FROM clicks c
+        WHERE c.recipient = s.recipient AND c.subject_hash = s.subject_hash AND c.sent_at = s.sent_at AND c.is_bot = 0
+      ) THEN 1 ELSE 0 END) AS clicked_human`;
+
+const REPORT_GROUPS: Record<string, string> = {
+  recipient: 's.recipient',
+  subject: 's.subject_hash',
+  campaign: "COALESCE(NULLIF(s.campaign, ''), '(none)')",
+};
+
+async function handleReport(request: Request, env: Env, url: URL): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  const groupBy = url.searchParams.get('group_by') || 'recipient';
+  const keyExpr = REPORT_GROUPS[groupBy];
+  if (!keyExpr) {
+    return new Response('Invalid group_by', { status: 400 });
+  }
+  const since = url.searchParams.get('since') || '1970-01-01T00:00:00Z';
+
+  const result = await env.DB.prepare(`
+    SELECT
+      ${keyExpr} AS key,
+      ${SEND_STATS}
+    FROM sends s
+    WHERE s.sent_at >= ?
+    GROUP BY key
+    ORDER BY sent DESC, key ASC
+  `).bind(since).all();
+
+  return Response.json({
+    group_by: groupBy,
+    since,
+    rows: result.results.map((row: any) => ({
+      key: row.key,
+      sent: row.sent,
+      opened: row.opened,
+      clicked: row.clicked,
+      opened_human: row.opened_human,
+      clicked_human: row.clicked_human,
+    })),
+  });
+}
+
+async function handleEvents(request: Request, env: Env, url: URL): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  const since = url.searchParams.get('since') || '1970-01-01T00:00:00Z';
+  const limit = Math.min(parseInt(url.searchParams.get('limit') || '1000', 10) || 1000, 5000);
+  const offset = parseInt(url.searchParams.get('offset') || '0', 10) || 0;
+
+  const result = await env.DB.prepare(`
+    SELECT 'open' AS type, o.opened_at AS at, o.tracking_id AS tracking_id, s.message_id AS message_id,
+      s.campaign AS campaign, o.recipient, o.subject_hash, NULL AS url, o.ip, o.user_agent, o.country, o.region, o.city,
+      o.is_bot, o.bot_type
+    FROM opens o
+    LEFT JOIN sends s ON s.tracking_id = o.tracking_id
+    WHERE o.opened_at >= ?
+    UNION ALL
+    SELECT 'click' AS type, c.clicked_at AS at, s.tracking_id AS tracking_id, s.message_id AS message_id,
+      s.campaign AS campaign, c.recipient, c.subject_hash, c.url, c.ip, c.user_agent, c.country, c.region, c.city,
+      c.is_bot, c.bot_type
+    FROM clicks c
+    LEFT JOIN sends s ON s.recipient = c.recipient AND s.subject_hash = c.subject_hash AND s.sent_at = c.sent_at
+    WHERE c.clicked_at >= ?
+    ORDER BY at ASC
+    LIMIT ? OFFSET ?
+  `).bind(since, since, limit, offset).all();
+
+  return Response.json({
+    events: result.results.map((row: any) => ({
+      type: row.type,
+      at: row.at,
+      tracking_id: row.tracking_id,
+      message_id: row.message_id,
+      campaign: row.campaign,
+      recipient: row.recipient,
+      subject_hash: row.subject_hash,
+      url: row.url,
+      ip: row.ip,
+      user_agent: row.user_agent,
+      country: row.country,
+      region: row.region,
+      city: row.city,
+      is_bot: row.is_bot === 1,
+      bot_type: row.bot_type,
+    })),
+  });
+}
+
+async function handleGroup(request: Request, env: Env, path: string): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  const trackingId = path.slice(7); // Remove '/group/'
+  const result = await env.DB.prepare(`
+    SELECT
+      s.recipient, s.message_id, s.tracking_id, s.group_id, s.sent_at,
+      (SELECT COUNT(*) FROM opens o WHERE o.tracking_id = s.tracking_id) AS opens,
+      (SELECT COUNT(*) FROM opens o WHERE o.tracking_id = s.tracking_id AND o.is_bot = 0) AS human_opens,
+      (SELECT MIN(o.opened_at) FROM opens o WHERE o.tracking_id = s.tracking_id) AS first_open,
+      (SELECT MIN(o.opened_at) FROM opens o WHERE o.tracking_id = s.tracking_id AND o.is_bot = 0) AS first_human_open,
+      (SELECT COUNT(*) FROM clicks c
+        WHERE c.recipient = s.recipient AND c.subject_hash = s.subject_hash AND c.sent_at = s.sent_at) AS clicks,
+      (SELECT COUNT(*) FROM clicks c
+        WHERE c.recipient = s.recipient AND c.subject_hash = s.subject_hash AND c.sent_at = s.sent_at AND c.is_bot = 0) AS human_clicks
+    FROM sends s
+    WHERE s.tracking_id = ?1
+      OR s.group_id = (SELECT group_id FROM sends WHERE tracking_id = ?1 AND group_id IS NOT NULL)
+    ORDER BY s.recipient ASC
+  `).bind(trackingId).all();
+
+  if (result.results.length === 0) {
+    return new Response('Send not registered', { status: 404 });
+  }
+
+  return Response.json({
+    group_id: (result.results[0] as any).group_id,
+    recipients: result.results.map((row: any) => ({
+      recipient: row.recipient,
+      message_id: row.message_id,
+      tracking_id: row.tracking_id,
+      sent_at: row.sent_at,
+      opens: row.opens,
+      human_opens: row.human_opens,
+      first_open: row.first_open,
+      first_human_open: row.first_human_open,
+      clicks: row.clicks,
+      human_clicks: row.human_clicks,
+    })),
+  });
+}
+
+async function handlePurge(request: Request, env: Env, url: URL): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  const before = url.searchParams.get('before') || '';
+  if (Number.isNaN(Date.parse(before))) {
+    return new Response('Invalid before', { status: 400 });
+  }
+
+  return Response.json(await purgeBefore(env, new Date(before).toISOString()));
+}
+
+async function handleCampaigns(request: Request, env: Env, url: URL): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  const since = url.searchParams.get('since') || '1970-01-01T00:00:00Z';
+  const result = await env.DB.prepare(`
+    SELECT
+      s.campaign AS campaign,
+      ${SEND_STATS},
+      MIN(s.sent_at) AS first_sent,
+      MAX(s.sent_at) AS last_sent
+    FROM sends s
+    WHERE s.campaign IS NOT NULL AND s.campaign != '' AND s.sent_at >= ?
+    GROUP BY s.campaign
+    ORDER BY last_sent DESC
+  `).bind(since).all();
+
+  return Response.json({ since, campaigns: result.results });
+}
+
+async function handleCampaign(request: Request, env: Env, path: string): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  const campaign = decodeURIComponent(path.slice(11)); // Remove '/campaigns/'
+  const summary = await env.DB.prepare(`
+    SELECT
+      ${SEND_STATS},
+      MIN(s.sent_at) AS first_sent,
+      MAX(s.sent_at) AS last_sent
+    FROM sends s
+    WHERE s.campaign = ?
+  `).bind(campaign).first<any>();
+
+  if (!summary || summary.sent === 0) {
+    return new Response('Campaign not found', { status: 404 });
+  }
+
+  const sends = await env.DB.prepare(`
+    SELECT
+      s.recipient, s.message_id, s.tracking_id, s.sent_at, s.subject_hash,
+      (SELECT COUNT(*) FROM opens o WHERE o.tracking_id = s.tracking_id) AS opens,
+      (SELECT COUNT(*) FROM opens o WHERE o.tracking_id = s.tracking_id AND o.is_bot = 0) AS human_opens,
+      (SELECT COUNT(*) FROM clicks c
+        WHERE c.recipient = s.recipient AND c.subject_hash = s.subject_hash AND c.sent_at = s.sent_at) AS clicks,
+      (SELECT COUNT(*) FROM clicks c
+        WHERE c.recipient = s.recipient AND c.subject_hash = s.subject_hash AND c.sent_at = s.sent_at AND c.is_bot = 0) AS human_clicks
+    FROM sends s
+    WHERE s.campaign = ?
+    ORDER BY s.sent_at DESC, s.recipient ASC
+  `).bind(campaign).all();
+
+  return Response.json({ campaign, ...summary, sends: sends.results });
+}
+
+async function handleVersion(request: Request, env: Env): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  return Response.json({
+    schema_version: await schemaVersion(env),
+    worker_schema_version: SCHEMA_VERSION,
+  });
+}
+
+async function handleMigrate(request: Request, env: Env): Promise<Response> {
+  if (!isAdmin(request, env)) {
+    return new Response('Unauthorized', { status: 401 });
+  }
+
+  return Response.json({ applied: await migrateColumns(env) });
+}
@@ -0,0 +1,18 @@
+import { describe, it, expect } from 'vitest';
+import { retentionCutoff } from './retention';
+
+describe('retentionCutoff', () => {
+  const now = Date.parse('2025-07-01T00:00:00Z');
+
+  it('keeps data forever when unset or zero', () => {
+    expect(retentionCutoff(undefined, now)).toBeNull();
+    expect(retentionCutoff('', now)).toBeNull();
+    expect(retentionCutoff('0', now)).toBeNull();
+    expect(retentionCutoff('nope', now)).toBeNull();
+  });
+
+  it('returns the cutoff timestamp', () => {
+    expect(retentionCutoff('180', now)).toBe('2025-01-02T00:00:00.000Z');
+    expect(retentionCutoff(' 1 ', now)).toBe('2025-06-30T00:00:00.000Z');
+  });
+});
@@ -0,0 +1,33 @@
+import type { Env } from './types';
+
+export interface PurgeResult {
+  before: string;
+  opens: number;
+  clicks: number;
+  sends: number;
+}
+
+// retentionCutoff returns the ISO timestamp before which data is dropped, or
+// null when RETENTION_DAYS is unset/0 (keep forever).
+export function retentionCutoff(retentionDays: string | undefined, now: number): string | null {
+  const days = Number.parseInt((retentionDays || '').trim(), 10);
+  if (!Number.isFinite(days) || days <= 0) {
+    return null;
+  }
+  return new Date(now - days * 24 * 60 * 60 * 1000).toISOString();
+}
+
+// purgeBefore deletes opens, clicks and registered sends older than before.
+export async function purgeBefore(env: Env, before: string): Promise<PurgeResult> {
+  const [opens, clicks, sends] = await env.DB.batch([
+    env.DB.prepare('DELETE FROM opens WHERE opened_at < ?').bind(before),
+    env.DB.prepare('DELETE FROM clicks WHERE clicked_at < ?').bind(before),
+    env.DB.prepare('DELETE FROM sends WHERE sent_at < ?').bind(before),
+  ]);
+  return {
+    before,
+    opens: opens.meta.changes ?? 0,
+    clicks: clicks.meta.changes ?? 0,
+    sends: sends.meta.changes ?? 0,
+  };
+}
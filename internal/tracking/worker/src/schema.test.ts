@@ -0,0 +1,52 @@
+import { describe, it, expect } from 'vitest';
+import { migrateColumns, schemaVersion } from './schema';
+import type { Env } from './types';
+
+function fakeEnv(tables: Record<string, string[]>, version?: string): { env: Env; executed: string[] } {
+  const executed: string[] = [];
+  const DB = {
+    prepare(sql: string) {
+      return {
+        async all() {
+          const table = /PRAGMA table_info\((\w+)\)/.exec(sql)?.[1] || '';
+          return { results: (tables[table] || []).map(name => ({ name })) };
+        },
+        async run() {
+          executed.push(sql);
+          return {};
+        },
+        async first() {
+          if (version === undefined) {
+            throw new Error('no such table: meta');
+          }
+          return { value: version };
+        },
+      };
+    },
+  };
+  return { env: { DB } as unknown as Env, executed };
+}
+
+describe('migrateColumns', () => {
+  it('adds missing columns to existing tables only', async () => {
+    const { env, executed } = fakeEnv({ sends: ['tracking_id', 'recipient', 'message_id'] });
+    expect(await migrateColumns(env)).toEqual(['sends.group_id']);
+    expect(executed).toEqual(['ALTER TABLE sends ADD COLUMN group_id TEXT']);
+  });
+
+  it('skips tables schema.sql will create', async () => {
+    const { env, executed } = fakeEnv({});
+    expect(await migrateColumns(env)).toEqual([]);
+    expect(executed).toEqual([]);
+  });
+});
+
+describe('schemaVersion', () => {
+  it('reads the recorded version', async () => {
+    expect(await schemaVersion(fakeEnv({}, '1').env)).toBe(1);
+  });
+
+  it('treats unversioned databases as 0', async () => {
+    expect(await schemaVersion(fakeEnv({}).env)).toBe(0);
+  });
+});
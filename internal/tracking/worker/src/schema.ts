@@ -0,0 +1,47 @@
+import type { Env } from './types';
+
+// Bump together with schema.sql (meta.schema_version) and tracking.SchemaVersion
+// in the CLI whenever the schema changes.
+export const SCHEMA_VERSION = 1;
+
+// Columns added to tables after they first shipped. CREATE TABLE IF NOT EXISTS
+// in schema.sql leaves existing tables alone, so these are added in place.
+const COLUMN_MIGRATIONS: Array<{ table: string; column: string; type: string }> = [
+  { table: 'sends', column: 'message_id', type: 'TEXT' },
+  { table: 'sends', column: 'group_id', type: 'TEXT' },
+];
+
+// schemaVersion reads the version recorded by schema.sql; 0 means the
+// database predates versioning.
+export async function schemaVersion(env: Env): Promise<number> {
+  try {
+    const row = await env.DB.prepare("SELECT value FROM meta WHERE key = 'schema_version'").first<{ value: string }>();
+    return row ? Number.parseInt(row.value, 10) || 0 : 0;
+  } catch {
+    return 0;
+  }
+}
+
+// migrateColumns adds missing columns to existing tables and returns the
+// ones it added ("table.column").
+export async function migrateColumns(env: Env): Promise<string[]> {
+  const applied: string[] = [];
+  const columns = new Map<string, Set<string> | null>();
+
+  for (const { table, column, type } of COLUMN_MIGRATIONS) {
+    if (!columns.has(table)) {
+      const info = await env.DB.prepare(`PRAGMA table_info(${table})`).all<{ name: string }>();
+      columns.set(table, info.results.length > 0 ? new Set(info.results.map(row => row.name)) : null);
+    }
+    const existing = columns.get(table);
+    // Missing tables are created complete by schema.sql.
+    if (!existing || existing.has(column)) {
+      continue;
+    }
+    await env.DB.prepare(`ALTER TABLE ${table} ADD COLUMN ${column} ${type}`).run();
+    existing.add(column);
+    applied.push(`${table}.${column}`);
+  }
+
+  return applied;
+}
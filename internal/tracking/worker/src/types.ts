@@ -2,12 +2,18 @@ export interface Env {
   DB: D1Database;
   TRACKING_KEY: string;
   ADMIN_KEY: string;
+  WEBHOOK_URL?: string;
+  WEBHOOK_SECRET?: string;
+  WEBHOOK_FORMAT?: string;
+  WEBHOOK_INCLUDE_BOTS?: string;
+  RETENTION_DAYS?: string;
 }
 
 export interface PixelPayload {
   r: string; // recipient
   s: string; // subject hash (first 6 chars)
   t: number; // sent timestamp (unix)
+  u?: string; // link target (click tracking only)
 }
 
 export interface OpenRecord {
@@ -0,0 +1,30 @@
+import { describe, it, expect } from 'vitest';
+import { buildWebhookBody, signWebhookPayload } from './webhook';
+
+const event = {
+  event: 'open' as const,
+  tracking_id: 'abcdefghijklmnop',
+  recipient: 'ada@example.com',
+  subject_hash: 'abc123',
+  sent_at: '2024-01-01T00:00:00Z',
+  opened_at: '2024-01-01T00:05:00Z',
+  is_bot: false,
+  location: { city: 'London', region: 'England', country: 'GB' },
+};
+
+describe('webhook', () => {
+  it('renders slack text', () => {
+    const body = JSON.parse(buildWebhookBody('slack', event));
+    expect(body.text).toBe('Email opened by ada@example.com (tracking abcdefghijkl…) from London, GB');
+  });
+
+  it('renders raw json by default', () => {
+    expect(JSON.parse(buildWebhookBody(undefined, event)).recipient).toBe('ada@example.com');
+  });
+
+  it('signs timestamp and body like the CLI', async () => {
+    // Matches tracking.SignWebhookPayload("secret", 1700000000, []byte(`{"a":1}`)).
+    const sig = await signWebhookPayload('secret', 1700000000, '{"a":1}');
+    expect(sig).toBe('49f24e537407743fa4a0242bb63b94b9a47ee99cbbe071ccd8a22550ae411686');
+  });
+});
@@ -0,0 +1,72 @@
+import type { Env } from './types';
+
+export interface WebhookEvent {
+  event: 'open';
+  tracking_id: string;
+  recipient: string;
+  subject_hash: string;
+  sent_at: string;
+  opened_at: string;
+  is_bot: boolean;
+  bot_type?: string;
+  location?: { city?: string; region?: string; country?: string };
+}
+
+export function webhookEnabled(env: Env): boolean {
+  return typeof env.WEBHOOK_URL === 'string' && env.WEBHOOK_URL.trim() !== '';
+}
+
+// Mirrors tracking.BuildWebhookBody in the CLI.
+export function buildWebhookBody(format: string | undefined, event: WebhookEvent): string {
+  if ((format || 'json').toLowerCase() === 'slack') {
+    const id = event.tracking_id.length > 12 ? `${event.tracking_id.slice(0, 12)}…` : event.tracking_id;
+    let text = `Email opened by ${event.recipient} (tracking ${id})`;
+    if (event.location?.city) {
+      text += ` from ${event.location.city}, ${event.location.country}`;
+    }
+    if (event.is_bot) {
+      text += ` [bot: ${event.bot_type}]`;
+    }
+    return JSON.stringify({ text });
+  }
+  return JSON.stringify(event);
+}
+
+// HMAC-SHA256(secret, `${timestamp}.${body}`), hex encoded.
+export async function signWebhookPayload(secret: string, timestamp: number, body: string): Promise<string> {
+  const key = await crypto.subtle.importKey(
+    'raw',
+    new TextEncoder().encode(secret),
+    { name: 'HMAC', hash: 'SHA-256' },
+    false,
+    ['sign']
+  );
+  const sig = await crypto.subtle.sign('HMAC', key, new TextEncoder().encode(`${timestamp}.${body}`));
+  return Array.from(new Uint8Array(sig), b => b.toString(16).padStart(2, '0')).join('');
+}
+
+export async function sendWebhook(env: Env, event: WebhookEvent): Promise<void> {
+  if (!webhookEnabled(env)) {
+    return;
+  }
+  if (event.is_bot && env.WEBHOOK_INCLUDE_BOTS !== 'true') {
+    return;
+  }
+
+  const body = buildWebhookBody(env.WEBHOOK_FORMAT, event);
+  const headers: Record<string, string> = { 'Content-Type': 'application/json' };
+  if (env.WEBHOOK_SECRET) {
+    const timestamp = Math.floor(Date.now() / 1000);
+    headers['X-Gog-Timestamp'] = String(timestamp);
+    headers['X-Gog-Signature'] = `sha256=${await signWebhookPayload(env.WEBHOOK_SECRET, timestamp, body)}`;
+  }
+
+  try {
+    const resp = await fetch(env.WEBHOOK_URL as string, { method: 'POST', headers, body });
+    if (!resp.ok) {
+      console.error('Webhook returned', resp.status);
+    }
+  } catch (error) {
+    console.error('Webhook delivery failed:', error);
+  }
+}